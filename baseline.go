@@ -0,0 +1,122 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// previous is the rank and count of a sequence in the baseline
+type previous struct {
+	Rank  int `json:"rank"`
+	Count int `json:"count"`
+}
+
+// baseline is the rank and count of each sequence in earlier results, keyed by
+// the file and the words of the sequence
+type baseline map[string]previous
+
+func baselineKey(file string, words []string) string {
+	return file + "\x00" + strings.Join(words, " ")
+}
+
+// baselineItem is a record or, when there were multiple cutoffs, a section of
+// them
+type baselineItem struct {
+	File      string   `json:"file"`
+	Count     int      `json:"count"`
+	Words     []string `json:"words"`
+	Sequences []record `json:"sequences"`
+}
+
+// readBaseline reads the results written to fn by -output json or ndjson
+func readBaseline(fn string) (baseline, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b := baseline{}
+
+	add := func(file string, rank, count int, words []string) {
+		// sections repeat the sequences of smaller cutoffs at the same ranks
+		if _, ok := b[baselineKey(file, words)]; !ok {
+			b[baselineKey(file, words)] = previous{Rank: rank, Count: count}
+		}
+	}
+
+	// records are ranked separately for each file and sequence size
+	ranks := map[string]int{}
+
+	r := bufio.NewReader(f)
+	dec := json.NewDecoder(r)
+
+	// json output is an array, ndjson is a stream of records
+	if c, err := firstByte(r); err == nil && c == '[' {
+		if _, err = dec.Token(); err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+	}
+
+	for dec.More() {
+		var item baselineItem
+		if err := dec.Decode(&item); err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+
+		if item.Words != nil {
+			group := fmt.Sprintf("%s\x00%d", item.File, len(item.Words))
+			ranks[group]++
+			add(item.File, ranks[group], item.Count, item.Words)
+			continue
+		}
+
+		for i, seq := range item.Sequences {
+			add(item.File, i+1, seq.Count, seq.Words)
+		}
+	}
+
+	return b, nil
+}
+
+// firstByte returns the first byte of r that isn't white space, without
+// consuming it
+func firstByte(r *bufio.Reader) (byte, error) {
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+
+		return c, r.UnreadByte()
+	}
+}
+
+// lookup returns the baseline of the sequence of words in file
+func (b baseline) lookup(file string, words []string) (previous, bool) {
+	prev, ok := b[baselineKey(file, words)]
+	return prev, ok
+}
+
+// changeString describes a change of delta, or "new" if there was nothing to
+// compare with
+func changeString(delta int, ok bool) string {
+	switch {
+	case !ok:
+		return "new"
+	case delta == 0:
+		return "="
+	}
+	return fmt.Sprintf("%+d", delta)
+}
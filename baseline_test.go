@@ -0,0 +1,98 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestReadBaseline(t *testing.T) {
+	tests := []struct {
+		name, data string
+	}{
+		{"json", `[{"count":5,"words":["a","b"]},{"count":3,"words":["b","c"]},{"file":"x","count":1,"words":["b","c"]}]`},
+		{"sections", `[
+  {"n":1,"sequences":[{"count":5,"words":["a","b"]}]},
+  {"n":10,"sequences":[{"count":5,"words":["a","b"]},{"count":3,"words":["b","c"]}]},
+  {"file":"x","n":10,"sequences":[{"count":1,"words":["b","c"]}]}
+]`},
+		{"ndjson", `{"count":5,"words":["a","b"]}
+{"count":3,"words":["b","c"]}
+{"file":"x","count":1,"words":["b","c"]}
+`},
+	}
+
+	dir := t.TempDir()
+
+	for _, test := range tests {
+		fn := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(fn, []byte(test.data), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := readBaseline(fn)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		expect := map[string]previous{
+			baselineKey("", []string{"a", "b"}):  {Rank: 1, Count: 5},
+			baselineKey("", []string{"b", "c"}):  {Rank: 2, Count: 3},
+			baselineKey("x", []string{"b", "c"}): {Rank: 1, Count: 1},
+		}
+
+		if len(b) != len(expect) {
+			t.Errorf("%s: %v != %v", test.name, b, expect)
+		}
+
+		for key, prev := range expect {
+			if b[key] != prev {
+				t.Errorf("%s: %q: %v != %v", test.name, key, b[key], prev)
+			}
+		}
+	}
+
+	fn := filepath.Join(dir, "bad")
+	if err := ioutil.WriteFile(fn, []byte("1 2 3"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readBaseline(fn); err == nil {
+		t.Error("expected error for invalid baseline")
+	}
+}
+
+func TestBaselineTable(t *testing.T) {
+	c := config{
+		baseline: baseline{
+			baselineKey("", []string{"a"}): {Rank: 1, Count: 5},
+			baselineKey("", []string{"b"}): {Rank: 2, Count: 3},
+		},
+	}
+
+	seqs := []*wordseq.Sequence{
+		{Words: []string{"b"}, Count: 6},
+		{Words: []string{"a"}, Count: 5},
+		{Words: []string{"c"}, Count: 1},
+	}
+
+	var buf bytes.Buffer
+	if err := writeTable(&buf, c, "", seqs); err != nil {
+		t.Fatal(err)
+	}
+
+	const expect = "" +
+		" 6   +3   +1 [b]\n" +
+		" 5    =   -1 [a]\n" +
+		" 1  new  new [c]\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
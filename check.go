@@ -0,0 +1,155 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/unicode"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// checked is what -check found of a file: its encoding, how it was determined
+// and how many runes couldn't be decoded, the first on line
+type checked struct {
+	encoding   string
+	confidence string
+	invalid    int
+	line       int
+}
+
+func (ck checked) String() string {
+	if ck.confidence == "" {
+		return ck.encoding
+	}
+
+	s := fmt.Sprintf("%s (%s)", ck.encoding, ck.confidence)
+	if ck.invalid == 0 {
+		return s + ", ok"
+	}
+
+	return fmt.Sprintf("%s, %d decode errors, the first on line %d", s, ck.invalid, ck.line)
+}
+
+// checkFile determines the encoding of the file fn, as when it is counted, and
+// decodes all of it, counting the errors
+func checkFile(fn string, c config) (checked, error) {
+	if c.DetectBytes < 1 {
+		return checked{}, usageErrorf("invalid detection size: %d", c.DetectBytes)
+	}
+
+	in, err := openInput(fn, c.Include, nil)
+	if err != nil {
+		return checked{}, err
+	}
+	defer in.Close()
+
+	br := bufio.NewReader(in)
+	if wordseq.IsState(br) {
+		return checked{encoding: "saved counts"}, nil
+	}
+
+	var ck checked
+	var enc encoding.Encoding
+	var r io.Reader = br
+
+	label := c.Encoding.label(fn)
+	ck.confidence = "given"
+	if label == "" && in.charset != "" {
+		label = in.charset
+		ck.confidence = "declared"
+	}
+
+	if label != "" {
+		if enc, err = htmlindex.Get(label); err != nil {
+			return checked{}, err
+		}
+
+		if ck.encoding, err = htmlindex.Name(enc); err != nil {
+			ck.encoding = label
+		}
+	} else {
+		buf := make([]byte, c.DetectBytes)
+		n, err := io.ReadFull(br, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return checked{}, err
+		}
+
+		enc, ck.encoding, ck.confidence = sniffEncoding(buf[:n])
+		r = io.MultiReader(bytes.NewReader(buf[:n]), br)
+	}
+
+	// decoders replace what they can't decode with U+FFFD, which is also
+	// what invalid utf-8 is read as
+	if enc != encoding.Nop && enc != unicode.UTF8 {
+		r = enc.NewDecoder().Reader(r)
+	}
+
+	rr := bufio.NewReader(r)
+	line := 1
+	for {
+		ch, _, err := rr.ReadRune()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return checked{}, err
+		}
+
+		switch ch {
+		case '\n':
+			line++
+		case utf8.RuneError:
+			if ck.invalid == 0 {
+				ck.line = line
+			}
+			ck.invalid++
+		}
+	}
+
+	return ck, nil
+}
+
+// runCheck writes what checkFile finds of each of files to out, without
+// counting them. It returns an error if any couldn't be read or had decode
+// errors.
+func runCheck(out io.Writer, c config, files []string) error {
+	var failed int
+	for _, fn := range files {
+		ck, err := checkFile(fn, c)
+
+		name := fn
+		if name == "-" {
+			name = "stdin"
+		}
+
+		if err != nil {
+			failed++
+			fmt.Fprintf(out, "%s: error: %v\n", name, err)
+			continue
+		}
+
+		if ck.invalid > 0 {
+			failed++
+		}
+
+		if _, err = fmt.Fprintf(out, "%s: %s\n", name, ck); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return exitErrorf(exitDecode, "%d of %d files had errors", failed, len(files))
+	}
+
+	return nil
+}
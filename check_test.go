@@ -0,0 +1,79 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckFile(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, v := range []struct {
+		data   string
+		label  string
+		expect string
+	}{
+		{"plain text\n", "", "utf-8 (presumed), ok"},
+		{"\xef\xbb\xbfbom\n", "", "utf-8 (certain), ok"},
+		{"caf\xc3\xa9\n", "", "utf-8 (uncertain), ok"},
+		{"caf\xe9\n", "", "windows-1252 (uncertain), ok"},
+		{"a\nb \xe9\nc \xff\n", "utf-8", "utf-8 (given), 2 decode errors, the first on line 2"},
+		{"\xa4", "latin1", "windows-1252 (given), ok"},
+	} {
+		fn := filepath.Join(dir, "a.txt")
+		if err := ioutil.WriteFile(fn, []byte(v.data), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		ck, err := checkFile(fn, config{
+			Encoding:    encodingFlag{all: v.label},
+			DetectBytes: 1024,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := ck.String(); got != v.expect {
+			t.Errorf("%q: %s != %s", v.data, got, v.expect)
+		}
+	}
+}
+
+func TestRunCheck(t *testing.T) {
+	dir := t.TempDir()
+
+	good := filepath.Join(dir, "good.txt")
+	if err := ioutil.WriteFile(good, []byte("fine\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	bad := filepath.Join(dir, "bad.txt")
+	if err := ioutil.WriteFile(bad, []byte("\xff\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{Encoding: encodingFlag{all: "utf-8"}, DetectBytes: 1024}
+
+	var buf bytes.Buffer
+	if err := runCheck(&buf, c, []string{good}); err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := good + ": utf-8 (given), ok\n"; buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	if err := runCheck(&buf, c, []string{good, bad}); err == nil {
+		t.Error("expected error for decode errors")
+	}
+
+	if expect := good + ": utf-8 (given), ok\n" + bad + ": utf-8 (given), 1 decode errors, the first on line 1\n"; buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
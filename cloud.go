@@ -0,0 +1,194 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// the font sizes of the least and most frequent sequences of a cloud, the space
+// kept around each and the height of the headings of multiple clouds
+const (
+	cloudMinFont = 12
+	cloudMaxFont = 64
+	cloudPadding = 2
+	cloudHeading = 24
+)
+
+// cloudMaxWords is the most sequences placed in a cloud, since each is checked
+// against every one placed before it
+const cloudMaxWords = 300
+
+// cloudColors are given to the sequences in turn, from the most frequent
+var cloudColors = []string{"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd", "#8c564b", "#e377c2", "#17becf"}
+
+// cloudWord is a sequence placed in a cloud, centered on x, y
+type cloudWord struct {
+	text       string
+	font       float64
+	x, y, w, h float64
+	color      string
+}
+
+func (a cloudWord) overlaps(b cloudWord) bool {
+	return math.Abs(a.x-b.x)*2 < a.w+b.w && math.Abs(a.y-b.y)*2 < a.h+b.h
+}
+
+// cloud is the placed sequences of a result and the box that holds them
+type cloud struct {
+	heading                string
+	words                  []cloudWord
+	minX, minY, maxX, maxY float64
+}
+
+// layoutCloud places each of seqs, up to the cloudMaxWords most frequent, along
+// a spiral from the center, the most frequent first, at the first position
+// where it doesn't overlap any placed before it. Font sizes are proportional to
+// the counts.
+func layoutCloud(seqs []*wordseq.Sequence) cloud {
+	sorted := make([]*wordseq.Sequence, len(seqs))
+	copy(sorted, seqs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Count > sorted[j].Count
+	})
+
+	if len(sorted) > cloudMaxWords {
+		sorted = sorted[:cloudMaxWords]
+	}
+
+	var cl cloud
+	if len(sorted) == 0 {
+		return cl
+	}
+
+	most, least := sorted[0].Count, sorted[len(sorted)-1].Count
+
+	for i, seq := range sorted {
+		font := float64(cloudMaxFont)
+		if most > least {
+			font = cloudMinFont + (cloudMaxFont-cloudMinFont)*float64(seq.Count-least)/float64(most-least)
+		}
+
+		text := strings.Join(seq.Words, " ")
+
+		// the width of a character is about 0.6 of the font size in most sans
+		// serif fonts
+		word := cloudWord{
+			text:  text,
+			font:  font,
+			w:     0.6*font*float64(utf8.RuneCountInString(text)) + 2*cloudPadding,
+			h:     font + 2*cloudPadding,
+			color: cloudColors[i%len(cloudColors)],
+		}
+
+		for t := 0.0; ; t += 0.1 {
+			// the spiral is wider than it is tall, as are the words
+			word.x = 2 * t * math.Cos(t)
+			word.y = t * math.Sin(t)
+
+			ok := true
+			for _, placed := range cl.words {
+				if word.overlaps(placed) {
+					ok = false
+					break
+				}
+			}
+
+			if ok {
+				break
+			}
+		}
+
+		cl.words = append(cl.words, word)
+
+		cl.minX = math.Min(cl.minX, word.x-word.w/2)
+		cl.maxX = math.Max(cl.maxX, word.x+word.w/2)
+		cl.minY = math.Min(cl.minY, word.y-word.h/2)
+		cl.maxY = math.Max(cl.maxY, word.y+word.h/2)
+	}
+
+	return cl
+}
+
+// writeCloud writes an svg word cloud of the sequences of each result. When
+// there are several results, their clouds are stacked, each under a heading.
+func writeCloud(out io.Writer, c config, results []result) error {
+	var clouds []cloud
+	for _, res := range results {
+		cl := layoutCloud(res.Seqs)
+
+		var heading []string
+		if res.Partial {
+			heading = append(heading, "partial")
+		}
+
+		if res.Approximate {
+			heading = append(heading, "approximate")
+		}
+
+		if c.PerFile {
+			file := res.File
+			if file == "" {
+				file = "total"
+			}
+			heading = append(heading, file)
+		}
+
+		if len(c.SequenceSize) > 1 {
+			heading = append(heading, fmt.Sprintf("size %d", res.Size))
+		}
+
+		cl.heading = strings.Join(heading, " ")
+		clouds = append(clouds, cl)
+	}
+
+	var width, height float64
+	for _, cl := range clouds {
+		width = math.Max(width, cl.maxX-cl.minX)
+		height += cl.maxY - cl.minY
+		if cl.heading != "" {
+			height += cloudHeading
+		}
+	}
+
+	w := bufio.NewWriter(out)
+
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="sans-serif" text-anchor="middle" dominant-baseline="central">`+"\n",
+		math.Ceil(width), math.Ceil(height), math.Ceil(width), math.Ceil(height))
+
+	var top float64
+	for _, cl := range clouds {
+		if cl.heading != "" {
+			fmt.Fprintf(w, `<text x="%.1f" y="%.1f" font-size="%d" fill="#333">`, width/2, top+cloudHeading/2, cloudMinFont)
+			_ = xml.EscapeText(w, []byte(cl.heading)) // #nosec
+			fmt.Fprintln(w, "</text>")
+			top += cloudHeading
+		}
+
+		// each cloud is centered horizontally
+		dx := (width-(cl.maxX-cl.minX))/2 - cl.minX
+		dy := top - cl.minY
+
+		for _, word := range cl.words {
+			fmt.Fprintf(w, `<text x="%.1f" y="%.1f" font-size="%.1f" fill="%s">`, word.x+dx, word.y+dy, word.font, word.color)
+			_ = xml.EscapeText(w, []byte(word.text)) // #nosec
+			fmt.Fprintln(w, "</text>")
+		}
+
+		top += cl.maxY - cl.minY
+	}
+
+	fmt.Fprintln(w, "</svg>")
+
+	return w.Flush()
+}
@@ -0,0 +1,105 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestLayoutCloud(t *testing.T) {
+	seqs := []*wordseq.Sequence{
+		{Words: []string{"a", "b"}, Count: 1},
+		{Words: []string{"the", "cat"}, Count: 10},
+		{Words: []string{"sat", "on"}, Count: 4},
+		{Words: []string{"the", "mat"}, Count: 7},
+		{Words: []string{"x", "y"}, Count: 1},
+	}
+
+	cl := layoutCloud(seqs)
+	if len(cl.words) != len(seqs) {
+		t.Fatalf("%d words != %d", len(cl.words), len(seqs))
+	}
+
+	// the most frequent is placed first, in the center, at the largest size
+	if w := cl.words[0]; w.text != "the cat" || w.x != 0 || w.y != 0 || w.font != cloudMaxFont {
+		t.Errorf("unexpected first word %+v", w)
+	}
+
+	if w := cl.words[len(cl.words)-1]; w.font != cloudMinFont {
+		t.Errorf("%v != %v", w.font, cloudMinFont)
+	}
+
+	for i, a := range cl.words {
+		for _, b := range cl.words[i+1:] {
+			if a.overlaps(b) {
+				t.Errorf("%q overlaps %q", a.text, b.text)
+			}
+		}
+
+		if a.x-a.w/2 < cl.minX || a.x+a.w/2 > cl.maxX || a.y-a.h/2 < cl.minY || a.y+a.h/2 > cl.maxY {
+			t.Errorf("%q is outside of the cloud", a.text)
+		}
+	}
+
+	if cl := layoutCloud(nil); len(cl.words) != 0 {
+		t.Errorf("unexpected words %+v", cl.words)
+	}
+
+	// only the most frequent are placed
+	seqs = nil
+	for i := 0; i < 2*cloudMaxWords; i++ {
+		seqs = append(seqs, &wordseq.Sequence{Words: []string{fmt.Sprint(i)}, Count: i + 1})
+	}
+
+	if cl = layoutCloud(seqs); len(cl.words) != cloudMaxWords || cl.words[0].text != fmt.Sprint(2*cloudMaxWords-1) {
+		t.Errorf("%d words != %d", len(cl.words), cloudMaxWords)
+	}
+}
+
+func TestWriteCloud(t *testing.T) {
+	c := config{SequenceSize: sizesFlag{1, 2}}
+
+	results := []result{
+		{Size: 1, Seqs: []*wordseq.Sequence{{Words: []string{"<b>"}, Count: 2}, {Words: []string{"&"}, Count: 1}}},
+		{Size: 2, Seqs: []*wordseq.Sequence{{Words: []string{"a", "b"}, Count: 1}}},
+	}
+
+	var buf bytes.Buffer
+	if err := writeCloud(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	var texts []string
+	dec := xml.NewDecoder(&buf)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if data, ok := tok.(xml.CharData); ok && len(bytes.TrimSpace(data)) > 0 {
+			texts = append(texts, string(data))
+		}
+	}
+
+	expect := []string{"size 1", "<b>", "&", "size 2", "a b"}
+	if len(texts) != len(expect) {
+		t.Fatalf("%q != %q", texts, expect)
+	}
+
+	for i := range expect {
+		if texts[i] != expect[i] {
+			t.Errorf("%q != %q", texts[i], expect[i])
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// the ansi escape sequences used to color the text output
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+)
+
+// colorTop is how many of the first sequences of a table are highlighted
+const colorTop = 3
+
+// useColor reports whether the text output should be colored as selected by
+// -color. By default it is, unless it isn't written to a terminal or NO_COLOR
+// is set.
+func useColor(c config) (bool, error) {
+	switch c.Color {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		_, noColor := os.LookupEnv("NO_COLOR")
+		return !noColor && c.OutputFile == "" && isTerminal(os.Stdout), nil
+	}
+
+	return false, usageErrorf("invalid -color value: %s", c.Color)
+}
+
+// paint surrounds s with the escape sequence code and a reset
+func paint(s, code string) string {
+	if s == "" {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// highlighter finds the parts of sequences that matched -match or -containing
+type highlighter struct {
+	re    *regexp.Regexp
+	words []string
+}
+
+func newHighlighter(c config) (*highlighter, error) {
+	var h highlighter
+
+	if c.Match != "" {
+		var err error
+		if h.re, err = regexp.Compile(c.Match); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Containing != "" {
+		h.words = strings.Split(c.Containing, ",")
+	}
+
+	return &h, nil
+}
+
+// highlight returns words formatted as with %v, with the parts that matched
+// highlighted
+func (h *highlighter) highlight(words []string) string {
+	text := strings.Join(words, " ")
+
+	// marked are the bytes of text to highlight
+	marked := make([]bool, len(text))
+
+	if h != nil && h.re != nil {
+		for _, loc := range h.re.FindAllStringIndex(text, -1) {
+			for i := loc[0]; i < loc[1]; i++ {
+				marked[i] = true
+			}
+		}
+	}
+
+	var start int
+	for _, word := range words {
+		for _, w := range h.containing() {
+			if strings.EqualFold(word, strings.TrimSpace(w)) {
+				for i := start; i < start+len(word); i++ {
+					marked[i] = true
+				}
+			}
+		}
+		start += len(word) + 1
+	}
+
+	var b strings.Builder
+	b.WriteByte('[')
+	for i := 0; i < len(text); {
+		j := i
+		for j < len(text) && marked[j] == marked[i] {
+			j++
+		}
+
+		if marked[i] {
+			b.WriteString(paint(text[i:j], ansiBold+ansiRed))
+		} else {
+			b.WriteString(text[i:j])
+		}
+		i = j
+	}
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+func (h *highlighter) containing() []string {
+	if h == nil {
+		return nil
+	}
+	return h.words
+}
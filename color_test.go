@@ -0,0 +1,109 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestUseColor(t *testing.T) {
+	tests := []struct {
+		c      config
+		expect bool
+		err    bool
+	}{
+		{c: config{Color: "always"}, expect: true},
+		{c: config{Color: "always", OutputFile: "out.txt"}, expect: true},
+		{c: config{Color: "never"}},
+		{c: config{Color: "auto", OutputFile: "out.txt"}},
+		{c: config{Color: "maybe"}, err: true},
+	}
+
+	for _, test := range tests {
+		got, err := useColor(test.c)
+		if test.err != (err != nil) {
+			t.Errorf("%q: unexpected error %v", test.c.Color, err)
+		}
+
+		if got != test.expect {
+			t.Errorf("%q: %v != %v", test.c.Color, got, test.expect)
+		}
+	}
+
+	if v, ok := os.LookupEnv("NO_COLOR"); ok {
+		defer os.Setenv("NO_COLOR", v)
+	} else {
+		defer os.Unsetenv("NO_COLOR")
+	}
+
+	if err := os.Setenv("NO_COLOR", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := useColor(config{Color: "auto"}); got {
+		t.Error("NO_COLOR should disable color")
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		c      config
+		words  []string
+		expect string
+	}{
+		{config{}, []string{"the", "cat"}, "[the cat]"},
+		{config{Match: "he c"}, []string{"the", "cat"}, "[t\x1b[1m\x1b[31mhe c\x1b[0mat]"},
+		{config{Containing: "Cat,dog"}, []string{"the", "cat", "cats"}, "[the \x1b[1m\x1b[31mcat\x1b[0m cats]"},
+		{config{Match: "^the", Containing: "cat"}, []string{"the", "cat"}, "[\x1b[1m\x1b[31mthe\x1b[0m \x1b[1m\x1b[31mcat\x1b[0m]"},
+	}
+
+	for _, test := range tests {
+		h, err := newHighlighter(test.c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := h.highlight(test.words); got != test.expect {
+			t.Errorf("%q != %q", got, test.expect)
+		}
+	}
+
+	if _, err := newHighlighter(config{Match: "("}); err == nil {
+		t.Error("expected error for invalid -match")
+	}
+}
+
+func TestWriteColor(t *testing.T) {
+	seqs := []*wordseq.Sequence{
+		{Words: []string{"a"}, Count: 12},
+		{Words: []string{"b"}, Count: 9},
+		{Words: []string{"c"}, Count: 9},
+		{Words: []string{"d"}, Count: 1},
+	}
+
+	c := config{TopN: intsFlag{100}, Sort: "count-desc", Color: "always", Overflow: "truncate", Output: "text", Containing: "d"}
+	if err := checkOutput(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeTable(&buf, c, "", seqs); err != nil {
+		t.Fatal(err)
+	}
+
+	// the padding isn't affected by the escape sequences
+	const expect = "" +
+		" \x1b[1m\x1b[33m12\x1b[0m [a]\n" +
+		"  \x1b[1m\x1b[33m9\x1b[0m [b]\n" +
+		"  \x1b[1m\x1b[33m9\x1b[0m [c]\n" +
+		"  \x1b[36m1\x1b[0m [\x1b[1m\x1b[31md\x1b[0m]\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// row is what a column of the text output is given for each sequence
+type row struct {
+	rank int
+	seq  *wordseq.Sequence
+
+	// max is the largest count of the sequences in the table
+	max int
+
+	// prev is the sequence in the -baseline, if ok
+	prev previous
+	ok   bool
+
+	// hl highlights the words when the output is colored
+	hl *highlighter
+}
+
+// column is a field of the text output
+type column struct {
+	value func(r row) string
+
+	// color, if not nil, returns the value with the escape sequences that
+	// color it
+	color func(r row, value string) string
+
+	// left aligned columns are padded on the right, others on the left
+	left bool
+
+	// long columns are shortened or wrapped by -max-width
+	long bool
+}
+
+// columns are the fields that -columns can choose from
+var columns = map[string]column{
+	"rank": {value: func(r row) string {
+		return strconv.Itoa(r.rank)
+	}, color: colorTopRows},
+	"count": {value: func(r row) string {
+		return strconv.Itoa(r.seq.Count)
+	}, color: colorTopRows},
+	"bar": {left: true, value: func(r row) string {
+		return bar(r.seq.Count, r.max)
+	}},
+	"per-million": {value: func(r row) string {
+		return fmt.Sprintf("%.2f", r.seq.PerMillion())
+	}},
+	"percent": {value: func(r row) string {
+		return fmt.Sprintf("%.2f%%", r.seq.Percent())
+	}},
+	"count-change": {value: func(r row) string {
+		return changeString(r.seq.Count-r.prev.Count, r.ok)
+	}, color: colorChange},
+	"rank-change": {value: func(r row) string {
+		return changeString(r.prev.Rank-r.rank, r.ok)
+	}, color: colorChange},
+	"words": {left: true, long: true, value: func(r row) string {
+		return fmt.Sprint(r.seq.Words)
+	}, color: func(r row, _ string) string {
+		return r.hl.highlight(r.seq.Words)
+	}},
+	"context": {left: true, long: true, value: func(r row) string {
+		return r.seq.Context
+	}},
+}
+
+// colorTopRows colors a count or rank, highlighting those of the first rows
+func colorTopRows(r row, value string) string {
+	if r.rank <= colorTop {
+		return paint(value, ansiBold+ansiYellow)
+	}
+	return paint(value, ansiCyan)
+}
+
+// colorChange colors a change from the baseline by its direction
+func colorChange(_ row, value string) string {
+	switch {
+	case value == "new":
+		return paint(value, ansiCyan)
+	case strings.HasPrefix(value, "+"):
+		return paint(value, ansiGreen)
+	case strings.HasPrefix(value, "-"):
+		return paint(value, ansiRed)
+	}
+	return value
+}
+
+// columnNames are the names of the columns in the order they are listed by
+// -columns
+var columnNames = []string{"rank", "count", "bar", "per-million", "percent", "count-change", "rank-change", "words", "context"}
+
+// parseColumns returns the columns of the text output, either those named by
+// -columns or, by default, the count, the columns selected by other flags and
+// the words
+func parseColumns(c config) ([]string, error) {
+	if c.Columns == "" {
+		ret := []string{"count"}
+
+		if c.Bars {
+			ret = append(ret, "bar")
+		}
+		if c.PerMillion {
+			ret = append(ret, "per-million")
+		}
+		if c.Percent {
+			ret = append(ret, "percent")
+		}
+		if c.baseline != nil {
+			ret = append(ret, "count-change", "rank-change")
+		}
+
+		ret = append(ret, "words")
+
+		if c.Context > 0 {
+			ret = append(ret, "context")
+		}
+
+		return ret, nil
+	}
+
+	var ret []string
+	for _, name := range strings.Split(c.Columns, ",") {
+		name = strings.TrimSpace(name)
+
+		if _, ok := columns[name]; !ok {
+			return nil, usageErrorf("invalid column: %s, must be one of: %s", name, strings.Join(columnNames, ", "))
+		}
+
+		switch {
+		case (name == "count-change" || name == "rank-change") && c.baseline == nil:
+			return nil, usageErrorf("-columns %s requires -baseline", name)
+		case name == "context" && c.Context == 0:
+			return nil, usageErrorf("-columns context requires -context")
+		}
+
+		ret = append(ret, name)
+	}
+
+	return ret, nil
+}
+
+// fitWidth returns the lines of s, which is truncated with an ellipsis or, with
+// -overflow wrap, wrapped so that none are wider than width, where 0 is
+// unlimited. Lines are wrapped at a space if there is one.
+func fitWidth(s string, width int, overflow string) []string {
+	runes := []rune(s)
+	if width <= 0 || len(runes) <= width {
+		return []string{s}
+	}
+
+	if overflow != "wrap" {
+		if width == 1 {
+			return []string{"…"}
+		}
+		return []string{string(runes[:width-1]) + "…"}
+	}
+
+	var lines []string
+	for len(runes) > width {
+		n := width
+		for i := width; i > 0; i-- {
+			if runes[i] == ' ' {
+				n = i
+				break
+			}
+		}
+
+		lines = append(lines, strings.TrimRight(string(runes[:n]), " "))
+
+		runes = runes[n:]
+		for len(runes) > 0 && runes[0] == ' ' {
+			runes = runes[1:]
+		}
+	}
+
+	if len(runes) > 0 {
+		lines = append(lines, string(runes))
+	}
+
+	return lines
+}
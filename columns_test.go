@@ -0,0 +1,142 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestParseColumns(t *testing.T) {
+	tests := []struct {
+		c      config
+		expect []string
+		err    bool
+	}{
+		{c: config{}, expect: []string{"count", "words"}},
+		{c: config{Bars: true, Percent: true, Context: 1}, expect: []string{"count", "bar", "percent", "words", "context"}},
+		{c: config{baseline: baseline{}}, expect: []string{"count", "count-change", "rank-change", "words"}},
+		{c: config{Columns: "rank, words,count"}, expect: []string{"rank", "words", "count"}},
+		{c: config{Columns: "count,rank-change", baseline: baseline{}}, expect: []string{"count", "rank-change"}},
+		{c: config{Columns: "count,rank-change"}, err: true},
+		{c: config{Columns: "context"}, err: true},
+		{c: config{Columns: "count,foo"}, err: true},
+		{c: config{Columns: ""}, expect: []string{"count", "words"}},
+	}
+
+	for _, test := range tests {
+		got, err := parseColumns(test.c)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: expected error", test.c.Columns)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("%q: %q != %q", test.c.Columns, got, test.expect)
+		}
+	}
+}
+
+func TestWriteColumns(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c a b c dd"), 2, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{TopN: intsFlag{100}, Output: "text", Sort: "count-desc", Color: "never", Overflow: "truncate", Columns: "rank,words,count,percent"}
+	if err = checkOutput(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := " 1  [a b]   2 33.33%\n" +
+		" 2  [b c]   2 33.33%\n" +
+		" 3  [c a]   1 16.67%\n" +
+		" 4  [c dd]  1 16.67%\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
+
+func TestFitWidth(t *testing.T) {
+	tests := []struct {
+		s        string
+		width    int
+		overflow string
+		expect   []string
+	}{
+		{"[a b c]", 0, "truncate", []string{"[a b c]"}},
+		{"[a b c]", 7, "truncate", []string{"[a b c]"}},
+		{"[a b c]", 5, "truncate", []string{"[a b…"}},
+		{"[aaaaaaaa]", 1, "truncate", []string{"…"}},
+		{"[a b c]", 5, "wrap", []string{"[a b", "c]"}},
+		{"[aaaaaaaa]", 4, "wrap", []string{"[aaa", "aaaa", "a]"}},
+		{"[aa bbbbbbbbb c]", 6, "wrap", []string{"[aa", "bbbbbb", "bbb c]"}},
+		{"[é é é]", 4, "wrap", []string{"[é é", "é]"}},
+	}
+
+	for _, test := range tests {
+		if got := fitWidth(test.s, test.width, test.overflow); !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("%q %d %s: %q != %q", test.s, test.width, test.overflow, got, test.expect)
+		}
+	}
+}
+
+func TestWriteMaxWidth(t *testing.T) {
+	seqs := []*wordseq.Sequence{
+		{Words: []string{strings.Repeat("x", 20)}, Count: 10},
+		{Words: []string{"a"}, Count: 2},
+	}
+
+	tests := []struct {
+		overflow string
+		columns  string
+		expect   string
+	}{
+		{"truncate", "", "" +
+			" 10 [xxxxxx…\n" +
+			"  2 [a]\n"},
+		{"wrap", "", "" +
+			" 10 [xxxxxxx\n" +
+			"    xxxxxxxx\n" +
+			"    xxxxx]\n" +
+			"  2 [a]\n"},
+		{"wrap", "words,count", "" +
+			" [xxxxxxx 10\n" +
+			" xxxxxxxx\n" +
+			" xxxxx]\n" +
+			" [a]      2\n"},
+	}
+
+	for _, test := range tests {
+		c := config{TopN: intsFlag{100}, Output: "text", Sort: "count-desc", Color: "never", MaxWidth: 8, Overflow: test.overflow, Columns: test.columns}
+		if err := checkOutput(&c); err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		if err := writeTable(&buf, c, "", seqs); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.String() != test.expect {
+			t.Errorf("%s %q: %q != %q", test.overflow, test.columns, buf.String(), test.expect)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"text/tabwriter"
+)
+
+// command is a subcommand of nr, such as count
+type command struct {
+	name    string
+	summary string
+
+	// usage is written before the flags by -h, with %[1]s replaced by the
+	// name of the program
+	usage string
+
+	// flags adds the command's flags to fs, storing their values in c
+	flags func(fs *flag.FlagSet, c *config)
+	run   func(c config, args []string) error
+}
+
+// commands are the subcommands of nr, the first of which is run when none is
+// given
+var commands = []*command{
+	{
+		name:    "count",
+		summary: "count the most frequent sequences of words, the default",
+		usage:   countUsage,
+		flags:   countFlags,
+		run:     runCount,
+	},
+	{
+		name:    "serve",
+		summary: "serve an http api that counts the text posted to it",
+		usage:   serveUsage,
+		flags:   serveFlags,
+		run:     runServe,
+	},
+	{
+		name:    "diff",
+		summary: "compare the sequences of two corpora",
+		usage:   diffUsage,
+		flags:   diffFlags,
+		run:     runDiff,
+	},
+	{
+		name:    "merge",
+		summary: "combine the counts saved by count -save-state",
+		usage:   mergeUsage,
+		flags:   mergeFlags,
+		run:     runMerge,
+	},
+	{
+		name:    "generate",
+		summary: "generate text from a Markov model of the counted sequences",
+		usage:   generateUsage,
+		flags:   generateFlags,
+		run:     runGenerate,
+	},
+	{
+		name:    "top",
+		summary: "browse the counted sequences in the terminal",
+		usage:   topUsage,
+		flags:   topFlags,
+		run:     runTop,
+	},
+	{
+		name:    "encodings",
+		summary: "list the encodings that may be given to -encoding",
+		usage:   encodingsUsage,
+		flags:   encodingsFlags,
+		run:     runEncodings,
+	},
+	{
+		name:    "version",
+		summary: "show the version of nr and of the unicode data it uses",
+		usage:   versionUsage,
+		flags:   versionFlags,
+		run:     runVersion,
+	},
+}
+
+const countUsage = `Usage of %[1]s count:
+
+	%[1]s [count] [flags] file1.txt file2.txt ...
+	cat file1.txt | %[1]s
+
+	Counts the most frequent sequences of words. count is the default
+	command and may be omitted unless the first file has the name of a
+	command. '%[1]s help' lists the other commands.
+
+	A filename argument of '-' indicates that stdin should be read. It
+	may appear anywhere among the files, and only its first appearance
+	is read.
+	If no filenames are given, input is assumed to come from stdin.
+	Files compressed with gzip, bzip2 or xz are decompressed automatically
+	and the members of zip and tar archives are read as separate files.
+	Arguments starting with http:// or https:// are fetched, as are
+	objects given as s3://bucket/key or gs://bucket/key, using the
+	credentials of the environment, e.g. AWS_ACCESS_KEY_ID or
+	GOOGLE_APPLICATION_CREDENTIALS.
+	Filenames may be glob patterns. With -r, directories are read
+	recursively and patterns without a directory, such as '*.txt',
+	select which of their files are read.
+	Files are counted in parallel and their counts are combined, so
+	sequences do not span files. An interrupt (Ctrl-C) stops reading
+	and shows the results so far, marked as partial.
+	With -follow, files are read as they grow, as with tail -f, and the
+	results are shown every -interval until interrupted. -listen-raw
+	does the same with the text sent to a socket, each connection being
+	a separate document, and -stream with each line read from a url or
+	socket, such as the messages of a Kafka topic. With -http, the
+	latest results of -watch, -follow, -listen-raw or -stream are also
+	shown in a browser.
+	Counts saved with -save-state may be given as inputs to continue
+	counting from where they were saved.
+
+	Defaults for any flag can be set in ~/.config/nr/config.toml and
+	./.nr.toml, where each key is a flag name, e.g. sequence-size = 2
+	or n = [10, 100]. Values in ./.nr.toml take precedence and flags
+	given on the command line override both.
+
+	The exit status is 0 on success, 2 for invalid flags or arguments,
+	3 when an input can't be found or read, 4 when an input can't be
+	decoded, 5 with -fail-if-empty when no sequences were found and 1
+	for any other error.
+
+flags:
+`
+
+func lookupCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// isFlag reports whether name is a flag of any command
+func isFlag(name string) bool {
+	for _, cmd := range commands {
+		var c config
+		if cmd.flagSet(&c).Lookup(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// findCommand returns the command named by the first of args, or the default
+// command if it isn't one, and the remaining args
+func findCommand(args []string) (*command, []string) {
+	if len(args) > 0 {
+		if cmd := lookupCommand(args[0]); cmd != nil {
+			return cmd, args[1:]
+		}
+	}
+	return commands[0], args
+}
+
+// flagSet returns the flags of cmd, storing their values in c
+func (cmd *command) flagSet(c *config) *flag.FlagSet {
+	fs := flag.NewFlagSet(os.Args[0]+" "+cmd.name, flag.ExitOnError)
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), cmd.usage, os.Args[0]) // #nosec
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(
+		&c.ConfigFile,
+		"config",
+		"",
+		"read default flag values from this toml `file` instead of ~/.config/nr/config.toml and ./.nr.toml",
+	)
+
+	fs.BoolVar(
+		&c.Quiet,
+		"q",
+		false,
+		"only log warnings and errors, not informative messages such as the encodings that were detected",
+	)
+
+	fs.BoolVar(
+		&c.Verbose,
+		"v",
+		false,
+		"also log the details of the work being done, such as the size of each file and how long it took",
+	)
+
+	cmd.flags(fs, c)
+
+	return fs
+}
+
+// help writes the usage of the command named by args, or a list of the
+// commands if there isn't one
+func help(out io.Writer, args []string) error {
+	if len(args) > 0 {
+		cmd := lookupCommand(args[0])
+		if cmd == nil {
+			return usageErrorf("unknown command: %s", args[0])
+		}
+
+		var c config
+		fs := cmd.flagSet(&c)
+		fs.SetOutput(out)
+		fs.Usage()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Usage: %s [command] [flags] [file ...]\n\ncommands:\n", os.Args[0])
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "\t%s\t%s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\thelp\tshow the usage and flags of a command\n")
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(out, "\n'%s help <command>' shows the flags of a command.\n", os.Args[0])
+	return err
+}
+
+func main() {
+	args := os.Args[1:]
+
+	if len(args) > 0 && args[0] == "help" {
+		if err := help(os.Stdout, args[1:]); err != nil {
+			fatal(err)
+		}
+		return
+	}
+
+	cmd, args := findCommand(args)
+
+	var c config
+	fs := cmd.flagSet(&c)
+	_ = fs.Parse(args) // #nosec
+
+	if err := loadConfig(fs, c.ConfigFile); err != nil {
+		fatal(withExit(exitUsage, err))
+	}
+
+	if err := setVerbosity(c); err != nil {
+		fatal(err)
+	}
+
+	if err := cmd.run(c, fs.Args()); err != nil {
+		fatal(err)
+	}
+}
+
+// runCount runs the count command
+func runCount(c config, args []string) error {
+	if c.HTTP != "" {
+		if !c.Watch && !c.Follow && c.ListenRaw == "" && c.Stream == "" {
+			return usageErrorf("-http requires -watch, -follow, -listen-raw or -stream")
+		}
+
+		ln, err := net.Listen("tcp", c.HTTP)
+		if err != nil {
+			return err
+		}
+
+		c.dash = newDashboard()
+		go func() {
+			log.Fatalf("%+v", http.Serve(ln, c.dash))
+		}()
+
+		infof("showing results at http://%s/", ln.Addr())
+	}
+
+	if c.Watch {
+		return watch(c, args)
+	}
+
+	return run(c, args...)
+}
@@ -0,0 +1,80 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFindCommand(t *testing.T) {
+	tests := []struct {
+		args []string
+		name string
+		rest []string
+	}{
+		{nil, "count", nil},
+		{[]string{"a.txt"}, "count", []string{"a.txt"}},
+		{[]string{"-n", "10", "a.txt"}, "count", []string{"-n", "10", "a.txt"}},
+		{[]string{"count", "-n", "10"}, "count", []string{"-n", "10"}},
+		{[]string{"count", "count"}, "count", []string{"count"}},
+	}
+
+	for _, test := range tests {
+		cmd, rest := findCommand(test.args)
+		if cmd.name != test.name {
+			t.Errorf("%v: command(%s) != %s", test.args, cmd.name, test.name)
+		}
+
+		if strings.Join(rest, " ") != strings.Join(test.rest, " ") {
+			t.Errorf("%v: args(%v) != %v", test.args, rest, test.rest)
+		}
+	}
+}
+
+func TestCommandFlags(t *testing.T) {
+	for _, cmd := range commands {
+		var c config
+		fs := cmd.flagSet(&c)
+
+		if fs.Lookup("config") == nil {
+			t.Errorf("%s: missing -config", cmd.name)
+		}
+
+		if err := fs.Parse([]string{"-config", "nr.toml", "a.txt"}); err != nil {
+			t.Errorf("%s: %v", cmd.name, err)
+		}
+
+		if c.ConfigFile != "nr.toml" || fs.NArg() != 1 {
+			t.Errorf("%s: flags weren't parsed", cmd.name)
+		}
+	}
+}
+
+func TestHelp(t *testing.T) {
+	var buf bytes.Buffer
+	if err := help(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cmd := range commands {
+		if !strings.Contains(buf.String(), cmd.summary) {
+			t.Errorf("%s isn't listed", cmd.name)
+		}
+	}
+
+	buf.Reset()
+	if err := help(&buf, []string{"count"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "-sequence-size") {
+		t.Error("count flags weren't shown")
+	}
+
+	if err := help(&buf, []string{"missing"}); err == nil {
+		t.Error("expected error for unknown command")
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// configFiles returns the default config files, from lowest to highest
+// precedence
+func configFiles() []string {
+	var ret []string
+
+	if dir, err := os.UserConfigDir(); err == nil {
+		ret = append(ret, filepath.Join(dir, "nr", "config.toml"))
+	}
+
+	return append(ret, ".nr.toml")
+}
+
+// loadConfig sets the flags in fs that weren't given on the command line to the
+// values in the config files. Each key in a config file is the name of a flag,
+// and those of other commands are ignored. When fn is empty, the default config
+// files are read if they exist.
+func loadConfig(fs *flag.FlagSet, fn string) error {
+	files := configFiles()
+	if fn != "" {
+		files = []string{fn}
+	}
+
+	values := map[string]interface{}{}
+	for _, file := range files {
+		var v map[string]interface{}
+		if _, err := toml.DecodeFile(file, &v); err != nil {
+			if fn == "" && os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		for key, value := range v {
+			if key == "config" || (fs.Lookup(key) == nil && !isFlag(key)) {
+				return usageErrorf("%s: unknown key: %s", file, key)
+			}
+
+			// the config files are shared by every command
+			if fs.Lookup(key) == nil {
+				continue
+			}
+
+			values[key] = value
+		}
+	}
+
+	// flags given on the command line take precedence
+	fs.Visit(func(f *flag.Flag) {
+		delete(values, f.Name)
+	})
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := setFlag(fs.Lookup(key), values[key]); err != nil {
+			return usageErrorf("config: %s: %v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// setFlag sets f to the config file value. Each item of a list is set in turn
+// so that repeatable flags collect all of them, except for lists of integers
+// and sizes which are given as a single comma separated value.
+func setFlag(f *flag.Flag, value interface{}) error {
+	list, ok := value.([]interface{})
+	if !ok {
+		if _, ok = value.(map[string]interface{}); ok {
+			return usageErrorf("unexpected table")
+		}
+		return f.Value.Set(fmt.Sprint(value))
+	}
+
+	values := make([]string, len(list))
+	for i, item := range list {
+		values[i] = fmt.Sprint(item)
+	}
+
+	switch f.Value.(type) {
+	case *intsFlag, *sizesFlag:
+		return f.Value.Set(strings.Join(values, ","))
+	}
+
+	for _, v := range values {
+		if err := f.Value.Set(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "config.toml")
+
+	err := ioutil.WriteFile(fn, []byte(strings.Join([]string{
+		`sequence-size = 2`,
+		`n = [10, 100]`,
+		`output = "json"`,
+		`include = ["*.txt", "*.md"]`,
+		`per-million = true`,
+	}, "\n")), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c config
+	c.TopN = intsFlag{100}
+	c.SequenceSize = sizesFlag{3}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&c.SequenceSize, "sequence-size", "")
+	fs.Var(&c.TopN, "n", "")
+	fs.StringVar(&c.Output, "output", "text", "")
+	fs.Var(&c.Include, "include", "")
+	fs.BoolVar(&c.PerMillion, "per-million", false, "")
+
+	if err = fs.Parse([]string{"-output", "csv"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = loadConfig(fs, fn); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.SequenceSize.String() != "2" {
+		t.Errorf("sequence-size(%s) != 2", c.SequenceSize.String())
+	}
+
+	if c.TopN.String() != "10,100" {
+		t.Errorf("n(%s) != 10,100", c.TopN.String())
+	}
+
+	if c.Output != "csv" {
+		t.Errorf("output(%s) != csv, command line should take precedence", c.Output)
+	}
+
+	if c.Include.String() != "*.txt,*.md" {
+		t.Errorf("include(%s) != *.txt,*.md", c.Include.String())
+	}
+
+	if !c.PerMillion {
+		t.Error("per-million wasn't set")
+	}
+
+	if err = ioutil.WriteFile(fn, []byte(`unknown = 1`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = loadConfig(fs, fn); err == nil {
+		t.Error("expected error for unknown key")
+	}
+
+	if err = loadConfig(fs, filepath.Join(t.TempDir(), "missing.toml")); err == nil {
+		t.Error("expected error for missing config file")
+	}
+}
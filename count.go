@@ -0,0 +1,279 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"time"
+
+	"jrubin.io/nr/stopwords"
+	"jrubin.io/nr/wordseq"
+)
+
+// fileCounter counts the sequences in files as selected by c
+type fileCounter struct {
+	c    config
+	opts []wordseq.Option
+
+	// p, if not nil, is sent the progress of reading the files
+	p *progress
+
+	// stop, when closed, ends the content of every file, as if it had been
+	// completely read, and prevents any more from being started
+	stop <-chan struct{}
+
+	// follow, if not nil, counts every file into its counters, following them
+	// as they grow
+	follow *follower
+
+	// timing, if not nil, is added the time spent in each stage of counting
+	timing *timing
+
+	// langs, if not nil, is added the languages detected in each file
+	langs *languages
+}
+
+// stopReader reads from r until stop is closed, after which it returns io.EOF
+type stopReader struct {
+	io.Reader
+	stop <-chan struct{}
+}
+
+func (r stopReader) Read(b []byte) (int, error) {
+	select {
+	case <-r.stop:
+		return 0, io.EOF
+	default:
+		return r.Reader.Read(b)
+	}
+}
+
+// newCounters returns a Counter for each sequence size. first are options for
+// only the first of them.
+func (fc *fileCounter) newCounters(first ...wordseq.Option) ([]*wordseq.Counter, error) {
+	// every counter reads the same words, only report them once
+	if fc.p != nil {
+		first = append(first, wordseq.WithWords(fc.p.word))
+	}
+
+	counters := make([]*wordseq.Counter, len(fc.c.SequenceSize))
+	for i, size := range fc.c.SequenceSize {
+		o := fc.opts
+		if i == 0 {
+			o = append(o[:len(o):len(o)], first...)
+		}
+
+		var err error
+		if counters[i], err = wordseq.NewCounter(size, o...); err != nil {
+			return nil, err
+		}
+	}
+
+	return counters, nil
+}
+
+// count returns the counts of the sequences in the file fn, with a Counter for
+// each sequence size
+func (fc *fileCounter) count(fn string) ([]*wordseq.Counter, error) {
+	if fc.follow != nil {
+		return fc.follow.count(fc, fn)
+	}
+
+	start := time.Now()
+
+	in, err := openInput(fn, fc.c.Include, fc.p)
+	if err != nil {
+		return nil, withExit(exitInput, err)
+	}
+	defer in.Close()
+
+	// saved counts are continued rather than counted again
+	br := bufio.NewReader(in)
+	if wordseq.IsState(br) {
+		counters, err := stateSizes(fn, br, fc.c.SequenceSize, fc.opts)
+		return counters, withExit(exitInput, err)
+	}
+
+	var (
+		first    []wordseq.Option
+		decoding time.Duration
+		st       wordseq.Timing
+	)
+	if fc.timing != nil {
+		first = append(first, wordseq.WithTiming(&st))
+	}
+
+	var detector *stopwords.Detector
+	if fc.langs != nil {
+		detector = stopwords.NewDetector()
+		first = append(first, wordseq.WithWords(detector.Add))
+	}
+
+	counters, err := fc.newCounters(first...)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the start of the content is counted with -max-bytes
+	var content io.Reader = br
+	if fc.c.MaxBytes > 0 {
+		content = io.LimitReader(br, int64(fc.c.MaxBytes))
+	}
+
+	// ensure that the encoding is converted to utf-8
+	r, err := decode(fn, &input{Reader: content, Closer: in.Closer, charset: in.charset}, fc.c)
+	if err != nil {
+		return nil, withExit(exitDecode, err)
+	}
+
+	r = sample(fn, prepare(fn, r, fc.c), fc.c)
+
+	if fc.timing != nil {
+		r = timedReader{Reader: r, d: &decoding}
+	}
+
+	if fc.stop != nil {
+		r = stopReader{Reader: r, stop: fc.stop}
+	}
+
+	if err = wordseq.AddAll(r, counters...); err != nil {
+		return nil, withExit(exitInput, err)
+	}
+
+	if fc.timing != nil {
+		fc.timing.add(decoding, st, counters[0])
+	}
+
+	if detector != nil {
+		fc.langs.add(detector)
+	}
+
+	if verbosity >= levelVerbose {
+		name := fn
+		if name == "-" {
+			name = "stdin"
+		}
+
+		debugf("%s: counted %d words, %s, in %s", name, counters[0].Words(), byteSize(counters[0].Bytes()), time.Since(start).Round(time.Millisecond))
+	}
+
+	return counters, nil
+}
+
+// counted is the outcome of counting the file at index i
+type counted struct {
+	i        int
+	counters []*wordseq.Counter
+	err      error
+}
+
+// countAll counts each of files using up to jobs concurrent workers. fn is
+// called with the counts of each file in the order they were given.
+func (fc *fileCounter) countAll(files []string, jobs int, fn func(string, []*wordseq.Counter) error) error {
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	if jobs > len(files) {
+		jobs = len(files)
+	}
+
+	queue := make(chan int)
+	results := make(chan counted)
+	done := make(chan struct{})
+	defer close(done)
+
+	// files may finish out of order and their counts are held until their
+	// turn, so a slot is taken for each file until fn has been called with it,
+	// keeping no more than jobs of them in memory however slow one of them is
+	slots := make(chan struct{}, jobs)
+
+	go func() {
+		defer close(queue)
+		for i := range files {
+			select {
+			case slots <- struct{}{}:
+			case <-fc.stop:
+				return
+			case <-done:
+				return
+			}
+
+			select {
+			case queue <- i:
+			case <-fc.stop:
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+	for j := 0; j < jobs; j++ {
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				counters, err := fc.count(files[i])
+				select {
+				case results <- counted{i: i, counters: counters, err: err}:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// files that finish early are held on to until their turn
+	pending := map[int][]*wordseq.Counter{}
+	next := 0
+
+	for res := range results {
+		if res.err != nil {
+			return res.err
+		}
+
+		pending[res.i] = res.counters
+
+		for counters, ok := pending[next]; ok; counters, ok = pending[next] {
+			delete(pending, next)
+
+			if err := fn(files[next], counters); err != nil {
+				return err
+			}
+
+			<-slots
+			next++
+		}
+	}
+
+	return nil
+}
+
+// totals returns the combined counts of every one of files, with a Counter for
+// each sequence size
+func (fc *fileCounter) totals(files []string) ([]*wordseq.Counter, error) {
+	var totals []*wordseq.Counter
+	err := fc.countAll(files, fc.c.Jobs, func(_ string, counters []*wordseq.Counter) error {
+		if totals == nil {
+			totals = counters
+			return nil
+		}
+
+		for i, counter := range counters {
+			totals[i].Merge(counter)
+		}
+		return nil
+	})
+
+	return totals, err
+}
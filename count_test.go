@@ -0,0 +1,253 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestCountAll(t *testing.T) {
+	dir := t.TempDir()
+
+	var files []string
+	for i := 0; i < 20; i++ {
+		fn := filepath.Join(dir, strconv.Itoa(i)+".txt")
+		if err := ioutil.WriteFile(fn, []byte("a b c a b c"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, fn)
+	}
+
+	c := config{
+		SequenceSize: sizesFlag{3},
+		Encoding:     encodingFlag{all: "utf-8"},
+	}
+
+	total, err := wordseq.NewCounter(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fc := fileCounter{c: c}
+
+	var got []string
+	err = fc.countAll(files, 4, func(file string, counters []*wordseq.Counter) error {
+		got = append(got, file)
+		total.Merge(counters[0])
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(got, ",") != strings.Join(files, ",") {
+		t.Errorf("files were not given in order: %v", got)
+	}
+
+	if total.Total() != 4*len(files) {
+		t.Errorf("total(%d) != %d", total.Total(), 4*len(files))
+	}
+
+	err = total.Top(1, func(seq *wordseq.Sequence) error {
+		if seq.Count != 2*len(files) || strings.Join(seq.Words, " ") != "a b c" {
+			t.Errorf("unexpected top sequence %d %v", seq.Count, seq.Words)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files = append(files, filepath.Join(dir, "missing.txt"))
+	err = fc.countAll(files, 4, func(string, []*wordseq.Counter) error {
+		return nil
+	})
+	if err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestCountAllSlowFirst(t *testing.T) {
+	// the first file isn't sent until release is closed
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		_, _ = w.Write([]byte("a b c")) // #nosec
+	}))
+	defer srv.Close()
+
+	const text = "a b c a b c"
+
+	dir := t.TempDir()
+	files := []string{srv.URL + "/slow.txt"}
+	for i := 0; i < 20; i++ {
+		fn := filepath.Join(dir, strconv.Itoa(i)+".txt")
+		if err := ioutil.WriteFile(fn, []byte(text), 0600); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, fn)
+	}
+
+	const jobs = 4
+
+	p := &progress{}
+	fc := fileCounter{
+		c: config{
+			SequenceSize: sizesFlag{3},
+			Encoding:     encodingFlag{all: "utf-8"},
+		},
+		p: p,
+	}
+
+	var got []string
+	errc := make(chan error, 1)
+	go func() {
+		errc <- fc.countAll(files, jobs, func(file string, _ []*wordseq.Counter) error {
+			got = append(got, file)
+			return nil
+		})
+	}()
+
+	// only the files that fit beside the first are counted while it is slow,
+	// the rest wait for their turn rather than being held in memory
+	expect := int64((jobs - 1) * len(text))
+	for start := time.Now(); atomic.LoadInt64(&p.read) < expect && time.Since(start) < 5*time.Second; {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if read := atomic.LoadInt64(&p.read); read != expect {
+		t.Errorf("read %d bytes before the first file, expected %d", read, expect)
+	}
+
+	close(release)
+
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(got, ",") != strings.Join(files, ",") {
+		t.Errorf("files were not given in order: %v", got)
+	}
+}
+
+func TestCountStop(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "a.txt")
+	if err := ioutil.WriteFile(fn, []byte("a b c a b c"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	close(stop)
+
+	fc := fileCounter{
+		c: config{
+			SequenceSize: sizesFlag{3},
+			Encoding:     encodingFlag{all: "utf-8"},
+		},
+		stop: stop,
+	}
+
+	counters, err := fc.count(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if counters[0].Total() != 0 {
+		t.Errorf("total(%d) != 0, reading should have stopped", counters[0].Total())
+	}
+}
+
+func TestCountMaxBytes(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "a.txt")
+	if err := ioutil.WriteFile(fn, []byte("a b c a b c"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		max    bytesFlag
+		expect int
+	}{
+		{0, 4},
+		{5, 1},
+		{3, 0},
+	} {
+		fc := fileCounter{c: config{
+			SequenceSize: sizesFlag{3},
+			Encoding:     encodingFlag{all: "utf-8"},
+			MaxBytes:     v.max,
+		}}
+
+		counters, err := fc.count(fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if counters[0].Total() != v.expect {
+			t.Errorf("%d: total(%d) != %d", v.max, counters[0].Total(), v.expect)
+		}
+	}
+}
+
+func TestCountState(t *testing.T) {
+	dir := t.TempDir()
+
+	fn := filepath.Join(dir, "a.txt")
+	if err := ioutil.WriteFile(fn, []byte("a b c a b c"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	fc := fileCounter{c: config{
+		SequenceSize: sizesFlag{1, 3},
+		Encoding:     encodingFlag{all: "utf-8"},
+	}}
+
+	counters, err := fc.count(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := filepath.Join(dir, "a.nr")
+	if err = saveState(state, counters); err != nil {
+		t.Fatal(err)
+	}
+
+	// saved counts are continued
+	var totals []*wordseq.Counter
+	err = fc.countAll([]string{state, fn}, 2, func(_ string, counters []*wordseq.Counter) error {
+		if totals == nil {
+			totals = counters
+			return nil
+		}
+
+		for i, counter := range counters {
+			totals[i].Merge(counter)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, total := range totals {
+		if total.Total() != 2*counters[i].Total() {
+			t.Errorf("size %d: total(%d) != %d", total.SeqSize(), total.Total(), 2*counters[i].Total())
+		}
+	}
+
+	fc.c.SequenceSize = sizesFlag{2}
+	if _, err = fc.count(state); err == nil {
+		t.Error("expected error for missing sequence size")
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	_ "embed" // for dashboardHTML
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+//go:embed dashboard.html
+var dashboardHTML []byte
+
+// dashboardResult is the sequences of a single file and sequence size as sent
+// to the dashboard
+type dashboardResult struct {
+	File      string   `json:"file,omitempty"`
+	Size      int      `json:"size"`
+	Sequences []record `json:"sequences"`
+}
+
+// dashboard serves a page showing the latest results, which are sent to it
+// with server-sent events as they are updated
+type dashboard struct {
+	mu sync.Mutex
+
+	// data is the json encoded latest results
+	data []byte
+	subs map[chan []byte]struct{}
+}
+
+func newDashboard() *dashboard {
+	return &dashboard{subs: map[chan []byte]struct{}{}}
+}
+
+// update replaces the results shown by the dashboard
+func (d *dashboard) update(c config, results []result) error {
+	ret := make([]dashboardResult, len(results))
+	for i, res := range results {
+		ret[i] = dashboardResult{
+			File:      res.File,
+			Size:      res.Size,
+			Sequences: records(c, res, res.Seqs),
+		}
+	}
+
+	data, err := json.Marshal(ret)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.data = data
+
+	for sub := range d.subs {
+		// slow subscribers only need the latest results
+		select {
+		case <-sub:
+		default:
+		}
+		sub <- data
+	}
+
+	return nil
+}
+
+func (d *dashboard) subscribe() chan []byte {
+	sub := make(chan []byte, 1)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.data != nil {
+		sub <- d.data
+	}
+	d.subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (d *dashboard) unsubscribe(sub chan []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.subs, sub)
+}
+
+func (d *dashboard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(dashboardHTML) // #nosec
+	case "/events":
+		d.events(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// events sends the results, and every update to them, as server-sent events
+func (d *dashboard) events(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	// send the headers before there are any results
+	flusher.Flush()
+
+	sub := d.subscribe()
+	defer d.unsubscribe(sub)
+
+	for {
+		select {
+		case data := <-sub:
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
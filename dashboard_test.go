@@ -0,0 +1,80 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestDashboard(t *testing.T) {
+	d := newDashboard()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close() // #nosec
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), "EventSource") {
+		t.Error("page doesn't subscribe to the events")
+	}
+
+	resp, err = http.Get(srv.URL + "/missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close() // #nosec
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("status(%d) != %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/events", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = http.DefaultClient.Do(req.WithContext(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("unexpected content type %q", ct)
+	}
+
+	c := config{TopN: intsFlag{10}}
+	results := []result{{
+		Size: 2,
+		Seqs: []*wordseq.Sequence{{Words: []string{"a", "b"}, Count: 3}},
+	}}
+
+	if err = d.update(c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expect = `data: [{"size":2,"sequences":[{"count":3,"words":["a","b"]}]}]` + "\n"
+	if line != expect {
+		t.Errorf("%q != %q", line, expect)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"crypto/sha256"
+	"io"
+	"log"
+	"os"
+)
+
+// duplicateInputs returns the indexes of the files that have the same content
+// as an earlier one, mapped to the index of that one. Only local files are
+// compared, and only those that are the same size as another are read.
+func duplicateInputs(files []string) (map[int]int, error) {
+	bySize := map[int64][]int{}
+	for i, fn := range files {
+		if fn == "-" || isURL(fn) {
+			continue
+		}
+
+		// files that can't be read are reported when they are counted
+		fi, err := os.Stat(fn)
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+
+		bySize[fi.Size()] = append(bySize[fi.Size()], i)
+	}
+
+	dups := map[int]int{}
+	for _, same := range bySize {
+		if len(same) < 2 {
+			continue
+		}
+
+		first := map[[sha256.Size]byte]int{}
+		for _, i := range same {
+			sum, err := hashFile(files[i])
+			if err != nil {
+				return nil, withExit(exitInput, err)
+			}
+
+			if j, ok := first[sum]; ok {
+				dups[i] = j
+				continue
+			}
+			first[sum] = i
+		}
+	}
+
+	return dups, nil
+}
+
+// hashFile returns the sha256 of the content of fn
+func hashFile(fn string) ([sha256.Size]byte, error) {
+	var sum [sha256.Size]byte
+
+	f, err := os.Open(fn)
+	if err != nil {
+		return sum, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return sum, err
+	}
+
+	copy(sum[:], h.Sum(nil))
+	return sum, nil
+}
+
+// dedupeInputs warns about the files that have the same content as an earlier
+// one, as they would be counted twice, or with -dedupe-inputs, removes them
+func dedupeInputs(c config, files []string) ([]string, error) {
+	dups, err := duplicateInputs(files)
+	if err != nil || len(dups) == 0 {
+		return files, err
+	}
+
+	ret := make([]string, 0, len(files)-len(dups))
+	for i, fn := range files {
+		j, ok := dups[i]
+		switch {
+		case !ok:
+			ret = append(ret, fn)
+		case c.DedupeInputs:
+			infof("skipping %s, it is the same as %s", fn, files[j])
+		default:
+			ret = append(ret, fn)
+			log.Printf("%s is the same as %s, its sequences are counted again (skip it with -dedupe-inputs)", fn, files[j])
+		}
+	}
+
+	return ret, nil
+}
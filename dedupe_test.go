@@ -0,0 +1,55 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDedupeInputs(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"a.txt": "a b c",
+		"b.txt": "a b c",
+		"c.txt": "a b d",
+		"d.txt": "a b",
+	}
+	for name, text := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path := func(names ...string) []string {
+		ret := make([]string, len(names))
+		for i, name := range names {
+			ret[i] = filepath.Join(dir, name)
+		}
+		return ret
+	}
+
+	for _, v := range []struct {
+		files  []string
+		dedupe bool
+		expect []string
+	}{
+		{path("a.txt", "b.txt", "c.txt", "d.txt"), false, path("a.txt", "b.txt", "c.txt", "d.txt")},
+		{path("a.txt", "b.txt", "c.txt", "d.txt"), true, path("a.txt", "c.txt", "d.txt")},
+		{path("b.txt", "c.txt", "a.txt", "b.txt"), true, path("b.txt", "c.txt")},
+		{append([]string{"-"}, path("missing.txt", "c.txt")...), true, append([]string{"-"}, path("missing.txt", "c.txt")...)},
+	} {
+		got, err := dedupeInputs(config{DedupeInputs: v.dedupe}, v.files)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(got, v.expect) {
+			t.Errorf("%v != %v", got, v.expect)
+		}
+	}
+}
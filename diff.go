@@ -0,0 +1,380 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"jrubin.io/nr/wordseq"
+)
+
+const diffUsage = `Usage of %[1]s diff:
+
+	%[1]s diff [flags] corpusA corpusB
+
+	Compares the sequences of two corpora, showing those that are most
+	over-represented in each relative to the other. Each corpus is a
+	file, url or glob pattern, or with -r a directory, and all of its
+	files are counted together.
+
+	With -score ll, sequences are ranked by their log-likelihood (G²)
+	score, which favors differences that are unlikely to be chance.
+	With -score ratio, they are ranked by how many times more frequent
+	they are in one corpus than the other, with 0.5 added to the counts
+	of both so that sequences missing from one corpus can be compared.
+	Each line shows the score, the counts in corpusA and corpusB and the
+	sequence.
+
+flags:
+`
+
+// diffFlags adds the flags of the diff command
+func diffFlags(fs *flag.FlagSet, c *config) {
+	inputFlags(fs, c)
+	sequenceFlags(fs, c)
+	filterFlags(fs, c)
+
+	c.TopN = intsFlag{20}
+	fs.Var(
+		intFlag{&c.TopN},
+		"n",
+		"show this `number` of the sequences most over-represented in each corpus, 0 shows all of them",
+	)
+
+	fs.StringVar(
+		&c.Output,
+		"output",
+		"text",
+		"output format, one of text, json, csv or tsv",
+	)
+
+	fs.StringVar(
+		&c.Score,
+		"score",
+		"ll",
+		"how sequences are ranked, ll (log-likelihood) or ratio",
+	)
+}
+
+// diffRecord is a sequence and its counts in both corpora. Score is how
+// strongly it is over-represented in the corpus of its section.
+type diffRecord struct {
+	Score  float64  `json:"score"`
+	CountA int      `json:"count_a"`
+	CountB int      `json:"count_b"`
+	Words  []string `json:"words"`
+}
+
+// diffSection is the sequences that are over-represented in Corpus
+type diffSection struct {
+	Size      int          `json:"size,omitempty"`
+	Corpus    string       `json:"corpus"`
+	Sequences []diffRecord `json:"sequences"`
+}
+
+// logLikelihood returns the log-likelihood (G²) score of a sequence counted a
+// times out of totalA in one corpus and b times out of totalB in the other
+func logLikelihood(a, totalA, b, totalB int) float64 {
+	sum := float64(a + b)
+	all := float64(totalA + totalB)
+
+	term := func(n, total int) float64 {
+		if n == 0 {
+			return 0
+		}
+		expected := float64(total) * sum / all
+		return float64(n) * math.Log(float64(n)/expected)
+	}
+
+	return 2 * (term(a, totalA) + term(b, totalB))
+}
+
+// ratio returns how many times more frequent a sequence counted a times out
+// of totalA is than one counted b times out of totalB, smoothing both by 0.5
+func ratio(a, totalA, b, totalB int) float64 {
+	return ((float64(a) + 0.5) / float64(totalA)) / ((float64(b) + 0.5) / float64(totalB))
+}
+
+// compare returns the topN sequences most over-represented in a and those
+// most over-represented in b, each ranked by score
+func compare(a, b *wordseq.Counter, score string, minCount, topN int) (overA, overB []diffRecord, err error) {
+	// the frequencies of an empty corpus aren't numbers
+	if a.Total() == 0 || b.Total() == 0 {
+		return nil, nil, fmt.Errorf("an empty corpus can't be compared")
+	}
+
+	counts := map[string]*diffRecord{}
+
+	add := func(counter *wordseq.Counter, count func(*diffRecord) *int) error {
+		return counter.Top(counter.Len(), func(seq *wordseq.Sequence) error {
+			key := strings.Join(seq.Words, " ")
+			r, ok := counts[key]
+			if !ok {
+				r = &diffRecord{Words: seq.Words}
+				counts[key] = r
+			}
+			*count(r) = seq.Count
+			return nil
+		})
+	}
+
+	if err = add(a, func(r *diffRecord) *int { return &r.CountA }); err != nil {
+		return nil, nil, err
+	}
+
+	if err = add(b, func(r *diffRecord) *int { return &r.CountB }); err != nil {
+		return nil, nil, err
+	}
+
+	for _, r := range counts {
+		if r.CountA+r.CountB < minCount {
+			continue
+		}
+
+		freqA := float64(r.CountA) / float64(a.Total())
+		freqB := float64(r.CountB) / float64(b.Total())
+
+		switch score {
+		case "ratio":
+			if freqA >= freqB {
+				r.Score = ratio(r.CountA, a.Total(), r.CountB, b.Total())
+			} else {
+				r.Score = ratio(r.CountB, b.Total(), r.CountA, a.Total())
+			}
+		default:
+			r.Score = logLikelihood(r.CountA, a.Total(), r.CountB, b.Total())
+		}
+
+		switch {
+		case freqA > freqB:
+			overA = append(overA, *r)
+		case freqB > freqA:
+			overB = append(overB, *r)
+		}
+	}
+
+	rank := func(recs []diffRecord) []diffRecord {
+		sort.Slice(recs, func(i, j int) bool {
+			if recs[i].Score != recs[j].Score {
+				return recs[i].Score > recs[j].Score
+			}
+			return a.Less(recs[i].Words, recs[j].Words)
+		})
+
+		if len(recs) > topN {
+			recs = recs[:topN]
+		}
+		return recs
+	}
+
+	return rank(overA), rank(overB), nil
+}
+
+// countCorpus returns the combined counts of every file of the corpus arg,
+// with a Counter for each sequence size
+func countCorpus(c config, opts []wordseq.Option, arg string) ([]*wordseq.Counter, error) {
+	ig, err := newIgnorer(c)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := expandArgs([]string{arg}, c.Recursive, ig)
+	if err != nil {
+		return nil, err
+	}
+
+	ig.reportSkipped()
+
+	fc := fileCounter{c: c, opts: opts}
+	counters, err := fc.totals(files)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, counter := range counters {
+		if counter.Total() == 0 {
+			return nil, exitErrorf(exitInput, "%s: no sequences of %d words to compare", arg, counter.SeqSize())
+		}
+	}
+
+	return counters, nil
+}
+
+// runDiff runs the diff command
+func runDiff(c config, args []string) error {
+	if len(args) != 2 {
+		return usageErrorf("diff requires two corpora")
+	}
+
+	if c.FilesFrom != "" {
+		return usageErrorf("diff can't be used with -files-from")
+	}
+
+	if args[0] == "-" && args[1] == "-" {
+		return usageErrorf("stdin can't be both corpora")
+	}
+
+	switch c.Output {
+	case "text", "json", "csv", "tsv":
+	default:
+		return usageErrorf("invalid output format: %s", c.Output)
+	}
+
+	switch c.Score {
+	case "ll", "ratio":
+	default:
+		return usageErrorf("invalid score: %s", c.Score)
+	}
+
+	// a list, such as from a config file, has no section for each value
+	if len(c.TopN) != 1 || c.TopN[0] < 0 {
+		return usageErrorf("invalid -n value: %s", c.TopN.String())
+	}
+
+	if c.Jobs < 0 {
+		return usageErrorf("invalid -jobs value: %d", c.Jobs)
+	}
+
+	if c.Jobs == 0 {
+		c.Jobs = runtime.GOMAXPROCS(0)
+	}
+
+	opts, err := counterOptions(c)
+	if err != nil {
+		return err
+	}
+
+	// the minimum applies to the sequence across both corpora
+	opts = append(opts, wordseq.WithMinCount(1))
+
+	a, err := countCorpus(c, opts, args[0])
+	if err != nil {
+		return err
+	}
+
+	b, err := countCorpus(c, opts, args[1])
+	if err != nil {
+		return err
+	}
+
+	var sections []diffSection
+	for i := range a {
+		overA, overB, err := compare(a[i], b[i], c.Score, c.MinCount, c.TopN.limit())
+		if err != nil {
+			return err
+		}
+
+		var size int
+		if len(c.SequenceSize) > 1 {
+			size = a[i].SeqSize()
+		}
+
+		sections = append(sections,
+			diffSection{Size: size, Corpus: args[0], Sequences: overA},
+			diffSection{Size: size, Corpus: args[1], Sequences: overB},
+		)
+	}
+
+	return writeDiff(os.Stdout, c, sections)
+}
+
+func writeDiff(out io.Writer, c config, sections []diffSection) error {
+	switch c.Output {
+	case "json":
+		for i := range sections {
+			if sections[i].Sequences == nil {
+				sections[i].Sequences = []diffRecord{}
+			}
+		}
+
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(sections)
+	case "csv":
+		return writeDiffCSV(out, ',', c, sections)
+	case "tsv":
+		return writeDiffCSV(out, '\t', c, sections)
+	}
+
+	for i, sec := range sections {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+
+		header := "more frequent in " + sec.Corpus
+		if sec.Size > 0 {
+			header += fmt.Sprintf(" size %d", sec.Size)
+		}
+		fmt.Fprintf(out, "%s:\n", header)
+
+		w := tabwriter.NewWriter(out, 0, 0, 1, ' ', tabwriter.AlignRight)
+		for _, r := range sec.Sequences {
+			fmt.Fprintf(w, "%.2f\t %d\t %d\t %v\n", r.Score, r.CountA, r.CountB, r.Words)
+		}
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeDiffCSV writes the sequences with a header row and a column per word,
+// after a corpus column identifying which corpus they are more frequent in
+func writeDiffCSV(out io.Writer, comma rune, c config, sections []diffSection) error {
+	w := csv.NewWriter(out)
+	w.Comma = comma
+
+	header := []string{"corpus"}
+	if len(c.SequenceSize) > 1 {
+		header = append(header, "size")
+	}
+	header = append(header, "score", "count_a", "count_b")
+	for i := 1; i <= c.SequenceSize.max(); i++ {
+		header = append(header, fmt.Sprintf("word%d", i))
+	}
+
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, sec := range sections {
+		for _, r := range sec.Sequences {
+			row := []string{sec.Corpus}
+			if len(c.SequenceSize) > 1 {
+				row = append(row, strconv.Itoa(sec.Size))
+			}
+			row = append(row,
+				strconv.FormatFloat(r.Score, 'f', 4, 64),
+				strconv.Itoa(r.CountA),
+				strconv.Itoa(r.CountB),
+			)
+			row = append(row, r.Words...)
+
+			// smaller sequences leave the remaining word columns empty
+			for j := len(r.Words); j < c.SequenceSize.max(); j++ {
+				row = append(row, "")
+			}
+
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
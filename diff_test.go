@@ -0,0 +1,127 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestScores(t *testing.T) {
+	tests := []struct {
+		a, totalA, b, totalB int
+		ll, ratio            float64
+	}{
+		{10, 100, 10, 100, 0, 1},
+		{2, 10, 0, 10, 2 * 2 * math.Log(2), 5},
+		{0, 10, 2, 10, 2 * 2 * math.Log(2), 0.2},
+		{20, 100, 10, 200, 2 * (20*math.Log(20/10.0) + 10*math.Log(10/20.0)), (20.5 / 100) / (10.5 / 200)},
+	}
+
+	for _, test := range tests {
+		if ll := logLikelihood(test.a, test.totalA, test.b, test.totalB); math.Abs(ll-test.ll) > 1e-9 {
+			t.Errorf("%v: ll(%f) != %f", test, ll, test.ll)
+		}
+
+		if r := ratio(test.a, test.totalA, test.b, test.totalB); math.Abs(r-test.ratio) > 1e-9 {
+			t.Errorf("%v: ratio(%f) != %f", test, r, test.ratio)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	count := func(text string) *wordseq.Counter {
+		counter, err := wordseq.NewCounter(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = counter.Add(strings.NewReader(text)); err != nil {
+			t.Fatal(err)
+		}
+		return counter
+	}
+
+	a := count("the cat sat on the mat the cat sat")
+	b := count("the dog sat on the log the dog ran")
+
+	words := func(recs []diffRecord) string {
+		var ret []string
+		for _, r := range recs {
+			ret = append(ret, strings.Join(r.Words, " "))
+		}
+		return strings.Join(ret, ",")
+	}
+
+	for _, score := range []string{"ll", "ratio"} {
+		overA, overB, err := compare(a, b, score, 0, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := words(overA); got != "cat sat,the cat" {
+			t.Errorf("%s: over-represented in a: %s", score, got)
+		}
+
+		if got := words(overB); got != "the dog,dog ran" {
+			t.Errorf("%s: over-represented in b: %s", score, got)
+		}
+	}
+
+	overA, overB, err := compare(a, b, "ll", 2, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(overA) != 2 || len(overB) != 1 {
+		t.Errorf("min count wasn't applied: %s / %s", words(overA), words(overB))
+	}
+
+	if _, _, err = compare(a, count("one"), "ll", 0, 100); err == nil {
+		t.Error("expected error for an empty corpus")
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	c := config{Output: "text", SequenceSize: sizesFlag{2}}
+
+	sections := []diffSection{
+		{Corpus: "a.txt", Sequences: []diffRecord{{Score: 2.5, CountA: 2, Words: []string{"the", "cat"}}}},
+		{Corpus: "b.txt"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeDiff(&buf, c, sections); err != nil {
+		t.Fatal(err)
+	}
+
+	const expect = "more frequent in a.txt:\n 2.50  2  0 [the cat]\n\nmore frequent in b.txt:\n"
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "csv"
+	if err := writeDiff(&buf, c, sections); err != nil {
+		t.Fatal(err)
+	}
+
+	const expectCSV = "corpus,score,count_a,count_b,word1,word2\na.txt,2.5000,2,0,the,cat\n"
+	if buf.String() != expectCSV {
+		t.Errorf("%q != %q", buf.String(), expectCSV)
+	}
+}
+
+func TestRunDiffN(t *testing.T) {
+	c := config{Output: "text", Score: "ll"}
+	for _, n := range []intsFlag{{1, 2}, {-1}, nil} {
+		c.TopN = n
+		if err := runDiff(c, []string{"a.txt", "b.txt"}); exitCode(err) != exitUsage {
+			t.Errorf("-n %s: expected usage error, got %v", n.String(), err)
+		}
+	}
+}
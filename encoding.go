@@ -0,0 +1,248 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// encodingFlag is a flag.Value holding the encoding for all files and any
+// overrides for individual files given as file=encoding
+type encodingFlag struct {
+	all   string
+	files map[string]string
+}
+
+var _ flag.Value = (*encodingFlag)(nil)
+
+func (f *encodingFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	s := make([]string, 0, len(f.files)+1)
+	if f.all != "" {
+		s = append(s, f.all)
+	}
+	for fn, label := range f.files {
+		s = append(s, fn+"="+label)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f *encodingFlag) Set(value string) error {
+	label := value
+	i := strings.LastIndex(value, "=")
+	if i >= 0 {
+		label = value[i+1:]
+	}
+
+	if _, err := htmlindex.Get(label); err != nil {
+		return fmt.Errorf("%s: %v", label, err)
+	}
+
+	if i < 0 {
+		f.all = label
+		return nil
+	}
+
+	if f.files == nil {
+		f.files = map[string]string{}
+	}
+	f.files[filepath.Clean(value[:i])] = label
+	return nil
+}
+
+// label returns the encoding given for fn, if any
+func (f encodingFlag) label(fn string) string {
+	if label, ok := f.files[filepath.Clean(fn)]; ok {
+		return label
+	}
+	return f.all
+}
+
+// decode returns a reader that converts the content of in to utf-8. The
+// encoding is, in order of precedence, the one given for fn, the one given for
+// all files, the one declared by the input itself or, failing those, the one
+// detected from its content.
+func decode(fn string, in *input, c config) (io.Reader, error) {
+	var r io.Reader = in
+	var enc encoding.Encoding
+
+	label := c.Encoding.label(fn)
+	if label == "" {
+		label = in.charset
+	}
+
+	switch {
+	case label != "":
+		var err error
+		if enc, err = htmlindex.Get(label); err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+	default:
+		// try to determine the encoding
+		var err error
+		if enc, r, err = detectEncoding(fn, r, c.DetectBytes, in.live); err != nil {
+			return nil, err
+		}
+	}
+
+	if enc == unicode.UTF8 {
+		return r, nil
+	}
+
+	return enc.NewDecoder().Reader(r), nil
+}
+
+func isASCII(data []byte) bool {
+	for _, b := range data {
+		if b >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// trimPartialRune removes an incomplete utf-8 sequence from the end of data
+func trimPartialRune(data []byte) []byte {
+	for i := len(data) - 1; i >= 0 && i > len(data)-utf8.UTFMax; i-- {
+		if data[i] < utf8.RuneSelf {
+			break
+		}
+
+		if utf8.RuneStart(data[i]) {
+			if utf8.FullRune(data[i:]) {
+				break
+			}
+			return data[:i]
+		}
+	}
+	return data
+}
+
+// sniffUTF16 reports whether data, without a BOM, looks like utf-16 text that
+// is mostly ascii, returning the encoding of its byte order. Such text has a
+// NUL in every other byte.
+func sniffUTF16(data []byte) (encoding.Encoding, string, bool) {
+	n := len(data) &^ 1
+	if n < 4 {
+		return nil, "", false
+	}
+
+	// the NULs at even offsets are the high bytes of big endian text, those
+	// at odd offsets of little endian
+	var even, odd int
+	for i := 0; i < n; i += 2 {
+		if data[i] == 0 {
+			even++
+		}
+		if data[i+1] == 0 {
+			odd++
+		}
+	}
+
+	units := n / 2
+	switch {
+	case odd*2 > units && even*10 < units:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), "utf-16le", true
+	case even*2 > units && odd*10 < units:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), "utf-16be", true
+	}
+
+	return nil, "", false
+}
+
+// sniffEncoding determines the encoding of data, the start of some content,
+// returning it with its name and how sure it is: "certain" when there is a BOM
+// or declaration, "presumed" for ascii, which is taken to be utf-8, or
+// "uncertain"
+func sniffEncoding(data []byte) (encoding.Encoding, string, string) {
+	// DetermineEncoding only considers the first 1024 bytes, which is enough
+	// for BOMs and <meta charset> declarations
+	enc, name, certain := charset.DetermineEncoding(data, "")
+	if certain {
+		// the decoders of utf-16 found by its BOM would keep the BOM
+		switch name {
+		case "utf-16le":
+			enc = unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM)
+		case "utf-16be":
+			enc = unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM)
+		}
+		return enc, name, "certain"
+	}
+
+	// utf-16 without a BOM, such as from a PowerShell pipe, is otherwise taken
+	// to be ascii with NULs
+	if enc, name, ok := sniffUTF16(data); ok {
+		return enc, name, "uncertain"
+	}
+
+	switch {
+	case name != "utf-8" && name != "windows-1252":
+		// anything but the fallbacks came from the content (e.g. a <meta>)
+		return enc, name, "uncertain"
+	case isASCII(data):
+		return encoding.Nop, "utf-8", "presumed"
+	case utf8.Valid(trimPartialRune(data)):
+		return encoding.Nop, "utf-8", "uncertain"
+	}
+
+	return charmap.Windows1252, "windows-1252", "uncertain"
+}
+
+// detectEncoding determines the encoding of r, named fn, by inspecting up to
+// size bytes from the start of it. When r is live, only what a single read
+// returns is inspected, rather than waiting for more to be sent. The returned
+// reader yields the entirety of r, including the bytes that were inspected.
+func detectEncoding(fn string, r io.Reader, size int, live bool) (encoding.Encoding, io.Reader, error) {
+	if size < 1 {
+		return nil, nil, fmt.Errorf("invalid detection size: %d", size)
+	}
+
+	buf := make([]byte, size)
+
+	var n int
+	var err error
+	if live {
+		n, err = r.Read(buf)
+	} else {
+		n, err = io.ReadFull(r, buf)
+	}
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, nil, err
+	}
+	buf = buf[:n]
+
+	// reset the reader so nothing is lost
+	r = io.MultiReader(bytes.NewReader(buf), r)
+
+	if fn == "-" {
+		fn = "stdin"
+	}
+
+	enc, name, confidence := sniffEncoding(buf)
+	switch confidence {
+	case "certain":
+		infof("%s: detected %s encoding", fn, name)
+	case "presumed":
+		infof("%s: could not determine encoding, presuming utf-8", fn)
+	default:
+		infof("%s: detected %s encoding (uncertain)", fn, name)
+	}
+
+	return enc, r, nil
+}
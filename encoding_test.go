@@ -0,0 +1,264 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestDetectEncodingPreservesStream(t *testing.T) {
+	data := make([]byte, 5000)
+	for i := range data {
+		data[i] = byte('a' + rand.Intn(26)) // #nosec
+	}
+
+	for _, size := range []int{1, 10, 1024, 4999, 5000, 5001, 100000} {
+		_, r, err := detectEncoding("test", bytes.NewReader(data), size, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, data) {
+			t.Errorf("stream not preserved with a probe of %d bytes", size)
+		}
+	}
+
+	if _, _, err := detectEncoding("test", bytes.NewReader(data), 0, false); err == nil {
+		t.Error("expected error for zero probe size")
+	}
+}
+
+func TestDetectEncodingProbeSize(t *testing.T) {
+	// the first non-ascii character is more than 1024 bytes in
+	text := strings.Repeat("plain ascii text ", 100) + "café crème brûlée"
+
+	data, err := charmap.Windows1252.NewEncoder().Bytes([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enc, _, err := detectEncoding("test", bytes.NewReader(data), 1024, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if enc != encoding.Nop {
+		t.Error("expected small probe to fall back to utf-8")
+	}
+
+	enc, r, err := detectEncoding("test", bytes.NewReader(data), 4096, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if enc != charmap.Windows1252 {
+		t.Fatal("expected large probe to detect windows-1252")
+	}
+
+	got, err := ioutil.ReadAll(enc.NewDecoder().Reader(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != text {
+		t.Error("decoded content did not match the original")
+	}
+
+	// valid utf-8 beyond the first 1024 bytes stays utf-8
+	enc, _, err = detectEncoding("test", strings.NewReader(text), 4096, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if enc != encoding.Nop {
+		t.Error("expected utf-8 content to be detected as utf-8")
+	}
+}
+
+func TestTrimPartialRune(t *testing.T) {
+	for _, v := range []struct {
+		in, expect string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"ab\xc3", "ab"},
+		{"abé", "abé"},
+		{"ab\xe2\x82", "ab"},
+		{"ab€", "ab€"},
+	} {
+		if got := string(trimPartialRune([]byte(v.in))); got != v.expect {
+			t.Errorf("%q: %q != %q", v.in, got, v.expect)
+		}
+	}
+}
+
+func TestEncodingFlag(t *testing.T) {
+	var f encodingFlag
+
+	for _, v := range []string{"utf-8", "a.txt=shift_jis", "dir/../b.txt=latin1", "c=d.txt=windows-1252"} {
+		if err := f.Set(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for fn, expect := range map[string]string{
+		"a.txt":   "shift_jis",
+		"./a.txt": "shift_jis",
+		"b.txt":   "latin1",
+		"c=d.txt": "windows-1252",
+		"other":   "utf-8",
+	} {
+		if label := f.label(fn); label != expect {
+			t.Errorf("%s: %s != %s", fn, label, expect)
+		}
+	}
+
+	for _, v := range []string{"nope", "a.txt=nope"} {
+		if err := f.Set(v); err == nil {
+			t.Errorf("%s: expected error for invalid encoding", v)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	latin1 := []byte("caf\xe9 cr\xe8me")
+	const text = "café crème"
+
+	read := func(fn string, in *input, c config) string {
+		t.Helper()
+
+		r, err := decode(fn, in, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(got)
+	}
+
+	var c config
+	if err := c.Encoding.Set("a.txt=iso-8859-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// per file override
+	if got := read("a.txt", &input{Reader: bytes.NewReader(latin1)}, c); got != text {
+		t.Errorf("%q != %q", got, text)
+	}
+
+	// declared charset
+	if got := read("b.txt", &input{Reader: bytes.NewReader(latin1), charset: "iso-8859-1"}, c); got != text {
+		t.Errorf("%q != %q", got, text)
+	}
+
+	// a global encoding takes precedence over the declared one
+	if err := c.Encoding.Set("utf-8"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := read("b.txt", &input{Reader: strings.NewReader(text), charset: "iso-8859-1"}, c); got != text {
+		t.Errorf("%q != %q", got, text)
+	}
+
+	// the per file override takes precedence over the global one
+	if got := read("a.txt", &input{Reader: bytes.NewReader(latin1)}, c); got != text {
+		t.Errorf("%q != %q", got, text)
+	}
+}
+
+func TestDecodeDetectsEachInput(t *testing.T) {
+	const text = "café crème brûlée"
+
+	latin1, err := charmap.Windows1252.NewEncoder().Bytes([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a utf-8 input followed by a windows-1252 one
+	for _, data := range [][]byte{[]byte(text), latin1} {
+		r, err := decode("test", &input{Reader: bytes.NewReader(data)}, config{DetectBytes: 1024})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != text {
+			t.Errorf("%q != %q", got, text)
+		}
+	}
+}
+
+func TestDecodeUTF16(t *testing.T) {
+	const text = "the cat sat on the mat, señor\r\n"
+
+	for _, v := range []struct {
+		name  string
+		order unicode.Endianness
+		bom   unicode.BOMPolicy
+	}{
+		{"le with bom", unicode.LittleEndian, unicode.UseBOM},
+		{"be with bom", unicode.BigEndian, unicode.UseBOM},
+		{"le", unicode.LittleEndian, unicode.IgnoreBOM},
+		{"be", unicode.BigEndian, unicode.IgnoreBOM},
+	} {
+		data, err := unicode.UTF16(v.order, v.bom).NewEncoder().Bytes([]byte(text))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := decode("-", &input{Reader: bytes.NewReader(data)}, config{DetectBytes: 1024})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != text {
+			t.Errorf("%s: %q != %q", v.name, got, text)
+		}
+	}
+}
+
+func TestSniffUTF16(t *testing.T) {
+	for _, v := range []struct {
+		data   string
+		expect string
+	}{
+		{"h\x00i\x00 \x00t\x00h\x00e\x00r\x00e\x00", "utf-16le"},
+		{"\x00h\x00i\x00 \x00t\x00h\x00e\x00r\x00e", "utf-16be"},
+		{"h\x00", ""},
+		{"plain ascii text", ""},
+		{"\x00\x00\x00\x00\x00\x00\x00\x00", ""},
+		{"a\x00b\x00\x00\x00\x00\x00\x00\x00", ""},
+	} {
+		_, name, ok := sniffUTF16([]byte(v.data))
+		if ok != (v.expect != "") || name != v.expect {
+			t.Errorf("%q: %q, %v != %q", v.data, name, ok, v.expect)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const encodingsUsage = `Usage of %[1]s encodings:
+
+	%[1]s encodings [term]
+
+	Lists the encodings that may be given to -encoding, each by its name
+	followed by the other labels that select it. With a term, only the
+	encodings with a name or label containing it are listed.
+
+flags:
+`
+
+// encodingsFlags adds the flags of the encodings command, of which there are
+// none
+func encodingsFlags(*flag.FlagSet, *config) {}
+
+// encodingLabels are the encodings of the WHATWG Encoding Standard, as accepted
+// by htmlindex, each with its name and the other labels that select it. The
+// replacement encoding, which only decodes to U+FFFD, is left out.
+var encodingLabels = []struct {
+	name   string
+	labels []string
+}{
+	{"utf-8", []string{"unicode-1-1-utf-8", "utf8"}},
+	{"ibm866", []string{"866", "cp866", "csibm866"}},
+	{"iso-8859-2", []string{"csisolatin2", "iso-ir-101", "iso8859-2", "iso88592", "iso_8859-2", "iso_8859-2:1987", "l2", "latin2"}},
+	{"iso-8859-3", []string{"csisolatin3", "iso-ir-109", "iso8859-3", "iso88593", "iso_8859-3", "iso_8859-3:1988", "l3", "latin3"}},
+	{"iso-8859-4", []string{"csisolatin4", "iso-ir-110", "iso8859-4", "iso88594", "iso_8859-4", "iso_8859-4:1988", "l4", "latin4"}},
+	{"iso-8859-5", []string{"csisolatincyrillic", "cyrillic", "iso-ir-144", "iso8859-5", "iso88595", "iso_8859-5", "iso_8859-5:1988"}},
+	{"iso-8859-6", []string{"arabic", "asmo-708", "csiso88596e", "csiso88596i", "csisolatinarabic", "ecma-114", "iso-8859-6-e", "iso-8859-6-i", "iso-ir-127", "iso8859-6", "iso88596", "iso_8859-6", "iso_8859-6:1987"}},
+	{"iso-8859-7", []string{"csisolatingreek", "ecma-118", "elot_928", "greek", "greek8", "iso-ir-126", "iso8859-7", "iso88597", "iso_8859-7", "iso_8859-7:1987", "sun_eu_greek"}},
+	{"iso-8859-8", []string{"csiso88598e", "csisolatinhebrew", "hebrew", "iso-8859-8-e", "iso-ir-138", "iso8859-8", "iso88598", "iso_8859-8", "iso_8859-8:1988", "visual"}},
+	{"iso-8859-8-i", []string{"csiso88598i", "logical"}},
+	{"iso-8859-10", []string{"csisolatin6", "iso-ir-157", "iso8859-10", "iso885910", "l6", "latin6"}},
+	{"iso-8859-13", []string{"iso8859-13", "iso885913"}},
+	{"iso-8859-14", []string{"iso8859-14", "iso885914"}},
+	{"iso-8859-15", []string{"csisolatin9", "iso8859-15", "iso885915", "iso_8859-15", "l9"}},
+	{"iso-8859-16", []string{}},
+	{"koi8-r", []string{"cskoi8r", "koi", "koi8", "koi8_r"}},
+	{"koi8-u", []string{"koi8-ru"}},
+	{"macintosh", []string{"csmacintosh", "mac", "x-mac-roman"}},
+	{"windows-874", []string{"dos-874", "iso-8859-11", "iso8859-11", "iso885911", "tis-620"}},
+	{"windows-1250", []string{"cp1250", "x-cp1250"}},
+	{"windows-1251", []string{"cp1251", "x-cp1251"}},
+	{"windows-1252", []string{"ansi_x3.4-1968", "ascii", "cp1252", "cp819", "csisolatin1", "ibm819", "iso-8859-1", "iso-ir-100", "iso8859-1", "iso88591", "iso_8859-1", "iso_8859-1:1987", "l1", "latin1", "us-ascii", "x-cp1252"}},
+	{"windows-1253", []string{"cp1253", "x-cp1253"}},
+	{"windows-1254", []string{"cp1254", "csisolatin5", "iso-8859-9", "iso-ir-148", "iso8859-9", "iso88599", "iso_8859-9", "iso_8859-9:1989", "l5", "latin5", "x-cp1254"}},
+	{"windows-1255", []string{"cp1255", "x-cp1255"}},
+	{"windows-1256", []string{"cp1256", "x-cp1256"}},
+	{"windows-1257", []string{"cp1257", "x-cp1257"}},
+	{"windows-1258", []string{"cp1258", "x-cp1258"}},
+	{"x-mac-cyrillic", []string{"x-mac-ukrainian"}},
+	{"gbk", []string{"chinese", "csgb2312", "csiso58gb231280", "gb2312", "gb_2312", "gb_2312-80", "iso-ir-58", "x-gbk"}},
+	{"gb18030", []string{}},
+	{"big5", []string{"big5-hkscs", "cn-big5", "csbig5", "x-x-big5"}},
+	{"euc-jp", []string{"cseucpkdfmtjapanese", "x-euc-jp"}},
+	{"iso-2022-jp", []string{"csiso2022jp"}},
+	{"shift_jis", []string{"csshiftjis", "ms932", "ms_kanji", "shift-jis", "sjis", "windows-31j", "x-sjis"}},
+	{"euc-kr", []string{"cseuckr", "csksc56011987", "iso-ir-149", "korean", "ks_c_5601-1987", "ks_c_5601-1989", "ksc5601", "ksc_5601", "windows-949"}},
+	{"utf-16be", []string{}},
+	{"utf-16le", []string{"utf-16"}},
+	{"x-user-defined", []string{}},
+}
+
+// writeEncodings writes the encodings with a name or label containing term,
+// ignoring case, or all of them if term is empty. It returns false if there
+// were none.
+func writeEncodings(out io.Writer, term string) (bool, error) {
+	term = strings.ToLower(term)
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	var found bool
+	for _, enc := range encodingLabels {
+		match := strings.Contains(enc.name, term)
+		for _, label := range enc.labels {
+			match = match || strings.Contains(label, term)
+		}
+
+		if !match {
+			continue
+		}
+
+		found = true
+		if len(enc.labels) == 0 {
+			fmt.Fprintln(w, enc.name)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\n", enc.name, strings.Join(enc.labels, ", "))
+	}
+
+	return found, w.Flush()
+}
+
+// runEncodings runs the encodings command
+func runEncodings(c config, args []string) error {
+	if len(args) > 1 {
+		return usageErrorf("encodings takes at most one search term")
+	}
+
+	var term string
+	if len(args) == 1 {
+		term = args[0]
+	}
+
+	found, err := writeEncodings(os.Stdout, term)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("no encodings match %s", term)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+func TestEncodingLabels(t *testing.T) {
+	for _, enc := range encodingLabels {
+		for _, label := range append([]string{enc.name}, enc.labels...) {
+			e, err := htmlindex.Get(label)
+			if err != nil {
+				t.Errorf("%s: %v", label, err)
+				continue
+			}
+
+			if name, err := htmlindex.Name(e); err != nil || name != enc.name {
+				t.Errorf("%s: %s, %v != %s", label, name, err, enc.name)
+			}
+		}
+	}
+}
+
+func TestWriteEncodings(t *testing.T) {
+	for _, v := range []struct {
+		term   string
+		expect string
+		found  bool
+	}{
+		{"KOI", "koi8-r  cskoi8r, koi, koi8, koi8_r\nkoi8-u  koi8-ru\n", true},
+		{"utf-16", "utf-16be\nutf-16le  utf-16\n", true},
+		{"nonesuch", "", false},
+	} {
+		var buf bytes.Buffer
+		found, err := writeEncodings(&buf, v.term)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found != v.found || buf.String() != v.expect {
+			t.Errorf("%s: %v, %q != %v, %q", v.term, found, buf.String(), v.found, v.expect)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := writeEncodings(&buf, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := bytes.Count(buf.Bytes(), []byte("\n")); n != len(encodingLabels) {
+		t.Errorf("%d != %d", n, len(encodingLabels))
+	}
+}
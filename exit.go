@@ -0,0 +1,65 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+)
+
+// the exit codes, so that scripts can tell why nr failed
+const (
+	exitFailure = 1 // any error not covered below
+	exitUsage   = 2 // invalid flags or arguments, as with the flag package
+	exitInput   = 3 // an input couldn't be found or read
+	exitDecode  = 4 // an input couldn't be decoded
+	exitEmpty   = 5 // -fail-if-empty and no sequences were found
+)
+
+// exitError is an error that exits with code
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
+// withExit returns err, if not nil, exiting with code unless it already has an
+// exit code
+func withExit(code int, err error) error {
+	var ee *exitError
+	if err == nil || errors.As(err, &ee) {
+		return err
+	}
+	return &exitError{code: code, err: err}
+}
+
+// exitErrorf formats an error that exits with code
+func exitErrorf(code int, format string, a ...interface{}) error {
+	return &exitError{code: code, err: fmt.Errorf(format, a...)}
+}
+
+// usageErrorf formats an error of invalid flags or arguments
+func usageErrorf(format string, a ...interface{}) error {
+	return exitErrorf(exitUsage, format, a...)
+}
+
+// exitCode returns the code to exit with because of err
+func exitCode(err error) int {
+	var ee *exitError
+	if errors.As(err, &ee) {
+		return ee.code
+	}
+	return exitFailure
+}
+
+// fatal logs err and exits with its code
+func fatal(err error) {
+	log.Printf("%+v", err)
+	os.Exit(exitCode(err))
+}
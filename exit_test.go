@@ -0,0 +1,84 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	for _, v := range []struct {
+		err    error
+		expect int
+	}{
+		{errors.New("failed"), exitFailure},
+		{usageErrorf("invalid"), exitUsage},
+		{withExit(exitInput, errors.New("missing")), exitInput},
+		{fmt.Errorf("wrapped: %w", withExit(exitDecode, errors.New("bad"))), exitDecode},
+		{withExit(exitInput, usageErrorf("invalid")), exitUsage},
+	} {
+		if got := exitCode(v.err); got != v.expect {
+			t.Errorf("%v: %d != %d", v.err, got, v.expect)
+		}
+	}
+
+	if withExit(exitInput, nil) != nil {
+		t.Error("expected nil")
+	}
+}
+
+func TestRunExitCode(t *testing.T) {
+	dir := t.TempDir()
+
+	text := filepath.Join(dir, "a.txt")
+	if err := ioutil.WriteFile(text, []byte("a b c"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{
+		SequenceSize: sizesFlag{3},
+		TopN:         intsFlag{10},
+		Output:       "text",
+		Sort:         "count-desc",
+		Color:        "never",
+		Overflow:     "truncate",
+		Sample:       1,
+		Encoding:     encodingFlag{all: "utf-8"},
+		OutputFile:   filepath.Join(dir, "out.txt"),
+	}
+
+	for _, v := range []struct {
+		name   string
+		args   []string
+		modify func(*config)
+		expect int
+	}{
+		{"ok", []string{text}, nil, 0},
+		{"missing", []string{filepath.Join(dir, "missing.txt")}, nil, exitInput},
+		{"no match", []string{filepath.Join(dir, "*.none")}, nil, exitInput},
+		{"usage", []string{text}, func(c *config) { c.Jobs = -1 }, exitUsage},
+		{"no sample", []string{text}, func(c *config) { c.Sample = 0 }, exitUsage},
+		{"decode", []string{text}, func(c *config) { c.Encoding.files = map[string]string{text: "bogus"} }, exitDecode},
+		{"empty", []string{text}, func(c *config) { c.FailIfEmpty = true; c.SequenceSize = sizesFlag{4} }, exitEmpty},
+		{"not empty", []string{text}, func(c *config) { c.FailIfEmpty = true }, 0},
+	} {
+		c := c
+		if v.modify != nil {
+			v.modify(&c)
+		}
+
+		var got int
+		if err := run(c, v.args...); err != nil {
+			got = exitCode(err)
+		}
+
+		if got != v.expect {
+			t.Errorf("%s: %d != %d", v.name, got, v.expect)
+		}
+	}
+}
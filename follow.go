@@ -0,0 +1,167 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// followPoll is how often a followed file is checked for more content once
+// everything in it has been read
+const followPoll = 250 * time.Millisecond
+
+// follower counts every followed file into the same counters. The counters
+// may only be used while holding mu, which is released whenever the files are
+// waiting for more content.
+type follower struct {
+	mu       sync.Mutex
+	counters []*wordseq.Counter
+
+	// clear is whether the screen is cleared before showing the results,
+	// shown is how many times they have been
+	clear bool
+	shown int
+}
+
+// followReader reads f, waiting for more to be appended once the end has been
+// reached. It is read while holding mu, which is released while reading f.
+type followReader struct {
+	f    *os.File
+	mu   *sync.Mutex
+	stop <-chan struct{}
+
+	// wait is whether to wait at the end of f rather than returning io.EOF
+	wait bool
+	pos  int64
+}
+
+func (r *followReader) Read(b []byte) (int, error) {
+	r.mu.Unlock()
+	defer r.mu.Lock()
+
+	for {
+		n, err := r.f.Read(b)
+		r.pos += int64(n)
+
+		if n > 0 || err != io.EOF || !r.wait {
+			return n, err
+		}
+
+		// start over when the file has been truncated, as when logs are rotated
+		if fi, err := r.f.Stat(); err == nil && fi.Size() < r.pos {
+			if _, err = r.f.Seek(0, io.SeekStart); err != nil {
+				return 0, err
+			}
+			r.pos = 0
+			continue
+		}
+
+		select {
+		case <-r.stop:
+			return 0, io.EOF
+		case <-time.After(followPoll):
+		}
+	}
+}
+
+// count adds the content of fn, and anything appended to it, to the counters
+// until fc.stop is closed. Content is read as it is, without decompression.
+// stdin is read until it ends and saved counts are added as they are.
+func (fl *follower) count(fc *fileCounter, fn string) ([]*wordseq.Counter, error) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	f := os.Stdin
+	if fn != "-" {
+		var err error
+		if f, err = os.Open(fn); err != nil {
+			return nil, withExit(exitInput, err)
+		}
+		defer f.Close()
+
+		// saved counts are added to the counters, there is nothing to follow
+		if br := bufio.NewReader(f); wordseq.IsState(br) {
+			counters, err := stateSizes(fn, br, fc.c.SequenceSize, fc.opts)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, counter := range counters {
+				fl.counters[i].Merge(counter)
+			}
+
+			return fl.counters, nil
+		}
+
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	fr := &followReader{f: f, mu: &fl.mu, stop: fc.stop}
+	in := &input{Reader: fc.p.reader(fr), Closer: nopCloser{}}
+
+	// encoding detection only considers what the file already has
+	r, err := decode(fn, in, fc.c)
+	if err != nil {
+		return nil, err
+	}
+	fr.wait = fn != "-"
+
+	r = sample(fn, prepare(fn, r, fc.c), fc.c)
+
+	if fc.stop != nil {
+		r = stopReader{Reader: r, stop: fc.stop}
+	}
+
+	if err = wordseq.AddAll(r, fl.counters...); err != nil {
+		return nil, err
+	}
+
+	return fl.counters, nil
+}
+
+// show calls collect with each of the counters and then writes the results
+// with write
+func (fl *follower) show(out io.Writer, c config, collect func(*wordseq.Counter) error, write func() error) error {
+	fl.mu.Lock()
+	for _, counter := range fl.counters {
+		if err := collect(counter); err != nil {
+			fl.mu.Unlock()
+			return err
+		}
+	}
+	fl.mu.Unlock()
+
+	fl.separate(out, c)
+
+	return write()
+}
+
+// separate clears the screen, or separates the results about to be written
+// from the previous ones
+func (fl *follower) separate(out io.Writer, c config) {
+	switch {
+	case fl.clear:
+		fmt.Fprint(out, "\x1b[H\x1b[2J")
+	case fl.shown > 0 && c.Output == "text":
+		fmt.Fprintln(out)
+	}
+	fl.shown++
+}
+
+// save writes the counters to fn, as with -save-state
+func (fl *follower) save(fn string) error {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	return saveState(fn, fl.counters)
+}
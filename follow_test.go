@@ -0,0 +1,111 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestFollow(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")}
+
+	for _, fn := range files {
+		if err := ioutil.WriteFile(fn, []byte("a b c\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := config{
+		SequenceSize: sizesFlag{3},
+		Encoding:     encodingFlag{all: "utf-8"},
+	}
+
+	stop := make(chan struct{})
+	fc := fileCounter{c: c, stop: stop, follow: &follower{}}
+
+	var err error
+	if fc.follow.counters, err = fc.newCounters(); err != nil {
+		t.Fatal(err)
+	}
+
+	total := func() int {
+		fc.follow.mu.Lock()
+		defer fc.follow.mu.Unlock()
+		return fc.follow.counters[0].Total()
+	}
+
+	// waitFor waits for the followed files to have n sequences
+	waitFor := func(n int) {
+		t.Helper()
+		for deadline := time.Now().Add(5 * time.Second); total() != n; {
+			if time.Now().After(deadline) {
+				t.Fatalf("total(%d) != %d", total(), n)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- fc.countAll(files, len(files), func(_ string, counters []*wordseq.Counter) error {
+			if counters[0] != fc.follow.counters[0] {
+				t.Error("followed files weren't counted together")
+			}
+			return nil
+		})
+	}()
+
+	waitFor(2)
+
+	f, err := os.OpenFile(files[0], os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.WriteString("a b c\n"); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close() // #nosec
+
+	// sequences span the appended lines
+	waitFor(5)
+
+	// truncated files are read again from the start
+	if err = ioutil.WriteFile(files[1], []byte("d e\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(7)
+
+	var buf bytes.Buffer
+	err = fc.follow.show(&buf, c, func(counter *wordseq.Counter) error {
+		return counter.Top(1, func(seq *wordseq.Sequence) error {
+			buf.WriteString(seq.Words[0])
+			return nil
+		})
+	}, func() error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "a" {
+		t.Errorf("unexpected top sequence %q", buf.String())
+	}
+
+	close(stop)
+
+	select {
+	case err = <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("following didn't stop")
+	}
+}
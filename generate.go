@@ -0,0 +1,249 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"jrubin.io/nr/wordseq"
+)
+
+const generateUsage = `Usage of %[1]s generate:
+
+	%[1]s generate [flags] file1.txt file2.txt ...
+
+	Counts the sequences of the files, as with count, and uses them as a
+	Markov model to generate text. Each word is chosen according to how
+	often it followed the words before it, so the text shows what the
+	model captured. Counts saved with 'count -save-state' may be given
+	as inputs. When the last words were never followed by anything, the
+	text continues from a sequence chosen at random.
+
+flags:
+`
+
+// generateWidth is the column at which the generated text is wrapped
+const generateWidth = 72
+
+// generateFlags adds the flags of the generate command
+func generateFlags(fs *flag.FlagSet, c *config) {
+	inputFlags(fs, c)
+	sequenceFlags(fs, c)
+	filterFlags(fs, c)
+
+	fs.IntVar(
+		&c.Words,
+		"words",
+		100,
+		"number of words to generate",
+	)
+}
+
+// transition is a word that followed a prefix, and how many times
+type transition struct {
+	word  string
+	count int
+}
+
+// chain is a Markov model of the sequences of a Counter, where the last word of
+// each is chosen by the words before it
+type chain struct {
+	size int
+
+	// starts are every sequence, from which the text begins, and total is the
+	// sum of their counts
+	starts []*wordseq.Sequence
+	total  int
+
+	next map[string][]transition
+	sums map[string]int
+}
+
+func newChain(counter *wordseq.Counter) (*chain, error) {
+	ch := chain{
+		size: counter.SeqSize(),
+		next: map[string][]transition{},
+		sums: map[string]int{},
+	}
+
+	// the sequences are added in a fixed order so that a seed always generates
+	// the same text
+	err := counter.Top(counter.Len(), func(seq *wordseq.Sequence) error {
+		ch.starts = append(ch.starts, seq)
+		ch.total += seq.Count
+
+		n := len(seq.Words) - 1
+		prefix := strings.Join(seq.Words[:n], " ")
+		ch.next[prefix] = append(ch.next[prefix], transition{word: seq.Words[n], count: seq.Count})
+		ch.sums[prefix] += seq.Count
+		return nil
+	})
+
+	return &ch, err
+}
+
+// start returns the words of a sequence chosen at random, weighted by count
+func (ch *chain) start(r *rand.Rand) []string {
+	i := r.Intn(ch.total)
+	for _, seq := range ch.starts {
+		if i -= seq.Count; i < 0 {
+			return seq.Words
+		}
+	}
+	return nil
+}
+
+// follow returns a word chosen at random from those that followed words,
+// weighted by count, or false if none did
+func (ch *chain) follow(r *rand.Rand, words []string) (string, bool) {
+	prefix := strings.Join(words, " ")
+
+	sum, ok := ch.sums[prefix]
+	if !ok {
+		return "", false
+	}
+
+	i := r.Intn(sum)
+	for _, t := range ch.next[prefix] {
+		if i -= t.count; i < 0 {
+			return t.word, true
+		}
+	}
+	return "", false
+}
+
+// generate calls fn with each of n words chosen by the chain
+func (ch *chain) generate(r *rand.Rand, n int, fn func(string) error) error {
+	var words []string
+	for len(words) < n {
+		// continue from the words that will form the next prefix
+		var word string
+		ok := len(words) > 0
+		if ok {
+			word, ok = ch.follow(r, words[len(words)-ch.size+1:])
+		}
+
+		if !ok {
+			for _, w := range ch.start(r) {
+				if len(words) == n {
+					break
+				}
+
+				if err := fn(w); err != nil {
+					return err
+				}
+				words = append(words, w)
+			}
+			continue
+		}
+
+		if err := fn(word); err != nil {
+			return err
+		}
+		words = append(words, word)
+	}
+
+	return nil
+}
+
+// runGenerate runs the generate command
+func runGenerate(c config, args []string) error {
+	if c.Words < 0 {
+		return usageErrorf("invalid -words value: %d", c.Words)
+	}
+
+	switch {
+	case len(c.SequenceSize) != 1:
+		return usageErrorf("generate requires a single sequence size")
+	case c.SequenceSize[0] < 2:
+		return usageErrorf("generate requires a sequence size of at least 2")
+	}
+
+	if c.Jobs < 0 {
+		return usageErrorf("invalid -jobs value: %d", c.Jobs)
+	}
+
+	if c.Jobs == 0 {
+		c.Jobs = runtime.GOMAXPROCS(0)
+	}
+
+	ig, err := newIgnorer(c)
+	if err != nil {
+		return err
+	}
+
+	files, err := inputFiles(c, args, ig)
+	if err != nil {
+		return err
+	}
+	defer ig.reportSkipped()
+
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	opts, err := counterOptions(c)
+	if err != nil {
+		return err
+	}
+
+	fc := fileCounter{c: c, opts: opts}
+
+	totals, err := fc.totals(files)
+	if err != nil {
+		return err
+	}
+
+	ch, err := newChain(totals[0])
+	if err != nil {
+		return err
+	}
+
+	if ch.total == 0 {
+		return fmt.Errorf("no sequences to generate text from")
+	}
+
+	if c.Seed == 0 {
+		c.Seed = time.Now().UnixNano()
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+
+	// the words are wrapped at generateWidth
+	var col int
+	err = ch.generate(rand.New(rand.NewSource(c.Seed)), c.Words, func(word string) error { // #nosec
+		n := utf8.RuneCountInString(word)
+
+		switch {
+		case col == 0:
+		case col+1+n > generateWidth:
+			_ = out.WriteByte('\n') // #nosec
+			col = 0
+		default:
+			_ = out.WriteByte(' ') // #nosec
+			col++
+		}
+
+		col += n
+		_, err := out.WriteString(word)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if col > 0 {
+		_ = out.WriteByte('\n') // #nosec
+	}
+
+	return out.Flush()
+}
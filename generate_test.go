@@ -0,0 +1,98 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func testChain(t *testing.T, size int, text string) *chain {
+	t.Helper()
+
+	counter, err := wordseq.NewCounter(size)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = counter.Add(strings.NewReader(text)); err != nil {
+		t.Fatal(err)
+	}
+
+	ch, err := newChain(counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ch
+}
+
+func generateWords(t *testing.T, ch *chain, seed int64, n int) []string {
+	t.Helper()
+
+	var words []string
+	err := ch.generate(rand.New(rand.NewSource(seed)), n, func(word string) error { // #nosec
+		words = append(words, word)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return words
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		size  int
+		text  string
+		n     int
+		pairs []string
+	}{
+		// every word has a single successor
+		{2, "a b c a b c a b c", 10, []string{"a b", "b c", "c a"}},
+		{3, "a b c d a b c d", 10, []string{"a b", "b c", "c d", "d a"}},
+
+		// d is never followed, so the text continues from a random sequence
+		{2, "a b c d", 10, []string{"a b", "b c", "c d", "d a", "d b", "d c"}},
+	}
+
+	for _, test := range tests {
+		ch := testChain(t, test.size, test.text)
+
+		for seed := int64(1); seed <= 5; seed++ {
+			words := generateWords(t, ch, seed, test.n)
+			if len(words) != test.n {
+				t.Fatalf("%q: %d words != %d", test.text, len(words), test.n)
+			}
+
+			for i := 1; i < len(words); i++ {
+				pair := words[i-1] + " " + words[i]
+
+				var ok bool
+				for _, p := range test.pairs {
+					ok = ok || p == pair
+				}
+
+				if !ok {
+					t.Errorf("%q: unexpected %q in %q", test.text, pair, words)
+				}
+			}
+
+			// the same seed always generates the same text
+			if again := generateWords(t, ch, seed, test.n); !reflect.DeepEqual(words, again) {
+				t.Errorf("%q: %q != %q", test.text, again, words)
+			}
+		}
+	}
+
+	// fewer words than a sequence
+	if words := generateWords(t, testChain(t, 3, "a b c"), 1, 2); !reflect.DeepEqual(words, []string{"a", "b"}) {
+		t.Errorf("%q != %q", words, []string{"a", "b"})
+	}
+}
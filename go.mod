@@ -1,6 +1,19 @@
 module jrubin.io/nr
 
+go 1.23.0
+
+require (
+	github.com/BurntSushi/toml v1.3.2
+	github.com/fsnotify/fsnotify v1.6.0
+	github.com/ulikunitz/xz v0.5.12
+	golang.org/x/net v0.41.0
+	golang.org/x/term v0.32.0
+	golang.org/x/text v0.26.0
+	google.golang.org/grpc v1.75.1
+	google.golang.org/protobuf v1.36.6
+)
+
 require (
-	golang.org/x/net v0.0.0-20180921000356-2f5d2388922f
-	golang.org/x/text v0.3.0
+	golang.org/x/sys v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
 )
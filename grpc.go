@@ -0,0 +1,160 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"jrubin.io/nr/nrpb"
+	"jrubin.io/nr/wordseq"
+)
+
+// grpcServer serves the grpc api of s, which counts the text of the chunks
+// streamed to it as the http api does the bodies posted to it
+type grpcServer struct {
+	nrpb.UnimplementedCounterServer
+	s *server
+}
+
+var _ nrpb.CounterServer = grpcServer{}
+
+// chunkReader reads the text of the chunks returned by recv, which are only
+// received as the text is read so that a client can't send faster than it is
+// counted
+type chunkReader struct {
+	recv func() (*nrpb.Chunk, error)
+	buf  []byte
+
+	// err is the error that ended the stream, io.EOF once the client has
+	// sent all of the chunks
+	err error
+}
+
+func (r *chunkReader) Read(b []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		var chunk *nrpb.Chunk
+		if chunk, r.err = r.recv(); chunk != nil {
+			r.buf = chunk.Text
+		}
+	}
+
+	n := copy(b, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// count returns the counts of the text of the chunks returned by recv, the
+// charset of which is read from the first of them
+func (g grpcServer) count(recv func() (*nrpb.Chunk, error)) ([]*wordseq.Counter, error) {
+	cr := &chunkReader{recv: recv}
+
+	first, err := recv()
+	if err != nil {
+		if err != io.EOF {
+			return nil, err
+		}
+		cr.err = err
+	} else {
+		cr.buf = first.Text
+	}
+
+	counters, err := g.s.count(&input{
+		Reader:  cr,
+		Closer:  nopCloser{},
+		charset: first.GetCharset(),
+		live:    true,
+	})
+
+	// the stream ending early isn't a problem with its text
+	if cr.err != nil && cr.err != io.EOF {
+		return nil, cr.err
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return counters, nil
+}
+
+// sequences returns the ranked sequences of results
+func (g grpcServer) sequences(results []result) []*nrpb.Sequence {
+	var seqs []*nrpb.Sequence
+	for _, res := range results {
+		for i, seq := range res.Seqs {
+			r := newRecord(g.s.c, res, seq, i+1)
+			seqs = append(seqs, &nrpb.Sequence{
+				Words:       r.Words,
+				Count:       int64(r.Count),
+				Size:        int32(r.Size),
+				Context:     r.Context,
+				Contexts:    r.Contexts,
+				Approximate: r.Approximate,
+			})
+		}
+	}
+	return seqs
+}
+
+// send sends seqs with fn
+func send(seqs []*nrpb.Sequence, fn func(*nrpb.Sequence) error) error {
+	for _, seq := range seqs {
+		if err := fn(seq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g grpcServer) Count(stream nrpb.Counter_CountServer) error {
+	counters, err := g.count(stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	results, err := g.s.results(counters)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return send(g.sequences(results), stream.Send)
+}
+
+func (g grpcServer) Add(stream nrpb.Counter_AddServer) error {
+	counters, err := g.count(stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	if err = g.s.add(counters); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return stream.SendAndClose(&emptypb.Empty{})
+}
+
+func (g grpcServer) Results(_ *emptypb.Empty, stream nrpb.Counter_ResultsServer) error {
+	// the sequences are copied so that the totals can be added to while they
+	// are sent
+	g.s.mu.Lock()
+	results, err := g.s.results(g.s.totals)
+	var seqs []*nrpb.Sequence
+	if err == nil {
+		seqs = g.sequences(results)
+	}
+	g.s.mu.Unlock()
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return send(seqs, stream.Send)
+}
@@ -0,0 +1,146 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"jrubin.io/nr/nrpb"
+)
+
+func TestGRPC(t *testing.T) {
+	s, err := newServer(config{
+		SequenceSize: sizesFlag{2},
+		DetectBytes:  1024,
+		Sample:       1,
+		TopN:         intsFlag{1},
+		Sort:         "count-desc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln := bufconn.Listen(1 << 16)
+	gs := grpc.NewServer()
+	nrpb.RegisterCounterServer(gs, grpcServer{s: s})
+	go func() { _ = gs.Serve(ln) }() // #nosec
+	defer gs.Stop()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return ln.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }() // #nosec
+
+	client := nrpb.NewCounterClient(conn)
+	ctx := context.Background()
+
+	// recv returns the words and counts of the sequences of a stream
+	recv := func(stream interface {
+		Recv() (*nrpb.Sequence, error)
+	}) string {
+		var got []string
+		for {
+			seq, err := stream.Recv()
+			if err == io.EOF {
+				return strings.Join(got, ",")
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, strings.Join(seq.Words, " ")+":"+strconv.Itoa(int(seq.Count)))
+		}
+	}
+
+	// the text is split within words and, in the latin-1 chunks, characters
+	// are bytes
+	for _, v := range []struct {
+		chunks  []string
+		charset string
+		expect  string
+	}{
+		{[]string{"a b a", " b c"}, "", "a b:2"},
+		{[]string{"caf\xe9 au caf", "\xe9 au"}, "iso-8859-1", "café au:2"},
+		{nil, "", ""},
+	} {
+		stream, err := client.Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, chunk := range v.chunks {
+			c := &nrpb.Chunk{Text: []byte(chunk)}
+			if i == 0 {
+				c.Charset = v.charset
+			}
+			if err = stream.Send(c); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err = stream.CloseSend(); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := recv(stream); got != v.expect {
+			t.Errorf("%q: %q != %q", v.chunks, got, v.expect)
+		}
+	}
+
+	// Count doesn't affect the totals
+	results, err := client.Results(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := recv(results); got != "" {
+		t.Errorf("unexpected totals: %q", got)
+	}
+
+	for _, text := range []string{"x y", "w x y"} {
+		stream, err := client.Add(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = stream.Send(&nrpb.Chunk{Text: []byte(text)}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = stream.CloseAndRecv(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if results, err = client.Results(ctx, &emptypb.Empty{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := recv(results); got != "x y:2" {
+		t.Errorf("unexpected totals: %q", got)
+	}
+
+	// an unknown charset is the client's mistake
+	stream, err := client.Add(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = stream.Send(&nrpb.Chunk{Text: []byte("a b"), Charset: "bogus"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.CloseAndRecv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
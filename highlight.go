@@ -0,0 +1,173 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"jrubin.io/nr/wordreader"
+	"jrubin.io/nr/wordseq"
+)
+
+// the markers around the top sequences highlighted in text without color
+const (
+	markStart = "[["
+	markEnd   = "]]"
+)
+
+// token is a word, space or punctuation of text being highlighted
+type token struct {
+	text   string
+	word   string // normalized, empty if not part of sequences
+	marked bool
+}
+
+// writeHighlighted writes the text of files, as it was counted, with the
+// occurrences of the top sequences of results colored or marked. counter
+// normalizes the words as they were when counted.
+func writeHighlighted(out io.Writer, c config, files []string, results []result, counter *wordseq.Counter) error {
+	// the sequences of each size, keyed by their joined words
+	seqs := map[int]map[string]bool{}
+	for _, res := range results {
+		if res.File != "" {
+			continue
+		}
+
+		for _, seq := range res.Seqs {
+			if seqs[len(seq.Words)] == nil {
+				seqs[len(seq.Words)] = map[string]bool{}
+			}
+			seqs[len(seq.Words)][strings.Join(seq.Words, "\x00")] = true
+		}
+	}
+
+	w := bufio.NewWriter(out)
+
+	for i, fn := range files {
+		tokens, err := readTokens(fn, c, counter)
+		if err != nil {
+			return err
+		}
+
+		if len(files) > 1 {
+			if i > 0 {
+				_ = w.WriteByte('\n') // #nosec
+			}
+			fmt.Fprintf(w, "%s:\n", fn)
+		}
+
+		for size, keys := range seqs {
+			markSequences(tokens, size, keys)
+		}
+
+		writeTokens(w, c, tokens)
+	}
+
+	return w.Flush()
+}
+
+// readTokens returns the tokens of the text of fn, decoded and prepared as it
+// is counted
+func readTokens(fn string, c config, counter *wordseq.Counter) ([]token, error) {
+	in, err := openInput(fn, c.Include, nil)
+	if err != nil {
+		return nil, withExit(exitInput, err)
+	}
+	defer in.Close()
+
+	br := bufio.NewReader(in)
+	if wordseq.IsState(br) {
+		return nil, exitErrorf(exitInput, "%s: saved counts can't be highlighted", fn)
+	}
+
+	var content io.Reader = br
+	if c.MaxBytes > 0 {
+		content = io.LimitReader(br, int64(c.MaxBytes))
+	}
+
+	r, err := decode(fn, &input{Reader: content, Closer: in.Closer, charset: in.charset}, c)
+	if err != nil {
+		return nil, withExit(exitDecode, err)
+	}
+
+	wr := wordreader.New(prepare(fn, r, c))
+
+	var tokens []token
+	for {
+		text, err := wr.ReadWord()
+		if err == io.EOF {
+			return tokens, nil
+		}
+
+		if err != nil {
+			return nil, withExit(exitInput, err)
+		}
+
+		tokens = append(tokens, token{text: text, word: counter.Normalize(text)})
+	}
+}
+
+// markSequences marks the tokens of every occurrence of the sequences of size
+// words in keys, including the space and punctuation between their words
+func markSequences(tokens []token, size int, keys map[string]bool) {
+	// window holds the indexes of the last words of sequences
+	window := make([]int, 0, size+1)
+	words := make([]string, 0, size+1)
+
+	for i, t := range tokens {
+		if t.word == "" {
+			continue
+		}
+
+		window = append(window, i)
+		words = append(words, t.word)
+
+		if len(window) > size {
+			window = window[1:]
+			words = words[1:]
+		}
+
+		if len(window) < size || !keys[strings.Join(words, "\x00")] {
+			continue
+		}
+
+		for j := window[0]; j <= i; j++ {
+			tokens[j].marked = true
+		}
+	}
+}
+
+// writeTokens writes the text of tokens with each run of marked ones colored
+// or, without color, between markers
+func writeTokens(w *bufio.Writer, c config, tokens []token) {
+	var run strings.Builder
+
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+
+		if c.color {
+			_, _ = w.WriteString(paint(run.String(), ansiBold+ansiYellow)) // #nosec
+		} else {
+			_, _ = w.WriteString(markStart + run.String() + markEnd) // #nosec
+		}
+		run.Reset()
+	}
+
+	for _, t := range tokens {
+		if t.marked {
+			run.WriteString(t.text)
+			continue
+		}
+
+		flush()
+		_, _ = w.WriteString(t.text) // #nosec
+	}
+
+	flush()
+}
@@ -0,0 +1,59 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteHighlighted(t *testing.T) {
+	dir := t.TempDir()
+
+	text := filepath.Join(dir, "a.txt")
+	if err := ioutil.WriteFile(text, []byte("The cat sat on the mat. The cat sat, again, on the mat!\nA dog ran.\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		size, n int
+		expect  string
+	}{
+		{3, 2, "[[The cat sat]] [[on the mat]]. [[The cat sat]], again, [[on the mat]]!\nA dog ran.\n"},
+		{2, 1, "The [[cat sat]] on the mat. The [[cat sat]], again, on the mat!\nA dog ran.\n"},
+		{1, 1, "[[The]] cat sat on [[the]] mat. [[The]] cat sat, again, on [[the]] mat!\nA dog ran.\n"},
+	} {
+		c := config{
+			SequenceSize: sizesFlag{v.size},
+			TopN:         intsFlag{v.n},
+			Output:       "text",
+			Sort:         "count-desc",
+			Color:        "never",
+			Overflow:     "truncate",
+			Sample:       1,
+			Encoding:     encodingFlag{all: "utf-8"},
+			OutputFile:   filepath.Join(dir, "out.txt"),
+			Highlight:    true,
+		}
+
+		if err := run(c, text); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadFile(c.OutputFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("size %d, top %d: %q != %q", v.size, v.n, got, v.expect)
+		}
+	}
+
+	c := config{Output: "text", Color: "never", Highlight: true}
+	if err := run(c, "-"); exitCode(err) != exitUsage {
+		t.Errorf("stdin: %v", err)
+	}
+}
@@ -0,0 +1,412 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ignoreRule is a line of a .gitignore style file, matched against the paths
+// below base, the slash separated directory of the file relative to the root
+// of the walk
+type ignoreRule struct {
+	base     string
+	segments []string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// parseIgnoreRule parses a line of a .gitignore style file in base. It returns
+// false if the line is blank or a comment.
+func parseIgnoreRule(base, line string) (ignoreRule, bool, error) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || line[0] == '#' {
+		return ignoreRule{}, false, nil
+	}
+
+	r := ignoreRule{base: base}
+
+	switch {
+	case line[0] == '!':
+		r.negate = true
+		line = line[1:]
+	case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		r.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	// patterns with a slash, other than at the end, are relative to base
+	// rather than matching names at any depth
+	r.anchored = strings.Contains(line, "/")
+	line = strings.TrimLeft(line, "/")
+
+	if line == "" {
+		return ignoreRule{}, false, nil
+	}
+
+	r.segments = strings.Split(line, "/")
+	for _, seg := range r.segments {
+		if _, err := path.Match(seg, ""); err != nil {
+			return ignoreRule{}, false, usageErrorf("invalid ignore pattern %q: %v", line, err)
+		}
+	}
+
+	return r, true, nil
+}
+
+// match reports whether rel, a slash separated path relative to the root of
+// the walk, is matched by r
+func (r ignoreRule) match(rel string, dir bool) bool {
+	if r.dirOnly && !dir {
+		return false
+	}
+
+	if r.base != "" {
+		if !strings.HasPrefix(rel, r.base+"/") {
+			return false
+		}
+		rel = rel[len(r.base)+1:]
+	}
+
+	parts := strings.Split(rel, "/")
+	if !r.anchored {
+		parts = parts[len(parts)-1:]
+	}
+
+	return matchSegments(r.segments, parts)
+}
+
+// matchSegments reports whether the segments of a path match those of a
+// pattern, where "**" matches any number of segments
+func matchSegments(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(parts); i++ {
+				if matchSegments(pattern[1:], parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(parts) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], parts[0]); !ok {
+			return false
+		}
+
+		pattern, parts = pattern[1:], parts[1:]
+	}
+
+	return len(parts) == 0
+}
+
+// ignored reports whether rel is ignored by rules, where the last rule that
+// matches decides
+func ignored(rules []ignoreRule, rel string, dir bool) bool {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].match(rel, dir) {
+			return !rules[i].negate
+		}
+	}
+	return false
+}
+
+// readIgnoreFile returns the rules of the .gitignore style file fn, which
+// apply to the paths below base
+func readIgnoreFile(fn, base string) ([]ignoreRule, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rules []ignoreRule
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		r, ok, err := parseIgnoreRule(base, s.Text())
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", fn, line, err)
+		}
+
+		if ok {
+			rules = append(rules, r)
+		}
+	}
+
+	if err = s.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %v", fn, err)
+	}
+
+	return rules, nil
+}
+
+// ignorer skips the files and directories of walks that are matched by the
+// -ignore patterns, the -ignore-file files and, with -gitignore, the
+// .gitignore files found along the way. Unless binary is set, it also skips
+// files that appear to be binary, remembering them so they can be reported.
+// Symlinks are only followed when follow is set.
+type ignorer struct {
+	rules     []ignoreRule
+	gitignore bool
+	follow    bool
+	binary    bool
+	include   []string
+	skipped   []string
+}
+
+func newIgnorer(c config) (*ignorer, error) {
+	ig := ignorer{
+		gitignore: c.GitIgnore,
+		follow:    c.FollowLinks,
+		binary:    c.Binary,
+		include:   c.Include,
+	}
+
+	for _, pattern := range c.Ignore {
+		r, ok, err := parseIgnoreRule("", pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			ig.rules = append(ig.rules, r)
+		}
+	}
+
+	for _, fn := range c.IgnoreFiles {
+		rules, err := readIgnoreFile(fn, "")
+		if err != nil {
+			return nil, err
+		}
+		ig.rules = append(ig.rules, rules...)
+	}
+
+	return &ig, nil
+}
+
+// walk calls fn with every file below root that isn't ignored. Root itself is
+// walked even if it is a symlink, as with find -H. Below it, symlinks to files
+// are read but those to directories are skipped unless following them, in
+// which case those that lead back to a directory that was already walked are
+// skipped instead. A nil ignorer ignores nothing.
+func (ig *ignorer) walk(root string, fn func(string, fs.DirEntry) error) error {
+	var rules []ignoreRule
+	var gitignore, follow bool
+	if ig != nil {
+		rules = append(rules, ig.rules...)
+		gitignore = ig.gitignore
+		follow = ig.follow
+	}
+
+	// the real paths of the directories walked so far
+	visited := map[string]bool{}
+
+	// walkDir walks dir, which is the target of the symlink at name when they
+	// differ, reporting the paths below it as being below name
+	var walkDir func(dir, as string) error
+	walkDir = func(dir, as string) error {
+		return filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if as != dir {
+				sub, err := filepath.Rel(dir, name)
+				if err != nil {
+					return err
+				}
+				name = filepath.Join(as, sub)
+			}
+
+			rel, err := filepath.Rel(root, name)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+
+			isDir := d.IsDir()
+			link := d.Type()&fs.ModeSymlink != 0
+
+			if link {
+				info, err := os.Stat(name)
+				if err != nil {
+					log.Printf("%s: skipping broken symlink", name)
+					return nil
+				}
+				isDir = info.IsDir()
+
+				if isDir && !follow {
+					infof("%s: skipping symlink to a directory (use -follow-symlinks to read it)", name)
+					return nil
+				}
+			}
+
+			if rel != "." && ignored(rules, rel, isDir) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if !isDir {
+				return fn(name, d)
+			}
+
+			if link {
+				target, err := filepath.EvalSymlinks(name)
+				if err != nil {
+					return err
+				}
+				return walkDir(target, name)
+			}
+
+			if follow {
+				real, err := filepath.EvalSymlinks(name)
+				if err != nil {
+					return err
+				}
+
+				if visited[real] {
+					log.Printf("%s: skipping symlink loop", name)
+					return filepath.SkipDir
+				}
+				visited[real] = true
+			}
+
+			if !gitignore {
+				return nil
+			}
+
+			// the rules of a directory follow those of its parents, so they
+			// take precedence
+			base := rel
+			if base == "." {
+				base = ""
+			}
+
+			more, err := readIgnoreFile(filepath.Join(name, ".gitignore"), base)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			rules = append(rules, more...)
+
+			return nil
+		})
+	}
+
+	if info, err := os.Lstat(root); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+		target, err := filepath.EvalSymlinks(root)
+		if err != nil {
+			return err
+		}
+		return walkDir(target, root)
+	}
+
+	return walkDir(root, root)
+}
+
+// binarySniff is how much of each file is inspected to decide whether it is
+// binary
+const binarySniff = 8000
+
+// byte order marks of utf-16 and utf-32 text, which is full of NULs
+var textBOMs = [][]byte{
+	{0x00, 0x00, 0xfe, 0xff},
+	{0xff, 0xfe},
+	{0xfe, 0xff},
+}
+
+// looksBinary reports whether data, the start of a file, appears to be binary.
+// It is if it has a NUL, other than in utf-16 or utf-32 text with a BOM or
+// utf-16 text found by sniffUTF16, or if more than a tenth of it is control
+// characters.
+func looksBinary(data []byte) bool {
+	for _, bom := range textBOMs {
+		if bytes.HasPrefix(data, bom) {
+			return false
+		}
+	}
+
+	if _, _, ok := sniffUTF16(data); ok {
+		return false
+	}
+
+	var control int
+	for _, b := range data {
+		switch {
+		case b == 0:
+			return true
+		case b == '\t', b == '\n', b == '\r', b == '\f', b == '\v', b == 0x1b:
+		case b < 0x20, b == 0x7f:
+			control++
+		}
+	}
+
+	return control*10 > len(data)
+}
+
+// skipBinary reports whether fn should be skipped for appearing to be binary
+// after it is decompressed, remembering it if so
+func (ig *ignorer) skipBinary(fn string) bool {
+	if ig == nil || ig.binary {
+		return false
+	}
+
+	in, err := openInput(fn, ig.include, nil)
+	if err != nil {
+		// the error is reported when the file is read
+		return false
+	}
+	defer in.Close()
+
+	buf := make([]byte, binarySniff)
+	n, err := io.ReadFull(in, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false
+	}
+
+	if !looksBinary(buf[:n]) {
+		return false
+	}
+
+	ig.skipped = append(ig.skipped, fn)
+	return true
+}
+
+// reportSkipped logs the files that were skipped for appearing to be binary
+func (ig *ignorer) reportSkipped() {
+	if ig == nil || len(ig.skipped) == 0 {
+		return
+	}
+
+	const maxShown = 10
+
+	shown := ig.skipped
+	var more string
+	if len(shown) > maxShown {
+		more = fmt.Sprintf(" and %d more", len(shown)-maxShown)
+		shown = shown[:maxShown]
+	}
+
+	log.Printf("skipped files that appear to be binary (use -binary to read them): %s%s",
+		strings.Join(shown, ", "), more)
+}
@@ -0,0 +1,254 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIgnoreRule(t *testing.T) {
+	for _, v := range []struct {
+		pattern string
+		base    string
+		rel     string
+		dir     bool
+		expect  bool
+	}{
+		{"*.o", "", "a.o", false, true},
+		{"*.o", "", "sub/deeper/a.o", false, true},
+		{"*.o", "", "a.c", false, false},
+		{"vendor/", "", "sub/vendor", true, true},
+		{"vendor/", "", "vendor", false, false},
+		{"/build", "", "build", true, true},
+		{"/build", "", "sub/build", true, false},
+		{"docs/*.md", "", "docs/a.md", false, true},
+		{"docs/*.md", "", "docs/sub/a.md", false, false},
+		{"**/gen/*.go", "", "a/b/gen/x.go", false, true},
+		{"**/gen/*.go", "", "gen/x.go", false, true},
+		{"a/**/b", "", "a/x/y/b", false, true},
+		{"*.log", "sub", "sub/x.log", false, true},
+		{"*.log", "sub", "x.log", false, false},
+		{"*.log", "sub", "subway/x.log", false, false},
+		{`\#notes`, "", "#notes", false, true},
+	} {
+		r, ok, err := parseIgnoreRule(v.base, v.pattern)
+		if err != nil || !ok {
+			t.Fatalf("%s: %v, %v", v.pattern, ok, err)
+		}
+
+		if got := r.match(v.rel, v.dir); got != v.expect {
+			t.Errorf("%s in %q, %s: %v != %v", v.pattern, v.base, v.rel, got, v.expect)
+		}
+	}
+
+	for _, line := range []string{"", "  ", "# comment", "/"} {
+		if _, ok, err := parseIgnoreRule("", line); ok || err != nil {
+			t.Errorf("%q: %v, %v", line, ok, err)
+		}
+	}
+
+	if _, _, err := parseIgnoreRule("", "a/[b"); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
+
+func TestIgnored(t *testing.T) {
+	var rules []ignoreRule
+	for _, pattern := range []string{"*.txt", "!keep.txt"} {
+		r, _, err := parseIgnoreRule("", pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rules = append(rules, r)
+	}
+
+	for rel, expect := range map[string]bool{
+		"a.txt":        true,
+		"keep.txt":     false,
+		"sub/keep.txt": false,
+		"a.md":         false,
+	} {
+		if got := ignored(rules, rel, false); got != expect {
+			t.Errorf("%s: %v != %v", rel, got, expect)
+		}
+	}
+}
+
+func TestIgnorerWalk(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir,
+		"a.txt", "a.o", "build/b.txt", "sub/c.txt", "sub/d.log",
+		"sub/vendor/e.txt", "sub/deeper/f.txt", "sub/deeper/g.txt",
+	)
+
+	write := func(name, data string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(".gitignore", "/build\n*.log\n")
+	write("sub/deeper/.gitignore", "*.txt\n!g.txt\n")
+	write("ignore", "vendor/\n")
+
+	walk := func(ig *ignorer) []string {
+		var ret []string
+		err := ig.walk(dir, func(fn string, d fs.DirEntry) error {
+			if d.Name() != ".gitignore" && d.Name() != "ignore" {
+				rel, _ := filepath.Rel(dir, fn) // #nosec
+				ret = append(ret, filepath.ToSlash(rel))
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ret
+	}
+
+	for _, v := range []struct {
+		c      config
+		expect []string
+	}{{
+		expect: []string{"a.o", "a.txt", "build/b.txt", "sub/c.txt", "sub/d.log", "sub/deeper/f.txt", "sub/deeper/g.txt", "sub/vendor/e.txt"},
+	}, {
+		c:      config{Ignore: stringsFlag{"*.o"}, IgnoreFiles: stringsFlag{filepath.Join(dir, "ignore")}},
+		expect: []string{"a.txt", "build/b.txt", "sub/c.txt", "sub/d.log", "sub/deeper/f.txt", "sub/deeper/g.txt"},
+	}, {
+		c:      config{GitIgnore: true},
+		expect: []string{"a.o", "a.txt", "sub/c.txt", "sub/deeper/g.txt", "sub/vendor/e.txt"},
+	}} {
+		ig, err := newIgnorer(v.c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := walk(ig); strings.Join(got, ",") != strings.Join(v.expect, ",") {
+			t.Errorf("%v != %v", got, v.expect)
+		}
+	}
+
+	// a nil ignorer ignores nothing
+	if got := walk(nil); len(got) != 8 {
+		t.Errorf("%v", got)
+	}
+
+	if _, err := newIgnorer(config{IgnoreFiles: stringsFlag{filepath.Join(dir, "missing")}}); err == nil {
+		t.Error("expected error for missing ignore file")
+	}
+}
+
+func TestLooksBinary(t *testing.T) {
+	for _, v := range []struct {
+		data   string
+		expect bool
+	}{
+		{"", false},
+		{"hello world\r\n\tbye\n", false},
+		{"caf\xc3\xa9 \xe9", false},
+		{"\x1b[1mbold\x1b[0m", false},
+		{"\x7fELF\x02\x01\x01\x00", true},
+		{"abc\x00def", true},
+		{"\xff\xfeh\x00i\x00", false},
+		{"\xfe\xff\x00h\x00i", false},
+		{"h\x00i\x00 \x00t\x00h\x00e\x00r\x00e\x00", false},
+		{"\x01\x02\x03abcdefgh", true},
+		{"\x01abcdefghijk", false},
+	} {
+		if got := looksBinary([]byte(v.data)); got != v.expect {
+			t.Errorf("%q: %v != %v", v.data, got, v.expect)
+		}
+	}
+}
+
+func TestSkipBinary(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir, "a.txt", "sub/b.txt")
+
+	bin := filepath.Join(dir, "sub", "c.bin")
+	if err := ioutil.WriteFile(bin, []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ig, err := newIgnorer(config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := expandArgs([]string{dir}, true, ig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "sub", "b.txt")}
+	if strings.Join(got, ",") != strings.Join(expect, ",") {
+		t.Errorf("%v != %v", got, expect)
+	}
+
+	if len(ig.skipped) != 1 || ig.skipped[0] != bin {
+		t.Errorf("skipped %v", ig.skipped)
+	}
+
+	// explicit files aren't sniffed
+	if got, err = expandArgs([]string{bin}, true, ig); err != nil || len(got) != 1 {
+		t.Errorf("%v, %v", got, err)
+	}
+
+	if ig, err = newIgnorer(config{Binary: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err = expandArgs([]string{dir}, true, ig); err != nil || len(got) != 3 {
+		t.Errorf("%v, %v", got, err)
+	}
+}
+
+func TestIgnorerSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir, "root/a.txt", "root/sub/b.txt", "other/c.txt")
+
+	root := filepath.Join(dir, "root")
+	for link, target := range map[string]string{
+		"root/c.txt":     "../other/c.txt",
+		"root/other":     "../other",
+		"root/sub/loop":  "..",
+		"root/broken":    "missing",
+		"root/sub/again": ".",
+		"link":           "root",
+	} {
+		if err := os.Symlink(target, filepath.Join(dir, filepath.FromSlash(link))); err != nil {
+			t.Skip(err)
+		}
+	}
+
+	for _, v := range []struct {
+		follow bool
+		expect []string
+	}{
+		{false, []string{"a.txt", "c.txt", "sub/b.txt"}},
+		{true, []string{"a.txt", "c.txt", "other/c.txt", "sub/b.txt"}},
+	} {
+		// a root that is a symlink is walked as its target
+		for _, walked := range []string{root, filepath.Join(dir, "link")} {
+			var got []string
+			err := (&ignorer{follow: v.follow}).walk(walked, func(fn string, d fs.DirEntry) error {
+				rel, _ := filepath.Rel(walked, fn) // #nosec
+				got = append(got, filepath.ToSlash(rel))
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if strings.Join(got, ",") != strings.Join(v.expect, ",") {
+				t.Errorf("%s follow %v: %v != %v", walked, v.follow, got, v.expect)
+			}
+		}
+	}
+}
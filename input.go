@@ -0,0 +1,528 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ulikunitz/xz"
+)
+
+var (
+	magicGzip  = []byte{0x1f, 0x8b}
+	magicBzip2 = []byte("BZh")
+	magicXZ    = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+	magicZip   = []byte("PK\x03\x04")
+	magicTar   = []byte("ustar")
+)
+
+const magicTarOffset = 257
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// isURL reports whether fn is fetched rather than read from a file, being an
+// http or https url or the uri of an object
+func isURL(fn string) bool {
+	return strings.HasPrefix(fn, "http://") || strings.HasPrefix(fn, "https://") || isObject(fn)
+}
+
+// an input is a single source of content
+type input struct {
+	io.Reader
+	io.Closer
+
+	// the charset declared by the source, such as in the Content-Type of a url
+	charset string
+
+	// live is set when the content arrives as it is sent, such as from a
+	// socket, so that it isn't known when more will follow
+	live bool
+}
+
+// openInput opens fn, or stdin if fn is "-", for reading. Compressed content is
+// decompressed and the members of zip and tar archives that match include (or
+// all of them if it is empty) are read one after another. The bytes read, before
+// decompression, are reported to p unless it is nil.
+func openInput(fn string, include []string, p *progress) (*input, error) {
+	if fn == "-" {
+		r, err := unarchive(p.reader(os.Stdin), include)
+		if err != nil {
+			return nil, err
+		}
+		return &input{Reader: r, Closer: nopCloser{}}, nil
+	}
+
+	if isURL(fn) {
+		return openURL(fn, include, p)
+	}
+
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	// zip archives need random access, so they can only be read from files
+	magic := make([]byte, len(magicZip))
+	if _, err = io.ReadFull(f, magic); err == nil && bytes.Equal(magic, magicZip) {
+		_ = f.Close() // #nosec
+
+		zr, err := zip.OpenReader(fn)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+
+		return &input{Reader: newZipReader(&zr.Reader, include), Closer: zr}, nil
+	}
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		_ = f.Close() // #nosec
+		return nil, err
+	}
+
+	r, err := unarchive(p.reader(f), include)
+	if err != nil {
+		_ = f.Close() // #nosec
+		return nil, fmt.Errorf("%s: %v", fn, err)
+	}
+
+	return &input{Reader: r, Closer: f}, nil
+}
+
+// openURL fetches the url, or object, noting the charset from its
+// Content-Type header
+func openURL(url string, include []string, p *progress) (*input, error) {
+	req, err := newRequest(context.Background(), url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close() // #nosec
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	in := input{Closer: resp.Body}
+
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		in.charset = params["charset"]
+	}
+
+	if in.Reader, err = unarchive(p.reader(resp.Body), include); err != nil {
+		_ = resp.Body.Close() // #nosec
+		return nil, fmt.Errorf("%s: %v", url, err)
+	}
+
+	return &in, nil
+}
+
+// unarchive decompresses r and, if it is a tar archive, returns a reader of the
+// members that match include
+func unarchive(r io.Reader, include []string) (io.Reader, error) {
+	r, err := decompress(r)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+
+	n := magicTarOffset + len(magicTar)
+	if magic, _ := br.Peek(n); len(magic) == n && bytes.Equal(magic[magicTarOffset:], magicTar) {
+		return newTarReader(tar.NewReader(br), include), nil
+	}
+
+	return br, nil
+}
+
+// matchMember reports whether the archive member name, or its base name,
+// matches any of the patterns, or true if there are no patterns
+func matchMember(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+
+		if ok, _ := path.Match(pattern, path.Base(name)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// archiveReader reads the members of an archive one after another, separated
+// by a space so that words don't run together across members
+type archiveReader struct {
+	// next returns the next member or io.EOF when there are none left
+	next func() (io.ReadCloser, error)
+	cur  io.ReadCloser
+	sep  bool
+}
+
+func (a *archiveReader) Read(p []byte) (int, error) {
+	for {
+		if a.sep {
+			a.sep = false
+			if len(p) == 0 {
+				return 0, nil
+			}
+			p[0] = ' '
+			return 1, nil
+		}
+
+		if a.cur == nil {
+			r, err := a.next()
+			if err != nil {
+				return 0, err
+			}
+			a.cur = r
+		}
+
+		n, err := a.cur.Read(p)
+		if err == io.EOF {
+			err = a.cur.Close()
+			a.cur = nil
+			a.sep = true
+		}
+
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+}
+
+func newZipReader(zr *zip.Reader, include []string) io.Reader {
+	files := zr.File
+
+	return &archiveReader{
+		next: func() (io.ReadCloser, error) {
+			for len(files) > 0 {
+				f := files[0]
+				files = files[1:]
+
+				if !f.Mode().IsRegular() || !matchMember(include, f.Name) {
+					continue
+				}
+
+				rc, err := f.Open()
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", f.Name, err)
+				}
+
+				r, err := decompress(rc)
+				if err != nil {
+					_ = rc.Close() // #nosec
+					return nil, fmt.Errorf("%s: %v", f.Name, err)
+				}
+
+				return struct {
+					io.Reader
+					io.Closer
+				}{r, rc}, nil
+			}
+
+			return nil, io.EOF
+		},
+	}
+}
+
+func newTarReader(tr *tar.Reader, include []string) io.Reader {
+	return &archiveReader{
+		next: func() (io.ReadCloser, error) {
+			for {
+				hdr, err := tr.Next()
+				if err != nil {
+					return nil, err
+				}
+
+				if !hdr.FileInfo().Mode().IsRegular() || !matchMember(include, hdr.Name) {
+					continue
+				}
+
+				r, err := decompress(tr)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", hdr.Name, err)
+				}
+
+				return ioutil.NopCloser(r), nil
+			}
+		},
+	}
+}
+
+// decompress detects, by its magic bytes, whether r is gzip, bzip2 or xz
+// compressed and if so returns a reader of the decompressed content. Otherwise
+// the content is returned unmodified.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+
+	// errors, including short content, are left for the actual reads
+	magic, _ := br.Peek(len(magicXZ))
+
+	switch {
+	case bytes.HasPrefix(magic, magicGzip):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, magicBzip2) && len(magic) > 3 && magic[3] >= '1' && magic[3] <= '9':
+		// the magic is followed by the block size
+		return bzip2.NewReader(br), nil
+	case bytes.HasPrefix(magic, magicXZ):
+		return xz.NewReader(br)
+	}
+
+	return br, nil
+}
+
+func hasMeta(path string) bool {
+	return strings.ContainsAny(path, `*?[\`)
+}
+
+// expandArgs converts the arguments into a list of files to read. Glob patterns
+// are expanded and, when recursive is set, directories are walked, skipping
+// what ig ignores. In recursive mode, patterns without a directory (e.g.
+// '*.txt') select which files are read from the walked directories rather than
+// being expanded themselves.
+func expandArgs(args []string, recursive bool, ig *ignorer) ([]string, error) {
+	var paths, filters []string
+	var explicit bool
+
+	for _, arg := range args {
+		switch {
+		case arg == "-":
+			paths = append(paths, arg)
+		case isURL(arg), !hasMeta(arg):
+			paths = append(paths, arg)
+			explicit = true
+		case recursive && !strings.ContainsRune(arg, filepath.Separator):
+			if _, err := filepath.Match(arg, ""); err != nil {
+				return nil, err
+			}
+			filters = append(filters, arg)
+		default:
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(matches) == 0 {
+				return nil, exitErrorf(exitInput, "no files match %s", arg)
+			}
+
+			paths = append(paths, matches...)
+			explicit = true
+		}
+	}
+
+	// only filters were given, so look for matching files here
+	if len(filters) > 0 && !explicit {
+		paths = append(paths, ".")
+	}
+
+	files, err := expandPaths(paths, filters, recursive, ig)
+	if err != nil {
+		return nil, err
+	}
+
+	// stdin isn't read in place of directories without any files
+	if len(files) == 0 && len(args) > 0 {
+		return nil, exitErrorf(exitInput, "no input files found in %s", strings.Join(args, " "))
+	}
+
+	return stdinOnce(files), nil
+}
+
+// stdinOnce removes every "-" from files after the first, where stdin is read,
+// as it can only be read once
+func stdinOnce(files []string) []string {
+	ret := files[:0]
+	var stdin bool
+	for _, fn := range files {
+		if fn == "-" {
+			if stdin {
+				continue
+			}
+			stdin = true
+		}
+		ret = append(ret, fn)
+	}
+	return ret
+}
+
+// expandPaths converts paths into a list of files to read, walking directories
+// for the files matching filters that ig doesn't ignore when recursive is set
+func expandPaths(paths, filters []string, recursive bool, ig *ignorer) ([]string, error) {
+	var ret []string
+	for _, path := range paths {
+		if path == "-" || isURL(path) {
+			ret = append(ret, path)
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, withExit(exitInput, err)
+		}
+
+		if !info.IsDir() {
+			ret = append(ret, path)
+			continue
+		}
+
+		if !recursive {
+			return nil, exitErrorf(exitInput, "%s is a directory (use -r to read it recursively)", path)
+		}
+
+		err = ig.walk(path, func(fn string, d fs.DirEntry) error {
+			if !matchAny(filters, d.Name()) || ig.skipBinary(fn) {
+				return nil
+			}
+
+			ret = append(ret, fn)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+// inputFiles returns the files to read, those of args as expanded by
+// expandArgs followed by those listed in the -files-from file, walking
+// directories with ig. Listed paths are read as they are, without expanding
+// glob patterns.
+func inputFiles(c config, args []string, ig *ignorer) ([]string, error) {
+	if c.Null && c.FilesFrom == "" {
+		return nil, usageErrorf("-0 requires -files-from")
+	}
+
+	files, err := expandArgs(args, c.Recursive, ig)
+	if err != nil || c.FilesFrom == "" {
+		return files, err
+	}
+
+	sep := byte('\n')
+	if c.Null {
+		sep = 0
+	}
+
+	listed, err := readFileList(c.FilesFrom, sep)
+	if err != nil {
+		return nil, withExit(exitInput, err)
+	}
+
+	if c.FilesFrom == "-" {
+		for _, fn := range append(args, listed...) {
+			if fn == "-" {
+				return nil, usageErrorf("stdin can't be read when -files-from is -")
+			}
+		}
+	}
+
+	if listed, err = expandPaths(listed, nil, c.Recursive, ig); err != nil {
+		return nil, err
+	}
+
+	files = stdinOnce(append(files, listed...))
+
+	// stdin isn't read in place of an empty list
+	if len(files) == 0 {
+		return nil, exitErrorf(exitInput, "no files listed in %s", c.FilesFrom)
+	}
+
+	return files, nil
+}
+
+// readFileList returns the paths listed in fn, or in stdin if fn is "-",
+// separated by sep. When sep is a newline, a trailing carriage return is
+// removed. Empty paths are skipped.
+func readFileList(fn string, sep byte) ([]string, error) {
+	r := io.Reader(os.Stdin)
+	if fn != "-" {
+		f, err := os.Open(fn)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var ret []string
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	s.Split(splitAt(sep))
+	for s.Scan() {
+		path := s.Text()
+		if sep == '\n' {
+			path = strings.TrimSuffix(path, "\r")
+		}
+
+		if path != "" {
+			ret = append(ret, path)
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %v", fn, err)
+	}
+
+	return ret, nil
+}
+
+// splitAt returns a bufio.SplitFunc that splits at each sep
+func splitAt(sep byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, sep); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+
+		return 0, nil, nil
+	}
+}
+
+// matchAny reports whether name matches any of the patterns, or true if there
+// are no patterns
+func matchAny(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,444 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ulikunitz/xz"
+)
+
+func mkfiles(t *testing.T, dir string, names ...string) {
+	t.Helper()
+
+	for _, name := range names {
+		fn := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(fn), 0700); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(fn, []byte(name), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExpandArgs(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir, "a.txt", "b.md", "sub/c.txt", "sub/deeper/d.txt", "sub/e.md")
+	if err := os.Mkdir(filepath.Join(dir, "empty"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	p := func(name string) string {
+		return filepath.Join(dir, filepath.FromSlash(name))
+	}
+
+	for _, v := range []struct {
+		args      []string
+		recursive bool
+		expect    []string
+		err       bool
+	}{{
+		args:   []string{p("a.txt"), "-", p("b.md")},
+		expect: []string{p("a.txt"), "-", p("b.md")},
+	}, {
+		args:   []string{"-", p("a.txt"), "-", p("b.md"), "-"},
+		expect: []string{"-", p("a.txt"), p("b.md")},
+	}, {
+		args:   []string{p("*.txt")},
+		expect: []string{p("a.txt")},
+	}, {
+		args:   []string{p("sub/*.txt")},
+		expect: []string{p("sub/c.txt")},
+	}, {
+		args: []string{p("*.none")},
+		err:  true,
+	}, {
+		args: []string{p("sub")},
+		err:  true,
+	}, {
+		args: []string{p("a.txt"), p("missing")},
+		err:  true,
+	}, {
+		args:      []string{p("sub")},
+		recursive: true,
+		expect:    []string{p("sub/c.txt"), p("sub/deeper/d.txt"), p("sub/e.md")},
+	}, {
+		args:      []string{dir, "*.txt"},
+		recursive: true,
+		expect:    []string{p("a.txt"), p("sub/c.txt"), p("sub/deeper/d.txt")},
+	}, {
+		args:      []string{p("sub"), "*.md", "d.*"},
+		recursive: true,
+		expect:    []string{p("sub/deeper/d.txt"), p("sub/e.md")},
+	}, {
+		args:      []string{"[", dir},
+		recursive: true,
+		err:       true,
+	}, {
+		args:      []string{p("empty")},
+		recursive: true,
+		err:       true,
+	}, {
+		args:      []string{p("sub"), "*.go"},
+		recursive: true,
+		err:       true,
+	}} {
+		got, err := expandArgs(v.args, v.recursive, nil)
+		if v.err {
+			if err == nil {
+				t.Errorf("%v: expected error", v.args)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(got, "\n") != strings.Join(v.expect, "\n") {
+			t.Errorf("%v: %v != %v", v.args, got, v.expect)
+		}
+	}
+}
+
+func TestExpandArgsFilterOnly(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir, "a.txt", "b.md", "sub/c.txt")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Chdir(wd) }()
+
+	got, err := expandArgs([]string{"*.txt"}, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{"a.txt", filepath.Join("sub", "c.txt")}
+	if strings.Join(got, "\n") != strings.Join(expect, "\n") {
+		t.Errorf("%v != %v", got, expect)
+	}
+}
+
+func TestInputFiles(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir, "a.txt", "b[1].txt", "sub/c.txt", "new\nline .txt")
+
+	p := func(name string) string {
+		return filepath.Join(dir, filepath.FromSlash(name))
+	}
+
+	list := p("list.txt")
+	if err := ioutil.WriteFile(list, []byte(p("b[1].txt")+"\r\n\n"+p("sub")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	null := p("null.txt")
+	if err := ioutil.WriteFile(null, []byte(p("new\nline .txt")+"\x00"+p("a.txt")), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	empty := p("empty.txt")
+	if err := ioutil.WriteFile(empty, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		args      []string
+		from      string
+		null      bool
+		recursive bool
+		expect    []string
+		err       bool
+	}{{
+		args:   []string{p("a.txt")},
+		expect: []string{p("a.txt")},
+	}, {
+		args:      []string{p("a.txt")},
+		from:      list,
+		recursive: true,
+		expect:    []string{p("a.txt"), p("b[1].txt"), p("sub/c.txt")},
+	}, {
+		from: list,
+		err:  true,
+	}, {
+		from:   null,
+		null:   true,
+		expect: []string{p("new\nline .txt"), p("a.txt")},
+	}, {
+		args: []string{p("a.txt")},
+		null: true,
+		err:  true,
+	}, {
+		from: p("missing.txt"),
+		err:  true,
+	}, {
+		from: empty,
+		err:  true,
+	}, {
+		args:   []string{p("a.txt")},
+		from:   empty,
+		expect: []string{p("a.txt")},
+	}} {
+		got, err := inputFiles(config{FilesFrom: v.from, Null: v.null, Recursive: v.recursive}, v.args, nil)
+		if v.err {
+			if err == nil {
+				t.Errorf("%v, %s: expected error", v.args, v.from)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(got, "\n") != strings.Join(v.expect, "\n") {
+			t.Errorf("%v, %s: %v != %v", v.args, v.from, got, v.expect)
+		}
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	const text = "hello world\n"
+
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	if _, err := gw.Write([]byte(text)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var x bytes.Buffer
+	xw, err := xz.NewWriter(&x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = xw.Write([]byte(text)); err != nil {
+		t.Fatal(err)
+	}
+	if err = xw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// printf 'hello world\n' | bzip2
+	bz := []byte{
+		0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0x4e, 0xec,
+		0xe8, 0x36, 0x00, 0x00, 0x02, 0x51, 0x80, 0x00, 0x10, 0x40, 0x00, 0x06,
+		0x44, 0x90, 0x80, 0x20, 0x00, 0x31, 0x06, 0x4c, 0x41, 0x01, 0xa7, 0xa9,
+		0xa5, 0x80, 0xbb, 0x94, 0x31, 0xf8, 0xbb, 0x92, 0x29, 0xc2, 0x84, 0x82,
+		0x77, 0x67, 0x41, 0xb0,
+	}
+
+	for name, data := range map[string][]byte{
+		"plain": []byte(text),
+		"gzip":  gz.Bytes(),
+		"bzip2": bz,
+		"xz":    x.Bytes(),
+	} {
+		r, err := decompress(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if string(got) != text {
+			t.Errorf("%s: %q != %q", name, got, text)
+		}
+	}
+
+	// short and empty content is passed through
+	for _, text := range []string{"", "a", "\x1f", "BZh is not bzip2"} {
+		r, err := decompress(strings.NewReader(text))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != text {
+			t.Errorf("%q != %q", got, text)
+		}
+	}
+}
+
+func TestOpenInputArchives(t *testing.T) {
+	dir := t.TempDir()
+
+	members := []struct {
+		name, body string
+	}{
+		{"docs/a.txt", "alpha"},
+		{"docs/b.md", "bravo"},
+		{"c.txt", "charlie"},
+	}
+
+	var zbuf bytes.Buffer
+	zw := zip.NewWriter(&zbuf)
+	for _, m := range members {
+		w, err := zw.Create(m.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(m.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var tbuf bytes.Buffer
+	gw := gzip.NewWriter(&tbuf)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{Name: "docs/", Typeflag: tar.TypeDir, Mode: 0700}); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range members {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     m.name,
+			Typeflag: tar.TypeReg,
+			Mode:     0600,
+			Size:     int64(len(m.body)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tw.Write([]byte(m.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archives := map[string][]byte{
+		"test.zip":    zbuf.Bytes(),
+		"test.tar.gz": tbuf.Bytes(),
+	}
+
+	for name, data := range archives {
+		fn := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(fn, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range []struct {
+			include []string
+			expect  string
+		}{
+			{nil, "alpha bravo charlie "},
+			{[]string{"*.txt"}, "alpha charlie "},
+			{[]string{"docs/*"}, "alpha bravo "},
+			{[]string{"*.none"}, ""},
+		} {
+			in, err := openInput(fn, v.include, nil)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			got, err := ioutil.ReadAll(in)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			if err = in.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != v.expect {
+				t.Errorf("%s %v: %q != %q", name, v.include, got, v.expect)
+			}
+		}
+	}
+}
+
+func TestOpenURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latin1":
+			w.Header().Set("Content-Type", "text/plain; charset=iso-8859-1")
+			_, _ = w.Write([]byte("caf\xe9")) // #nosec
+		case "/plain":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("caf\xe9")) // #nosec
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	for _, v := range []struct {
+		path    string
+		charset string
+	}{
+		{"/latin1", "iso-8859-1"},
+		{"/plain", ""},
+	} {
+		in, err := openInput(srv.URL+v.path, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ioutil.ReadAll(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = in.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != "caf\xe9" {
+			t.Errorf("%s: %q != %q", v.path, got, "caf\xe9")
+		}
+
+		if in.charset != v.charset {
+			t.Errorf("%s: charset(%s) != %s", v.path, in.charset, v.charset)
+		}
+	}
+
+	if _, err := openInput(srv.URL+"/missing", nil, nil); err == nil {
+		t.Error("expected error for missing url")
+	}
+
+	args, err := expandArgs([]string{srv.URL + "/plain?a=*"}, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(args) != 1 || args[0] != srv.URL+"/plain?a=*" {
+		t.Errorf("urls should not be expanded: %v", args)
+	}
+}
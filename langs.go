@@ -0,0 +1,146 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"jrubin.io/nr/stopwords"
+	"jrubin.io/nr/wordreader"
+	"jrubin.io/nr/wordseq"
+)
+
+// minLanguageShare is the least part of the text that a language must be for it
+// to be shown by -stats
+const minLanguageShare = 0.1
+
+// detectBytes is how much of the start of each file is read to choose the
+// stopwords of -stopwords-lang auto
+const detectBytes = 64 << 10
+
+// languages are the languages detected in every file counted, for -stats
+type languages struct {
+	mu sync.Mutex
+	d  *stopwords.Detector
+}
+
+func newLanguages() *languages {
+	return &languages{d: stopwords.NewDetector()}
+}
+
+// add adds the languages detected in a file
+func (l *languages) add(d *stopwords.Detector) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.d.Merge(d)
+}
+
+// dominant returns the languages of at least minLanguageShare of the text
+func (l *languages) dominant() []stopwords.Share {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var ret []stopwords.Share
+	for _, share := range l.d.Languages() {
+		if share.Fraction >= minLanguageShare {
+			ret = append(ret, share)
+		}
+	}
+	return ret
+}
+
+// languagesString returns langs as a list such as "en 90%, fr 10%"
+func languagesString(langs []stopwords.Share) string {
+	ret := make([]string, len(langs))
+	for i, share := range langs {
+		ret[i] = fmt.Sprintf("%s %.0f%%", share.Lang, share.Fraction*100)
+	}
+	return strings.Join(ret, ", ")
+}
+
+// autoStopwords replaces "auto" in -stopwords-lang with the language detected
+// in the start of files, which are read again when counted. stdin, which can't
+// be read again, is skipped.
+func autoStopwords(c *config, files []string) error {
+	var auto bool
+	langs := make(stringsFlag, 0, len(c.StopLangs))
+	for _, lang := range c.StopLangs {
+		if strings.EqualFold(lang, "auto") {
+			auto = true
+			continue
+		}
+		langs = append(langs, lang)
+	}
+
+	if !auto {
+		return nil
+	}
+
+	d := stopwords.NewDetector()
+	for _, fn := range files {
+		if fn == "-" {
+			continue
+		}
+
+		if err := detectFile(d, fn, *c); err != nil {
+			return err
+		}
+	}
+
+	detected := d.Languages()
+	if len(detected) == 0 {
+		infof("no language detected, no stopwords are ignored")
+		c.StopLangs = langs
+		return nil
+	}
+
+	infof("ignoring the stopwords of %s, the language detected in the input", detected[0].Lang)
+	c.StopLangs = append(langs, detected[0].Lang)
+	return nil
+}
+
+// detectFile adds the words of the start of fn to d
+func detectFile(d *stopwords.Detector, fn string, c config) error {
+	in, err := openInput(fn, c.Include, nil)
+	if err != nil {
+		return withExit(exitInput, err)
+	}
+	defer in.Close()
+
+	// saved counts have no text
+	br := bufio.NewReader(in)
+	if wordseq.IsState(br) {
+		return nil
+	}
+
+	content := io.LimitReader(br, detectBytes)
+
+	r, err := decode(fn, &input{Reader: content, Closer: in.Closer, charset: in.charset}, c)
+	if err != nil {
+		return withExit(exitDecode, err)
+	}
+
+	wr := wordreader.New(prepare(fn, r, c))
+	for {
+		word, err := wr.ReadWord()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return withExit(exitInput, err)
+		}
+
+		d.Add(word)
+	}
+}
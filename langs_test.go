@@ -0,0 +1,46 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAutoStopwords(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, text := range map[string]string{
+		"en.txt":   "the cat sat on the mat and it was happy",
+		"fr.txt":   "le chat est sur le tapis et il est content",
+		"none.txt": "zzz",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, v := range []struct {
+		langs  stringsFlag
+		file   string
+		expect stringsFlag
+	}{
+		{stringsFlag{"auto"}, "en.txt", stringsFlag{"en"}},
+		{stringsFlag{"de", "AUTO"}, "fr.txt", stringsFlag{"de", "fr"}},
+		{stringsFlag{"auto"}, "none.txt", stringsFlag{}},
+		{stringsFlag{"es"}, "en.txt", stringsFlag{"es"}},
+	} {
+		c := config{StopLangs: v.langs, Encoding: encodingFlag{all: "utf-8"}}
+
+		if err := autoStopwords(&c, []string{"-", filepath.Join(dir, v.file)}); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(c.StopLangs, v.expect) {
+			t.Errorf("%v, %s: %v != %v", v.langs, v.file, c.StopLangs, v.expect)
+		}
+	}
+}
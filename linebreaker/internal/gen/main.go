@@ -0,0 +1,342 @@
+// Command gen downloads the line break properties of a version of the Unicode
+// Character Database, along with the data that they are resolved and tailored
+// with, and writes the range tables of linebreaker made from them. It is run
+// by go generate in the linebreaker directory.
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// lineBreakTables are the tables made from LineBreak.txt, by the class they
+// hold. AI, SG, XX and the letters of SA are left out, as linebreaker resolves
+// the runes that aren't in any table to AL, and so are H2 and H3, as the
+// hangul syllables are classified by where they are.
+var lineBreakTables = map[string]string{
+	"B2":  "tableB2",
+	"BA":  "tableBA",
+	"BB":  "tableBB",
+	"BK":  "tableBK",
+	"CB":  "tableCB",
+	"CL":  "tableCL",
+	"CM":  "tableCM",
+	"CP":  "tableCP",
+	"CR":  "tableCR",
+	"EB":  "tableEB",
+	"EM":  "tableEM",
+	"EX":  "tableEX",
+	"GL":  "tableGL",
+	"HL":  "tableHL",
+	"HY":  "tableHY",
+	"ID":  "tableID",
+	"IN":  "tableIN",
+	"IS":  "tableIS",
+	"JL":  "tableJL",
+	"JT":  "tableJT",
+	"JV":  "tableJV",
+	"LF":  "tableLF",
+	"NL":  "tableNL",
+	"NS":  "tableNS",
+	"NU":  "tableNU",
+	"OP":  "tableOP",
+	"PO":  "tablePO",
+	"PR":  "tablePR",
+	"QU":  "tableQU",
+	"RI":  "tableRI",
+	"SP":  "tableSP",
+	"SY":  "tableSY",
+	"WJ":  "tableWJ",
+	"ZW":  "tableZW",
+	"ZWJ": "tableZWJ",
+}
+
+const header = `// Code generated by go run ./internal/gen. DO NOT EDIT.
+
+package linebreaker
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode"
+
+// UnicodeVersion is the version of the Unicode Character Database that the line
+// break property tables were made from
+const UnicodeVersion = %q
+
+var (
+`
+
+func main() {
+	log.SetFlags(0)
+
+	var (
+		version = flag.String("version", "", "version of the unicode character database to make the line break tables from")
+		baseURL = flag.String("url", "https://www.unicode.org/Public", "url of the unicode character database")
+		dir     = flag.String("o", ".", "directory to write the tables to")
+	)
+	flag.Parse()
+
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+
+	if err := generate(*baseURL+"/"+*version+"/ucd", filepath.Join(*dir, "tables.go"), *version); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate writes the tables made from the character database at url, and the
+// version constant, to fn
+func generate(url, fn, version string) error {
+	var lineBreak, eastAsianWidth, generalCategory, emoji map[string][]unicode.Range32
+	for _, v := range []struct {
+		file  string
+		props *map[string][]unicode.Range32
+	}{
+		{"LineBreak.txt", &lineBreak},
+		{"EastAsianWidth.txt", &eastAsianWidth},
+		{"extracted/DerivedGeneralCategory.txt", &generalCategory},
+		{"emoji/emoji-data.txt", &emoji},
+	} {
+		var err error
+		if *v.props, err = fetch(url + "/" + v.file); err != nil {
+			return err
+		}
+	}
+
+	src, err := write(resolve(lineBreak, eastAsianWidth, generalCategory, emoji), version)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(fn, src, 0644) // #nosec
+}
+
+// fetch returns the properties of the data file at url
+func fetch(url string) (map[string][]unicode.Range32, error) {
+	resp, err := http.Get(url) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }() // #nosec
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	props, err := parse(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", url, err)
+	}
+
+	return props, nil
+}
+
+// resolve returns the ranges of each table, by name, made from the line break
+// classes, east asian widths, general categories and emoji properties.
+//
+// As in LB1, CJ is resolved to NS and the marks of SA, those of general
+// category Mn or Mc, to CM. tableEastAsian holds the runes that are east asian
+// fullwidth, wide or halfwidth, which LB30 doesn't apply to, and
+// tableUnassignedPictographic those that are extended pictographic but not yet
+// assigned, which LB30b treats as emoji bases.
+func resolve(lineBreak, eastAsianWidth, generalCategory, emoji map[string][]unicode.Range32) map[string][]unicode.Range32 {
+	tables := map[string][]unicode.Range32{}
+	for class, name := range lineBreakTables {
+		tables[name] = append(tables[name], lineBreak[class]...)
+	}
+
+	tables["tableNS"] = append(tables["tableNS"], lineBreak["CJ"]...)
+
+	marks := merge(generalCategory["Mn"], generalCategory["Mc"])
+	tables["tableCM"] = append(tables["tableCM"], intersect(lineBreak["SA"], marks)...)
+
+	tables["tableEastAsian"] = merge(eastAsianWidth["F"], eastAsianWidth["W"], eastAsianWidth["H"])
+	tables["tableUnassignedPictographic"] = intersect(emoji["Extended_Pictographic"], generalCategory["Cn"])
+
+	for name, ranges := range tables {
+		tables[name] = merge(ranges)
+	}
+
+	return tables
+}
+
+// merge returns the ranges of all of sets, sorted and with those that overlap
+// or are adjacent joined
+func merge(sets ...[]unicode.Range32) []unicode.Range32 {
+	var ranges []unicode.Range32
+	for _, set := range sets {
+		ranges = append(ranges, set...)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Lo < ranges[j].Lo })
+
+	var merged []unicode.Range32
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.Lo <= merged[n-1].Hi+1 {
+			if r.Hi > merged[n-1].Hi {
+				merged[n-1].Hi = r.Hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// intersect returns the ranges of the runes that are in both a and b
+func intersect(a, b []unicode.Range32) []unicode.Range32 {
+	a, b = merge(a), merge(b)
+
+	var ranges []unicode.Range32
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		lo, hi := a[i].Lo, a[i].Hi
+		if b[j].Lo > lo {
+			lo = b[j].Lo
+		}
+		if b[j].Hi < hi {
+			hi = b[j].Hi
+		}
+
+		if lo <= hi {
+			ranges = append(ranges, unicode.Range32{Lo: lo, Hi: hi, Stride: 1})
+		}
+
+		if a[i].Hi < b[j].Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return ranges
+}
+
+// parse returns the ranges of each property in a file of the character
+// database, with lines such as "0041..005A    ; AL # ..."
+func parse(r io.Reader) (map[string][]unicode.Range32, error) {
+	props := map[string][]unicode.Range32{}
+
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: invalid: %q", n, s.Text())
+		}
+
+		bounds := strings.SplitN(strings.TrimSpace(fields[0]), "..", 2)
+		if len(bounds) == 1 {
+			bounds = append(bounds, bounds[0])
+		}
+
+		lo, err := strconv.ParseUint(bounds[0], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		hi, err := strconv.ParseUint(bounds[1], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		prop := strings.TrimSpace(fields[1])
+		props[prop] = append(props[prop], unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+	}
+
+	return props, s.Err()
+}
+
+// write returns the formatted source of tables
+func write(tables map[string][]unicode.Range32, version string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, header, version)
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		writeTable(&buf, name, tables[name])
+	}
+
+	buf.WriteString(")\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// writeTable writes the declaration of a table named name holding ranges,
+// which are sorted
+func writeTable(w io.Writer, name string, ranges []unicode.Range32) {
+	var r16, r32 []unicode.Range32
+	latinOffset := 0
+	for _, r := range ranges {
+		switch {
+		case r.Hi <= unicode.MaxLatin1:
+			latinOffset++
+			r16 = append(r16, r)
+		case r.Hi <= 0xffff:
+			r16 = append(r16, r)
+		case r.Lo > 0xffff:
+			r32 = append(r32, r)
+		default:
+			r16 = append(r16, unicode.Range32{Lo: r.Lo, Hi: 0xffff, Stride: 1})
+			r32 = append(r32, unicode.Range32{Lo: 0x10000, Hi: r.Hi, Stride: 1})
+		}
+	}
+
+	fmt.Fprintf(w, "\t%s = &unicode.RangeTable{\n", name)
+
+	if latinOffset > 0 {
+		fmt.Fprintf(w, "\t\tLatinOffset: %d,\n", latinOffset)
+	}
+
+	if len(r16) > 0 {
+		fmt.Fprintf(w, "\t\tR16: []unicode.Range16{\n")
+		for _, r := range r16 {
+			fmt.Fprintf(w, "\t\t\t{Lo: 0x%04x, Hi: 0x%04x, Stride: 1},\n", r.Lo, r.Hi)
+		}
+		fmt.Fprintf(w, "\t\t},\n")
+	}
+
+	if len(r32) > 0 {
+		fmt.Fprintf(w, "\t\tR32: []unicode.Range32{\n")
+		for _, r := range r32 {
+			fmt.Fprintf(w, "\t\t\t{Lo: 0x%x, Hi: 0x%x, Stride: 1},\n", r.Lo, r.Hi)
+		}
+		fmt.Fprintf(w, "\t\t},\n")
+	}
+
+	fmt.Fprintf(w, "\t}\n")
+}
@@ -0,0 +1,170 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// ucd is the part of the character database that TestGenerate serves, by
+// path
+var ucd = map[string]string{
+	"/14.0.0/ucd/LineBreak.txt": `# LineBreak-14.0.0.txt
+
+0030..0039;NU     # Nd    [10] DIGIT ZERO..DIGIT NINE
+0041..005A;AL     # Lu    [26] LATIN CAPITAL LETTER A..LATIN CAPITAL LETTER Z
+0E01..0E30;SA     # Lo    [48] THAI CHARACTER KO KAI..THAI CHARACTER SARA A
+0E31;SA           # Mn         THAI CHARACTER MAI HAN-AKAT
+0E34..0E3A;SA     # Mn     [7] THAI CHARACTER SARA I..THAI CHARACTER PHINTHU
+0300..036F;CM     # Mn   [112] COMBINING GRAVE ACCENT..COMBINING LATIN SMALL LETTER X
+3041;CJ           # Lo         HIRAGANA LETTER SMALL A
+309B..309C;NS     # Sk     [2] KATAKANA-HIRAGANA VOICED SOUND MARK..KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+3042;ID           # Lo         HIRAGANA LETTER A
+`,
+	"/14.0.0/ucd/EastAsianWidth.txt": `# EastAsianWidth-14.0.0.txt
+
+0030..0039;Na     # Nd    [10] DIGIT ZERO..DIGIT NINE
+3041..3096;W      # Lo    [86] HIRAGANA LETTER SMALL A..HIRAGANA LETTER SMALL KE
+FF01..FF60;F      # Po         FULLWIDTH EXCLAMATION MARK..FULLWIDTH RIGHT WHITE PARENTHESIS
+FF61..FF64;H      # Po         HALFWIDTH IDEOGRAPHIC FULL STOP..HALFWIDTH IDEOGRAPHIC COMMA
+`,
+	"/14.0.0/ucd/extracted/DerivedGeneralCategory.txt": `# DerivedGeneralCategory-14.0.0.txt
+
+0E31          ; Mn #       THAI CHARACTER MAI HAN-AKAT
+0E34..0E3A    ; Mn #   [7] THAI CHARACTER SARA I..THAI CHARACTER PHINTHU
+0E47..0E4E    ; Mn #   [8] THAI CHARACTER MAITAIKHU..THAI CHARACTER YAMAKKAN
+1FC00..1FFFD  ; Cn # [1022] <reserved-1FC00>..<reserved-1FFFD>
+`,
+	"/14.0.0/ucd/emoji/emoji-data.txt": `# emoji-data-14.0.0.txt
+
+1F600..1F64F  ; Extended_Pictographic# E1.0 [80] (😀..🙏)    grinning face..folded hands
+1FC00..1FFFD  ; Extended_Pictographic# E0.0[1022] (🰀..🿽)    <reserved-1FC00>..<reserved-1FFFD>
+`,
+}
+
+func TestParse(t *testing.T) {
+	props, err := parse(strings.NewReader(ucd["/14.0.0/ucd/LineBreak.txt"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(props["SA"]); n != 3 {
+		t.Errorf("%d SA ranges != 3", n)
+	}
+
+	if r := props["CJ"]; len(r) != 1 || r[0].Lo != 'ぁ' || r[0].Hi != 'ぁ' {
+		t.Errorf("unexpected CJ ranges %v", r)
+	}
+
+	if _, err = parse(strings.NewReader("0041 AL\n")); err == nil {
+		t.Error("expected error for line without property")
+	}
+
+	if _, err = parse(strings.NewReader("XYZ ; AL\n")); err == nil {
+		t.Error("expected error for invalid code point")
+	}
+}
+
+func TestMergeIntersect(t *testing.T) {
+	r := func(lo, hi uint32) unicode.Range32 { return unicode.Range32{Lo: lo, Hi: hi, Stride: 1} }
+
+	for _, test := range []struct {
+		a, b, merged, intersected []unicode.Range32
+	}{
+		{nil, nil, nil, nil},
+		{[]unicode.Range32{r(5, 9), r(1, 2)}, []unicode.Range32{r(3, 4)}, []unicode.Range32{r(1, 9)}, nil},
+		{[]unicode.Range32{r(1, 5), r(8, 9)}, []unicode.Range32{r(4, 8)}, []unicode.Range32{r(1, 9)}, []unicode.Range32{r(4, 5), r(8, 8)}},
+		{[]unicode.Range32{r(1, 9)}, []unicode.Range32{r(2, 3), r(5, 6)}, []unicode.Range32{r(1, 9)}, []unicode.Range32{r(2, 3), r(5, 6)}},
+		{[]unicode.Range32{r(1, 2)}, []unicode.Range32{r(4, 5)}, []unicode.Range32{r(1, 2), r(4, 5)}, nil},
+	} {
+		if got := merge(test.a, test.b); !reflect.DeepEqual(got, test.merged) {
+			t.Errorf("merge(%v, %v): %v != %v", test.a, test.b, got, test.merged)
+		}
+
+		if got := intersect(test.a, test.b); !reflect.DeepEqual(got, test.intersected) {
+			t.Errorf("intersect(%v, %v): %v != %v", test.a, test.b, got, test.intersected)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := ucd[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(data)) // #nosec
+	}))
+	defer srv.Close()
+
+	fn := filepath.Join(t.TempDir(), "tables.go")
+	if err := generate(srv.URL+"/14.0.0/ucd", fn, "14.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := ioutil.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expect := range []string{
+		"// Code generated by go run ./internal/gen. DO NOT EDIT.\n",
+		"const UnicodeVersion = \"14.0.0\"\n",
+
+		// the marks of SA are CM, and its letters aren't in any table
+		"\ttableCM = &unicode.RangeTable{\n" +
+			"\t\tR16: []unicode.Range16{\n" +
+			"\t\t\t{Lo: 0x0300, Hi: 0x036f, Stride: 1},\n" +
+			"\t\t\t{Lo: 0x0e31, Hi: 0x0e31, Stride: 1},\n" +
+			"\t\t\t{Lo: 0x0e34, Hi: 0x0e3a, Stride: 1},\n" +
+			"\t\t},\n" +
+			"\t}\n",
+
+		// CJ is NS
+		"\ttableNS = &unicode.RangeTable{\n" +
+			"\t\tR16: []unicode.Range16{\n" +
+			"\t\t\t{Lo: 0x3041, Hi: 0x3041, Stride: 1},\n" +
+			"\t\t\t{Lo: 0x309b, Hi: 0x309c, Stride: 1},\n" +
+			"\t\t},\n" +
+			"\t}\n",
+
+		"\ttableEastAsian = &unicode.RangeTable{\n" +
+			"\t\tR16: []unicode.Range16{\n" +
+			"\t\t\t{Lo: 0x3041, Hi: 0x3096, Stride: 1},\n" +
+			"\t\t\t{Lo: 0xff01, Hi: 0xff64, Stride: 1},\n" +
+			"\t\t},\n" +
+			"\t}\n",
+
+		"\ttableUnassignedPictographic = &unicode.RangeTable{\n" +
+			"\t\tR32: []unicode.Range32{\n" +
+			"\t\t\t{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},\n" +
+			"\t\t},\n" +
+			"\t}\n",
+
+		// classes that aren't in the data are empty
+		"\ttableB2 = &unicode.RangeTable{}\n",
+	} {
+		if !strings.Contains(string(src), expect) {
+			t.Errorf("%s doesn't contain %q", src, expect)
+		}
+	}
+
+	for _, unexpected := range []string{"tableAL", "tableSA", "tableCJ"} {
+		if strings.Contains(string(src), unexpected) {
+			t.Errorf("unexpected %s", unexpected)
+		}
+	}
+
+	if err = generate(srv.URL+"/missing", fn, "14.0.0"); err == nil {
+		t.Error("expected error for missing data")
+	}
+}
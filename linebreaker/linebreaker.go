@@ -0,0 +1,463 @@
+package linebreaker
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+//go:generate go run ./internal/gen -version 14.0.0
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LineBreaker is an interface wrapping a basic ReadSegment method.
+//
+// ReadSegment reads the text up to the next line break opportunity, including
+// any spaces before it, returning it along with whether the break is
+// mandatory, as it is after a line break, or any error encountered. At the end
+// of the input it will return an empty segment and io.EOF.
+type LineBreaker interface {
+	ReadSegment() (string, bool, error)
+}
+
+// New returns a new LineBreaker
+func New(r io.Reader) LineBreaker {
+	return &lineBreaker{
+		Reader: bufio.NewReader(r),
+	}
+}
+
+// lineBreaker takes an input io.Reader and finds the line break opportunities
+// in it using the Unicode line breaking algorithm in
+// <URL:http://unicode.org/reports/tr14/>.
+type lineBreaker struct {
+	*bufio.Reader
+	Buf bytes.Buffer
+
+	// last and prev are the classes of the last two runes of Buf, with combining
+	// marks taking the class of the rune they follow, and lastLiteral that of
+	// the last rune as it is. lastRune is the rune that last is the class of.
+	last, prev, lastLiteral class
+	lastRune                rune
+
+	// beforeSpace is the class of the last rune of Buf that isn't a space, and
+	// spaced is set when Buf ends with spaces
+	beforeSpace class
+	spaced      bool
+
+	// ris is the number of regional indicators that Buf ends with
+	ris int
+
+	// number is how much of a number Buf ends with
+	number number
+}
+
+// number is how much of a number a segment ends with, as in the tailoring of
+// LB25 in example 7 of section 8.2 of UAX #14, which LineBreakTest.txt uses
+type number int
+
+const (
+	noNumber     number = iota
+	openNumber          // NU (NU | SY | IS)*
+	closedNumber        // NU (NU | SY | IS)* (CL | CP)
+)
+
+// class is the line break property of a rune, with AI, SG, XX and the letters
+// of SA resolved to AL, the marks of SA to CM and CJ to NS as in LB1
+type class int
+
+const (
+	al class = iota
+	b2
+	ba
+	bb
+	bk
+	cb
+	cl
+	cm
+	cp
+	cr
+	eb
+	em
+	ex
+	gl
+	h2
+	h3
+	hl
+	hy
+	id
+	in
+	is
+	jl
+	jt
+	jv
+	lf
+	nl
+	ns
+	nu
+	op
+	po
+	pr
+	qu
+	ri
+	sp
+	sy
+	wj
+	zw
+	zwj
+)
+
+var classTables = []struct {
+	class class
+	table *unicode.RangeTable
+}{
+	{b2, tableB2},
+	{ba, tableBA},
+	{bb, tableBB},
+	{bk, tableBK},
+	{cb, tableCB},
+	{cl, tableCL},
+	{cm, tableCM},
+	{cp, tableCP},
+	{cr, tableCR},
+	{eb, tableEB},
+	{em, tableEM},
+	{ex, tableEX},
+	{gl, tableGL},
+	{hl, tableHL},
+	{hy, tableHY},
+	{id, tableID},
+	{in, tableIN},
+	{is, tableIS},
+	{jl, tableJL},
+	{jt, tableJT},
+	{jv, tableJV},
+	{lf, tableLF},
+	{nl, tableNL},
+	{ns, tableNS},
+	{nu, tableNU},
+	{op, tableOP},
+	{po, tablePO},
+	{pr, tablePR},
+	{qu, tableQU},
+	{ri, tableRI},
+	{sp, tableSP},
+	{sy, tableSY},
+	{wj, tableWJ},
+	{zw, tableZW},
+	{zwj, tableZWJ},
+}
+
+// the hangul syllables, which are H2 every 28 runes from the first and H3
+// otherwise
+const (
+	hangulFirst = '가'
+	hangulLast  = '힣'
+	hangulTs    = 28
+)
+
+func classOf(r rune) class {
+	if r >= hangulFirst && r <= hangulLast {
+		if (r-hangulFirst)%hangulTs == 0 {
+			return h2
+		}
+		return h3
+	}
+
+	for _, t := range classTables {
+		if unicode.Is(t.table, r) {
+			return t.class
+		}
+	}
+
+	return al
+}
+
+func hard(c class) bool {
+	return c == bk || c == cr || c == lf || c == nl
+}
+
+func alphabetic(c class) bool {
+	return c == al || c == hl
+}
+
+func ideographic(c class) bool {
+	return c == id || c == eb || c == em
+}
+
+func hangul(c class) bool {
+	return c == jl || c == jv || c == jt || c == h2 || c == h3
+}
+
+// eastAsian reports whether r is east asian fullwidth, wide or halfwidth
+func eastAsian(r rune) bool {
+	return unicode.Is(tableEastAsian, r)
+}
+
+// ReadSegment returns the text up to the next line break opportunity from a
+// lineBreaker's source.
+func (lb *lineBreaker) ReadSegment() (string, bool, error) {
+	for {
+		r, _, err := lb.ReadRune()
+		if err == io.EOF && lb.Buf.Len() > 0 {
+			segment, mandatory := lb.emit()
+			return segment, mandatory, nil
+		}
+
+		if err != nil {
+			return "", false, err
+		}
+
+		c := classOf(r)
+
+		// LB2	Never break at the start of text.
+		if lb.Buf.Len() == 0 || lb.joins(r, c) {
+			lb.push(r, c)
+			continue
+		}
+
+		segment, mandatory := lb.emit()
+		lb.push(r, c)
+		return segment, mandatory, nil
+	}
+}
+
+// emit returns the segment in Buf, and whether the break after it is
+// mandatory, and starts the next
+func (lb *lineBreaker) emit() (string, bool) {
+	segment, mandatory := lb.Buf.String(), hard(lb.lastLiteral)
+	lb.Buf.Reset()
+	lb.last, lb.prev, lb.lastLiteral, lb.lastRune = al, al, al, 0
+	lb.beforeSpace, lb.spaced = al, false
+	lb.ris, lb.number = 0, noNumber
+	return segment, mandatory
+}
+
+// push adds r, of class c, to the segment in Buf
+func (lb *lineBreaker) push(r rune, c class) {
+	base := lb.Buf.Len() == 0 || isolates(lb.lastLiteral)
+
+	_, _ = lb.Buf.WriteRune(r) // #nosec
+	lb.lastLiteral = c
+
+	if c == cm || c == zwj {
+		if !base {
+			// LB9	Treat X (CM | ZWJ)* as if it were X.
+			return
+		}
+
+		// LB10	Treat any remaining CM or ZWJ as if it were AL.
+		c = al
+	}
+
+	if c == ri {
+		lb.ris++
+	} else {
+		lb.ris = 0
+	}
+
+	switch {
+	case c == nu, lb.number == openNumber && (c == sy || c == is):
+		lb.number = openNumber
+	case lb.number == openNumber && (c == cl || c == cp):
+		lb.number = closedNumber
+	default:
+		lb.number = noNumber
+	}
+
+	lb.prev, lb.last, lb.lastRune = lb.last, c, r
+
+	if c == sp {
+		lb.spaced = true
+		return
+	}
+
+	lb.beforeSpace, lb.spaced = c, false
+}
+
+// isolates reports whether the combining marks, and ZWJ, that follow a rune
+// of class c are treated as AL rather than as part of it
+func isolates(c class) bool {
+	return hard(c) || c == sp || c == zw
+}
+
+// peek returns the class of the next rune, without reading it
+func (lb *lineBreaker) peek() class {
+	// at the end of the input there are fewer bytes, or none
+	b, _ := lb.Peek(utf8.UTFMax) // #nosec
+	r, _ := utf8.DecodeRune(b)
+	return classOf(r)
+}
+
+// joins reports whether r, of class c, follows the segment in Buf without a
+// break opportunity between them
+func (lb *lineBreaker) joins(r rune, c class) bool {
+	last, prev, before := lb.last, lb.prev, lb.beforeSpace
+
+	switch {
+	// Always break after hard line breaks, but not within CRLF.
+	case lb.lastLiteral == bk:
+		// LB4	BK	!
+		return false
+	case lb.lastLiteral == cr && c == lf:
+		// LB5	CR	×	LF
+		return true
+	case hard(lb.lastLiteral):
+		// LB5	(CR | LF | NL)	!
+		return false
+
+	// Do not break before hard line breaks, spaces or zero width spaces.
+	case hard(c):
+		// LB6	×	(BK | CR | LF | NL)
+		return true
+	case c == sp || c == zw:
+		// LB7	×	SP, ×	ZW
+		return true
+	case before == zw:
+		// LB8	ZW SP*	÷
+		return false
+	case lb.lastLiteral == zwj:
+		// LB8a	ZWJ	×
+		return true
+
+	// Do not break a combining character sequence.
+	case (c == cm || c == zwj) && !isolates(lb.lastLiteral):
+		// LB9	X (CM | ZWJ)*	→	X
+		return true
+	}
+
+	if c == cm || c == zwj {
+		// LB10	Treat any remaining CM or ZWJ as if it were AL.
+		c = al
+	}
+
+	switch {
+	// Do not break before or after word joiners and related characters.
+	case c == wj || last == wj:
+		// LB11	×	WJ, WJ	×
+		return true
+	case last == gl:
+		// LB12	GL	×
+		return true
+	case c == gl && !lb.spaced && last != ba && last != hy:
+		// LB12a	[^SP BA HY]	×	GL
+		return true
+
+	// Do not break before closing punctuation, even after spaces.
+	case c == cl || c == cp || c == ex || c == is || c == sy:
+		// LB13	×	(CL | CP | EX | IS | SY)
+		return true
+	case before == op:
+		// LB14	OP SP*	×
+		return true
+	case before == qu && c == op:
+		// LB15	QU SP*	×	OP
+		return true
+	case (before == cl || before == cp) && c == ns:
+		// LB16	(CL | CP) SP*	×	NS
+		return true
+	case before == b2 && c == b2:
+		// LB17	B2 SP*	×	B2
+		return true
+
+	// Break after spaces.
+	case lb.spaced:
+		// LB18	SP	÷
+		return false
+
+	// Do not break before or after quotation marks.
+	case c == qu || last == qu:
+		// LB19	×	QU, QU	×
+		return true
+
+	// Break before and after unresolved CB.
+	case c == cb || last == cb:
+		// LB20	÷	CB, CB	÷
+		return false
+
+	// Do not break before hyphen-minus, other hyphens, fixed-width spaces,
+	// small kana, and other non-starters, or after acute accents.
+	case c == ba || c == hy || c == ns || last == bb:
+		// LB21	×	BA, ×	HY, ×	NS, BB	×
+		return true
+	case prev == hl && (last == hy || last == ba):
+		// LB21a	HL (HY | BA)	×
+		return true
+	case last == sy && c == hl:
+		// LB21b	SY	×	HL
+		return true
+
+	// Do not break before ellipses.
+	case c == in:
+		// LB22	×	IN
+		return true
+
+	// Do not break between digits and letters, or between numeric prefixes
+	// and suffixes and ideographs and letters.
+	case alphabetic(last) && c == nu, last == nu && alphabetic(c):
+		// LB23	(AL | HL)	×	NU, NU	×	(AL | HL)
+		return true
+	case last == pr && ideographic(c), ideographic(last) && c == po:
+		// LB23a	PR	×	(ID | EB | EM), (ID | EB | EM)	×	PO
+		return true
+	case (last == pr || last == po) && alphabetic(c), alphabetic(last) && (c == pr || c == po):
+		// LB24	(PR | PO)	×	(AL | HL), (AL | HL)	×	(PR | PO)
+		return true
+
+	// Do not break between the parts of numbers.
+	case (last == pr || last == po) && c == nu,
+		(last == pr || last == po) && (c == op || c == hy) && lb.peek() == nu,
+		(last == op || last == hy) && c == nu:
+		// LB25	(PR | PO)	×	(OP | HY)? NU, (OP | HY)	×	NU
+		return true
+	case lb.number == openNumber && (c == nu || c == sy || c == is || c == cl || c == cp),
+		lb.number != noNumber && (c == po || c == pr):
+		// LB25	NU (NU | SY | IS)*	×	(NU | SY | IS | CL | CP),
+		//	NU (NU | SY | IS)* (CL | CP)?	×	(PO | PR)
+		return true
+
+	// Do not break a Korean syllable.
+	case last == jl && (c == jl || c == jv || c == h2 || c == h3),
+		(last == jv || last == h2) && (c == jv || c == jt),
+		(last == jt || last == h3) && c == jt:
+		// LB26	JL	×	(JL | JV | H2 | H3), (JV | H2)	×	(JV | JT), (JT | H3)	×	JT
+		return true
+	case hangul(last) && c == po, last == pr && hangul(c):
+		// LB27	(JL | JV | JT | H2 | H3)	×	PO, PR	×	(JL | JV | JT | H2 | H3)
+		return true
+
+	// Do not break between alphabetics, or after an infix numeric separator
+	// before letters.
+	case alphabetic(last) && alphabetic(c):
+		// LB28	(AL | HL)	×	(AL | HL)
+		return true
+	case last == is && alphabetic(c):
+		// LB29	IS	×	(AL | HL)
+		return true
+
+	// Do not break between letters, numbers, or ordinary symbols and opening
+	// or closing parentheses.
+	case (alphabetic(last) || last == nu) && c == op && !eastAsian(r),
+		last == cp && !eastAsian(lb.lastRune) && (alphabetic(c) || c == nu):
+		// LB30	(AL | HL | NU)	×	[OP - EastAsian], [CP - EastAsian]	×	(AL | HL | NU)
+		return true
+
+	// Do not break within emoji flag sequences.
+	case lb.ris%2 == 1 && c == ri:
+		// LB30a	sot (RI RI)* RI	×	RI, [^RI] (RI RI)* RI	×	RI
+		return true
+
+	// Do not break between an emoji base and an emoji modifier.
+	case (last == eb || unicode.Is(tableUnassignedPictographic, lb.lastRune)) && c == em:
+		// LB30b	EB	×	EM, [\p{Extended_Pictographic}&\p{Cn}]	×	EM
+		return true
+
+	// Otherwise, break everywhere.
+	default:
+		// LB31	ALL	÷, ÷	ALL
+		return false
+	}
+}
@@ -0,0 +1,166 @@
+package linebreaker
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReadSegment(t *testing.T) {
+	for _, test := range []struct {
+		str       string
+		segments  []string
+		mandatory []bool
+	}{
+		{"", nil, nil},
+		{"foo", []string{"foo"}, []bool{false}},
+		{"foo bar  baz", []string{"foo ", "bar  ", "baz"}, []bool{false, false, false}},
+
+		// http://unicode.org/reports/tr14/#LB4
+		// http://unicode.org/reports/tr14/#LB5
+		// http://unicode.org/reports/tr14/#LB6
+		{"foo\nbar\r\nbaz", []string{"foo\n", "bar\r\n", "baz"}, []bool{true, true, false}},
+		{"foo \n\n", []string{"foo \n", "\n"}, []bool{true, true}},
+
+		// http://unicode.org/reports/tr14/#LB8
+		{"foo​bar", []string{"foo​", "bar"}, []bool{false, false}},
+
+		// http://unicode.org/reports/tr14/#LB9
+		// http://unicode.org/reports/tr14/#LB10
+		{"é́ ́a", []string{"é́ ", "́a"}, []bool{false, false}},
+
+		// http://unicode.org/reports/tr14/#LB12
+		{"100\u00a0km away", []string{"100\u00a0km ", "away"}, []bool{false, false}},
+
+		// http://unicode.org/reports/tr14/#LB13
+		// http://unicode.org/reports/tr14/#LB14
+		{"(foo) bar !", []string{"(foo) ", "bar !"}, []bool{false, false}},
+		{"( foo", []string{"( foo"}, []bool{false}},
+
+		// http://unicode.org/reports/tr14/#LB19
+		{"say \"hi\" now", []string{"say ", "\"hi\" ", "now"}, []bool{false, false, false}},
+
+		// http://unicode.org/reports/tr14/#LB21
+		{"well-known", []string{"well-", "known"}, []bool{false, false}},
+
+		// http://unicode.org/reports/tr14/#LB25
+		{"$-1,234.56% off", []string{"$-1,234.56% ", "off"}, []bool{false, false}},
+
+		// http://unicode.org/reports/tr14/#LB26
+		{"한국어 abc", []string{"한", "국", "어 ", "abc"}, []bool{false, false, false, false}},
+
+		// http://unicode.org/reports/tr14/#LB30
+		{"f(x)", []string{"f(x)"}, []bool{false}},
+
+		// http://unicode.org/reports/tr14/#LB30a
+		// http://unicode.org/reports/tr14/#LB30b
+		{"🇺🇸🇫🇷👍🏽", []string{"🇺🇸", "🇫🇷", "👍🏽"}, []bool{false, false, false}},
+
+		// http://unicode.org/reports/tr14/#LB31
+		{"日本語", []string{"日", "本", "語"}, []bool{false, false, false}},
+		{"日本。です", []string{"日", "本。", "で", "す"}, []bool{false, false, false, false}},
+	} {
+		lb := New(strings.NewReader(test.str))
+
+		var segments []string
+		var mandatory []bool
+		for {
+			segment, m, err := lb.ReadSegment()
+			if err == io.EOF {
+				if segment != "" {
+					t.Errorf("%q: segment %q with io.EOF", test.str, segment)
+				}
+				break
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			segments = append(segments, segment)
+			mandatory = append(mandatory, m)
+		}
+
+		if !reflect.DeepEqual(segments, test.segments) || !reflect.DeepEqual(mandatory, test.mandatory) {
+			t.Errorf("%q: %q %v != %q %v", test.str, segments, mandatory, test.segments, test.mandatory)
+		}
+	}
+}
+
+// TestLineBreakTest checks the line break opportunities of each of the cases
+// of LineBreakTest.txt, of the version of UnicodeVersion, which are written
+// as "× 0023 ÷ 2014 ÷" with ÷ where a line may break and × where it may not
+func TestLineBreakTest(t *testing.T) {
+	f, err := os.Open("testdata/LineBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }() // #nosec
+
+	s := bufio.NewScanner(f)
+
+	if !s.Scan() || !strings.Contains(s.Text(), "LineBreakTest-"+UnicodeVersion+".txt") {
+		t.Fatalf("testdata/LineBreakTest.txt isn't of version %s: %q", UnicodeVersion, s.Text())
+	}
+
+	for n := 2; s.Scan(); n++ {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// the segments between the break opportunities, the first of which
+		// is at the start of the text, where breaks are never allowed
+		var text strings.Builder
+		var expect []string
+		for _, field := range strings.Fields(line)[1:] {
+			switch field {
+			case "÷":
+				expect = append(expect, text.String())
+				text.Reset()
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatalf("line %d: %v", n, err)
+				}
+				text.WriteRune(rune(r))
+			}
+		}
+
+		lb := New(strings.NewReader(strings.Join(expect, "")))
+
+		var segments []string
+		for {
+			segment, _, err := lb.ReadSegment()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			segments = append(segments, segment)
+		}
+
+		if !reflect.DeepEqual(segments, expect) {
+			t.Errorf("line %d: %+q != %+q\n\t%s", n, segments, expect, s.Text())
+		}
+	}
+
+	if err = s.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
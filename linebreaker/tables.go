@@ -0,0 +1,1488 @@
+// Code generated by go run ./internal/gen. DO NOT EDIT.
+
+package linebreaker
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode"
+
+// UnicodeVersion is the version of the Unicode Character Database that the line
+// break property tables were made from
+const UnicodeVersion = "14.0.0"
+
+var (
+	tableB2 = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x2014, Hi: 0x2014, Stride: 1},
+			{Lo: 0x2e3a, Hi: 0x2e3b, Stride: 1},
+		},
+	}
+
+	tableBA = &unicode.RangeTable{
+		LatinOffset: 3,
+		R16: []unicode.Range16{
+			{Lo: 0x0009, Hi: 0x0009, Stride: 1},
+			{Lo: 0x007c, Hi: 0x007c, Stride: 1},
+			{Lo: 0x00ad, Hi: 0x00ad, Stride: 1},
+			{Lo: 0x058a, Hi: 0x058a, Stride: 1},
+			{Lo: 0x05be, Hi: 0x05be, Stride: 1},
+			{Lo: 0x0964, Hi: 0x0965, Stride: 1},
+			{Lo: 0x0e5a, Hi: 0x0e5b, Stride: 1},
+			{Lo: 0x0f0b, Hi: 0x0f0b, Stride: 1},
+			{Lo: 0x0f34, Hi: 0x0f34, Stride: 1},
+			{Lo: 0x0f7f, Hi: 0x0f7f, Stride: 1},
+			{Lo: 0x0f85, Hi: 0x0f85, Stride: 1},
+			{Lo: 0x0fbe, Hi: 0x0fbf, Stride: 1},
+			{Lo: 0x0fd2, Hi: 0x0fd2, Stride: 1},
+			{Lo: 0x104a, Hi: 0x104b, Stride: 1},
+			{Lo: 0x1361, Hi: 0x1361, Stride: 1},
+			{Lo: 0x1400, Hi: 0x1400, Stride: 1},
+			{Lo: 0x1680, Hi: 0x1680, Stride: 1},
+			{Lo: 0x16eb, Hi: 0x16ed, Stride: 1},
+			{Lo: 0x1735, Hi: 0x1736, Stride: 1},
+			{Lo: 0x17d4, Hi: 0x17d5, Stride: 1},
+			{Lo: 0x17d8, Hi: 0x17d8, Stride: 1},
+			{Lo: 0x17da, Hi: 0x17da, Stride: 1},
+			{Lo: 0x1804, Hi: 0x1805, Stride: 1},
+			{Lo: 0x1b5a, Hi: 0x1b5b, Stride: 1},
+			{Lo: 0x1b5d, Hi: 0x1b60, Stride: 1},
+			{Lo: 0x1b7d, Hi: 0x1b7e, Stride: 1},
+			{Lo: 0x1c3b, Hi: 0x1c3f, Stride: 1},
+			{Lo: 0x1c7e, Hi: 0x1c7f, Stride: 1},
+			{Lo: 0x2000, Hi: 0x2006, Stride: 1},
+			{Lo: 0x2008, Hi: 0x200a, Stride: 1},
+			{Lo: 0x2010, Hi: 0x2010, Stride: 1},
+			{Lo: 0x2012, Hi: 0x2013, Stride: 1},
+			{Lo: 0x2027, Hi: 0x2027, Stride: 1},
+			{Lo: 0x2056, Hi: 0x2056, Stride: 1},
+			{Lo: 0x2058, Hi: 0x205b, Stride: 1},
+			{Lo: 0x205d, Hi: 0x205f, Stride: 1},
+			{Lo: 0x2cfa, Hi: 0x2cfc, Stride: 1},
+			{Lo: 0x2cff, Hi: 0x2cff, Stride: 1},
+			{Lo: 0x2d70, Hi: 0x2d70, Stride: 1},
+			{Lo: 0x2e0e, Hi: 0x2e15, Stride: 1},
+			{Lo: 0x2e17, Hi: 0x2e17, Stride: 1},
+			{Lo: 0x2e19, Hi: 0x2e19, Stride: 1},
+			{Lo: 0x2e2a, Hi: 0x2e2d, Stride: 1},
+			{Lo: 0x2e30, Hi: 0x2e31, Stride: 1},
+			{Lo: 0x2e33, Hi: 0x2e34, Stride: 1},
+			{Lo: 0x2e3c, Hi: 0x2e3e, Stride: 1},
+			{Lo: 0x2e40, Hi: 0x2e41, Stride: 1},
+			{Lo: 0x2e43, Hi: 0x2e4a, Stride: 1},
+			{Lo: 0x2e4c, Hi: 0x2e4c, Stride: 1},
+			{Lo: 0x2e4e, Hi: 0x2e4f, Stride: 1},
+			{Lo: 0x2e5d, Hi: 0x2e5d, Stride: 1},
+			{Lo: 0x3000, Hi: 0x3000, Stride: 1},
+			{Lo: 0xa4fe, Hi: 0xa4ff, Stride: 1},
+			{Lo: 0xa60d, Hi: 0xa60d, Stride: 1},
+			{Lo: 0xa60f, Hi: 0xa60f, Stride: 1},
+			{Lo: 0xa6f3, Hi: 0xa6f7, Stride: 1},
+			{Lo: 0xa8ce, Hi: 0xa8cf, Stride: 1},
+			{Lo: 0xa92e, Hi: 0xa92f, Stride: 1},
+			{Lo: 0xa9c7, Hi: 0xa9c9, Stride: 1},
+			{Lo: 0xaa5d, Hi: 0xaa5f, Stride: 1},
+			{Lo: 0xaaf0, Hi: 0xaaf1, Stride: 1},
+			{Lo: 0xabeb, Hi: 0xabeb, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10100, Hi: 0x10102, Stride: 1},
+			{Lo: 0x1039f, Hi: 0x1039f, Stride: 1},
+			{Lo: 0x103d0, Hi: 0x103d0, Stride: 1},
+			{Lo: 0x10857, Hi: 0x10857, Stride: 1},
+			{Lo: 0x1091f, Hi: 0x1091f, Stride: 1},
+			{Lo: 0x10a50, Hi: 0x10a57, Stride: 1},
+			{Lo: 0x10af0, Hi: 0x10af5, Stride: 1},
+			{Lo: 0x10b39, Hi: 0x10b3f, Stride: 1},
+			{Lo: 0x10ead, Hi: 0x10ead, Stride: 1},
+			{Lo: 0x11047, Hi: 0x11048, Stride: 1},
+			{Lo: 0x110be, Hi: 0x110c1, Stride: 1},
+			{Lo: 0x11140, Hi: 0x11143, Stride: 1},
+			{Lo: 0x111c5, Hi: 0x111c6, Stride: 1},
+			{Lo: 0x111c8, Hi: 0x111c8, Stride: 1},
+			{Lo: 0x111dd, Hi: 0x111df, Stride: 1},
+			{Lo: 0x11238, Hi: 0x11239, Stride: 1},
+			{Lo: 0x1123b, Hi: 0x1123c, Stride: 1},
+			{Lo: 0x112a9, Hi: 0x112a9, Stride: 1},
+			{Lo: 0x1144b, Hi: 0x1144e, Stride: 1},
+			{Lo: 0x1145a, Hi: 0x1145b, Stride: 1},
+			{Lo: 0x115c2, Hi: 0x115c3, Stride: 1},
+			{Lo: 0x115c9, Hi: 0x115d7, Stride: 1},
+			{Lo: 0x11641, Hi: 0x11642, Stride: 1},
+			{Lo: 0x1173c, Hi: 0x1173e, Stride: 1},
+			{Lo: 0x11944, Hi: 0x11946, Stride: 1},
+			{Lo: 0x11a41, Hi: 0x11a44, Stride: 1},
+			{Lo: 0x11a9a, Hi: 0x11a9c, Stride: 1},
+			{Lo: 0x11aa1, Hi: 0x11aa2, Stride: 1},
+			{Lo: 0x11c41, Hi: 0x11c45, Stride: 1},
+			{Lo: 0x11fff, Hi: 0x11fff, Stride: 1},
+			{Lo: 0x12470, Hi: 0x12474, Stride: 1},
+			{Lo: 0x16a6e, Hi: 0x16a6f, Stride: 1},
+			{Lo: 0x16af5, Hi: 0x16af5, Stride: 1},
+			{Lo: 0x16b37, Hi: 0x16b39, Stride: 1},
+			{Lo: 0x16b44, Hi: 0x16b44, Stride: 1},
+			{Lo: 0x16e97, Hi: 0x16e98, Stride: 1},
+			{Lo: 0x1bc9f, Hi: 0x1bc9f, Stride: 1},
+			{Lo: 0x1da87, Hi: 0x1da8a, Stride: 1},
+		},
+	}
+
+	tableBB = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x00b4, Hi: 0x00b4, Stride: 1},
+			{Lo: 0x02c8, Hi: 0x02c8, Stride: 1},
+			{Lo: 0x02cc, Hi: 0x02cc, Stride: 1},
+			{Lo: 0x02df, Hi: 0x02df, Stride: 1},
+			{Lo: 0x0c77, Hi: 0x0c77, Stride: 1},
+			{Lo: 0x0c84, Hi: 0x0c84, Stride: 1},
+			{Lo: 0x0f01, Hi: 0x0f04, Stride: 1},
+			{Lo: 0x0f06, Hi: 0x0f07, Stride: 1},
+			{Lo: 0x0f09, Hi: 0x0f0a, Stride: 1},
+			{Lo: 0x0fd0, Hi: 0x0fd1, Stride: 1},
+			{Lo: 0x0fd3, Hi: 0x0fd3, Stride: 1},
+			{Lo: 0x1806, Hi: 0x1806, Stride: 1},
+			{Lo: 0x1ffd, Hi: 0x1ffd, Stride: 1},
+			{Lo: 0xa874, Hi: 0xa875, Stride: 1},
+			{Lo: 0xa8fc, Hi: 0xa8fc, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x11175, Hi: 0x11175, Stride: 1},
+			{Lo: 0x111db, Hi: 0x111db, Stride: 1},
+			{Lo: 0x115c1, Hi: 0x115c1, Stride: 1},
+			{Lo: 0x11660, Hi: 0x1166c, Stride: 1},
+			{Lo: 0x119e2, Hi: 0x119e2, Stride: 1},
+			{Lo: 0x11a3f, Hi: 0x11a3f, Stride: 1},
+			{Lo: 0x11a45, Hi: 0x11a45, Stride: 1},
+			{Lo: 0x11a9e, Hi: 0x11aa0, Stride: 1},
+			{Lo: 0x11c70, Hi: 0x11c70, Stride: 1},
+		},
+	}
+
+	tableBK = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x000b, Hi: 0x000c, Stride: 1},
+			{Lo: 0x2028, Hi: 0x2029, Stride: 1},
+		},
+	}
+
+	tableCB = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0xfffc, Hi: 0xfffc, Stride: 1},
+		},
+	}
+
+	tableCL = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x007d, Hi: 0x007d, Stride: 1},
+			{Lo: 0x0f3b, Hi: 0x0f3b, Stride: 1},
+			{Lo: 0x0f3d, Hi: 0x0f3d, Stride: 1},
+			{Lo: 0x169c, Hi: 0x169c, Stride: 1},
+			{Lo: 0x2046, Hi: 0x2046, Stride: 1},
+			{Lo: 0x207e, Hi: 0x207e, Stride: 1},
+			{Lo: 0x208e, Hi: 0x208e, Stride: 1},
+			{Lo: 0x2309, Hi: 0x2309, Stride: 1},
+			{Lo: 0x230b, Hi: 0x230b, Stride: 1},
+			{Lo: 0x232a, Hi: 0x232a, Stride: 1},
+			{Lo: 0x2769, Hi: 0x2769, Stride: 1},
+			{Lo: 0x276b, Hi: 0x276b, Stride: 1},
+			{Lo: 0x276d, Hi: 0x276d, Stride: 1},
+			{Lo: 0x276f, Hi: 0x276f, Stride: 1},
+			{Lo: 0x2771, Hi: 0x2771, Stride: 1},
+			{Lo: 0x2773, Hi: 0x2773, Stride: 1},
+			{Lo: 0x2775, Hi: 0x2775, Stride: 1},
+			{Lo: 0x27c6, Hi: 0x27c6, Stride: 1},
+			{Lo: 0x27e7, Hi: 0x27e7, Stride: 1},
+			{Lo: 0x27e9, Hi: 0x27e9, Stride: 1},
+			{Lo: 0x27eb, Hi: 0x27eb, Stride: 1},
+			{Lo: 0x27ed, Hi: 0x27ed, Stride: 1},
+			{Lo: 0x27ef, Hi: 0x27ef, Stride: 1},
+			{Lo: 0x2984, Hi: 0x2984, Stride: 1},
+			{Lo: 0x2986, Hi: 0x2986, Stride: 1},
+			{Lo: 0x2988, Hi: 0x2988, Stride: 1},
+			{Lo: 0x298a, Hi: 0x298a, Stride: 1},
+			{Lo: 0x298c, Hi: 0x298c, Stride: 1},
+			{Lo: 0x298e, Hi: 0x298e, Stride: 1},
+			{Lo: 0x2990, Hi: 0x2990, Stride: 1},
+			{Lo: 0x2992, Hi: 0x2992, Stride: 1},
+			{Lo: 0x2994, Hi: 0x2994, Stride: 1},
+			{Lo: 0x2996, Hi: 0x2996, Stride: 1},
+			{Lo: 0x2998, Hi: 0x2998, Stride: 1},
+			{Lo: 0x29d9, Hi: 0x29d9, Stride: 1},
+			{Lo: 0x29db, Hi: 0x29db, Stride: 1},
+			{Lo: 0x29fd, Hi: 0x29fd, Stride: 1},
+			{Lo: 0x2e23, Hi: 0x2e23, Stride: 1},
+			{Lo: 0x2e25, Hi: 0x2e25, Stride: 1},
+			{Lo: 0x2e27, Hi: 0x2e27, Stride: 1},
+			{Lo: 0x2e29, Hi: 0x2e29, Stride: 1},
+			{Lo: 0x2e56, Hi: 0x2e56, Stride: 1},
+			{Lo: 0x2e58, Hi: 0x2e58, Stride: 1},
+			{Lo: 0x2e5a, Hi: 0x2e5a, Stride: 1},
+			{Lo: 0x2e5c, Hi: 0x2e5c, Stride: 1},
+			{Lo: 0x3001, Hi: 0x3002, Stride: 1},
+			{Lo: 0x3009, Hi: 0x3009, Stride: 1},
+			{Lo: 0x300b, Hi: 0x300b, Stride: 1},
+			{Lo: 0x300d, Hi: 0x300d, Stride: 1},
+			{Lo: 0x300f, Hi: 0x300f, Stride: 1},
+			{Lo: 0x3011, Hi: 0x3011, Stride: 1},
+			{Lo: 0x3015, Hi: 0x3015, Stride: 1},
+			{Lo: 0x3017, Hi: 0x3017, Stride: 1},
+			{Lo: 0x3019, Hi: 0x3019, Stride: 1},
+			{Lo: 0x301b, Hi: 0x301b, Stride: 1},
+			{Lo: 0x301e, Hi: 0x301f, Stride: 1},
+			{Lo: 0xfd3e, Hi: 0xfd3e, Stride: 1},
+			{Lo: 0xfe11, Hi: 0xfe12, Stride: 1},
+			{Lo: 0xfe18, Hi: 0xfe18, Stride: 1},
+			{Lo: 0xfe36, Hi: 0xfe36, Stride: 1},
+			{Lo: 0xfe38, Hi: 0xfe38, Stride: 1},
+			{Lo: 0xfe3a, Hi: 0xfe3a, Stride: 1},
+			{Lo: 0xfe3c, Hi: 0xfe3c, Stride: 1},
+			{Lo: 0xfe3e, Hi: 0xfe3e, Stride: 1},
+			{Lo: 0xfe40, Hi: 0xfe40, Stride: 1},
+			{Lo: 0xfe42, Hi: 0xfe42, Stride: 1},
+			{Lo: 0xfe44, Hi: 0xfe44, Stride: 1},
+			{Lo: 0xfe48, Hi: 0xfe48, Stride: 1},
+			{Lo: 0xfe50, Hi: 0xfe50, Stride: 1},
+			{Lo: 0xfe52, Hi: 0xfe52, Stride: 1},
+			{Lo: 0xfe5a, Hi: 0xfe5a, Stride: 1},
+			{Lo: 0xfe5c, Hi: 0xfe5c, Stride: 1},
+			{Lo: 0xfe5e, Hi: 0xfe5e, Stride: 1},
+			{Lo: 0xff09, Hi: 0xff09, Stride: 1},
+			{Lo: 0xff0c, Hi: 0xff0c, Stride: 1},
+			{Lo: 0xff0e, Hi: 0xff0e, Stride: 1},
+			{Lo: 0xff3d, Hi: 0xff3d, Stride: 1},
+			{Lo: 0xff5d, Hi: 0xff5d, Stride: 1},
+			{Lo: 0xff60, Hi: 0xff61, Stride: 1},
+			{Lo: 0xff63, Hi: 0xff64, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1325b, Hi: 0x1325d, Stride: 1},
+			{Lo: 0x13282, Hi: 0x13282, Stride: 1},
+			{Lo: 0x13287, Hi: 0x13287, Stride: 1},
+			{Lo: 0x13289, Hi: 0x13289, Stride: 1},
+			{Lo: 0x1337a, Hi: 0x1337b, Stride: 1},
+			{Lo: 0x13438, Hi: 0x13438, Stride: 1},
+			{Lo: 0x145cf, Hi: 0x145cf, Stride: 1},
+		},
+	}
+
+	tableCM = &unicode.RangeTable{
+		LatinOffset: 4,
+		R16: []unicode.Range16{
+			{Lo: 0x0000, Hi: 0x0008, Stride: 1},
+			{Lo: 0x000e, Hi: 0x001f, Stride: 1},
+			{Lo: 0x007f, Hi: 0x0084, Stride: 1},
+			{Lo: 0x0086, Hi: 0x009f, Stride: 1},
+			{Lo: 0x0300, Hi: 0x034e, Stride: 1},
+			{Lo: 0x0350, Hi: 0x035b, Stride: 1},
+			{Lo: 0x0363, Hi: 0x036f, Stride: 1},
+			{Lo: 0x0483, Hi: 0x0489, Stride: 1},
+			{Lo: 0x0591, Hi: 0x05bd, Stride: 1},
+			{Lo: 0x05bf, Hi: 0x05bf, Stride: 1},
+			{Lo: 0x05c1, Hi: 0x05c2, Stride: 1},
+			{Lo: 0x05c4, Hi: 0x05c5, Stride: 1},
+			{Lo: 0x05c7, Hi: 0x05c7, Stride: 1},
+			{Lo: 0x0610, Hi: 0x061a, Stride: 1},
+			{Lo: 0x061c, Hi: 0x061c, Stride: 1},
+			{Lo: 0x064b, Hi: 0x065f, Stride: 1},
+			{Lo: 0x0670, Hi: 0x0670, Stride: 1},
+			{Lo: 0x06d6, Hi: 0x06dc, Stride: 1},
+			{Lo: 0x06df, Hi: 0x06e4, Stride: 1},
+			{Lo: 0x06e7, Hi: 0x06e8, Stride: 1},
+			{Lo: 0x06ea, Hi: 0x06ed, Stride: 1},
+			{Lo: 0x0711, Hi: 0x0711, Stride: 1},
+			{Lo: 0x0730, Hi: 0x074a, Stride: 1},
+			{Lo: 0x07a6, Hi: 0x07b0, Stride: 1},
+			{Lo: 0x07eb, Hi: 0x07f3, Stride: 1},
+			{Lo: 0x07fd, Hi: 0x07fd, Stride: 1},
+			{Lo: 0x0816, Hi: 0x0819, Stride: 1},
+			{Lo: 0x081b, Hi: 0x0823, Stride: 1},
+			{Lo: 0x0825, Hi: 0x0827, Stride: 1},
+			{Lo: 0x0829, Hi: 0x082d, Stride: 1},
+			{Lo: 0x0859, Hi: 0x085b, Stride: 1},
+			{Lo: 0x0898, Hi: 0x089f, Stride: 1},
+			{Lo: 0x08ca, Hi: 0x08e1, Stride: 1},
+			{Lo: 0x08e3, Hi: 0x0903, Stride: 1},
+			{Lo: 0x093a, Hi: 0x093c, Stride: 1},
+			{Lo: 0x093e, Hi: 0x094f, Stride: 1},
+			{Lo: 0x0951, Hi: 0x0957, Stride: 1},
+			{Lo: 0x0962, Hi: 0x0963, Stride: 1},
+			{Lo: 0x0981, Hi: 0x0983, Stride: 1},
+			{Lo: 0x09bc, Hi: 0x09bc, Stride: 1},
+			{Lo: 0x09be, Hi: 0x09c4, Stride: 1},
+			{Lo: 0x09c7, Hi: 0x09c8, Stride: 1},
+			{Lo: 0x09cb, Hi: 0x09cd, Stride: 1},
+			{Lo: 0x09d7, Hi: 0x09d7, Stride: 1},
+			{Lo: 0x09e2, Hi: 0x09e3, Stride: 1},
+			{Lo: 0x09fe, Hi: 0x09fe, Stride: 1},
+			{Lo: 0x0a01, Hi: 0x0a03, Stride: 1},
+			{Lo: 0x0a3c, Hi: 0x0a3c, Stride: 1},
+			{Lo: 0x0a3e, Hi: 0x0a42, Stride: 1},
+			{Lo: 0x0a47, Hi: 0x0a48, Stride: 1},
+			{Lo: 0x0a4b, Hi: 0x0a4d, Stride: 1},
+			{Lo: 0x0a51, Hi: 0x0a51, Stride: 1},
+			{Lo: 0x0a70, Hi: 0x0a71, Stride: 1},
+			{Lo: 0x0a75, Hi: 0x0a75, Stride: 1},
+			{Lo: 0x0a81, Hi: 0x0a83, Stride: 1},
+			{Lo: 0x0abc, Hi: 0x0abc, Stride: 1},
+			{Lo: 0x0abe, Hi: 0x0ac5, Stride: 1},
+			{Lo: 0x0ac7, Hi: 0x0ac9, Stride: 1},
+			{Lo: 0x0acb, Hi: 0x0acd, Stride: 1},
+			{Lo: 0x0ae2, Hi: 0x0ae3, Stride: 1},
+			{Lo: 0x0afa, Hi: 0x0aff, Stride: 1},
+			{Lo: 0x0b01, Hi: 0x0b03, Stride: 1},
+			{Lo: 0x0b3c, Hi: 0x0b3c, Stride: 1},
+			{Lo: 0x0b3e, Hi: 0x0b44, Stride: 1},
+			{Lo: 0x0b47, Hi: 0x0b48, Stride: 1},
+			{Lo: 0x0b4b, Hi: 0x0b4d, Stride: 1},
+			{Lo: 0x0b55, Hi: 0x0b57, Stride: 1},
+			{Lo: 0x0b62, Hi: 0x0b63, Stride: 1},
+			{Lo: 0x0b82, Hi: 0x0b82, Stride: 1},
+			{Lo: 0x0bbe, Hi: 0x0bc2, Stride: 1},
+			{Lo: 0x0bc6, Hi: 0x0bc8, Stride: 1},
+			{Lo: 0x0bca, Hi: 0x0bcd, Stride: 1},
+			{Lo: 0x0bd7, Hi: 0x0bd7, Stride: 1},
+			{Lo: 0x0c00, Hi: 0x0c04, Stride: 1},
+			{Lo: 0x0c3c, Hi: 0x0c3c, Stride: 1},
+			{Lo: 0x0c3e, Hi: 0x0c44, Stride: 1},
+			{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
+			{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
+			{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
+			{Lo: 0x0c62, Hi: 0x0c63, Stride: 1},
+			{Lo: 0x0c81, Hi: 0x0c83, Stride: 1},
+			{Lo: 0x0cbc, Hi: 0x0cbc, Stride: 1},
+			{Lo: 0x0cbe, Hi: 0x0cc4, Stride: 1},
+			{Lo: 0x0cc6, Hi: 0x0cc8, Stride: 1},
+			{Lo: 0x0cca, Hi: 0x0ccd, Stride: 1},
+			{Lo: 0x0cd5, Hi: 0x0cd6, Stride: 1},
+			{Lo: 0x0ce2, Hi: 0x0ce3, Stride: 1},
+			{Lo: 0x0d00, Hi: 0x0d03, Stride: 1},
+			{Lo: 0x0d3b, Hi: 0x0d3c, Stride: 1},
+			{Lo: 0x0d3e, Hi: 0x0d44, Stride: 1},
+			{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
+			{Lo: 0x0d4a, Hi: 0x0d4d, Stride: 1},
+			{Lo: 0x0d57, Hi: 0x0d57, Stride: 1},
+			{Lo: 0x0d62, Hi: 0x0d63, Stride: 1},
+			{Lo: 0x0d81, Hi: 0x0d83, Stride: 1},
+			{Lo: 0x0dca, Hi: 0x0dca, Stride: 1},
+			{Lo: 0x0dcf, Hi: 0x0dd4, Stride: 1},
+			{Lo: 0x0dd6, Hi: 0x0dd6, Stride: 1},
+			{Lo: 0x0dd8, Hi: 0x0ddf, Stride: 1},
+			{Lo: 0x0df2, Hi: 0x0df3, Stride: 1},
+			{Lo: 0x0e31, Hi: 0x0e31, Stride: 1},
+			{Lo: 0x0e34, Hi: 0x0e3a, Stride: 1},
+			{Lo: 0x0e47, Hi: 0x0e4e, Stride: 1},
+			{Lo: 0x0eb1, Hi: 0x0eb1, Stride: 1},
+			{Lo: 0x0eb4, Hi: 0x0ebc, Stride: 1},
+			{Lo: 0x0ec8, Hi: 0x0ecd, Stride: 1},
+			{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
+			{Lo: 0x0f35, Hi: 0x0f35, Stride: 1},
+			{Lo: 0x0f37, Hi: 0x0f37, Stride: 1},
+			{Lo: 0x0f39, Hi: 0x0f39, Stride: 1},
+			{Lo: 0x0f3e, Hi: 0x0f3f, Stride: 1},
+			{Lo: 0x0f71, Hi: 0x0f7e, Stride: 1},
+			{Lo: 0x0f80, Hi: 0x0f84, Stride: 1},
+			{Lo: 0x0f86, Hi: 0x0f87, Stride: 1},
+			{Lo: 0x0f8d, Hi: 0x0f97, Stride: 1},
+			{Lo: 0x0f99, Hi: 0x0fbc, Stride: 1},
+			{Lo: 0x0fc6, Hi: 0x0fc6, Stride: 1},
+			{Lo: 0x102b, Hi: 0x103e, Stride: 1},
+			{Lo: 0x1056, Hi: 0x1059, Stride: 1},
+			{Lo: 0x105e, Hi: 0x1060, Stride: 1},
+			{Lo: 0x1062, Hi: 0x1064, Stride: 1},
+			{Lo: 0x1067, Hi: 0x106d, Stride: 1},
+			{Lo: 0x1071, Hi: 0x1074, Stride: 1},
+			{Lo: 0x1082, Hi: 0x108d, Stride: 1},
+			{Lo: 0x108f, Hi: 0x108f, Stride: 1},
+			{Lo: 0x109a, Hi: 0x109d, Stride: 1},
+			{Lo: 0x135d, Hi: 0x135f, Stride: 1},
+			{Lo: 0x1712, Hi: 0x1715, Stride: 1},
+			{Lo: 0x1732, Hi: 0x1734, Stride: 1},
+			{Lo: 0x1752, Hi: 0x1753, Stride: 1},
+			{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+			{Lo: 0x17b4, Hi: 0x17d3, Stride: 1},
+			{Lo: 0x17dd, Hi: 0x17dd, Stride: 1},
+			{Lo: 0x180b, Hi: 0x180d, Stride: 1},
+			{Lo: 0x180f, Hi: 0x180f, Stride: 1},
+			{Lo: 0x1885, Hi: 0x1886, Stride: 1},
+			{Lo: 0x18a9, Hi: 0x18a9, Stride: 1},
+			{Lo: 0x1920, Hi: 0x192b, Stride: 1},
+			{Lo: 0x1930, Hi: 0x193b, Stride: 1},
+			{Lo: 0x1a17, Hi: 0x1a1b, Stride: 1},
+			{Lo: 0x1a55, Hi: 0x1a5e, Stride: 1},
+			{Lo: 0x1a60, Hi: 0x1a7c, Stride: 1},
+			{Lo: 0x1a7f, Hi: 0x1a7f, Stride: 1},
+			{Lo: 0x1ab0, Hi: 0x1ace, Stride: 1},
+			{Lo: 0x1b00, Hi: 0x1b04, Stride: 1},
+			{Lo: 0x1b34, Hi: 0x1b44, Stride: 1},
+			{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
+			{Lo: 0x1b80, Hi: 0x1b82, Stride: 1},
+			{Lo: 0x1ba1, Hi: 0x1bad, Stride: 1},
+			{Lo: 0x1be6, Hi: 0x1bf3, Stride: 1},
+			{Lo: 0x1c24, Hi: 0x1c37, Stride: 1},
+			{Lo: 0x1cd0, Hi: 0x1cd2, Stride: 1},
+			{Lo: 0x1cd4, Hi: 0x1ce8, Stride: 1},
+			{Lo: 0x1ced, Hi: 0x1ced, Stride: 1},
+			{Lo: 0x1cf4, Hi: 0x1cf4, Stride: 1},
+			{Lo: 0x1cf7, Hi: 0x1cf9, Stride: 1},
+			{Lo: 0x1dc0, Hi: 0x1dff, Stride: 1},
+			{Lo: 0x200c, Hi: 0x200c, Stride: 1},
+			{Lo: 0x200e, Hi: 0x200f, Stride: 1},
+			{Lo: 0x202a, Hi: 0x202e, Stride: 1},
+			{Lo: 0x2066, Hi: 0x206f, Stride: 1},
+			{Lo: 0x20d0, Hi: 0x20f0, Stride: 1},
+			{Lo: 0x2cef, Hi: 0x2cf1, Stride: 1},
+			{Lo: 0x2d7f, Hi: 0x2d7f, Stride: 1},
+			{Lo: 0x2de0, Hi: 0x2dff, Stride: 1},
+			{Lo: 0x302a, Hi: 0x302f, Stride: 1},
+			{Lo: 0x3035, Hi: 0x3035, Stride: 1},
+			{Lo: 0x3099, Hi: 0x309a, Stride: 1},
+			{Lo: 0xa66f, Hi: 0xa672, Stride: 1},
+			{Lo: 0xa674, Hi: 0xa67d, Stride: 1},
+			{Lo: 0xa69e, Hi: 0xa69f, Stride: 1},
+			{Lo: 0xa6f0, Hi: 0xa6f1, Stride: 1},
+			{Lo: 0xa802, Hi: 0xa802, Stride: 1},
+			{Lo: 0xa806, Hi: 0xa806, Stride: 1},
+			{Lo: 0xa80b, Hi: 0xa80b, Stride: 1},
+			{Lo: 0xa823, Hi: 0xa827, Stride: 1},
+			{Lo: 0xa82c, Hi: 0xa82c, Stride: 1},
+			{Lo: 0xa880, Hi: 0xa881, Stride: 1},
+			{Lo: 0xa8b4, Hi: 0xa8c5, Stride: 1},
+			{Lo: 0xa8e0, Hi: 0xa8f1, Stride: 1},
+			{Lo: 0xa8ff, Hi: 0xa8ff, Stride: 1},
+			{Lo: 0xa926, Hi: 0xa92d, Stride: 1},
+			{Lo: 0xa947, Hi: 0xa953, Stride: 1},
+			{Lo: 0xa980, Hi: 0xa983, Stride: 1},
+			{Lo: 0xa9b3, Hi: 0xa9c0, Stride: 1},
+			{Lo: 0xa9e5, Hi: 0xa9e5, Stride: 1},
+			{Lo: 0xaa29, Hi: 0xaa36, Stride: 1},
+			{Lo: 0xaa43, Hi: 0xaa43, Stride: 1},
+			{Lo: 0xaa4c, Hi: 0xaa4d, Stride: 1},
+			{Lo: 0xaa7b, Hi: 0xaa7d, Stride: 1},
+			{Lo: 0xaab0, Hi: 0xaab0, Stride: 1},
+			{Lo: 0xaab2, Hi: 0xaab4, Stride: 1},
+			{Lo: 0xaab7, Hi: 0xaab8, Stride: 1},
+			{Lo: 0xaabe, Hi: 0xaabf, Stride: 1},
+			{Lo: 0xaac1, Hi: 0xaac1, Stride: 1},
+			{Lo: 0xaaeb, Hi: 0xaaef, Stride: 1},
+			{Lo: 0xaaf5, Hi: 0xaaf6, Stride: 1},
+			{Lo: 0xabe3, Hi: 0xabea, Stride: 1},
+			{Lo: 0xabec, Hi: 0xabed, Stride: 1},
+			{Lo: 0xfb1e, Hi: 0xfb1e, Stride: 1},
+			{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+			{Lo: 0xfe20, Hi: 0xfe2f, Stride: 1},
+			{Lo: 0xfff9, Hi: 0xfffb, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x101fd, Hi: 0x101fd, Stride: 1},
+			{Lo: 0x102e0, Hi: 0x102e0, Stride: 1},
+			{Lo: 0x10376, Hi: 0x1037a, Stride: 1},
+			{Lo: 0x10a01, Hi: 0x10a03, Stride: 1},
+			{Lo: 0x10a05, Hi: 0x10a06, Stride: 1},
+			{Lo: 0x10a0c, Hi: 0x10a0f, Stride: 1},
+			{Lo: 0x10a38, Hi: 0x10a3a, Stride: 1},
+			{Lo: 0x10a3f, Hi: 0x10a3f, Stride: 1},
+			{Lo: 0x10ae5, Hi: 0x10ae6, Stride: 1},
+			{Lo: 0x10d24, Hi: 0x10d27, Stride: 1},
+			{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+			{Lo: 0x10f46, Hi: 0x10f50, Stride: 1},
+			{Lo: 0x10f82, Hi: 0x10f85, Stride: 1},
+			{Lo: 0x11000, Hi: 0x11002, Stride: 1},
+			{Lo: 0x11038, Hi: 0x11046, Stride: 1},
+			{Lo: 0x11070, Hi: 0x11070, Stride: 1},
+			{Lo: 0x11073, Hi: 0x11074, Stride: 1},
+			{Lo: 0x1107f, Hi: 0x11082, Stride: 1},
+			{Lo: 0x110b0, Hi: 0x110ba, Stride: 1},
+			{Lo: 0x110c2, Hi: 0x110c2, Stride: 1},
+			{Lo: 0x11100, Hi: 0x11102, Stride: 1},
+			{Lo: 0x11127, Hi: 0x11134, Stride: 1},
+			{Lo: 0x11145, Hi: 0x11146, Stride: 1},
+			{Lo: 0x11173, Hi: 0x11173, Stride: 1},
+			{Lo: 0x11180, Hi: 0x11182, Stride: 1},
+			{Lo: 0x111b3, Hi: 0x111c0, Stride: 1},
+			{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
+			{Lo: 0x111ce, Hi: 0x111cf, Stride: 1},
+			{Lo: 0x1122c, Hi: 0x11237, Stride: 1},
+			{Lo: 0x1123e, Hi: 0x1123e, Stride: 1},
+			{Lo: 0x112df, Hi: 0x112ea, Stride: 1},
+			{Lo: 0x11300, Hi: 0x11303, Stride: 1},
+			{Lo: 0x1133b, Hi: 0x1133c, Stride: 1},
+			{Lo: 0x1133e, Hi: 0x11344, Stride: 1},
+			{Lo: 0x11347, Hi: 0x11348, Stride: 1},
+			{Lo: 0x1134b, Hi: 0x1134d, Stride: 1},
+			{Lo: 0x11357, Hi: 0x11357, Stride: 1},
+			{Lo: 0x11362, Hi: 0x11363, Stride: 1},
+			{Lo: 0x11366, Hi: 0x1136c, Stride: 1},
+			{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+			{Lo: 0x11435, Hi: 0x11446, Stride: 1},
+			{Lo: 0x1145e, Hi: 0x1145e, Stride: 1},
+			{Lo: 0x114b0, Hi: 0x114c3, Stride: 1},
+			{Lo: 0x115af, Hi: 0x115b5, Stride: 1},
+			{Lo: 0x115b8, Hi: 0x115c0, Stride: 1},
+			{Lo: 0x115dc, Hi: 0x115dd, Stride: 1},
+			{Lo: 0x11630, Hi: 0x11640, Stride: 1},
+			{Lo: 0x116ab, Hi: 0x116b7, Stride: 1},
+			{Lo: 0x1171d, Hi: 0x1172b, Stride: 1},
+			{Lo: 0x1182c, Hi: 0x1183a, Stride: 1},
+			{Lo: 0x11930, Hi: 0x11935, Stride: 1},
+			{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+			{Lo: 0x1193b, Hi: 0x1193e, Stride: 1},
+			{Lo: 0x11940, Hi: 0x11940, Stride: 1},
+			{Lo: 0x11942, Hi: 0x11943, Stride: 1},
+			{Lo: 0x119d1, Hi: 0x119d7, Stride: 1},
+			{Lo: 0x119da, Hi: 0x119e0, Stride: 1},
+			{Lo: 0x119e4, Hi: 0x119e4, Stride: 1},
+			{Lo: 0x11a01, Hi: 0x11a0a, Stride: 1},
+			{Lo: 0x11a33, Hi: 0x11a39, Stride: 1},
+			{Lo: 0x11a3b, Hi: 0x11a3e, Stride: 1},
+			{Lo: 0x11a47, Hi: 0x11a47, Stride: 1},
+			{Lo: 0x11a51, Hi: 0x11a5b, Stride: 1},
+			{Lo: 0x11a8a, Hi: 0x11a99, Stride: 1},
+			{Lo: 0x11c2f, Hi: 0x11c36, Stride: 1},
+			{Lo: 0x11c38, Hi: 0x11c3f, Stride: 1},
+			{Lo: 0x11c92, Hi: 0x11ca7, Stride: 1},
+			{Lo: 0x11ca9, Hi: 0x11cb6, Stride: 1},
+			{Lo: 0x11d31, Hi: 0x11d36, Stride: 1},
+			{Lo: 0x11d3a, Hi: 0x11d3a, Stride: 1},
+			{Lo: 0x11d3c, Hi: 0x11d3d, Stride: 1},
+			{Lo: 0x11d3f, Hi: 0x11d45, Stride: 1},
+			{Lo: 0x11d47, Hi: 0x11d47, Stride: 1},
+			{Lo: 0x11d8a, Hi: 0x11d8e, Stride: 1},
+			{Lo: 0x11d90, Hi: 0x11d91, Stride: 1},
+			{Lo: 0x11d93, Hi: 0x11d97, Stride: 1},
+			{Lo: 0x11ef3, Hi: 0x11ef6, Stride: 1},
+			{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
+			{Lo: 0x16b30, Hi: 0x16b36, Stride: 1},
+			{Lo: 0x16f4f, Hi: 0x16f4f, Stride: 1},
+			{Lo: 0x16f51, Hi: 0x16f87, Stride: 1},
+			{Lo: 0x16f8f, Hi: 0x16f92, Stride: 1},
+			{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
+			{Lo: 0x1bc9d, Hi: 0x1bc9e, Stride: 1},
+			{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
+			{Lo: 0x1cf00, Hi: 0x1cf2d, Stride: 1},
+			{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
+			{Lo: 0x1d165, Hi: 0x1d169, Stride: 1},
+			{Lo: 0x1d16d, Hi: 0x1d182, Stride: 1},
+			{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
+			{Lo: 0x1d1aa, Hi: 0x1d1ad, Stride: 1},
+			{Lo: 0x1d242, Hi: 0x1d244, Stride: 1},
+			{Lo: 0x1da00, Hi: 0x1da36, Stride: 1},
+			{Lo: 0x1da3b, Hi: 0x1da6c, Stride: 1},
+			{Lo: 0x1da75, Hi: 0x1da75, Stride: 1},
+			{Lo: 0x1da84, Hi: 0x1da84, Stride: 1},
+			{Lo: 0x1da9b, Hi: 0x1da9f, Stride: 1},
+			{Lo: 0x1daa1, Hi: 0x1daaf, Stride: 1},
+			{Lo: 0x1e000, Hi: 0x1e006, Stride: 1},
+			{Lo: 0x1e008, Hi: 0x1e018, Stride: 1},
+			{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
+			{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
+			{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
+			{Lo: 0x1e130, Hi: 0x1e136, Stride: 1},
+			{Lo: 0x1e2ae, Hi: 0x1e2ae, Stride: 1},
+			{Lo: 0x1e2ec, Hi: 0x1e2ef, Stride: 1},
+			{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
+			{Lo: 0x1e944, Hi: 0x1e94a, Stride: 1},
+			{Lo: 0xe0001, Hi: 0xe0001, Stride: 1},
+			{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+			{Lo: 0xe0100, Hi: 0xe01ef, Stride: 1},
+		},
+	}
+
+	tableCP = &unicode.RangeTable{
+		LatinOffset: 2,
+		R16: []unicode.Range16{
+			{Lo: 0x0029, Hi: 0x0029, Stride: 1},
+			{Lo: 0x005d, Hi: 0x005d, Stride: 1},
+		},
+	}
+
+	tableCR = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x000d, Hi: 0x000d, Stride: 1},
+		},
+	}
+
+	tableEB = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x261d, Hi: 0x261d, Stride: 1},
+			{Lo: 0x26f9, Hi: 0x26f9, Stride: 1},
+			{Lo: 0x270a, Hi: 0x270d, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f385, Hi: 0x1f385, Stride: 1},
+			{Lo: 0x1f3c2, Hi: 0x1f3c4, Stride: 1},
+			{Lo: 0x1f3c7, Hi: 0x1f3c7, Stride: 1},
+			{Lo: 0x1f3ca, Hi: 0x1f3cc, Stride: 1},
+			{Lo: 0x1f442, Hi: 0x1f443, Stride: 1},
+			{Lo: 0x1f446, Hi: 0x1f450, Stride: 1},
+			{Lo: 0x1f466, Hi: 0x1f478, Stride: 1},
+			{Lo: 0x1f47c, Hi: 0x1f47c, Stride: 1},
+			{Lo: 0x1f481, Hi: 0x1f483, Stride: 1},
+			{Lo: 0x1f485, Hi: 0x1f487, Stride: 1},
+			{Lo: 0x1f48f, Hi: 0x1f48f, Stride: 1},
+			{Lo: 0x1f491, Hi: 0x1f491, Stride: 1},
+			{Lo: 0x1f4aa, Hi: 0x1f4aa, Stride: 1},
+			{Lo: 0x1f574, Hi: 0x1f575, Stride: 1},
+			{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+			{Lo: 0x1f590, Hi: 0x1f590, Stride: 1},
+			{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+			{Lo: 0x1f645, Hi: 0x1f647, Stride: 1},
+			{Lo: 0x1f64b, Hi: 0x1f64f, Stride: 1},
+			{Lo: 0x1f6a3, Hi: 0x1f6a3, Stride: 1},
+			{Lo: 0x1f6b4, Hi: 0x1f6b6, Stride: 1},
+			{Lo: 0x1f6c0, Hi: 0x1f6c0, Stride: 1},
+			{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+			{Lo: 0x1f90c, Hi: 0x1f90c, Stride: 1},
+			{Lo: 0x1f90f, Hi: 0x1f90f, Stride: 1},
+			{Lo: 0x1f918, Hi: 0x1f91f, Stride: 1},
+			{Lo: 0x1f926, Hi: 0x1f926, Stride: 1},
+			{Lo: 0x1f930, Hi: 0x1f939, Stride: 1},
+			{Lo: 0x1f93c, Hi: 0x1f93e, Stride: 1},
+			{Lo: 0x1f977, Hi: 0x1f977, Stride: 1},
+			{Lo: 0x1f9b5, Hi: 0x1f9b6, Stride: 1},
+			{Lo: 0x1f9b8, Hi: 0x1f9b9, Stride: 1},
+			{Lo: 0x1f9bb, Hi: 0x1f9bb, Stride: 1},
+			{Lo: 0x1f9cd, Hi: 0x1f9cf, Stride: 1},
+			{Lo: 0x1f9d1, Hi: 0x1f9dd, Stride: 1},
+			{Lo: 0x1fac3, Hi: 0x1fac5, Stride: 1},
+			{Lo: 0x1faf0, Hi: 0x1faf6, Stride: 1},
+		},
+	}
+
+	tableEM = &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
+		},
+	}
+
+	tableEX = &unicode.RangeTable{
+		LatinOffset: 2,
+		R16: []unicode.Range16{
+			{Lo: 0x0021, Hi: 0x0021, Stride: 1},
+			{Lo: 0x003f, Hi: 0x003f, Stride: 1},
+			{Lo: 0x05c6, Hi: 0x05c6, Stride: 1},
+			{Lo: 0x061b, Hi: 0x061b, Stride: 1},
+			{Lo: 0x061d, Hi: 0x061f, Stride: 1},
+			{Lo: 0x06d4, Hi: 0x06d4, Stride: 1},
+			{Lo: 0x07f9, Hi: 0x07f9, Stride: 1},
+			{Lo: 0x0f0d, Hi: 0x0f11, Stride: 1},
+			{Lo: 0x0f14, Hi: 0x0f14, Stride: 1},
+			{Lo: 0x1802, Hi: 0x1803, Stride: 1},
+			{Lo: 0x1808, Hi: 0x1809, Stride: 1},
+			{Lo: 0x1944, Hi: 0x1945, Stride: 1},
+			{Lo: 0x2762, Hi: 0x2763, Stride: 1},
+			{Lo: 0x2cf9, Hi: 0x2cf9, Stride: 1},
+			{Lo: 0x2cfe, Hi: 0x2cfe, Stride: 1},
+			{Lo: 0x2e2e, Hi: 0x2e2e, Stride: 1},
+			{Lo: 0x2e53, Hi: 0x2e54, Stride: 1},
+			{Lo: 0xa60e, Hi: 0xa60e, Stride: 1},
+			{Lo: 0xa876, Hi: 0xa877, Stride: 1},
+			{Lo: 0xfe15, Hi: 0xfe16, Stride: 1},
+			{Lo: 0xfe56, Hi: 0xfe57, Stride: 1},
+			{Lo: 0xff01, Hi: 0xff01, Stride: 1},
+			{Lo: 0xff1f, Hi: 0xff1f, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x115c4, Hi: 0x115c5, Stride: 1},
+			{Lo: 0x11c71, Hi: 0x11c71, Stride: 1},
+		},
+	}
+
+	tableEastAsian = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+			{Lo: 0x20a9, Hi: 0x20a9, Stride: 1},
+			{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+			{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+			{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+			{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+			{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+			{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+			{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+			{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+			{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+			{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+			{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+			{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+			{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+			{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+			{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+			{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+			{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+			{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+			{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+			{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+			{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+			{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+			{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+			{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+			{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+			{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+			{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+			{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+			{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+			{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+			{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+			{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+			{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+			{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+			{Lo: 0x2e80, Hi: 0x2e99, Stride: 1},
+			{Lo: 0x2e9b, Hi: 0x2ef3, Stride: 1},
+			{Lo: 0x2f00, Hi: 0x2fd5, Stride: 1},
+			{Lo: 0x2ff0, Hi: 0x2ffb, Stride: 1},
+			{Lo: 0x3000, Hi: 0x303e, Stride: 1},
+			{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+			{Lo: 0x3099, Hi: 0x30ff, Stride: 1},
+			{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+			{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+			{Lo: 0x3190, Hi: 0x31e3, Stride: 1},
+			{Lo: 0x31f0, Hi: 0x321e, Stride: 1},
+			{Lo: 0x3220, Hi: 0x3247, Stride: 1},
+			{Lo: 0x3250, Hi: 0x4dbf, Stride: 1},
+			{Lo: 0x4e00, Hi: 0xa48c, Stride: 1},
+			{Lo: 0xa490, Hi: 0xa4c6, Stride: 1},
+			{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+			{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+			{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+			{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+			{Lo: 0xfe30, Hi: 0xfe52, Stride: 1},
+			{Lo: 0xfe54, Hi: 0xfe66, Stride: 1},
+			{Lo: 0xfe68, Hi: 0xfe6b, Stride: 1},
+			{Lo: 0xff01, Hi: 0xffbe, Stride: 1},
+			{Lo: 0xffc2, Hi: 0xffc7, Stride: 1},
+			{Lo: 0xffca, Hi: 0xffcf, Stride: 1},
+			{Lo: 0xffd2, Hi: 0xffd7, Stride: 1},
+			{Lo: 0xffda, Hi: 0xffdc, Stride: 1},
+			{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+			{Lo: 0xffe8, Hi: 0xffee, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x16fe0, Hi: 0x16fe4, Stride: 1},
+			{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
+			{Lo: 0x17000, Hi: 0x187f7, Stride: 1},
+			{Lo: 0x18800, Hi: 0x18cd5, Stride: 1},
+			{Lo: 0x18d00, Hi: 0x18d08, Stride: 1},
+			{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+			{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+			{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
+			{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
+			{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+			{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+			{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
+			{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+			{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+			{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+			{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+			{Lo: 0x1f200, Hi: 0x1f202, Stride: 1},
+			{Lo: 0x1f210, Hi: 0x1f23b, Stride: 1},
+			{Lo: 0x1f240, Hi: 0x1f248, Stride: 1},
+			{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+			{Lo: 0x1f260, Hi: 0x1f265, Stride: 1},
+			{Lo: 0x1f300, Hi: 0x1f320, Stride: 1},
+			{Lo: 0x1f32d, Hi: 0x1f335, Stride: 1},
+			{Lo: 0x1f337, Hi: 0x1f37c, Stride: 1},
+			{Lo: 0x1f37e, Hi: 0x1f393, Stride: 1},
+			{Lo: 0x1f3a0, Hi: 0x1f3ca, Stride: 1},
+			{Lo: 0x1f3cf, Hi: 0x1f3d3, Stride: 1},
+			{Lo: 0x1f3e0, Hi: 0x1f3f0, Stride: 1},
+			{Lo: 0x1f3f4, Hi: 0x1f3f4, Stride: 1},
+			{Lo: 0x1f3f8, Hi: 0x1f43e, Stride: 1},
+			{Lo: 0x1f440, Hi: 0x1f440, Stride: 1},
+			{Lo: 0x1f442, Hi: 0x1f4fc, Stride: 1},
+			{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+			{Lo: 0x1f54b, Hi: 0x1f54e, Stride: 1},
+			{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+			{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+			{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+			{Lo: 0x1f5a4, Hi: 0x1f5a4, Stride: 1},
+			{Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 1},
+			{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+			{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+			{Lo: 0x1f6d0, Hi: 0x1f6d2, Stride: 1},
+			{Lo: 0x1f6d5, Hi: 0x1f6d7, Stride: 1},
+			{Lo: 0x1f6dd, Hi: 0x1f6df, Stride: 1},
+			{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+			{Lo: 0x1f6f4, Hi: 0x1f6fc, Stride: 1},
+			{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+			{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1},
+			{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+			{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+			{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+			{Lo: 0x1fa70, Hi: 0x1fa74, Stride: 1},
+			{Lo: 0x1fa78, Hi: 0x1fa7c, Stride: 1},
+			{Lo: 0x1fa80, Hi: 0x1fa86, Stride: 1},
+			{Lo: 0x1fa90, Hi: 0x1faac, Stride: 1},
+			{Lo: 0x1fab0, Hi: 0x1faba, Stride: 1},
+			{Lo: 0x1fac0, Hi: 0x1fac5, Stride: 1},
+			{Lo: 0x1fad0, Hi: 0x1fad9, Stride: 1},
+			{Lo: 0x1fae0, Hi: 0x1fae7, Stride: 1},
+			{Lo: 0x1faf0, Hi: 0x1faf6, Stride: 1},
+			{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+			{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+		},
+	}
+
+	tableGL = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x00a0, Hi: 0x00a0, Stride: 1},
+			{Lo: 0x034f, Hi: 0x034f, Stride: 1},
+			{Lo: 0x035c, Hi: 0x0362, Stride: 1},
+			{Lo: 0x0f08, Hi: 0x0f08, Stride: 1},
+			{Lo: 0x0f0c, Hi: 0x0f0c, Stride: 1},
+			{Lo: 0x0f12, Hi: 0x0f12, Stride: 1},
+			{Lo: 0x0fd9, Hi: 0x0fda, Stride: 1},
+			{Lo: 0x180e, Hi: 0x180e, Stride: 1},
+			{Lo: 0x2007, Hi: 0x2007, Stride: 1},
+			{Lo: 0x2011, Hi: 0x2011, Stride: 1},
+			{Lo: 0x202f, Hi: 0x202f, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x13430, Hi: 0x13436, Stride: 1},
+			{Lo: 0x16fe4, Hi: 0x16fe4, Stride: 1},
+		},
+	}
+
+	tableHL = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x05d0, Hi: 0x05ea, Stride: 1},
+			{Lo: 0x05ef, Hi: 0x05f2, Stride: 1},
+			{Lo: 0xfb1d, Hi: 0xfb1d, Stride: 1},
+			{Lo: 0xfb1f, Hi: 0xfb28, Stride: 1},
+			{Lo: 0xfb2a, Hi: 0xfb36, Stride: 1},
+			{Lo: 0xfb38, Hi: 0xfb3c, Stride: 1},
+			{Lo: 0xfb3e, Hi: 0xfb3e, Stride: 1},
+			{Lo: 0xfb40, Hi: 0xfb41, Stride: 1},
+			{Lo: 0xfb43, Hi: 0xfb44, Stride: 1},
+			{Lo: 0xfb46, Hi: 0xfb4f, Stride: 1},
+		},
+	}
+
+	tableHY = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x002d, Hi: 0x002d, Stride: 1},
+		},
+	}
+
+	tableID = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+			{Lo: 0x23f0, Hi: 0x23f3, Stride: 1},
+			{Lo: 0x2600, Hi: 0x2603, Stride: 1},
+			{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+			{Lo: 0x2618, Hi: 0x2618, Stride: 1},
+			{Lo: 0x261a, Hi: 0x261c, Stride: 1},
+			{Lo: 0x261e, Hi: 0x261f, Stride: 1},
+			{Lo: 0x2639, Hi: 0x263b, Stride: 1},
+			{Lo: 0x2668, Hi: 0x2668, Stride: 1},
+			{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+			{Lo: 0x26bd, Hi: 0x26c8, Stride: 1},
+			{Lo: 0x26cd, Hi: 0x26cd, Stride: 1},
+			{Lo: 0x26cf, Hi: 0x26d1, Stride: 1},
+			{Lo: 0x26d3, Hi: 0x26d4, Stride: 1},
+			{Lo: 0x26d8, Hi: 0x26d9, Stride: 1},
+			{Lo: 0x26dc, Hi: 0x26dc, Stride: 1},
+			{Lo: 0x26df, Hi: 0x26e1, Stride: 1},
+			{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+			{Lo: 0x26f1, Hi: 0x26f5, Stride: 1},
+			{Lo: 0x26f7, Hi: 0x26f8, Stride: 1},
+			{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+			{Lo: 0x26fd, Hi: 0x2704, Stride: 1},
+			{Lo: 0x2708, Hi: 0x2709, Stride: 1},
+			{Lo: 0x2764, Hi: 0x2764, Stride: 1},
+			{Lo: 0x2e80, Hi: 0x2e99, Stride: 1},
+			{Lo: 0x2e9b, Hi: 0x2ef3, Stride: 1},
+			{Lo: 0x2f00, Hi: 0x2fd5, Stride: 1},
+			{Lo: 0x2ff0, Hi: 0x2ffb, Stride: 1},
+			{Lo: 0x3003, Hi: 0x3004, Stride: 1},
+			{Lo: 0x3006, Hi: 0x3007, Stride: 1},
+			{Lo: 0x3012, Hi: 0x3013, Stride: 1},
+			{Lo: 0x3020, Hi: 0x3029, Stride: 1},
+			{Lo: 0x3030, Hi: 0x3034, Stride: 1},
+			{Lo: 0x3036, Hi: 0x303a, Stride: 1},
+			{Lo: 0x303d, Hi: 0x303f, Stride: 1},
+			{Lo: 0x3042, Hi: 0x3042, Stride: 1},
+			{Lo: 0x3044, Hi: 0x3044, Stride: 1},
+			{Lo: 0x3046, Hi: 0x3046, Stride: 1},
+			{Lo: 0x3048, Hi: 0x3048, Stride: 1},
+			{Lo: 0x304a, Hi: 0x3062, Stride: 1},
+			{Lo: 0x3064, Hi: 0x3082, Stride: 1},
+			{Lo: 0x3084, Hi: 0x3084, Stride: 1},
+			{Lo: 0x3086, Hi: 0x3086, Stride: 1},
+			{Lo: 0x3088, Hi: 0x308d, Stride: 1},
+			{Lo: 0x308f, Hi: 0x3094, Stride: 1},
+			{Lo: 0x309f, Hi: 0x309f, Stride: 1},
+			{Lo: 0x30a2, Hi: 0x30a2, Stride: 1},
+			{Lo: 0x30a4, Hi: 0x30a4, Stride: 1},
+			{Lo: 0x30a6, Hi: 0x30a6, Stride: 1},
+			{Lo: 0x30a8, Hi: 0x30a8, Stride: 1},
+			{Lo: 0x30aa, Hi: 0x30c2, Stride: 1},
+			{Lo: 0x30c4, Hi: 0x30e2, Stride: 1},
+			{Lo: 0x30e4, Hi: 0x30e4, Stride: 1},
+			{Lo: 0x30e6, Hi: 0x30e6, Stride: 1},
+			{Lo: 0x30e8, Hi: 0x30ed, Stride: 1},
+			{Lo: 0x30ef, Hi: 0x30f4, Stride: 1},
+			{Lo: 0x30f7, Hi: 0x30fa, Stride: 1},
+			{Lo: 0x30ff, Hi: 0x30ff, Stride: 1},
+			{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+			{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+			{Lo: 0x3190, Hi: 0x31e3, Stride: 1},
+			{Lo: 0x3200, Hi: 0x321e, Stride: 1},
+			{Lo: 0x3220, Hi: 0x3247, Stride: 1},
+			{Lo: 0x3250, Hi: 0x4dbf, Stride: 1},
+			{Lo: 0x4e00, Hi: 0xa014, Stride: 1},
+			{Lo: 0xa016, Hi: 0xa48c, Stride: 1},
+			{Lo: 0xa490, Hi: 0xa4c6, Stride: 1},
+			{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+			{Lo: 0xfe30, Hi: 0xfe34, Stride: 1},
+			{Lo: 0xfe45, Hi: 0xfe46, Stride: 1},
+			{Lo: 0xfe49, Hi: 0xfe4f, Stride: 1},
+			{Lo: 0xfe51, Hi: 0xfe51, Stride: 1},
+			{Lo: 0xfe58, Hi: 0xfe58, Stride: 1},
+			{Lo: 0xfe5f, Hi: 0xfe66, Stride: 1},
+			{Lo: 0xfe68, Hi: 0xfe68, Stride: 1},
+			{Lo: 0xfe6b, Hi: 0xfe6b, Stride: 1},
+			{Lo: 0xff02, Hi: 0xff03, Stride: 1},
+			{Lo: 0xff06, Hi: 0xff07, Stride: 1},
+			{Lo: 0xff0a, Hi: 0xff0b, Stride: 1},
+			{Lo: 0xff0d, Hi: 0xff0d, Stride: 1},
+			{Lo: 0xff0f, Hi: 0xff19, Stride: 1},
+			{Lo: 0xff1c, Hi: 0xff1e, Stride: 1},
+			{Lo: 0xff20, Hi: 0xff3a, Stride: 1},
+			{Lo: 0xff3c, Hi: 0xff3c, Stride: 1},
+			{Lo: 0xff3e, Hi: 0xff5a, Stride: 1},
+			{Lo: 0xff5c, Hi: 0xff5c, Stride: 1},
+			{Lo: 0xff5e, Hi: 0xff5e, Stride: 1},
+			{Lo: 0xff66, Hi: 0xff66, Stride: 1},
+			{Lo: 0xff71, Hi: 0xff9d, Stride: 1},
+			{Lo: 0xffa0, Hi: 0xffbe, Stride: 1},
+			{Lo: 0xffc2, Hi: 0xffc7, Stride: 1},
+			{Lo: 0xffca, Hi: 0xffcf, Stride: 1},
+			{Lo: 0xffd2, Hi: 0xffd7, Stride: 1},
+			{Lo: 0xffda, Hi: 0xffdc, Stride: 1},
+			{Lo: 0xffe2, Hi: 0xffe4, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x17000, Hi: 0x187f7, Stride: 1},
+			{Lo: 0x18800, Hi: 0x18aff, Stride: 1},
+			{Lo: 0x18d00, Hi: 0x18d08, Stride: 1},
+			{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
+			{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
+			{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+			{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
+			{Lo: 0x1f16d, Hi: 0x1f16f, Stride: 1},
+			{Lo: 0x1f1ad, Hi: 0x1f1e5, Stride: 1},
+			{Lo: 0x1f200, Hi: 0x1f384, Stride: 1},
+			{Lo: 0x1f386, Hi: 0x1f39b, Stride: 1},
+			{Lo: 0x1f39e, Hi: 0x1f3b4, Stride: 1},
+			{Lo: 0x1f3b7, Hi: 0x1f3bb, Stride: 1},
+			{Lo: 0x1f3bd, Hi: 0x1f3c1, Stride: 1},
+			{Lo: 0x1f3c5, Hi: 0x1f3c6, Stride: 1},
+			{Lo: 0x1f3c8, Hi: 0x1f3c9, Stride: 1},
+			{Lo: 0x1f3cd, Hi: 0x1f3fa, Stride: 1},
+			{Lo: 0x1f400, Hi: 0x1f441, Stride: 1},
+			{Lo: 0x1f444, Hi: 0x1f445, Stride: 1},
+			{Lo: 0x1f451, Hi: 0x1f465, Stride: 1},
+			{Lo: 0x1f479, Hi: 0x1f47b, Stride: 1},
+			{Lo: 0x1f47d, Hi: 0x1f480, Stride: 1},
+			{Lo: 0x1f484, Hi: 0x1f484, Stride: 1},
+			{Lo: 0x1f488, Hi: 0x1f48e, Stride: 1},
+			{Lo: 0x1f490, Hi: 0x1f490, Stride: 1},
+			{Lo: 0x1f492, Hi: 0x1f49f, Stride: 1},
+			{Lo: 0x1f4a1, Hi: 0x1f4a1, Stride: 1},
+			{Lo: 0x1f4a3, Hi: 0x1f4a3, Stride: 1},
+			{Lo: 0x1f4a5, Hi: 0x1f4a9, Stride: 1},
+			{Lo: 0x1f4ab, Hi: 0x1f4ae, Stride: 1},
+			{Lo: 0x1f4b0, Hi: 0x1f4b0, Stride: 1},
+			{Lo: 0x1f4b3, Hi: 0x1f4ff, Stride: 1},
+			{Lo: 0x1f507, Hi: 0x1f516, Stride: 1},
+			{Lo: 0x1f525, Hi: 0x1f531, Stride: 1},
+			{Lo: 0x1f54a, Hi: 0x1f573, Stride: 1},
+			{Lo: 0x1f576, Hi: 0x1f579, Stride: 1},
+			{Lo: 0x1f57b, Hi: 0x1f58f, Stride: 1},
+			{Lo: 0x1f591, Hi: 0x1f594, Stride: 1},
+			{Lo: 0x1f597, Hi: 0x1f5d3, Stride: 1},
+			{Lo: 0x1f5dc, Hi: 0x1f5f3, Stride: 1},
+			{Lo: 0x1f5fa, Hi: 0x1f644, Stride: 1},
+			{Lo: 0x1f648, Hi: 0x1f64a, Stride: 1},
+			{Lo: 0x1f680, Hi: 0x1f6a2, Stride: 1},
+			{Lo: 0x1f6a4, Hi: 0x1f6b3, Stride: 1},
+			{Lo: 0x1f6b7, Hi: 0x1f6bf, Stride: 1},
+			{Lo: 0x1f6c1, Hi: 0x1f6cb, Stride: 1},
+			{Lo: 0x1f6cd, Hi: 0x1f6ff, Stride: 1},
+			{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+			{Lo: 0x1f7d5, Hi: 0x1f7ff, Stride: 1},
+			{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+			{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+			{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+			{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+			{Lo: 0x1f8ae, Hi: 0x1f8ff, Stride: 1},
+			{Lo: 0x1f90d, Hi: 0x1f90e, Stride: 1},
+			{Lo: 0x1f910, Hi: 0x1f917, Stride: 1},
+			{Lo: 0x1f920, Hi: 0x1f925, Stride: 1},
+			{Lo: 0x1f927, Hi: 0x1f92f, Stride: 1},
+			{Lo: 0x1f93a, Hi: 0x1f93b, Stride: 1},
+			{Lo: 0x1f93f, Hi: 0x1f976, Stride: 1},
+			{Lo: 0x1f978, Hi: 0x1f9b4, Stride: 1},
+			{Lo: 0x1f9b7, Hi: 0x1f9b7, Stride: 1},
+			{Lo: 0x1f9ba, Hi: 0x1f9ba, Stride: 1},
+			{Lo: 0x1f9bc, Hi: 0x1f9cc, Stride: 1},
+			{Lo: 0x1f9d0, Hi: 0x1f9d0, Stride: 1},
+			{Lo: 0x1f9de, Hi: 0x1f9ff, Stride: 1},
+			{Lo: 0x1fa54, Hi: 0x1fac2, Stride: 1},
+			{Lo: 0x1fac6, Hi: 0x1faef, Stride: 1},
+			{Lo: 0x1faf7, Hi: 0x1faff, Stride: 1},
+			{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+			{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+			{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+		},
+	}
+
+	tableIN = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x2024, Hi: 0x2026, Stride: 1},
+			{Lo: 0x22ef, Hi: 0x22ef, Stride: 1},
+			{Lo: 0xfe19, Hi: 0xfe19, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10af6, Hi: 0x10af6, Stride: 1},
+		},
+	}
+
+	tableIS = &unicode.RangeTable{
+		LatinOffset: 3,
+		R16: []unicode.Range16{
+			{Lo: 0x002c, Hi: 0x002c, Stride: 1},
+			{Lo: 0x002e, Hi: 0x002e, Stride: 1},
+			{Lo: 0x003a, Hi: 0x003b, Stride: 1},
+			{Lo: 0x037e, Hi: 0x037e, Stride: 1},
+			{Lo: 0x0589, Hi: 0x0589, Stride: 1},
+			{Lo: 0x060c, Hi: 0x060d, Stride: 1},
+			{Lo: 0x07f8, Hi: 0x07f8, Stride: 1},
+			{Lo: 0x2044, Hi: 0x2044, Stride: 1},
+			{Lo: 0xfe10, Hi: 0xfe10, Stride: 1},
+			{Lo: 0xfe13, Hi: 0xfe14, Stride: 1},
+		},
+	}
+
+	tableJL = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+			{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+		},
+	}
+
+	tableJT = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x11a8, Hi: 0x11ff, Stride: 1},
+			{Lo: 0xd7cb, Hi: 0xd7fb, Stride: 1},
+		},
+	}
+
+	tableJV = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1160, Hi: 0x11a7, Stride: 1},
+			{Lo: 0xd7b0, Hi: 0xd7c6, Stride: 1},
+		},
+	}
+
+	tableLF = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x000a, Hi: 0x000a, Stride: 1},
+		},
+	}
+
+	tableNL = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x0085, Hi: 0x0085, Stride: 1},
+		},
+	}
+
+	tableNS = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x17d6, Hi: 0x17d6, Stride: 1},
+			{Lo: 0x203c, Hi: 0x203d, Stride: 1},
+			{Lo: 0x2047, Hi: 0x2049, Stride: 1},
+			{Lo: 0x3005, Hi: 0x3005, Stride: 1},
+			{Lo: 0x301c, Hi: 0x301c, Stride: 1},
+			{Lo: 0x303b, Hi: 0x303c, Stride: 1},
+			{Lo: 0x3041, Hi: 0x3041, Stride: 1},
+			{Lo: 0x3043, Hi: 0x3043, Stride: 1},
+			{Lo: 0x3045, Hi: 0x3045, Stride: 1},
+			{Lo: 0x3047, Hi: 0x3047, Stride: 1},
+			{Lo: 0x3049, Hi: 0x3049, Stride: 1},
+			{Lo: 0x3063, Hi: 0x3063, Stride: 1},
+			{Lo: 0x3083, Hi: 0x3083, Stride: 1},
+			{Lo: 0x3085, Hi: 0x3085, Stride: 1},
+			{Lo: 0x3087, Hi: 0x3087, Stride: 1},
+			{Lo: 0x308e, Hi: 0x308e, Stride: 1},
+			{Lo: 0x3095, Hi: 0x3096, Stride: 1},
+			{Lo: 0x309b, Hi: 0x309e, Stride: 1},
+			{Lo: 0x30a0, Hi: 0x30a1, Stride: 1},
+			{Lo: 0x30a3, Hi: 0x30a3, Stride: 1},
+			{Lo: 0x30a5, Hi: 0x30a5, Stride: 1},
+			{Lo: 0x30a7, Hi: 0x30a7, Stride: 1},
+			{Lo: 0x30a9, Hi: 0x30a9, Stride: 1},
+			{Lo: 0x30c3, Hi: 0x30c3, Stride: 1},
+			{Lo: 0x30e3, Hi: 0x30e3, Stride: 1},
+			{Lo: 0x30e5, Hi: 0x30e5, Stride: 1},
+			{Lo: 0x30e7, Hi: 0x30e7, Stride: 1},
+			{Lo: 0x30ee, Hi: 0x30ee, Stride: 1},
+			{Lo: 0x30f5, Hi: 0x30f6, Stride: 1},
+			{Lo: 0x30fb, Hi: 0x30fe, Stride: 1},
+			{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
+			{Lo: 0xa015, Hi: 0xa015, Stride: 1},
+			{Lo: 0xfe54, Hi: 0xfe55, Stride: 1},
+			{Lo: 0xff1a, Hi: 0xff1b, Stride: 1},
+			{Lo: 0xff65, Hi: 0xff65, Stride: 1},
+			{Lo: 0xff67, Hi: 0xff70, Stride: 1},
+			{Lo: 0xff9e, Hi: 0xff9f, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x16fe0, Hi: 0x16fe3, Stride: 1},
+			{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+			{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+			{Lo: 0x1f679, Hi: 0x1f67b, Stride: 1},
+		},
+	}
+
+	tableNU = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+			{Lo: 0x0660, Hi: 0x0669, Stride: 1},
+			{Lo: 0x066b, Hi: 0x066c, Stride: 1},
+			{Lo: 0x06f0, Hi: 0x06f9, Stride: 1},
+			{Lo: 0x07c0, Hi: 0x07c9, Stride: 1},
+			{Lo: 0x0966, Hi: 0x096f, Stride: 1},
+			{Lo: 0x09e6, Hi: 0x09ef, Stride: 1},
+			{Lo: 0x0a66, Hi: 0x0a6f, Stride: 1},
+			{Lo: 0x0ae6, Hi: 0x0aef, Stride: 1},
+			{Lo: 0x0b66, Hi: 0x0b6f, Stride: 1},
+			{Lo: 0x0be6, Hi: 0x0bef, Stride: 1},
+			{Lo: 0x0c66, Hi: 0x0c6f, Stride: 1},
+			{Lo: 0x0ce6, Hi: 0x0cef, Stride: 1},
+			{Lo: 0x0d66, Hi: 0x0d6f, Stride: 1},
+			{Lo: 0x0de6, Hi: 0x0def, Stride: 1},
+			{Lo: 0x0e50, Hi: 0x0e59, Stride: 1},
+			{Lo: 0x0ed0, Hi: 0x0ed9, Stride: 1},
+			{Lo: 0x0f20, Hi: 0x0f29, Stride: 1},
+			{Lo: 0x1040, Hi: 0x1049, Stride: 1},
+			{Lo: 0x1090, Hi: 0x1099, Stride: 1},
+			{Lo: 0x17e0, Hi: 0x17e9, Stride: 1},
+			{Lo: 0x1810, Hi: 0x1819, Stride: 1},
+			{Lo: 0x1946, Hi: 0x194f, Stride: 1},
+			{Lo: 0x19d0, Hi: 0x19d9, Stride: 1},
+			{Lo: 0x1a80, Hi: 0x1a89, Stride: 1},
+			{Lo: 0x1a90, Hi: 0x1a99, Stride: 1},
+			{Lo: 0x1b50, Hi: 0x1b59, Stride: 1},
+			{Lo: 0x1bb0, Hi: 0x1bb9, Stride: 1},
+			{Lo: 0x1c40, Hi: 0x1c49, Stride: 1},
+			{Lo: 0x1c50, Hi: 0x1c59, Stride: 1},
+			{Lo: 0xa620, Hi: 0xa629, Stride: 1},
+			{Lo: 0xa8d0, Hi: 0xa8d9, Stride: 1},
+			{Lo: 0xa900, Hi: 0xa909, Stride: 1},
+			{Lo: 0xa9d0, Hi: 0xa9d9, Stride: 1},
+			{Lo: 0xa9f0, Hi: 0xa9f9, Stride: 1},
+			{Lo: 0xaa50, Hi: 0xaa59, Stride: 1},
+			{Lo: 0xabf0, Hi: 0xabf9, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x104a0, Hi: 0x104a9, Stride: 1},
+			{Lo: 0x10d30, Hi: 0x10d39, Stride: 1},
+			{Lo: 0x11066, Hi: 0x1106f, Stride: 1},
+			{Lo: 0x110f0, Hi: 0x110f9, Stride: 1},
+			{Lo: 0x11136, Hi: 0x1113f, Stride: 1},
+			{Lo: 0x111d0, Hi: 0x111d9, Stride: 1},
+			{Lo: 0x112f0, Hi: 0x112f9, Stride: 1},
+			{Lo: 0x11450, Hi: 0x11459, Stride: 1},
+			{Lo: 0x114d0, Hi: 0x114d9, Stride: 1},
+			{Lo: 0x11650, Hi: 0x11659, Stride: 1},
+			{Lo: 0x116c0, Hi: 0x116c9, Stride: 1},
+			{Lo: 0x11730, Hi: 0x11739, Stride: 1},
+			{Lo: 0x118e0, Hi: 0x118e9, Stride: 1},
+			{Lo: 0x11950, Hi: 0x11959, Stride: 1},
+			{Lo: 0x11c50, Hi: 0x11c59, Stride: 1},
+			{Lo: 0x11d50, Hi: 0x11d59, Stride: 1},
+			{Lo: 0x11da0, Hi: 0x11da9, Stride: 1},
+			{Lo: 0x16a60, Hi: 0x16a69, Stride: 1},
+			{Lo: 0x16ac0, Hi: 0x16ac9, Stride: 1},
+			{Lo: 0x16b50, Hi: 0x16b59, Stride: 1},
+			{Lo: 0x1d7ce, Hi: 0x1d7ff, Stride: 1},
+			{Lo: 0x1e140, Hi: 0x1e149, Stride: 1},
+			{Lo: 0x1e2f0, Hi: 0x1e2f9, Stride: 1},
+			{Lo: 0x1e950, Hi: 0x1e959, Stride: 1},
+			{Lo: 0x1fbf0, Hi: 0x1fbf9, Stride: 1},
+		},
+	}
+
+	tableOP = &unicode.RangeTable{
+		LatinOffset: 5,
+		R16: []unicode.Range16{
+			{Lo: 0x0028, Hi: 0x0028, Stride: 1},
+			{Lo: 0x005b, Hi: 0x005b, Stride: 1},
+			{Lo: 0x007b, Hi: 0x007b, Stride: 1},
+			{Lo: 0x00a1, Hi: 0x00a1, Stride: 1},
+			{Lo: 0x00bf, Hi: 0x00bf, Stride: 1},
+			{Lo: 0x0f3a, Hi: 0x0f3a, Stride: 1},
+			{Lo: 0x0f3c, Hi: 0x0f3c, Stride: 1},
+			{Lo: 0x169b, Hi: 0x169b, Stride: 1},
+			{Lo: 0x201a, Hi: 0x201a, Stride: 1},
+			{Lo: 0x201e, Hi: 0x201e, Stride: 1},
+			{Lo: 0x2045, Hi: 0x2045, Stride: 1},
+			{Lo: 0x207d, Hi: 0x207d, Stride: 1},
+			{Lo: 0x208d, Hi: 0x208d, Stride: 1},
+			{Lo: 0x2308, Hi: 0x2308, Stride: 1},
+			{Lo: 0x230a, Hi: 0x230a, Stride: 1},
+			{Lo: 0x2329, Hi: 0x2329, Stride: 1},
+			{Lo: 0x2768, Hi: 0x2768, Stride: 1},
+			{Lo: 0x276a, Hi: 0x276a, Stride: 1},
+			{Lo: 0x276c, Hi: 0x276c, Stride: 1},
+			{Lo: 0x276e, Hi: 0x276e, Stride: 1},
+			{Lo: 0x2770, Hi: 0x2770, Stride: 1},
+			{Lo: 0x2772, Hi: 0x2772, Stride: 1},
+			{Lo: 0x2774, Hi: 0x2774, Stride: 1},
+			{Lo: 0x27c5, Hi: 0x27c5, Stride: 1},
+			{Lo: 0x27e6, Hi: 0x27e6, Stride: 1},
+			{Lo: 0x27e8, Hi: 0x27e8, Stride: 1},
+			{Lo: 0x27ea, Hi: 0x27ea, Stride: 1},
+			{Lo: 0x27ec, Hi: 0x27ec, Stride: 1},
+			{Lo: 0x27ee, Hi: 0x27ee, Stride: 1},
+			{Lo: 0x2983, Hi: 0x2983, Stride: 1},
+			{Lo: 0x2985, Hi: 0x2985, Stride: 1},
+			{Lo: 0x2987, Hi: 0x2987, Stride: 1},
+			{Lo: 0x2989, Hi: 0x2989, Stride: 1},
+			{Lo: 0x298b, Hi: 0x298b, Stride: 1},
+			{Lo: 0x298d, Hi: 0x298d, Stride: 1},
+			{Lo: 0x298f, Hi: 0x298f, Stride: 1},
+			{Lo: 0x2991, Hi: 0x2991, Stride: 1},
+			{Lo: 0x2993, Hi: 0x2993, Stride: 1},
+			{Lo: 0x2995, Hi: 0x2995, Stride: 1},
+			{Lo: 0x2997, Hi: 0x2997, Stride: 1},
+			{Lo: 0x29d8, Hi: 0x29d8, Stride: 1},
+			{Lo: 0x29da, Hi: 0x29da, Stride: 1},
+			{Lo: 0x29fc, Hi: 0x29fc, Stride: 1},
+			{Lo: 0x2e18, Hi: 0x2e18, Stride: 1},
+			{Lo: 0x2e22, Hi: 0x2e22, Stride: 1},
+			{Lo: 0x2e24, Hi: 0x2e24, Stride: 1},
+			{Lo: 0x2e26, Hi: 0x2e26, Stride: 1},
+			{Lo: 0x2e28, Hi: 0x2e28, Stride: 1},
+			{Lo: 0x2e42, Hi: 0x2e42, Stride: 1},
+			{Lo: 0x2e55, Hi: 0x2e55, Stride: 1},
+			{Lo: 0x2e57, Hi: 0x2e57, Stride: 1},
+			{Lo: 0x2e59, Hi: 0x2e59, Stride: 1},
+			{Lo: 0x2e5b, Hi: 0x2e5b, Stride: 1},
+			{Lo: 0x3008, Hi: 0x3008, Stride: 1},
+			{Lo: 0x300a, Hi: 0x300a, Stride: 1},
+			{Lo: 0x300c, Hi: 0x300c, Stride: 1},
+			{Lo: 0x300e, Hi: 0x300e, Stride: 1},
+			{Lo: 0x3010, Hi: 0x3010, Stride: 1},
+			{Lo: 0x3014, Hi: 0x3014, Stride: 1},
+			{Lo: 0x3016, Hi: 0x3016, Stride: 1},
+			{Lo: 0x3018, Hi: 0x3018, Stride: 1},
+			{Lo: 0x301a, Hi: 0x301a, Stride: 1},
+			{Lo: 0x301d, Hi: 0x301d, Stride: 1},
+			{Lo: 0xfd3f, Hi: 0xfd3f, Stride: 1},
+			{Lo: 0xfe17, Hi: 0xfe17, Stride: 1},
+			{Lo: 0xfe35, Hi: 0xfe35, Stride: 1},
+			{Lo: 0xfe37, Hi: 0xfe37, Stride: 1},
+			{Lo: 0xfe39, Hi: 0xfe39, Stride: 1},
+			{Lo: 0xfe3b, Hi: 0xfe3b, Stride: 1},
+			{Lo: 0xfe3d, Hi: 0xfe3d, Stride: 1},
+			{Lo: 0xfe3f, Hi: 0xfe3f, Stride: 1},
+			{Lo: 0xfe41, Hi: 0xfe41, Stride: 1},
+			{Lo: 0xfe43, Hi: 0xfe43, Stride: 1},
+			{Lo: 0xfe47, Hi: 0xfe47, Stride: 1},
+			{Lo: 0xfe59, Hi: 0xfe59, Stride: 1},
+			{Lo: 0xfe5b, Hi: 0xfe5b, Stride: 1},
+			{Lo: 0xfe5d, Hi: 0xfe5d, Stride: 1},
+			{Lo: 0xff08, Hi: 0xff08, Stride: 1},
+			{Lo: 0xff3b, Hi: 0xff3b, Stride: 1},
+			{Lo: 0xff5b, Hi: 0xff5b, Stride: 1},
+			{Lo: 0xff5f, Hi: 0xff5f, Stride: 1},
+			{Lo: 0xff62, Hi: 0xff62, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x13258, Hi: 0x1325a, Stride: 1},
+			{Lo: 0x13286, Hi: 0x13286, Stride: 1},
+			{Lo: 0x13288, Hi: 0x13288, Stride: 1},
+			{Lo: 0x13379, Hi: 0x13379, Stride: 1},
+			{Lo: 0x13437, Hi: 0x13437, Stride: 1},
+			{Lo: 0x145ce, Hi: 0x145ce, Stride: 1},
+			{Lo: 0x1e95e, Hi: 0x1e95f, Stride: 1},
+		},
+	}
+
+	tablePO = &unicode.RangeTable{
+		LatinOffset: 3,
+		R16: []unicode.Range16{
+			{Lo: 0x0025, Hi: 0x0025, Stride: 1},
+			{Lo: 0x00a2, Hi: 0x00a2, Stride: 1},
+			{Lo: 0x00b0, Hi: 0x00b0, Stride: 1},
+			{Lo: 0x0609, Hi: 0x060b, Stride: 1},
+			{Lo: 0x066a, Hi: 0x066a, Stride: 1},
+			{Lo: 0x09f2, Hi: 0x09f3, Stride: 1},
+			{Lo: 0x09f9, Hi: 0x09f9, Stride: 1},
+			{Lo: 0x0d79, Hi: 0x0d79, Stride: 1},
+			{Lo: 0x2030, Hi: 0x2037, Stride: 1},
+			{Lo: 0x20a7, Hi: 0x20a7, Stride: 1},
+			{Lo: 0x20b6, Hi: 0x20b6, Stride: 1},
+			{Lo: 0x20bb, Hi: 0x20bb, Stride: 1},
+			{Lo: 0x20be, Hi: 0x20be, Stride: 1},
+			{Lo: 0x20c0, Hi: 0x20c0, Stride: 1},
+			{Lo: 0x2103, Hi: 0x2103, Stride: 1},
+			{Lo: 0x2109, Hi: 0x2109, Stride: 1},
+			{Lo: 0xa838, Hi: 0xa838, Stride: 1},
+			{Lo: 0xfdfc, Hi: 0xfdfc, Stride: 1},
+			{Lo: 0xfe6a, Hi: 0xfe6a, Stride: 1},
+			{Lo: 0xff05, Hi: 0xff05, Stride: 1},
+			{Lo: 0xffe0, Hi: 0xffe0, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x11fdd, Hi: 0x11fe0, Stride: 1},
+			{Lo: 0x1ecac, Hi: 0x1ecac, Stride: 1},
+			{Lo: 0x1ecb0, Hi: 0x1ecb0, Stride: 1},
+		},
+	}
+
+	tablePR = &unicode.RangeTable{
+		LatinOffset: 5,
+		R16: []unicode.Range16{
+			{Lo: 0x0024, Hi: 0x0024, Stride: 1},
+			{Lo: 0x002b, Hi: 0x002b, Stride: 1},
+			{Lo: 0x005c, Hi: 0x005c, Stride: 1},
+			{Lo: 0x00a3, Hi: 0x00a5, Stride: 1},
+			{Lo: 0x00b1, Hi: 0x00b1, Stride: 1},
+			{Lo: 0x058f, Hi: 0x058f, Stride: 1},
+			{Lo: 0x07fe, Hi: 0x07ff, Stride: 1},
+			{Lo: 0x09fb, Hi: 0x09fb, Stride: 1},
+			{Lo: 0x0af1, Hi: 0x0af1, Stride: 1},
+			{Lo: 0x0bf9, Hi: 0x0bf9, Stride: 1},
+			{Lo: 0x0e3f, Hi: 0x0e3f, Stride: 1},
+			{Lo: 0x17db, Hi: 0x17db, Stride: 1},
+			{Lo: 0x20a0, Hi: 0x20a6, Stride: 1},
+			{Lo: 0x20a8, Hi: 0x20b5, Stride: 1},
+			{Lo: 0x20b7, Hi: 0x20ba, Stride: 1},
+			{Lo: 0x20bc, Hi: 0x20bd, Stride: 1},
+			{Lo: 0x20bf, Hi: 0x20bf, Stride: 1},
+			{Lo: 0x20c1, Hi: 0x20cf, Stride: 1},
+			{Lo: 0x2116, Hi: 0x2116, Stride: 1},
+			{Lo: 0x2212, Hi: 0x2213, Stride: 1},
+			{Lo: 0xfe69, Hi: 0xfe69, Stride: 1},
+			{Lo: 0xff04, Hi: 0xff04, Stride: 1},
+			{Lo: 0xffe1, Hi: 0xffe1, Stride: 1},
+			{Lo: 0xffe5, Hi: 0xffe6, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1e2ff, Hi: 0x1e2ff, Stride: 1},
+		},
+	}
+
+	tableQU = &unicode.RangeTable{
+		LatinOffset: 4,
+		R16: []unicode.Range16{
+			{Lo: 0x0022, Hi: 0x0022, Stride: 1},
+			{Lo: 0x0027, Hi: 0x0027, Stride: 1},
+			{Lo: 0x00ab, Hi: 0x00ab, Stride: 1},
+			{Lo: 0x00bb, Hi: 0x00bb, Stride: 1},
+			{Lo: 0x2018, Hi: 0x2019, Stride: 1},
+			{Lo: 0x201b, Hi: 0x201d, Stride: 1},
+			{Lo: 0x201f, Hi: 0x201f, Stride: 1},
+			{Lo: 0x2039, Hi: 0x203a, Stride: 1},
+			{Lo: 0x275b, Hi: 0x2760, Stride: 1},
+			{Lo: 0x2e00, Hi: 0x2e0d, Stride: 1},
+			{Lo: 0x2e1c, Hi: 0x2e1d, Stride: 1},
+			{Lo: 0x2e20, Hi: 0x2e21, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f676, Hi: 0x1f678, Stride: 1},
+		},
+	}
+
+	tableRI = &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		},
+	}
+
+	tableSP = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x0020, Hi: 0x0020, Stride: 1},
+		},
+	}
+
+	tableSY = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x002f, Hi: 0x002f, Stride: 1},
+		},
+	}
+
+	tableUnassignedPictographic = &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{Lo: 0x1f02c, Hi: 0x1f02f, Stride: 1},
+			{Lo: 0x1f094, Hi: 0x1f09f, Stride: 1},
+			{Lo: 0x1f0af, Hi: 0x1f0b0, Stride: 1},
+			{Lo: 0x1f0c0, Hi: 0x1f0c0, Stride: 1},
+			{Lo: 0x1f0d0, Hi: 0x1f0d0, Stride: 1},
+			{Lo: 0x1f0f6, Hi: 0x1f0ff, Stride: 1},
+			{Lo: 0x1f1ae, Hi: 0x1f1e5, Stride: 1},
+			{Lo: 0x1f203, Hi: 0x1f20f, Stride: 1},
+			{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+			{Lo: 0x1f249, Hi: 0x1f24f, Stride: 1},
+			{Lo: 0x1f252, Hi: 0x1f25f, Stride: 1},
+			{Lo: 0x1f266, Hi: 0x1f2ff, Stride: 1},
+			{Lo: 0x1f6d8, Hi: 0x1f6dc, Stride: 1},
+			{Lo: 0x1f6ed, Hi: 0x1f6ef, Stride: 1},
+			{Lo: 0x1f6fd, Hi: 0x1f6ff, Stride: 1},
+			{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+			{Lo: 0x1f7d9, Hi: 0x1f7df, Stride: 1},
+			{Lo: 0x1f7ec, Hi: 0x1f7ef, Stride: 1},
+			{Lo: 0x1f7f1, Hi: 0x1f7ff, Stride: 1},
+			{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+			{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+			{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+			{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+			{Lo: 0x1f8ae, Hi: 0x1f8af, Stride: 1},
+			{Lo: 0x1f8b2, Hi: 0x1f8ff, Stride: 1},
+			{Lo: 0x1fa54, Hi: 0x1fa5f, Stride: 1},
+			{Lo: 0x1fa6e, Hi: 0x1fa6f, Stride: 1},
+			{Lo: 0x1fa75, Hi: 0x1fa77, Stride: 1},
+			{Lo: 0x1fa7d, Hi: 0x1fa7f, Stride: 1},
+			{Lo: 0x1fa87, Hi: 0x1fa8f, Stride: 1},
+			{Lo: 0x1faad, Hi: 0x1faaf, Stride: 1},
+			{Lo: 0x1fabb, Hi: 0x1fabf, Stride: 1},
+			{Lo: 0x1fac6, Hi: 0x1facf, Stride: 1},
+			{Lo: 0x1fada, Hi: 0x1fadf, Stride: 1},
+			{Lo: 0x1fae8, Hi: 0x1faef, Stride: 1},
+			{Lo: 0x1faf7, Hi: 0x1faff, Stride: 1},
+			{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+		},
+	}
+
+	tableWJ = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x2060, Hi: 0x2060, Stride: 1},
+			{Lo: 0xfeff, Hi: 0xfeff, Stride: 1},
+		},
+	}
+
+	tableZW = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x200b, Hi: 0x200b, Stride: 1},
+		},
+	}
+
+	tableZWJ = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x200d, Hi: 0x200d, Stride: 1},
+		},
+	}
+)
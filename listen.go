@@ -0,0 +1,107 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// listenRaw listens at addr, either unix:/path/to/socket or tcp:host:port,
+// where tcp: may be omitted
+func listenRaw(addr string) (net.Listener, error) {
+	if path := strings.TrimPrefix(addr, "unix:"); path != addr {
+		return net.Listen("unix", path)
+	}
+
+	return net.Listen("tcp", strings.TrimPrefix(addr, "tcp:"))
+}
+
+// unlockedReader reads r while mu is released, so that mu can be held at all
+// other times
+type unlockedReader struct {
+	r  io.Reader
+	mu *sync.Mutex
+}
+
+func (r unlockedReader) Read(b []byte) (int, error) {
+	r.mu.Unlock()
+	defer r.mu.Lock()
+	return r.r.Read(b)
+}
+
+// listen counts the text of each connection accepted by ln into the counters,
+// as a separate document, until fc.stop is closed. fn is then called with the
+// counters.
+func (fl *follower) listen(fc *fileCounter, ln net.Listener, fn func(string, []*wordseq.Counter) error) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	go func() {
+		<-fc.stop
+		_ = ln.Close() // #nosec
+	}()
+
+	for i := 1; ; i++ {
+		conn, err := ln.Accept()
+		if err != nil {
+			select {
+			case <-fc.stop:
+				wg.Wait()
+				return fn("", fl.counters)
+			default:
+				return err
+			}
+		}
+
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			// an interrupt ends connections that are still open
+			finished := make(chan struct{})
+			defer close(finished)
+
+			go func() {
+				select {
+				case <-fc.stop:
+				case <-finished:
+				}
+				_ = conn.Close() // #nosec
+			}()
+
+			if err := fl.countConn(fc, name, conn); err != nil {
+				select {
+				case <-fc.stop:
+				default:
+					log.Printf("%s: %v", name, err)
+				}
+			}
+		}(fmt.Sprintf("connection %d", i))
+	}
+}
+
+// countConn adds the text of conn, named name, to the counters
+func (fl *follower) countConn(fc *fileCounter, name string, conn net.Conn) error {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	in := &input{Reader: fc.p.reader(unlockedReader{r: conn, mu: &fl.mu}), Closer: nopCloser{}, live: true}
+
+	r, err := decode(name, in, fc.c)
+	if err != nil {
+		return err
+	}
+
+	r = sample(name, prepare(name, r, fc.c), fc.c)
+	r = stopReader{Reader: r, stop: fc.stop}
+
+	return wordseq.AddAll(r, fl.counters...)
+}
@@ -0,0 +1,102 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestListenRaw(t *testing.T) {
+	for _, addr := range []string{"tcp:127.0.0.1:0", "127.0.0.1:0", "unix:" + filepath.Join(t.TempDir(), "nr.sock")} {
+		ln, err := listenRaw(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = ln.Close() // #nosec
+	}
+}
+
+func TestFollowerListen(t *testing.T) {
+	ln, err := listenRaw("unix:" + filepath.Join(t.TempDir(), "nr.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	fc := fileCounter{
+		c: config{
+			SequenceSize: sizesFlag{3},
+			DetectBytes:  1024,
+			Sample:       1,
+		},
+		stop: stop,
+	}
+
+	fl := &follower{}
+	if fl.counters, err = fc.newCounters(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error)
+	var got []*wordseq.Counter
+	go func() {
+		done <- fl.listen(&fc, ln, func(_ string, counters []*wordseq.Counter) error {
+			got = counters
+			return nil
+		})
+	}()
+
+	// each connection is a separate document, so no sequence spans them
+	for _, text := range []string{"a b c", "d e"} {
+		conn, err := net.Dial("unix", ln.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = conn.Write([]byte(text)); err != nil {
+			t.Fatal(err)
+		}
+		_ = conn.Close() // #nosec
+	}
+
+	// an open connection is ended by stopping
+	open, err := net.Dial("unix", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer open.Close()
+
+	if _, err = open.Write([]byte("a b c ")); err != nil {
+		t.Fatal(err)
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		fl.mu.Lock()
+		total := fl.counters[0].Total()
+		fl.mu.Unlock()
+
+		if total == 2 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("total(%d) != 2", total)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(stop)
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 || got[0].Total() != 2 {
+		t.Fatalf("unexpected counters: %v", got)
+	}
+}
@@ -4,165 +4,1470 @@ package main
 // Released under the MIT license
 
 import (
-	"bytes"
+	"bufio"
 	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"os"
+	"os/signal"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
-	"text/tabwriter"
+	"sync/atomic"
+	"time"
 
-	"golang.org/x/net/html/charset"
-	"golang.org/x/text/encoding"
-	"golang.org/x/text/encoding/htmlindex"
-	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"jrubin.io/nr/preprocess"
+	"jrubin.io/nr/stopwords"
+	"jrubin.io/nr/wordreader"
 	"jrubin.io/nr/wordseq"
 )
 
 type config struct {
-	Encoding     string
-	SequenceSize int
-	TopN         int
+	Encoding      encodingFlag
+	SequenceSize  sizesFlag
+	TopN          intsFlag
+	Context       int
+	ShowContext   int
+	Dehyphenate   bool
+	Logs          bool
+	Markdown      bool
+	Code          bool
+	PerMillion    bool
+	Percent       bool
+	DetectBytes   int
+	IDsFile       string
+	VocabFile     string
+	Output        string
+	Format        string
+	Recursive     bool
+	FilesFrom     string
+	Ignore        stringsFlag
+	IgnoreFiles   stringsFlag
+	GitIgnore     bool
+	Binary        bool
+	FollowLinks   bool
+	Check         bool
+	Quiet         bool
+	CPUProfile    string
+	MemProfile    string
+	Trace         string
+	Timing        bool
+	Highlight     bool
+	DedupeInputs  bool
+	Verbose       bool
+	FailIfEmpty   bool
+	Null          bool
+	Include       stringsFlag
+	HTML          bool
+	JSONField     string
+	PerFile       bool
+	Jobs          int
+	ConfigFile    string
+	OutputFile    string
+	Stopwords     stringsFlag
+	StopLangs     stringsFlag
+	CaseSensitive bool
+	KeepPunct     bool
+	MinCount      int
+	Sort          string
+	Match         string
+	Exclude       string
+	Containing    string
+	NoNumeric     bool
+	Lang          string
+	Normalize     string
+	Stats         bool
+	Progress      bool
+	Watch         bool
+	Follow        bool
+	ListenRaw     string
+	Stream        string
+	Interval      time.Duration
+	HTTP          string
+	GRPC          string
+	MaxBody       bytesFlag
+	Score         string
+	StateFile     string
+	Baseline      string
+	Words         int
+	Seed          int64
+	Bars          bool
+	Columns       string
+	Color         string
+	NoPager       bool
+	MaxWidth      int
+	Overflow      string
+	MaxBytes      bytesFlag
+	Sample        float64
+	MaxMemory     bytesFlag
+
+	// dash, if not nil, is sent the results as they are written
+	dash *dashboard
+
+	// baseline is read from the Baseline file by checkOutput
+	baseline baseline
+
+	// columns are the columns of the text output, set by checkOutput
+	columns []string
+
+	// color is set by checkOutput if the text output is colored, with the
+	// parts of sequences found by highlight
+	color     bool
+	highlight *highlighter
 }
 
-func initFlags(c *config) *flag.FlagSet {
-	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+// intsFlag is a flag.Value holding a comma separated list of integers
+type intsFlag []int
 
-	fs.Usage = func() {
-		_, _ = fmt.Fprintf(
-			fs.Output(),
-			`Usage of %s:
+var _ flag.Value = (*intsFlag)(nil)
 
-	%s file1.txt file2.txt ...
-	cat file1.txt | %s
+func (f *intsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	s := make([]string, len(*f))
+	for i, n := range *f {
+		s[i] = strconv.Itoa(n)
+	}
+	return strings.Join(s, ",")
+}
 
-	A filename argument of '-' indicates that stdin should be read.
-	If no filenames are given, input is assumed to come from stdin.
+func (f *intsFlag) Set(value string) error {
+	var ns intsFlag
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return err
+		}
+		ns = append(ns, n)
+	}
+	*f = ns
+	return nil
+}
 
-flags:
-`,
-			os.Args[0],
-			os.Args[0],
-			os.Args[0],
-		)
-		fs.PrintDefaults()
+func (f intsFlag) max() int {
+	var m int
+	for i, n := range f {
+		if i == 0 || n > m {
+			m = n
+		}
 	}
+	return m
+}
 
-	fs.StringVar(
+// limit returns the number of sequences needed for the largest cutoff, where 0
+// is unlimited
+func (f intsFlag) limit() int {
+	for _, n := range f {
+		if n == 0 {
+			return int(^uint(0) >> 1)
+		}
+	}
+	return f.max()
+}
+
+// intFlag is a flag.Value setting an intsFlag to a single integer, for commands
+// that don't show a section for each of a list of them
+type intFlag struct {
+	f *intsFlag
+}
+
+var _ flag.Value = intFlag{}
+
+func (f intFlag) String() string {
+	return f.f.String()
+}
+
+func (f intFlag) Set(value string) error {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return err
+	}
+	*f.f = intsFlag{n}
+	return nil
+}
+
+// sizesFlag is a flag.Value holding a comma separated list of sequence sizes
+// and ranges of them, e.g. 2-5
+type sizesFlag []int
+
+var _ flag.Value = (*sizesFlag)(nil)
+
+func (f *sizesFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return (*intsFlag)(f).String()
+}
+
+func (f *sizesFlag) Set(value string) error {
+	seen := map[int]bool{}
+
+	var ns sizesFlag
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+
+		lo, hi := v, v
+		if i := strings.Index(v, "-"); i > 0 {
+			lo, hi = v[:i], v[i+1:]
+		}
+
+		from, err := strconv.Atoi(lo)
+		if err != nil {
+			return err
+		}
+
+		to, err := strconv.Atoi(hi)
+		if err != nil {
+			return err
+		}
+
+		if from > to {
+			return fmt.Errorf("invalid range: %s", v)
+		}
+
+		for n := from; n <= to; n++ {
+			if !seen[n] {
+				seen[n] = true
+				ns = append(ns, n)
+			}
+		}
+	}
+	sort.Ints(ns)
+	*f = ns
+	return nil
+}
+
+func (f sizesFlag) max() int {
+	return intsFlag(f).max()
+}
+
+// stringsFlag is a flag.Value that collects every value it is set to
+type stringsFlag []string
+
+var _ flag.Value = (*stringsFlag)(nil)
+
+func (f *stringsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// bytesFlag is a flag.Value holding a number of bytes, which may have a k, m, g
+// or t suffix for KiB, MiB, GiB or TiB, e.g. 512m
+type bytesFlag int64
+
+var _ flag.Value = (*bytesFlag)(nil)
+
+func (f *bytesFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	// the largest suffix that the size is a whole number of
+	n := int64(*f)
+	for i := len(sizeSuffixes); i > 0; i-- {
+		if unit := int64(1) << (10 * uint(i)); n >= unit && n%unit == 0 {
+			return strconv.FormatInt(n/unit, 10) + sizeSuffixes[i-1:i]
+		}
+	}
+
+	return strconv.FormatInt(n, 10)
+}
+
+// sizeSuffixes are the suffixes of a bytesFlag, each 1024 times the last
+const sizeSuffixes = "kmgt"
+
+func (f *bytesFlag) Set(value string) error {
+	v := strings.ToLower(strings.TrimSpace(value))
+	v = strings.TrimSuffix(strings.TrimSuffix(v, "b"), "i")
+
+	var shift uint
+	if i := strings.IndexAny(v, sizeSuffixes); i >= 0 && i == len(v)-1 {
+		shift = 10 * uint(strings.IndexByte(sizeSuffixes, v[i])+1)
+		v = v[:i]
+	}
+
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n < 0 || n*float64(uint64(1)<<shift) > float64(1<<62) {
+		return fmt.Errorf("invalid size: %s", value)
+	}
+
+	*f = bytesFlag(n * float64(uint64(1)<<shift))
+	return nil
+}
+
+// inputFlags adds the flags that control how files are read
+func inputFlags(fs *flag.FlagSet, c *config) {
+	fs.Var(
 		&c.Encoding,
 		"encoding",
+		"file `encoding` of all files, including stdin, valid values are listed by the encodings command, use file=encoding to set the encoding of a single file, may be repeated",
+	)
+
+	fs.BoolVar(
+		&c.Dehyphenate,
+		"dehyphenate",
+		false,
+		"rejoin words that were hyphenated across line breaks (e.g. in OCR'd text)",
+	)
+
+	fs.BoolVar(
+		&c.Logs,
+		"logs",
+		false,
+		"read log files, removing the timestamps and levels that start each line and hex ids such as uuids, so that sequences reflect the messages rather than when they were logged",
+	)
+
+	fs.IntVar(
+		&c.DetectBytes,
+		"detect-bytes",
+		1024,
+		"number of bytes to inspect when detecting the encoding",
+	)
+
+	fs.BoolVar(
+		&c.Recursive,
+		"r",
+		false,
+		"read directories recursively",
+	)
+
+	fs.Var(
+		&c.Ignore,
+		"ignore",
+		"skip the files and directories matching this .gitignore style `pattern` when reading directories recursively, e.g. '*.o' or 'vendor/', may be repeated",
+	)
+
+	fs.Var(
+		&c.IgnoreFiles,
+		"ignore-file",
+		"skip what the patterns of this .gitignore style `file` match when reading directories recursively, may be repeated",
+	)
+
+	fs.BoolVar(
+		&c.GitIgnore,
+		"gitignore",
+		false,
+		"skip what the .gitignore files of the directories being read recursively ignore",
+	)
+
+	fs.BoolVar(
+		&c.FollowLinks,
+		"follow-symlinks",
+		false,
+		"also read the directories that symlinks lead to when reading directories recursively, rather than skipping them, symlinks to files are always read",
+	)
+
+	fs.BoolVar(
+		&c.Binary,
+		"binary",
+		false,
+		"also read the files that appear to be binary when reading directories recursively, rather than skipping them",
+	)
+
+	fs.StringVar(
+		&c.FilesFrom,
+		"files-from",
 		"",
-		"file encoding of all files, including stdin, valid values defined at https://www.w3.org/TR/encoding/",
+		"also read the files listed in this `file`, one path per line, or - to read the list from stdin, for more files than fit on the command line",
+	)
+
+	fs.BoolVar(
+		&c.Null,
+		"0",
+		false,
+		"the paths of -files-from are separated by NUL characters rather than lines, as written by find -print0",
+	)
+
+	fs.Func(
+		"files-from0",
+		"same as -files-from `file` -0",
+		func(value string) error {
+			c.FilesFrom = value
+			c.Null = true
+			return nil
+		},
+	)
+
+	fs.Var(
+		&c.Include,
+		"include",
+		"only read archive members whose name or path matches this glob `pattern`, may be repeated",
+	)
+
+	fs.BoolVar(
+		&c.HTML,
+		"html",
+		false,
+		"only count the visible text of html input, ignoring markup, scripts and styles",
+	)
+
+	fs.BoolVar(
+		&c.Markdown,
+		"markdown",
+		false,
+		"only count the text of markdown input, ignoring formatting, code blocks and the urls of links",
+	)
+
+	fs.BoolVar(
+		&c.Code,
+		"code",
+		false,
+		"only count the comments and string literals of source code, in the language of each file's extension, e.g. .go, .py, .sh or .sql, or that of c if it isn't known",
+	)
+
+	fs.StringVar(
+		&c.JSONField,
+		"json-field",
+		"",
+		"read json or json lines input and only count the string at this dot separated `path` in each record",
+	)
+
+	fs.Var(
+		&c.MaxBytes,
+		"max-bytes",
+		"only read the first `size` bytes of each file, after decompression, for quick approximate results, e.g. 100m, 0 reads all of them",
+	)
+
+	fs.Float64Var(
+		&c.Sample,
+		"sample",
+		1,
+		"only count this `fraction` of the lines of each file, greater than 0, chosen at random, for quick approximate results, e.g. 0.1",
+	)
+
+	fs.Int64Var(
+		&c.Seed,
+		"seed",
+		0,
+		"seed for random choices, such as the lines of -sample and the words of generate, so that they are the same each time, 0 uses the current time",
+	)
+
+	fs.Var(
+		&c.MaxMemory,
+		"max-memory",
+		"limit the memory used for sequences to about `size` bytes, e.g. 2g, counting approximately once there are too many to count exactly, 0 doesn't limit it",
 	)
 
 	fs.IntVar(
+		&c.Jobs,
+		"jobs",
+		0,
+		"number of files to decode and count in parallel, 0 uses GOMAXPROCS",
+	)
+
+	fs.StringVar(
+		&c.Normalize,
+		"normalize",
+		"",
+		"apply this unicode normalization `form` before counting, one of: nfc, nfd, nfkc, nfkd",
+	)
+
+	fs.BoolVar(
+		&c.Progress,
+		"progress",
+		false,
+		"report progress to stderr, which is done by default for inputs over 1 GiB when stderr is a terminal",
+	)
+}
+
+// sequenceFlags adds the flags that control which sequences are counted
+func sequenceFlags(fs *flag.FlagSet, c *config) {
+	c.SequenceSize = sizesFlag{3}
+	fs.Var(
 		&c.SequenceSize,
 		"sequence-size",
-		3,
-		"number of words per sequence",
+		"number of words per sequence, a comma separated `list` of sizes or ranges such as 2-5 counts each in a single pass",
+	)
+
+	fs.IntVar(
+		&c.Context,
+		"context",
+		0,
+		"show a snippet of n words before and after the first occurrence of each sequence",
+	)
+
+	fs.IntVar(
+		&c.ShowContext,
+		"show-context",
+		0,
+		"show up to k excerpts of how each sequence is used, below it, with the number of words around them set by -context, or 5",
+	)
+
+	fs.Var(
+		&c.Stopwords,
+		"stopwords",
+		"ignore the words in this `file`, one per line, before forming sequences, may be repeated",
+	)
+
+	fs.Var(
+		&c.StopLangs,
+		"stopwords-lang",
+		"ignore the built in stopwords for this `language` before forming sequences, one of: "+
+			strings.Join(stopwords.Languages(), ", ")+", or auto for the language detected in the start of the input files, may be repeated",
+	)
+
+	fs.BoolVar(
+		&c.CaseSensitive,
+		"case-sensitive",
+		false,
+		"count words that differ only in case, such as Apple and apple, separately",
+	)
+
+	fs.BoolVar(
+		&c.KeepPunct,
+		"keep-punct",
+		false,
+		"count words exactly as segmented, keeping their punctuation and words made up only of punctuation",
+	)
+
+	fs.StringVar(
+		&c.Lang,
+		"lang",
+		"",
+		"use the lower case mappings and collation of this BCP 47 language `tag`, e.g. tr for the Turkish dotless i, to normalize words and order sequences that occur equally often",
 	)
+}
 
+// filterFlags adds the flags that control which of the counted sequences are
+// shown
+func filterFlags(fs *flag.FlagSet, c *config) {
 	fs.IntVar(
+		&c.MinCount,
+		"min-count",
+		0,
+		"omit sequences that occur fewer than this many times",
+	)
+
+	fs.StringVar(
+		&c.Match,
+		"match",
+		"",
+		"only show sequences whose words, joined by spaces, match this `regexp`",
+	)
+
+	fs.StringVar(
+		&c.Exclude,
+		"exclude",
+		"",
+		"don't show sequences whose words, joined by spaces, match this `regexp`",
+	)
+
+	fs.StringVar(
+		&c.Containing,
+		"containing",
+		"",
+		"only show sequences that include at least one of the words in this comma separated `list`",
+	)
+
+	fs.BoolVar(
+		&c.NoNumeric,
+		"no-numeric",
+		false,
+		"don't show sequences made up entirely of numbers",
+	)
+}
+
+// outputFlags adds the flags that control how the results are shown
+func outputFlags(fs *flag.FlagSet, c *config) {
+	c.TopN = intsFlag{100}
+	fs.Var(
 		&c.TopN,
 		"n",
-		100,
-		"only show the top n sequences with the highest frequency count",
+		"only show the top n sequences with the highest frequency count, 0 shows all of them, a comma separated `list` shows a section for each",
+	)
+
+	fs.BoolVar(
+		&c.PerMillion,
+		"per-million",
+		false,
+		"also show the frequency of each sequence per million sequences",
 	)
 
-	_ = fs.Parse(os.Args[1:]) // #nosec
+	fs.BoolVar(
+		&c.Percent,
+		"percent",
+		false,
+		"also show each sequence's share of all sequences as a percentage, e.g. 1.42%",
+	)
 
-	return fs
+	fs.BoolVar(
+		&c.Bars,
+		"bars",
+		false,
+		"also show a bar after each count of the text output, proportional to the count",
+	)
+
+	fs.StringVar(
+		&c.Color,
+		"color",
+		"auto",
+		"color the counts and ranks of the text output, highlighting the top sequences and the parts matched by -match or -containing, one of: auto (when writing to a terminal, unless NO_COLOR is set), always, never",
+	)
+
+	fs.BoolVar(
+		&c.NoPager,
+		"no-pager",
+		false,
+		"don't show output that is longer than the terminal through $PAGER (less by default)",
+	)
+
+	fs.IntVar(
+		&c.MaxWidth,
+		"max-width",
+		0,
+		"the widest, in characters, that the words and context of the text output may be before they are shortened as set by -overflow, 0 is unlimited",
+	)
+
+	fs.StringVar(
+		&c.Overflow,
+		"overflow",
+		"truncate",
+		"how words and context wider than -max-width are shown, truncate (with an ellipsis) or wrap",
+	)
+
+	fs.StringVar(
+		&c.Columns,
+		"columns",
+		"",
+		"comma separated `list` of the columns of the text output, in order, from: rank, count, bar, per-million, percent, count-change, rank-change (with -baseline), words, context (with -context); by default the count, those chosen by other flags and the words",
+	)
+
+	fs.StringVar(
+		&c.Output,
+		"output",
+		"text",
+		"output `format`, one of: text, json, ndjson, csv, tsv, svg-cloud (a word cloud of up to 300 of the sequences sized by their counts)",
+	)
+
+	fs.StringVar(
+		&c.Format,
+		"format",
+		"",
+		"format each sequence using a go `template` with access to .File, .Rank, .Count, .Words, .Percent, .PerMillion, .Context and .Contexts, and a join function (overrides -output)",
+	)
+
+	fs.BoolVar(
+		&c.PerFile,
+		"per-file",
+		false,
+		"also show the top sequences of each file separately",
+	)
+
+	fs.StringVar(
+		&c.OutputFile,
+		"o",
+		"",
+		"write the results to this `file`, which is only replaced once they are complete, instead of stdout",
+	)
+
+	fs.StringVar(
+		&c.Sort,
+		"sort",
+		"count-desc",
+		"`order` of the sequences, one of: count-desc, count-asc (so that -n shows the rarest), lex (the most frequent, ordered by their words)",
+	)
+
+	fs.BoolVar(
+		&c.Stats,
+		"stats",
+		false,
+		"also show the number of words, sequences and bytes read, the languages detected and the time taken, on stderr unless -output is text",
+	)
+
+	fs.StringVar(
+		&c.Baseline,
+		"baseline",
+		"",
+		"annotate each sequence with its rank and count in earlier results written to this `file` by -output json or ndjson, and how they have changed",
+	)
 }
 
-func main() {
-	var c config
-	fs := initFlags(&c)
+// countFlags adds the flags of the count command
+func countFlags(fs *flag.FlagSet, c *config) {
+	inputFlags(fs, c)
+	sequenceFlags(fs, c)
+	filterFlags(fs, c)
+	outputFlags(fs, c)
+
+	fs.StringVar(
+		&c.IDsFile,
+		"ids",
+		"",
+		"write every sequence as space separated vocabulary ids, one per line, to this file (requires -vocab)",
+	)
+
+	fs.StringVar(
+		&c.VocabFile,
+		"vocab",
+		"",
+		"write the vocabulary used by -ids to this file, one word per line where the line number (from 0) is the id",
+	)
+
+	fs.BoolVar(
+		&c.Watch,
+		"watch",
+		false,
+		"run again, showing the new results, whenever any of the input files change",
+	)
+
+	fs.BoolVar(
+		&c.Follow,
+		"follow",
+		false,
+		"keep reading data appended to the input files, as with tail -f, showing the updated results every -interval until interrupted",
+	)
+
+	fs.StringVar(
+		&c.ListenRaw,
+		"listen-raw",
+		"",
+		"count the text sent to this `address`, unix:/path/to/socket or tcp:host:port, as with -follow, where each connection is a separate document",
+	)
+
+	fs.StringVar(
+		&c.Stream,
+		"stream",
+		"",
+		"count each line read from this `address`, an http or https url, unix:/path/to/socket or tcp:host:port, as a separate message, as with -follow, reconnecting whenever it ends, e.g. the records of a Kafka topic from a REST proxy",
+	)
+
+	fs.DurationVar(
+		&c.Interval,
+		"interval",
+		5*time.Second,
+		"how often -follow, -listen-raw and -stream show the updated results",
+	)
 
-	if err := run(c, fs.Args()...); err != nil {
-		log.Fatalf("%+v", err)
+	fs.StringVar(
+		&c.StateFile,
+		"save-state",
+		"",
+		"also save every sequence counted, not just the top n, to this `file`, which can be given as an input to continue counting or to the merge command, with -follow it is saved every -interval",
+	)
+
+	fs.StringVar(
+		&c.HTTP,
+		"http",
+		"",
+		"with -watch, -follow, -listen-raw or -stream, also serve a page at this address, e.g. localhost:8080, that shows the latest results",
+	)
+
+	fs.BoolVar(
+		&c.Check,
+		"check",
+		false,
+		"only report the encoding of each input, how sure its detection is and any decode errors, without counting, exiting with an error if there were any",
+	)
+
+	fs.BoolVar(
+		&c.FailIfEmpty,
+		"fail-if-empty",
+		false,
+		"exit with status 5 if no sequences were found, after showing the results",
+	)
+
+	fs.BoolVar(
+		&c.DedupeInputs,
+		"dedupe-inputs",
+		false,
+		"skip input files with the same content as an earlier one, rather than warning that they are counted again",
+	)
+
+	fs.BoolVar(
+		&c.Highlight,
+		"highlight",
+		false,
+		"instead of the sequences, show the text of the files with the occurrences of the top sequences colored, or between [[ and ]] without -color",
+	)
+
+	fs.BoolVar(
+		&c.Timing,
+		"timing",
+		false,
+		"also show the time spent decoding, segmenting and counting the files, and the MB/s and words/s of each, on stderr",
+	)
+
+	fs.StringVar(
+		&c.CPUProfile,
+		"cpuprofile",
+		"",
+		"write a cpu profile of the run to this `file`, for go tool pprof",
+	)
+
+	fs.StringVar(
+		&c.MemProfile,
+		"memprofile",
+		"",
+		"write a profile of the memory in use at the end of the run to this `file`, for go tool pprof",
+	)
+
+	fs.StringVar(
+		&c.Trace,
+		"trace",
+		"",
+		"write an execution trace of the run to this `file`, for go tool trace",
+	)
+}
+
+func writeVocabulary(fn string, vocab *wordseq.Vocabulary) error {
+	f, err := os.Create(fn)
+	if err != nil {
+		return err
 	}
+
+	w := bufio.NewWriter(f)
+	if _, err = vocab.WriteTo(w); err != nil {
+		_ = f.Close() // #nosec
+		return err
+	}
+
+	if err = w.Flush(); err != nil {
+		_ = f.Close() // #nosec
+		return err
+	}
+
+	return f.Close()
 }
 
-func max(a, b int) int {
-	if a > b {
-		return a
+// numeric reports whether every word is a number, allowing for separators such
+// as those in 1,000.50 when punctuation is kept
+func numeric(words []string) bool {
+	for _, word := range words {
+		if wordreader.Classify(word) != wordreader.Number {
+			return false
+		}
 	}
-	return b
+	return true
 }
 
-func run(c config, args ...string) error {
-	// build a list of all the things to read from
+var normForms = map[string]norm.Form{
+	"nfc":  norm.NFC,
+	"nfd":  norm.NFD,
+	"nfkc": norm.NFKC,
+	"nfkd": norm.NFKD,
+}
+
+// prepare applies the preprocessing selected by c to the decoded input r of fn
+func prepare(fn string, r io.Reader, c config) io.Reader {
+	if form, ok := normForms[strings.ToLower(c.Normalize)]; ok {
+		r = form.Reader(r)
+	}
+
+	if c.Code {
+		r = preprocess.Code(r, fn)
+	}
+
+	if c.JSONField != "" {
+		r = preprocess.JSONField(r, c.JSONField)
+	}
+
+	if c.HTML {
+		r = preprocess.HTML(r)
+	}
+
+	if c.Markdown {
+		r = preprocess.Markdown(r)
+	}
+
+	if c.Logs {
+		r = preprocess.Logs(r)
+	}
+
+	if c.Dehyphenate {
+		r = preprocess.Dehyphenate(r)
+	}
+
+	return r
+}
+
+// showContextWords is the number of words around the excerpts of -show-context
+// when -context isn't used
+const showContextWords = 5
+
+// counterOptions returns the options of the counters as selected by c
+func counterOptions(c config) ([]wordseq.Option, error) {
+	// a sample of no lines would count nothing
+	if c.Sample <= 0 || c.Sample > 1 {
+		return nil, usageErrorf("invalid -sample value: %v", c.Sample)
+	}
+
+	// the excerpts of -show-context need words around them even without
+	// -context
+	context := c.Context
+	if c.ShowContext > 0 && context == 0 {
+		context = showContextWords
+	}
+
+	opts := []wordseq.Option{
+		wordseq.WithContext(context),
+		wordseq.WithContexts(c.ShowContext),
+		wordseq.WithCaseSensitive(c.CaseSensitive),
+		wordseq.WithKeepPunct(c.KeepPunct),
+		wordseq.WithMinCount(c.MinCount),
+	}
+
+	if c.Match != "" {
+		re, err := regexp.Compile(c.Match)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, wordseq.WithFilter(func(words []string) bool {
+			return re.MatchString(strings.Join(words, " "))
+		}))
+	}
+
+	if c.Exclude != "" {
+		re, err := regexp.Compile(c.Exclude)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, wordseq.WithFilter(func(words []string) bool {
+			return !re.MatchString(strings.Join(words, " "))
+		}))
+	}
+
+	if c.Lang != "" {
+		tag, err := language.Parse(c.Lang)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, wordseq.WithLanguage(tag))
+	}
+
+	if c.NoNumeric {
+		opts = append(opts, wordseq.WithFilter(func(words []string) bool {
+			return !numeric(words)
+		}))
+	}
+
+	if c.Containing != "" {
+		opts = append(opts, wordseq.WithContaining(strings.Split(c.Containing, ",")))
+	}
+
+	for _, fn := range c.Stopwords {
+		words, err := stopwords.ReadFile(fn)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, wordseq.WithStopwords(words))
+	}
+
+	for _, lang := range c.StopLangs {
+		words, err := stopwords.Language(lang)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, wordseq.WithStopwords(words))
+	}
+
+	if c.MaxMemory > 0 {
+		n := maxSequences(c)
+		if n < 2 {
+			return nil, usageErrorf("invalid -max-memory value: %s", c.MaxMemory.String())
+		}
+		opts = append(opts, wordseq.WithMaxSequences(n))
+	}
+
+	return opts, nil
+}
+
+// seqBytes estimates the memory used by a sequence of size words, with its
+// entries in the counter's cache and heap
+func seqBytes(size int) int64 {
+	return 160 + 24*int64(size)
+}
+
+// maxSequences returns how many sequences each counter may hold within
+// -max-memory. Besides the totals, there is a counter for each sequence size of
+// every file being counted.
+func maxSequences(c config) int {
+	jobs := c.Jobs
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	size := 0
+	for _, n := range c.SequenceSize {
+		if n > size {
+			size = n
+		}
+	}
+
+	counters := int64(jobs+1) * int64(len(c.SequenceSize))
+	if counters == 0 {
+		counters = 1
+	}
+
+	return int(int64(c.MaxMemory) / counters / seqBytes(size))
+}
+
+func run(c config, args ...string) (err error) {
+	start := time.Now()
+
+	if err = checkOutput(&c); err != nil {
+		return err
+	}
+
+	if _, ok := normForms[strings.ToLower(c.Normalize)]; c.Normalize != "" && !ok {
+		return usageErrorf("invalid normalization form: %s", c.Normalize)
+	}
+
+	// listening and streaming count text as it arrives, as following does
+	// files
+	var sources []string
+	if c.Follow {
+		sources = append(sources, "-follow")
+	}
+	if c.ListenRaw != "" {
+		sources = append(sources, "-listen-raw")
+	}
+	if c.Stream != "" {
+		sources = append(sources, "-stream")
+	}
+	following := len(sources) > 0
+
+	if following {
+		name := sources[0]
+
+		switch {
+		case len(sources) > 1:
+			return usageErrorf("%s can't be used with %s", sources[0], sources[1])
+		case !c.Follow && (len(args) > 0 || c.FilesFrom != ""):
+			return usageErrorf("%s can't be used with input files", name)
+		case c.Watch:
+			return usageErrorf("%s can't be used with -watch", name)
+		case c.OutputFile != "":
+			return usageErrorf("%s can't be used with -o", name)
+		case c.PerFile:
+			return usageErrorf("%s can't be used with -per-file", name)
+		case c.IDsFile != "" || c.VocabFile != "":
+			return usageErrorf("%s can't be used with -ids or -vocab", name)
+		case c.Interval <= 0:
+			return usageErrorf("invalid -interval value: %s", c.Interval)
+		case c.MaxBytes > 0:
+			return usageErrorf("%s can't be used with -max-bytes", name)
+		case c.Check:
+			return usageErrorf("%s can't be used with -check", name)
+		case c.Highlight:
+			return usageErrorf("%s can't be used with -highlight", name)
+		}
+	}
+
+	if c.Highlight && (c.Output != "text" || c.Format != "") {
+		return usageErrorf("-highlight can't be used with -output %s or -format", c.Output)
+	}
+
+	if c.Jobs < 0 {
+		return usageErrorf("invalid -jobs value: %d", c.Jobs)
+	}
+
+	if c.Jobs == 0 {
+		c.Jobs = runtime.GOMAXPROCS(0)
+	}
+
+	stopProfiles, err := startProfiles(c)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if perr := stopProfiles(); err == nil {
+			err = perr
+		}
+	}()
+
+	ig, err := newIgnorer(c)
+	if err != nil {
+		return err
+	}
+
+	args, err = inputFiles(c, args, ig)
+	if err != nil {
+		return err
+	}
+
+	// after the results, so that it is seen
+	defer ig.reportSkipped()
 
-	readers := make([]io.Reader, 0, max(len(args), 1))
+	if len(args) == 0 && (!following || c.Follow) {
+		args = []string{"-"}
+	}
+
+	// files given more than once, even by different names, would double
+	// their counts
+	if !following {
+		if args, err = dedupeInputs(c, args); err != nil {
+			return err
+		}
+	}
+
+	// the files are read again to highlight them, which stdin can't be
 	for _, fn := range args {
-		if fn == "-" {
-			readers = append(readers, io.MultiReader(os.Stdin, strings.NewReader(" ")))
-			continue
+		if c.Highlight && fn == "-" {
+			return usageErrorf("-highlight can't be used with stdin")
 		}
+	}
+
+	if c.Check {
+		return runCheck(os.Stdout, c, args)
+	}
+
+	if err = autoStopwords(&c, args); err != nil {
+		return err
+	}
+
+	opts, err := counterOptions(c)
+	if err != nil {
+		return err
+	}
 
-		f, err := os.Open(fn)
+	var ids *bufio.Writer
+	var vocab *wordseq.Vocabulary
+
+	if c.IDsFile != "" || c.VocabFile != "" {
+		if c.IDsFile == "" || c.VocabFile == "" {
+			return usageErrorf("-ids and -vocab must be used together")
+		}
+
+		f, err := os.Create(c.IDsFile)
 		if err != nil {
 			return err
 		}
 		defer f.Close()
-		readers = append(readers, io.MultiReader(f, strings.NewReader(" ")))
+
+		ids = bufio.NewWriter(f)
+		vocab = wordseq.NewVocabulary()
+
+		opts = append(opts, wordseq.WithWindows(func(words []string) {
+			// write errors are retained by ids and returned by Flush
+			_ = vocab.WriteIDs(ids, words) // #nosec
+		}))
 	}
 
-	if len(readers) == 0 {
-		readers = append(readers, os.Stdin)
+	out, finish, err := createOutput(c)
+	if err != nil {
+		return err
 	}
+	defer func() { err = finish(err) }()
 
-	// concatenate the readers
-	reader := io.MultiReader(readers...)
+	var results []result
 
-	// ensure that the encoding is converted to utf-8
-	var enc encoding.Encoding
+	// found is set once the totals have any sequences to show
+	var found bool
 
-	if c.Encoding == "" {
-		// try to determine the encoding
-		buf := make([]byte, 1024)
-		n, err := reader.Read(buf)
-		if err != nil && err != io.EOF {
+	// ndjson doesn't need the full results before it can start writing
+	var nd *ndjsonWriter
+	if c.Output == "ndjson" {
+		nd = newNDJSONWriter(out, c)
+	}
+
+	// interrupted is set atomically once reading has been interrupted, done is
+	// closed once it has finished
+	var interrupted int32
+	done := make(chan struct{})
+
+	// collect the sequences of counter in the -sort order
+	collect := func(file string, counter *wordseq.Counter) error {
+		partial := atomic.LoadInt32(&interrupted) == 1
+
+		// ndjson in the counter's own order is written as it is ranked, only
+		// -sort lex and count-asc need the sequences first
+		if nd != nil && c.dash == nil && c.Sort == "count-desc" {
+			res := newResult(file, counter)
+			res.Partial = partial
+			nd.Start(res)
+
+			return counter.Top(c.TopN.limit(), func(seq *wordseq.Sequence) error {
+				if file == "" {
+					found = true
+				}
+				return nd.Write(seq)
+			})
+		}
+
+		res, err := rank(c, file, counter)
+		if err != nil {
 			return err
 		}
-		buf = buf[:n]
+		res.Partial = partial
 
-		// reset the reader so nothing is lost
-		reader = io.MultiReader(bytes.NewReader(buf), reader)
+		if file == "" && len(res.Seqs) > 0 {
+			found = true
+		}
 
-		var name string
-		var certain bool
-		enc, name, certain = charset.DetermineEncoding(buf, "")
-		if certain {
-			log.Printf("detected %s encoding", name)
-		} else {
-			log.Printf("could not determine encoding, presuming utf-8")
-			enc = encoding.Nop
+		if nd == nil || c.dash != nil {
+			results = append(results, res)
 		}
-	} else {
-		var err error
-		if enc, err = htmlindex.Get(c.Encoding); err != nil {
+
+		if nd == nil {
+			return nil
+		}
+
+		nd.Start(res)
+		for _, seq := range res.Seqs {
+			if err = nd.Write(seq); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// files are counted in parallel, but -ids must be written in order by a
+	// single writer
+	jobs := c.Jobs
+	if vocab != nil {
+		jobs = 1
+	}
+
+	// followed files never finish, so each needs its own worker
+	if c.Follow {
+		jobs = len(args)
+	}
+
+	// totals has a Counter for each sequence size
+	var totals []*wordseq.Counter
+
+	fc := fileCounter{c: c, opts: opts}
+	if c.Timing {
+		fc.timing = &timing{}
+	}
+	if c.Stats {
+		fc.langs = newLanguages()
+	}
+
+	tty := isTerminal(os.Stderr)
+	if size := inputSize(args); c.Progress || (tty && size > progressSize) {
+		fc.p = newProgress(os.Stderr, tty, size)
+		fc.p.Start(time.Second)
+	}
+
+	if following {
+		fc.follow = &follower{clear: isTerminal(os.Stdout)}
+		if fc.follow.counters, err = fc.newCounters(); err != nil {
+			return err
+		}
+	}
+
+	var ln net.Listener
+	if c.ListenRaw != "" {
+		if ln, err = listenRaw(c.ListenRaw); err != nil {
 			return err
 		}
+		defer ln.Close()
+
+		infof("counting the text sent to %s", c.ListenRaw)
+	}
+
+	if c.Stream != "" {
+		infof("counting the lines read from %s", c.Stream)
+	}
+
+	// an interrupt stops reading, but the results so far are still shown
+	stop := make(chan struct{})
+	fc.stop = stop
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	defer signal.Stop(sig)
+
+	go func() {
+		select {
+		case <-sig:
+			// a second interrupt exits immediately
+			signal.Stop(sig)
+
+			// interrupting is how following normally ends
+			if !following {
+				atomic.StoreInt32(&interrupted, 1)
+			}
+
+			close(stop)
+		case <-done:
+		}
+	}()
+
+	write := func() error {
+		if c.dash != nil {
+			if err := c.dash.update(c, results); err != nil {
+				return err
+			}
+		}
+
+		if nd != nil {
+			return nd.Flush()
+		}
+
+		if c.Highlight {
+			if len(totals) == 0 {
+				return nil
+			}
+			return writeHighlighted(out, c, args, results, totals[0])
+		}
+
+		return writeResults(out, c, results)
 	}
 
-	if enc != unicode.UTF8 {
-		reader = enc.NewDecoder().Reader(reader)
+	// shown is closed once following has stopped showing the results so far
+	shown := make(chan struct{})
+	if fc.follow == nil {
+		close(shown)
+	} else {
+		go func() {
+			defer close(shown)
+
+			t := time.NewTicker(c.Interval)
+			defer t.Stop()
+
+			for {
+				select {
+				case <-t.C:
+					err := fc.follow.show(out, c, func(counter *wordseq.Counter) error {
+						return collect("", counter)
+					}, write)
+					if err != nil {
+						log.Print(err)
+					}
+					results = nil
+
+					// checkpoint the counts so they aren't lost
+					if c.StateFile != "" {
+						if err = fc.follow.save(c.StateFile); err != nil {
+							log.Print(err)
+						}
+					}
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	count := func(file string, counters []*wordseq.Counter) error {
+		if c.PerFile {
+			if file == "-" {
+				file = "stdin"
+			}
+
+			for _, counter := range counters {
+				if err := collect(file, counter); err != nil {
+					return err
+				}
+			}
+		}
+
+		// the first file's counts are used as the starting point for the
+		// totals rather than copying them, followed files already share them
+		if totals == nil || following {
+			totals = counters
+			return nil
+		}
+
+		for i, counter := range counters {
+			totals[i].Merge(counter)
+		}
+		return nil
+	}
+
+	switch {
+	case ln != nil:
+		err = fc.follow.listen(&fc, ln, count)
+	case c.Stream != "":
+		err = fc.follow.stream(&fc, c.Stream, count)
+	default:
+		err = fc.countAll(args, jobs, count)
+	}
+
+	close(done)
+	<-shown
+
+	if fc.p != nil {
+		fc.p.Stop()
 	}
 
-	// read all the content
-	seqs, err := wordseq.Process(reader, c.SequenceSize, c.TopN)
 	if err != nil {
 		return err
 	}
 
-	// write out the results
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.AlignRight)
+	if atomic.LoadInt32(&interrupted) == 1 {
+		log.Print("interrupted, showing partial results")
+	}
+
+	for _, total := range totals {
+		if total.Approximate() {
+			log.Printf("-max-memory reached, counts of size %d are approximate and may be up to %d too low", total.SeqSize(), total.MaxError())
+		}
+	}
+
+	for _, total := range totals {
+		if err = collect("", total); err != nil {
+			return err
+		}
+	}
+
+	if c.StateFile != "" {
+		if err = saveState(c.StateFile, totals); err != nil {
+			return err
+		}
+	}
+
+	if vocab != nil {
+		if err = ids.Flush(); err != nil {
+			return err
+		}
+
+		if err = writeVocabulary(c.VocabFile, vocab); err != nil {
+			return err
+		}
+	}
+
+	if fc.follow != nil {
+		fc.follow.separate(out, c)
+	}
+
+	if err = write(); err != nil {
+		return err
+	}
+
+	if c.Stats {
+		// keep structured output parseable
+		if c.Output != "text" {
+			err = writeStats(os.Stderr, totals, fc.langs.dominant(), time.Since(start))
+		} else {
+			fmt.Fprintln(out)
+			err = writeStats(out, totals, fc.langs.dominant(), time.Since(start))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if fc.timing != nil {
+		if err = writeTiming(os.Stderr, fc.timing); err != nil {
+			return err
+		}
+	}
 
-	for _, seq := range seqs {
-		fmt.Fprintf(w, "%d\t %v\n", seq.Count, seq.Words)
+	if c.FailIfEmpty && !found {
+		return exitErrorf(exitEmpty, "no sequences found")
 	}
 
-	return w.Flush()
+	return nil
 }
@@ -0,0 +1,184 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"log"
+	"strings"
+	"testing"
+)
+
+func init() {
+	log.SetOutput(ioutil.Discard)
+}
+
+func TestIntsFlag(t *testing.T) {
+	var f intsFlag
+	if err := f.Set("10, 100,1000"); err != nil {
+		t.Fatal(err)
+	}
+
+	if f.String() != "10,100,1000" {
+		t.Errorf("%s != 10,100,1000", f.String())
+	}
+
+	if f.max() != 1000 || f.limit() != 1000 {
+		t.Errorf("max(%d) and limit(%d) != 1000", f.max(), f.limit())
+	}
+
+	if f = (intsFlag{10, 0}); f.limit() < 1<<31-1 {
+		t.Errorf("limit(%d) should be unlimited", f.limit())
+	}
+
+	if err := f.Set("10,x"); err == nil {
+		t.Error("expected error for invalid value")
+	}
+
+	// a single value replaces the list
+	if err := (intFlag{&f}).Set("20"); err != nil || f.String() != "20" {
+		t.Errorf("%s, %v != 20", f.String(), err)
+	}
+
+	if err := (intFlag{&f}).Set("10,100"); err == nil {
+		t.Error("expected error for a list")
+	}
+}
+
+func TestSizesFlag(t *testing.T) {
+	for _, v := range []struct {
+		value  string
+		expect string
+	}{
+		{"3", "3"},
+		{"2-5", "2,3,4,5"},
+		{"4, 1-2,2", "1,2,4"},
+	} {
+		var f sizesFlag
+		if err := f.Set(v.value); err != nil {
+			t.Fatal(err)
+		}
+
+		if f.String() != v.expect {
+			t.Errorf("%s: %s != %s", v.value, f.String(), v.expect)
+		}
+	}
+
+	var f sizesFlag
+	for _, value := range []string{"x", "5-2", "1-x"} {
+		if err := f.Set(value); err == nil {
+			t.Errorf("%s: expected error", value)
+		}
+	}
+}
+
+func TestBytesFlag(t *testing.T) {
+	for _, v := range []struct {
+		value  string
+		expect int64
+	}{
+		{"0", 0},
+		{"1000", 1000},
+		{"2k", 2 << 10},
+		{"1.5M", 3 << 19},
+		{"2GiB", 2 << 30},
+		{"1tb", 1 << 40},
+	} {
+		var f bytesFlag
+		if err := f.Set(v.value); err != nil {
+			t.Fatal(err)
+		}
+
+		if int64(f) != v.expect {
+			t.Errorf("%s: %d != %d", v.value, f, v.expect)
+		}
+	}
+
+	for _, v := range []struct {
+		value  bytesFlag
+		expect string
+	}{
+		{0, "0"},
+		{1000, "1000"},
+		{2 << 10, "2k"},
+		{3 << 19, "1536k"},
+		{32 << 20, "32m"},
+		{1 << 40, "1t"},
+	} {
+		if got := v.value.String(); got != v.expect {
+			t.Errorf("%d: %s != %s", v.value, got, v.expect)
+		}
+	}
+
+	var f bytesFlag
+	for _, value := range []string{"x", "-1", "1x", "k", "1e30"} {
+		if err := f.Set(value); err == nil {
+			t.Errorf("%s: expected error", value)
+		}
+	}
+}
+
+func TestMaxSequences(t *testing.T) {
+	for _, v := range []struct {
+		max    bytesFlag
+		jobs   int
+		sizes  sizesFlag
+		expect int
+	}{
+		{1 << 20, 1, sizesFlag{3}, 1 << 20 / 2 / 232},
+		{1 << 20, 0, sizesFlag{3}, 1 << 20 / 2 / 232},
+		{1 << 20, 3, sizesFlag{1, 3}, 1 << 20 / 8 / 232},
+		{1 << 10, 1, sizesFlag{3}, 2},
+	} {
+		c := config{MaxMemory: v.max, Jobs: v.jobs, SequenceSize: v.sizes}
+		if got := maxSequences(c); got != v.expect {
+			t.Errorf("%d, %d, %v: %d != %d", v.max, v.jobs, v.sizes, got, v.expect)
+		}
+	}
+
+	if _, err := counterOptions(config{MaxMemory: 100, SequenceSize: sizesFlag{3}}); err == nil {
+		t.Error("expected error for too little memory")
+	}
+}
+
+func TestNumeric(t *testing.T) {
+	for _, v := range []struct {
+		words  []string
+		expect bool
+	}{
+		{[]string{"1", "2024", "3"}, true},
+		{[]string{"1,000.50", "42"}, true},
+		{[]string{"1", "two", "3"}, false},
+		{[]string{"1a"}, false},
+		{[]string{"..."}, false},
+	} {
+		if got := numeric(v.words); got != v.expect {
+			t.Errorf("%v: %v != %v", v.words, got, v.expect)
+		}
+	}
+}
+
+func TestPrepareNormalize(t *testing.T) {
+	// full width latin, then e followed by a combining acute accent
+	const text = "\uff41\uff42\uff43 cafe\u0301"
+
+	for _, v := range []struct {
+		form   string
+		expect string
+	}{
+		{"", text},
+		{"nfc", "\uff41\uff42\uff43 caf\u00e9"},
+		{"NFKC", "abc caf\u00e9"},
+		{"nfkd", "abc cafe\u0301"},
+	} {
+		data, err := ioutil.ReadAll(prepare("", strings.NewReader(text), config{Normalize: v.form}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != v.expect {
+			t.Errorf("%s: %q != %q", v.form, string(data), v.expect)
+		}
+	}
+}
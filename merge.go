@@ -0,0 +1,253 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+const mergeUsage = `Usage of %[1]s merge:
+
+	%[1]s merge [flags] counts1.nr counts2.nr ...
+
+	Combines the counts saved by '%[1]s count -save-state', or by
+	'%[1]s count -output ndjson -n 0', into a single ranked result, as if
+	all of their content had been counted at once, so that counting can
+	be spread across machines. Each file may be compressed or fetched
+	from a url. Counts of different sequence sizes are shown separately.
+
+flags:
+`
+
+// mergeFlags adds the flags of the merge command
+func mergeFlags(fs *flag.FlagSet, c *config) {
+	filterFlags(fs, c)
+	outputFlags(fs, c)
+
+	// saved counts are merged whole, there is no -sample
+	c.Sample = 1
+}
+
+// saveState writes every counter to fn, replacing it only once they have all
+// been written
+func saveState(fn string, counters []*wordseq.Counter) error {
+	f, err := createAtomic(fn)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	for _, counter := range counters {
+		if _, err = counter.WriteTo(w); err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		if err = w.Flush(); err == nil {
+			err = f.Commit()
+		}
+	}
+
+	if err != nil {
+		f.Abort()
+	}
+
+	return err
+}
+
+// readState returns the counters saved in fn, by -save-state or as ndjson
+// results
+func readState(fn string, opts []wordseq.Option) ([]*wordseq.Counter, error) {
+	in, err := openInput(fn, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	br := bufio.NewReader(in)
+	if !wordseq.IsState(br) {
+		return readResults(fn, br, opts)
+	}
+
+	return readCounters(fn, br, opts)
+}
+
+// readResults returns a counter for each sequence size of the results written
+// to r, which was read from fn, by count -output ndjson
+func readResults(fn string, r io.Reader, opts []wordseq.Option) ([]*wordseq.Counter, error) {
+	dec := json.NewDecoder(r)
+
+	var counters []*wordseq.Counter
+	sizes := map[int]*wordseq.Counter{}
+	for {
+		var rec record
+		err := dec.Decode(&rec)
+		if err == io.EOF {
+			return counters, nil
+		}
+
+		if err == nil && len(rec.Words) == 0 {
+			err = fmt.Errorf("not saved counts")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+
+		// the counts of each file are also included in the totals
+		if rec.File != "" {
+			continue
+		}
+
+		counter, ok := sizes[len(rec.Words)]
+		if !ok {
+			if counter, err = wordseq.NewCounter(len(rec.Words), opts...); err != nil {
+				return nil, err
+			}
+			sizes[len(rec.Words)] = counter
+			counters = append(counters, counter)
+		}
+
+		if err = counter.AddSequence(rec.Words, rec.Count, rec.Context); err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+	}
+}
+
+// readCounters returns the counters saved in r, which was read from fn
+func readCounters(fn string, r *bufio.Reader, opts []wordseq.Option) ([]*wordseq.Counter, error) {
+	var counters []*wordseq.Counter
+	for {
+		counter, err := wordseq.ReadCounter(r, opts...)
+		if err == io.EOF {
+			return counters, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fn, err)
+		}
+
+		counters = append(counters, counter)
+	}
+}
+
+// stateSizes returns the counters saved in r, which was read from fn, for
+// each of sizes
+func stateSizes(fn string, r *bufio.Reader, sizes []int, opts []wordseq.Option) ([]*wordseq.Counter, error) {
+	counters, err := readCounters(fn, r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*wordseq.Counter, len(sizes))
+	for i, size := range sizes {
+		for _, counter := range counters {
+			if counter.SeqSize() == size {
+				ret[i] = counter
+			}
+		}
+
+		if ret[i] == nil {
+			return nil, fmt.Errorf("%s: no saved counts of sequence size %d", fn, size)
+		}
+	}
+
+	return ret, nil
+}
+
+// runMerge runs the merge command
+func runMerge(c config, args []string) (err error) {
+	start := time.Now()
+
+	if len(args) == 0 {
+		return usageErrorf("merge requires saved counts")
+	}
+
+	if err = checkOutput(&c); err != nil {
+		return err
+	}
+
+	opts, err := counterOptions(c)
+	if err != nil {
+		return err
+	}
+
+	var results []result
+
+	// totals has a Counter for each sequence size
+	totals := map[int]*wordseq.Counter{}
+
+	for _, fn := range stdinOnce(args) {
+		counters, err := readState(fn, opts)
+		if err != nil {
+			return withExit(exitInput, err)
+		}
+
+		for _, counter := range counters {
+			if c.PerFile {
+				res, err := rank(c, fn, counter)
+				if err != nil {
+					return err
+				}
+				results = append(results, res)
+			}
+
+			// the first file's counts are used as the starting point for the
+			// totals rather than copying them
+			total, ok := totals[counter.SeqSize()]
+			if !ok {
+				totals[counter.SeqSize()] = counter
+				continue
+			}
+
+			total.Merge(counter)
+		}
+	}
+
+	// the output is laid out by the sizes that were read
+	c.SequenceSize = nil
+	for size := range totals {
+		c.SequenceSize = append(c.SequenceSize, size)
+	}
+	sort.Ints(c.SequenceSize)
+
+	counters := make([]*wordseq.Counter, len(c.SequenceSize))
+	for i, size := range c.SequenceSize {
+		counters[i] = totals[size]
+
+		res, err := rank(c, "", counters[i])
+		if err != nil {
+			return err
+		}
+		results = append(results, res)
+	}
+
+	out, finish, err := createOutput(c)
+	if err != nil {
+		return err
+	}
+	defer func() { err = finish(err) }()
+
+	if err = writeResults(out, c, results); err != nil || !c.Stats {
+		return err
+	}
+
+	// keep structured output parseable
+	if c.Output != "text" {
+		return writeStats(os.Stderr, counters, nil, time.Since(start))
+	}
+
+	fmt.Fprintln(out)
+	return writeStats(out, counters, nil, time.Since(start))
+}
@@ -0,0 +1,106 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestMerge(t *testing.T) {
+	dir := t.TempDir()
+
+	var files []string
+	for i, text := range []string{"a b c a b c", "a b c d e f"} {
+		counters := make([]*wordseq.Counter, 2)
+		for j, size := range []int{1, 3} {
+			var err error
+			if counters[j], err = wordseq.NewCounter(size); err != nil {
+				t.Fatal(err)
+			}
+
+			if err = counters[j].Add(strings.NewReader(text)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		fn := filepath.Join(dir, string(rune('a'+i))+".nr")
+		if i == 0 {
+			if err := saveState(fn, counters); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			// save every sequence as count -output ndjson -n 0 does
+			saved := config{TopN: intsFlag{0}, Output: "ndjson", SequenceSize: sizesFlag{1, 3}}
+			var results []result
+			for _, counter := range counters {
+				res, err := rank(saved, "", counter)
+				if err != nil {
+					t.Fatal(err)
+				}
+				results = append(results, res)
+			}
+
+			var buf bytes.Buffer
+			if err := writeResults(&buf, saved, results); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ioutil.WriteFile(fn, buf.Bytes(), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		read, err := readState(fn, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(read) != 2 || read[0].SeqSize() != 1 || read[1].Total() != counters[1].Total() {
+			t.Errorf("%s: counts weren't read back", fn)
+		}
+
+		files = append(files, fn)
+	}
+
+	out := filepath.Join(dir, "out.csv")
+	c := config{
+		TopN:       intsFlag{2},
+		Output:     "csv",
+		Sort:       "count-desc",
+		Color:      "never",
+		Overflow:   "truncate",
+		OutputFile: out,
+		Sample:     1,
+	}
+
+	if err := runMerge(c, files); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expect = "size,count,word1,word2,word3\n" +
+		"1,3,a,,\n1,3,b,,\n" +
+		"3,3,a,b,c\n3,1,b,c,a\n"
+	if string(b) != expect {
+		t.Errorf("%q != %q", b, expect)
+	}
+
+	if err = ioutil.WriteFile(files[0], []byte("a b c"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = runMerge(c, files); err == nil {
+		t.Error("expected error for a file that isn't saved counts")
+	}
+}
@@ -0,0 +1,8 @@
+// Package nrpb is the protobuf and grpc code of the api served by nr serve
+// -grpc, generated from nr.proto.
+package nrpb
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative nr.proto
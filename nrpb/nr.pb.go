@@ -0,0 +1,249 @@
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: nr.proto
+
+package nrpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Chunk is a part of the text to count. The text doesn't need to break at
+// words, or even characters.
+type Chunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  []byte                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// charset is the encoding of the text, only read from the first chunk. If
+	// it isn't set the encoding is detected.
+	Charset       string `protobuf:"bytes,2,opt,name=charset,proto3" json:"charset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chunk) Reset() {
+	*x = Chunk{}
+	mi := &file_nr_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chunk) ProtoMessage() {}
+
+func (x *Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_nr_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return file_nr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Chunk) GetText() []byte {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *Chunk) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+// Sequence is one of the ranked sequences of words, in the order of the -sort
+// flag of nr serve. There are at most as many of each size as the largest -n.
+type Sequence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Words []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Count int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// size is the number of words of the sequence, set when there is more than
+	// one -sequence-size
+	Size int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// context is set by -context and contexts by -show-context
+	Context  string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	Contexts []string `protobuf:"bytes,5,rep,name=contexts,proto3" json:"contexts,omitempty"`
+	// approximate is set when -max-memory was reached and the least frequent
+	// sequences were discarded, so the counts may be too low
+	Approximate   bool `protobuf:"varint,6,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sequence) Reset() {
+	*x = Sequence{}
+	mi := &file_nr_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sequence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sequence) ProtoMessage() {}
+
+func (x *Sequence) ProtoReflect() protoreflect.Message {
+	mi := &file_nr_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sequence.ProtoReflect.Descriptor instead.
+func (*Sequence) Descriptor() ([]byte, []int) {
+	return file_nr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Sequence) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Sequence) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Sequence) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Sequence) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *Sequence) GetContexts() []string {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+func (x *Sequence) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+var File_nr_proto protoreflect.FileDescriptor
+
+const file_nr_proto_rawDesc = "" +
+	"\n" +
+	"\bnr.proto\x12\x02nr\x1a\x1bgoogle/protobuf/empty.proto\"5\n" +
+	"\x05Chunk\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\fR\x04text\x12\x18\n" +
+	"\acharset\x18\x02 \x01(\tR\acharset\"\xa2\x01\n" +
+	"\bSequence\x12\x14\n" +
+	"\x05words\x18\x01 \x03(\tR\x05words\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x18\n" +
+	"\acontext\x18\x04 \x01(\tR\acontext\x12\x1a\n" +
+	"\bcontexts\x18\x05 \x03(\tR\bcontexts\x12 \n" +
+	"\vapproximate\x18\x06 \x01(\bR\vapproximate2\x8e\x01\n" +
+	"\aCounter\x12$\n" +
+	"\x05Count\x12\t.nr.Chunk\x1a\f.nr.Sequence(\x010\x01\x12*\n" +
+	"\x03Add\x12\t.nr.Chunk\x1a\x16.google.protobuf.Empty(\x01\x121\n" +
+	"\aResults\x12\x16.google.protobuf.Empty\x1a\f.nr.Sequence0\x01B\x13Z\x11jrubin.io/nr/nrpbb\x06proto3"
+
+var (
+	file_nr_proto_rawDescOnce sync.Once
+	file_nr_proto_rawDescData []byte
+)
+
+func file_nr_proto_rawDescGZIP() []byte {
+	file_nr_proto_rawDescOnce.Do(func() {
+		file_nr_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nr_proto_rawDesc), len(file_nr_proto_rawDesc)))
+	})
+	return file_nr_proto_rawDescData
+}
+
+var file_nr_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nr_proto_goTypes = []any{
+	(*Chunk)(nil),         // 0: nr.Chunk
+	(*Sequence)(nil),      // 1: nr.Sequence
+	(*emptypb.Empty)(nil), // 2: google.protobuf.Empty
+}
+var file_nr_proto_depIdxs = []int32{
+	0, // 0: nr.Counter.Count:input_type -> nr.Chunk
+	0, // 1: nr.Counter.Add:input_type -> nr.Chunk
+	2, // 2: nr.Counter.Results:input_type -> google.protobuf.Empty
+	1, // 3: nr.Counter.Count:output_type -> nr.Sequence
+	2, // 4: nr.Counter.Add:output_type -> google.protobuf.Empty
+	1, // 5: nr.Counter.Results:output_type -> nr.Sequence
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_nr_proto_init() }
+func file_nr_proto_init() {
+	if File_nr_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nr_proto_rawDesc), len(file_nr_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nr_proto_goTypes,
+		DependencyIndexes: file_nr_proto_depIdxs,
+		MessageInfos:      file_nr_proto_msgTypes,
+	}.Build()
+	File_nr_proto = out.File
+	file_nr_proto_goTypes = nil
+	file_nr_proto_depIdxs = nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: nr.proto
+
+package nrpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Counter_Count_FullMethodName   = "/nr.Counter/Count"
+	Counter_Add_FullMethodName     = "/nr.Counter/Add"
+	Counter_Results_FullMethodName = "/nr.Counter/Results"
+)
+
+// CounterClient is the client API for Counter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Counter counts the sequences of words in the text streamed to it. It is
+// served by nr serve -grpc.
+type CounterClient interface {
+	// Count counts the text of the chunks on its own and, once the client has
+	// sent all of them, returns its most frequent sequences, as a POST to /count
+	// does.
+	Count(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Chunk, Sequence], error)
+	// Add adds the text of the chunks to the running totals, as a POST to /add
+	// does.
+	Add(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Chunk, emptypb.Empty], error)
+	// Results returns the most frequent sequences of the running totals.
+	Results(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sequence], error)
+}
+
+type counterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCounterClient(cc grpc.ClientConnInterface) CounterClient {
+	return &counterClient{cc}
+}
+
+func (c *counterClient) Count(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Chunk, Sequence], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Counter_ServiceDesc.Streams[0], Counter_Count_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Chunk, Sequence]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Counter_CountClient = grpc.BidiStreamingClient[Chunk, Sequence]
+
+func (c *counterClient) Add(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Chunk, emptypb.Empty], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Counter_ServiceDesc.Streams[1], Counter_Add_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Chunk, emptypb.Empty]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Counter_AddClient = grpc.ClientStreamingClient[Chunk, emptypb.Empty]
+
+func (c *counterClient) Results(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sequence], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Counter_ServiceDesc.Streams[2], Counter_Results_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Sequence]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Counter_ResultsClient = grpc.ServerStreamingClient[Sequence]
+
+// CounterServer is the server API for Counter service.
+// All implementations must embed UnimplementedCounterServer
+// for forward compatibility.
+//
+// Counter counts the sequences of words in the text streamed to it. It is
+// served by nr serve -grpc.
+type CounterServer interface {
+	// Count counts the text of the chunks on its own and, once the client has
+	// sent all of them, returns its most frequent sequences, as a POST to /count
+	// does.
+	Count(grpc.BidiStreamingServer[Chunk, Sequence]) error
+	// Add adds the text of the chunks to the running totals, as a POST to /add
+	// does.
+	Add(grpc.ClientStreamingServer[Chunk, emptypb.Empty]) error
+	// Results returns the most frequent sequences of the running totals.
+	Results(*emptypb.Empty, grpc.ServerStreamingServer[Sequence]) error
+	mustEmbedUnimplementedCounterServer()
+}
+
+// UnimplementedCounterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCounterServer struct{}
+
+func (UnimplementedCounterServer) Count(grpc.BidiStreamingServer[Chunk, Sequence]) error {
+	return status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedCounterServer) Add(grpc.ClientStreamingServer[Chunk, emptypb.Empty]) error {
+	return status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedCounterServer) Results(*emptypb.Empty, grpc.ServerStreamingServer[Sequence]) error {
+	return status.Errorf(codes.Unimplemented, "method Results not implemented")
+}
+func (UnimplementedCounterServer) mustEmbedUnimplementedCounterServer() {}
+func (UnimplementedCounterServer) testEmbeddedByValue()                 {}
+
+// UnsafeCounterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CounterServer will
+// result in compilation errors.
+type UnsafeCounterServer interface {
+	mustEmbedUnimplementedCounterServer()
+}
+
+func RegisterCounterServer(s grpc.ServiceRegistrar, srv CounterServer) {
+	// If the following call pancis, it indicates UnimplementedCounterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Counter_ServiceDesc, srv)
+}
+
+func _Counter_Count_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CounterServer).Count(&grpc.GenericServerStream[Chunk, Sequence]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Counter_CountServer = grpc.BidiStreamingServer[Chunk, Sequence]
+
+func _Counter_Add_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CounterServer).Add(&grpc.GenericServerStream[Chunk, emptypb.Empty]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Counter_AddServer = grpc.ClientStreamingServer[Chunk, emptypb.Empty]
+
+func _Counter_Results_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CounterServer).Results(m, &grpc.GenericServerStream[emptypb.Empty, Sequence]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Counter_ResultsServer = grpc.ServerStreamingServer[Sequence]
+
+// Counter_ServiceDesc is the grpc.ServiceDesc for Counter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Counter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nr.Counter",
+	HandlerType: (*CounterServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Count",
+			Handler:       _Counter_Count_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Add",
+			Handler:       _Counter_Add_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Results",
+			Handler:       _Counter_Results_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nr.proto",
+}
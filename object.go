@@ -0,0 +1,481 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// isObject reports whether fn is the uri of an object in s3 or google cloud
+// storage
+func isObject(fn string) bool {
+	return strings.HasPrefix(fn, "s3://") || strings.HasPrefix(fn, "gs://")
+}
+
+// splitObject returns the bucket and key of an object uri
+func splitObject(uri string) (string, string, error) {
+	i := strings.Index(uri, "://")
+	bucket := uri[i+3:]
+
+	var key string
+	if j := strings.IndexByte(bucket, '/'); j >= 0 {
+		bucket, key = bucket[:j], bucket[j+1:]
+	}
+
+	if bucket == "" || key == "" {
+		return "", "", fmt.Errorf("%s: expected %s://bucket/key", uri, uri[:i])
+	}
+
+	return bucket, key, nil
+}
+
+// newRequest returns a GET request of fn, an http or https url or the uri of
+// an object, which is signed with the credentials of the environment
+func newRequest(ctx context.Context, fn string) (*http.Request, error) {
+	switch {
+	case strings.HasPrefix(fn, "s3://"):
+		return s3Request(ctx, fn, time.Now())
+	case strings.HasPrefix(fn, "gs://"):
+		return gcsRequest(ctx, fn)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fn, nil)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
+// escapePath escapes each segment of p as s3 expects, which is everything but
+// the unreserved characters
+func escapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			_ = b.WriteByte(c) // #nosec
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// awsCredentials are those of AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN or, without them, of the AWS_PROFILE (or default) profile
+// of ~/.aws/credentials. Requests are unsigned without any, which is enough
+// for public buckets.
+type awsCredentials struct {
+	key, secret, token string
+}
+
+func loadAWSCredentials() (awsCredentials, error) {
+	creds := awsCredentials{
+		key:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secret: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		token:  os.Getenv("AWS_SESSION_TOKEN"),
+	}
+
+	if creds.key != "" {
+		return creds, nil
+	}
+
+	fn := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if fn == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return creds, nil
+		}
+		fn = filepath.Join(home, ".aws", "credentials")
+	}
+
+	profile := os.Getenv("AWS_PROFILE")
+	if profile == "" {
+		profile = "default"
+	}
+
+	f, err := os.Open(fn)
+	if errors.Is(err, os.ErrNotExist) {
+		return creds, nil
+	}
+	if err != nil {
+		return creds, err
+	}
+	defer f.Close()
+
+	var section string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		i := strings.IndexByte(line, '=')
+		if section != profile || i < 0 {
+			continue
+		}
+
+		value := strings.TrimSpace(line[i+1:])
+		switch strings.TrimSpace(line[:i]) {
+		case "aws_access_key_id":
+			creds.key = value
+		case "aws_secret_access_key":
+			creds.secret = value
+		case "aws_session_token":
+			creds.token = value
+		}
+	}
+
+	if err = s.Err(); err != nil {
+		return creds, fmt.Errorf("%s: %v", fn, err)
+	}
+
+	return creds, nil
+}
+
+// emptySHA256 is the hash of the empty payload of a GET
+const emptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// s3Request returns a GET request of the object at uri, signed at now. The
+// region is that of AWS_REGION or AWS_DEFAULT_REGION, and AWS_ENDPOINT_URL_S3
+// or AWS_ENDPOINT_URL replace the endpoint of the region, e.g. to use another
+// service that implements the s3 api.
+func s3Request(ctx context.Context, uri string, now time.Time) (*http.Request, error) {
+	bucket, key, err := splitObject(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	endpoint := os.Getenv("AWS_ENDPOINT_URL_S3")
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_ENDPOINT_URL")
+	}
+
+	// buckets are addressed by host at aws and by path elsewhere
+	var u string
+	if endpoint != "" {
+		u = strings.TrimRight(endpoint, "/") + "/" + escapePath(bucket+"/"+key)
+	} else {
+		u = fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", bucket, region, escapePath(key))
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", uri, err)
+	}
+	req = req.WithContext(ctx)
+
+	creds, err := loadAWSCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	if creds.key != "" {
+		signS3(req, creds, region, now)
+	}
+
+	return req, nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data)) // #nosec
+	return h.Sum(nil)
+}
+
+// signS3 signs req, which has no body, with the AWS signature version 4 of
+// its headers
+func signS3(req *http.Request, creds awsCredentials, region string, now time.Time) {
+	now = now.UTC()
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	req.Header.Set("X-Amz-Content-Sha256", emptySHA256)
+	if creds.token != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.token)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	fmt.Fprintf(&canonical, "%s\n%s\n%s\n", req.Method, escapePath(req.URL.Path), req.URL.Query().Encode())
+	for _, k := range names {
+		fmt.Fprintf(&canonical, "%s:%s\n", k, headers[k])
+	}
+	signed := strings.Join(names, ";")
+	fmt.Fprintf(&canonical, "\n%s\n%s", signed, emptySHA256)
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	sum := sha256.Sum256([]byte(canonical.String()))
+	toSign := "AWS4-HMAC-SHA256\n" + now.Format("20060102T150405Z") + "\n" + scope + "\n" + hex.EncodeToString(sum[:])
+
+	k := hmacSHA256([]byte("AWS4"+creds.secret), date)
+	k = hmacSHA256(k, region)
+	k = hmacSHA256(k, "s3")
+	k = hmacSHA256(k, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.key, scope, signed, hex.EncodeToString(hmacSHA256(k, toSign)),
+	))
+}
+
+// gcsScope is the oauth scope requested for reading objects
+const gcsScope = "https://www.googleapis.com/auth/devstorage.read_only"
+
+// gcsRequest returns a GET request of the object at uri, authorized by the
+// token of gcsToken unless there is none, which is enough for public buckets.
+// STORAGE_EMULATOR_HOST replaces the endpoint, as with the gcloud clients.
+func gcsRequest(ctx context.Context, uri string) (*http.Request, error) {
+	bucket, key, err := splitObject(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "https://storage.googleapis.com"
+	if host := os.Getenv("STORAGE_EMULATOR_HOST"); host != "" {
+		endpoint = host
+		if !isURL(endpoint) {
+			endpoint = "http://" + endpoint
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(endpoint, "/")+"/"+escapePath(bucket+"/"+key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", uri, err)
+	}
+	req = req.WithContext(ctx)
+
+	token, err := gcsToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return req, nil
+}
+
+// gcsTokenSlack is how long before it expires that a token is refreshed, so
+// that it doesn't expire while in use
+const gcsTokenSlack = time.Minute
+
+// gcsAuth is the token of the credentials file fn, which is used until shortly
+// before it expires
+var gcsAuth struct {
+	sync.Mutex
+	fn      string
+	token   string
+	expires time.Time
+}
+
+// gcsToken returns the access token of CLOUDSDK_AUTH_ACCESS_TOKEN or
+// GOOGLE_OAUTH_ACCESS_TOKEN or, without one, that of the application default
+// credentials, the service account or user credentials of
+// GOOGLE_APPLICATION_CREDENTIALS or those saved by
+// 'gcloud auth application-default login'
+func gcsToken() (string, error) {
+	for _, name := range []string{"CLOUDSDK_AUTH_ACCESS_TOKEN", "GOOGLE_OAUTH_ACCESS_TOKEN"} {
+		if token := os.Getenv(name); token != "" {
+			return token, nil
+		}
+	}
+
+	fn := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	if fn == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return "", nil
+		}
+
+		fn = filepath.Join(dir, "gcloud", "application_default_credentials.json")
+		if _, err = os.Stat(fn); err != nil {
+			return "", nil
+		}
+	}
+
+	gcsAuth.Lock()
+	defer gcsAuth.Unlock()
+
+	now := time.Now()
+	if gcsAuth.fn == fn && now.Before(gcsAuth.expires.Add(-gcsTokenSlack)) {
+		return gcsAuth.token, nil
+	}
+
+	token, expires, err := fetchGCSToken(fn, now)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", fn, err)
+	}
+
+	gcsAuth.fn, gcsAuth.token, gcsAuth.expires = fn, token, expires
+
+	return token, nil
+}
+
+// googleCredentials are the fields of the application default credentials
+// used to get a token
+type googleCredentials struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+const googleTokenURI = "https://oauth2.googleapis.com/token"
+
+// gcsTokenLifetime is how long a token lasts when its expiry isn't given
+const gcsTokenLifetime = time.Hour
+
+// fetchGCSToken exchanges the credentials of fn for an access token, returning
+// when it expires
+func fetchGCSToken(fn string, now time.Time) (string, time.Time, error) {
+	data, err := ioutil.ReadFile(fn) // #nosec
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var creds googleCredentials
+	if err = json.Unmarshal(data, &creds); err != nil {
+		return "", time.Time{}, err
+	}
+
+	if creds.TokenURI == "" {
+		creds.TokenURI = googleTokenURI
+	}
+
+	form := url.Values{}
+
+	switch creds.Type {
+	case "service_account":
+		assertion, err := signJWT(creds, now)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+		form.Set("assertion", assertion)
+	case "authorized_user":
+		form.Set("grant_type", "refresh_token")
+		form.Set("client_id", creds.ClientID)
+		form.Set("client_secret", creds.ClientSecret)
+		form.Set("refresh_token", creds.RefreshToken)
+	default:
+		return "", time.Time{}, fmt.Errorf("unsupported credentials type: %q", creds.Type)
+	}
+
+	resp, err := http.PostForm(creds.TokenURI, form)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("%s: %s", creds.TokenURI, resp.Status)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", time.Time{}, fmt.Errorf("%s: %v", creds.TokenURI, err)
+	}
+
+	lifetime := gcsTokenLifetime
+	if token.ExpiresIn > 0 {
+		lifetime = time.Duration(token.ExpiresIn) * time.Second
+	}
+
+	return token.AccessToken, now.Add(lifetime), nil
+}
+
+// signJWT returns the assertion of a service account that requests gcsScope
+func signJWT(creds googleCredentials, now time.Time) (string, error) {
+	block, _ := pem.Decode([]byte(creds.PrivateKey))
+	if block == nil {
+		return "", fmt.Errorf("invalid private key")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		if parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return "", err
+		}
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("private key is not rsa")
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   creds.ClientEmail,
+		"scope": gcsScope,
+		"aud":   creds.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	jwt := enc.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." + enc.EncodeToString(claims)
+
+	sum := sha256.Sum256([]byte(jwt))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+
+	return jwt + "." + enc.EncodeToString(sig), nil
+}
@@ -0,0 +1,823 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"jrubin.io/nr/stopwords"
+	"jrubin.io/nr/wordseq"
+)
+
+func validOutput(format string) bool {
+	switch format {
+	case "text", "json", "ndjson", "csv", "tsv", "svg-cloud":
+		return true
+	}
+	return false
+}
+
+// a result is the ranked sequences of a single size for a single file or, when
+// File is empty, all of the input
+type result struct {
+	File string
+	Size int
+	Seqs []*wordseq.Sequence
+
+	// Partial is set when reading was interrupted before all of the input
+	// was counted
+	Partial bool
+
+	// Approximate is set when -max-memory was reached and the least frequent
+	// sequences were discarded, so the counts may be too low
+	Approximate bool
+}
+
+// record is the representation of a sequence in structured output formats
+type record struct {
+	Partial     bool     `json:"partial,omitempty"`
+	Approximate bool     `json:"approximate,omitempty"`
+	File        string   `json:"file,omitempty"`
+	Size        int      `json:"size,omitempty"`
+	N           int      `json:"n,omitempty"`
+	Count       int      `json:"count"`
+	PerMillion  *float64 `json:"per_million,omitempty"`
+	Percent     *float64 `json:"percent,omitempty"`
+	Words       []string `json:"words"`
+	Context     string   `json:"context,omitempty"`
+	Contexts    []string `json:"contexts,omitempty"`
+
+	// with -baseline, the sequence's rank and count in the baseline and how
+	// they have changed, where a positive RankChange has moved up, or New if
+	// it isn't in the baseline
+	Previous    *previous `json:"previous,omitempty"`
+	RankChange  *int      `json:"rank_change,omitempty"`
+	CountChange *int      `json:"count_change,omitempty"`
+	New         bool      `json:"new,omitempty"`
+}
+
+// newRecord returns the record of seq, the rank-th sequence of res
+func newRecord(c config, res result, seq *wordseq.Sequence, rank int) record {
+	r := record{
+		Partial:     res.Partial,
+		Approximate: res.Approximate,
+		File:        res.File,
+		Count:       seq.Count,
+		Words:       seq.Words,
+		Contexts:    seq.Contexts,
+	}
+
+	// -show-context captures context without -context
+	if c.Context > 0 {
+		r.Context = seq.Context
+	}
+
+	// the sequences of each size are distinguished by it
+	if len(c.SequenceSize) > 1 {
+		r.Size = res.Size
+	}
+
+	if c.PerMillion {
+		pm := seq.PerMillion()
+		r.PerMillion = &pm
+	}
+
+	if c.Percent {
+		pct := seq.Percent()
+		r.Percent = &pct
+	}
+
+	if c.baseline != nil {
+		if prev, ok := c.baseline.lookup(res.File, seq.Words); ok {
+			rankChange := prev.Rank - rank
+			countChange := seq.Count - prev.Count
+			r.Previous = &prev
+			r.RankChange = &rankChange
+			r.CountChange = &countChange
+		} else {
+			r.New = true
+		}
+	}
+
+	return r
+}
+
+// checkOutput validates the flags that control how the results are shown
+func checkOutput(c *config) error {
+	if !validOutput(c.Output) {
+		return usageErrorf("invalid output format: %s", c.Output)
+	}
+
+	if c.Format != "" {
+		if _, err := newTemplate(c.Format); err != nil {
+			return err
+		}
+
+		c.Output = "text"
+	}
+
+	for _, n := range c.TopN {
+		if n < 0 {
+			return usageErrorf("invalid -n value: %d", n)
+		}
+	}
+
+	if c.MaxWidth < 0 {
+		return usageErrorf("invalid -max-width value: %d", c.MaxWidth)
+	}
+
+	switch c.Overflow {
+	case "truncate", "wrap":
+	default:
+		return usageErrorf("invalid -overflow value: %s", c.Overflow)
+	}
+
+	var err error
+	if c.Baseline != "" {
+		if c.baseline, err = readBaseline(c.Baseline); err != nil {
+			return err
+		}
+	}
+
+	if c.columns, err = parseColumns(*c); err != nil {
+		return err
+	}
+
+	if c.color, err = useColor(*c); err != nil {
+		return err
+	}
+
+	if c.color {
+		if c.highlight, err = newHighlighter(*c); err != nil {
+			return err
+		}
+	}
+
+	switch c.Sort {
+	case "count-desc", "count-asc":
+	case "lex":
+		// sections are prefixes of the sorted sequences
+		if len(c.TopN) > 1 {
+			return usageErrorf("-sort lex can't be used with multiple -n values")
+		}
+	default:
+		return usageErrorf("invalid sort order: %s", c.Sort)
+	}
+
+	return nil
+}
+
+// newResult returns the result of counter, without any of its sequences
+func newResult(file string, counter *wordseq.Counter) result {
+	return result{
+		File:        file,
+		Size:        counter.SeqSize(),
+		Approximate: counter.Approximate(),
+	}
+}
+
+// rank returns the sequences of counter in the -sort order, keeping enough for
+// the largest cutoff
+func rank(c config, file string, counter *wordseq.Counter) (result, error) {
+	res := newResult(file, counter)
+	add := func(seq *wordseq.Sequence) error {
+		res.Seqs = append(res.Seqs, seq)
+		return nil
+	}
+
+	var err error
+	if c.Sort == "count-asc" {
+		err = counter.Bottom(c.TopN.limit(), add)
+	} else {
+		err = counter.Top(c.TopN.limit(), add)
+	}
+	if err != nil {
+		return result{}, err
+	}
+
+	if c.Sort == "lex" {
+		sort.SliceStable(res.Seqs, func(i, j int) bool {
+			return counter.Less(res.Seqs[i].Words, res.Seqs[j].Words)
+		})
+	}
+
+	return res, nil
+}
+
+// section is the set of sequences for a single -n cutoff
+type section struct {
+	File      string   `json:"file,omitempty"`
+	Size      int      `json:"size,omitempty"`
+	N         int      `json:"n"`
+	Sequences []record `json:"sequences"`
+}
+
+// sections splits the ranked seqs into the prefixes for each cutoff, where 0
+// includes all of them
+func sections(seqs []*wordseq.Sequence, cutoffs []int) [][]*wordseq.Sequence {
+	ret := make([][]*wordseq.Sequence, len(cutoffs))
+	for i, n := range cutoffs {
+		if n == 0 || n > len(seqs) {
+			n = len(seqs)
+		}
+		ret[i] = seqs[:n]
+	}
+	return ret
+}
+
+func writeResults(out io.Writer, c config, results []result) error {
+	switch c.Output {
+	case "json":
+		return writeJSON(out, c, results)
+	case "ndjson":
+		w := newNDJSONWriter(out, c)
+		for _, res := range results {
+			w.Start(res)
+			for _, seq := range res.Seqs {
+				if err := w.Write(seq); err != nil {
+					return err
+				}
+			}
+		}
+		return w.Flush()
+	case "csv":
+		return writeCSV(out, ',', c, results)
+	case "tsv":
+		return writeCSV(out, '\t', c, results)
+	case "svg-cloud":
+		return writeCloud(out, c, results)
+	}
+
+	var tmpl *template.Template
+	if c.Format != "" {
+		var err error
+		if tmpl, err = newTemplate(c.Format); err != nil {
+			return err
+		}
+	}
+
+	var n int
+	for _, res := range results {
+		for i, section := range sections(res.Seqs, c.TopN) {
+			var header []string
+
+			if res.Partial {
+				header = append(header, "partial")
+			}
+
+			if res.Approximate {
+				header = append(header, "approximate")
+			}
+
+			if c.PerFile {
+				file := res.File
+				if file == "" {
+					file = "total"
+				}
+				header = append(header, file)
+			}
+
+			if len(c.SequenceSize) > 1 {
+				header = append(header, fmt.Sprintf("size %d", res.Size))
+			}
+
+			if len(c.TopN) > 1 {
+				if c.TopN[i] == 0 {
+					header = append(header, "all")
+				} else {
+					header = append(header, fmt.Sprintf("top %d", c.TopN[i]))
+				}
+			}
+
+			if len(header) > 0 {
+				if n > 0 {
+					fmt.Fprintln(out)
+				}
+				fmt.Fprintf(out, "%s:\n", strings.Join(header, " "))
+			}
+			n++
+
+			var err error
+			if tmpl != nil {
+				err = writeTemplate(out, tmpl, res.File, section)
+			} else {
+				err = writeTable(out, c, res.File, section)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// templateData is what is available to -format templates for each sequence
+type templateData struct {
+	File       string
+	Rank       int
+	Count      int
+	Words      []string
+	Percent    float64
+	PerMillion float64
+	Context    string
+	Contexts   []string
+}
+
+func newTemplate(format string) (*template.Template, error) {
+	return template.New("format").
+		Funcs(template.FuncMap{"join": strings.Join}).
+		Parse(format)
+}
+
+// writeTemplate executes tmpl, followed by a newline, for each sequence
+func writeTemplate(out io.Writer, tmpl *template.Template, file string, seqs []*wordseq.Sequence) error {
+	w := bufio.NewWriter(out)
+
+	for i, seq := range seqs {
+		err := tmpl.Execute(w, templateData{
+			File:       file,
+			Rank:       i + 1,
+			Count:      seq.Count,
+			Words:      seq.Words,
+			Percent:    seq.Percent(),
+			PerMillion: seq.PerMillion(),
+			Context:    seq.Context,
+			Contexts:   seq.Contexts,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// barWidth is the width, in characters, of the longest -bars bar
+const barWidth = 20
+
+// barBlocks are the eighths of a character that end a bar
+var barBlocks = []rune(" ▏▎▍▌▋▊▉")
+
+// bar returns a bar, barWidth characters wide, that is filled in proportion to
+// count out of max
+func bar(count, max int) string {
+	eighths := barWidth * 8
+	if max > 0 {
+		eighths = eighths * count / max
+	}
+
+	b := strings.Repeat("█", eighths/8)
+	if eighths%8 > 0 {
+		b += string(barBlocks[eighths%8])
+	}
+
+	// bars are padded so that the columns after them still line up
+	return b + strings.Repeat(" ", barWidth-utf8.RuneCountInString(b))
+}
+
+// excerptIndent precedes each line of the excerpts of -show-context
+const excerptIndent = "    "
+
+// writeTable writes seqs, the top sequences of file, aligned in the columns
+// chosen by -columns
+func writeTable(out io.Writer, c config, file string, seqs []*wordseq.Sequence) error {
+	if c.columns == nil {
+		var err error
+		if c.columns, err = parseColumns(c); err != nil {
+			return err
+		}
+	}
+
+	var max int
+	for _, seq := range seqs {
+		if seq.Count > max {
+			max = seq.Count
+		}
+	}
+
+	// each cell is a line for each line of its row, as long values may be
+	// wrapped by -max-width
+	rows := make([]row, len(seqs))
+	cells := make([][][]string, len(seqs))
+	widths := make([]int, len(c.columns))
+
+	for i, seq := range seqs {
+		rows[i] = row{rank: i + 1, seq: seq, max: max, hl: c.highlight}
+		if c.baseline != nil {
+			rows[i].prev, rows[i].ok = c.baseline.lookup(file, seq.Words)
+		}
+
+		cells[i] = make([][]string, len(c.columns))
+		for j, name := range c.columns {
+			cell := []string{columns[name].value(rows[i])}
+			if columns[name].long {
+				cell = fitWidth(cell[0], c.MaxWidth, c.Overflow)
+			}
+
+			for _, line := range cell {
+				if n := utf8.RuneCountInString(line); n > widths[j] {
+					widths[j] = n
+				}
+			}
+
+			cells[i][j] = cell
+		}
+	}
+
+	w := bufio.NewWriter(out)
+	last := len(c.columns) - 1
+
+	for i, row := range cells {
+		var lines int
+		for _, cell := range row {
+			if len(cell) > lines {
+				lines = len(cell)
+			}
+		}
+
+		for k := 0; k < lines; k++ {
+			var b strings.Builder
+
+			for j, cell := range row {
+				col := columns[c.columns[j]]
+
+				var value string
+				if k < len(cell) {
+					value = cell[k]
+				}
+				n := utf8.RuneCountInString(value)
+
+				// padding is added without the escape sequences of any
+				// color, which can only be added to values that weren't
+				// shortened
+				if c.color && col.color != nil && len(cell) == 1 && (!col.long || c.MaxWidth == 0 || n <= c.MaxWidth) {
+					value = col.color(rows[i], value)
+				}
+
+				// columns are separated by a space, and aligned to the
+				// right except for the last which isn't aligned at all
+				var left, right int
+				switch {
+				case j == last:
+				case col.left:
+					left, right = 1, widths[j]-n
+				default:
+					left = widths[j] - n + 1
+				}
+
+				if j > 0 {
+					left++
+				}
+
+				b.WriteString(strings.Repeat(" ", left))
+				b.WriteString(value)
+				b.WriteString(strings.Repeat(" ", right))
+			}
+
+			line := b.String()
+			if k > 0 {
+				// the other columns of wrapped lines are empty
+				line = strings.TrimRight(line, " ")
+			}
+
+			_, _ = w.WriteString(line) // #nosec
+			_ = w.WriteByte('\n')      // #nosec
+		}
+
+		// the excerpts of -show-context are indented below the row
+		for _, excerpt := range rows[i].seq.Contexts {
+			width := c.MaxWidth
+			if width > 0 {
+				width -= len(excerptIndent)
+				if width < 1 {
+					width = 1
+				}
+			}
+
+			for _, line := range fitWidth(excerpt, width, c.Overflow) {
+				_, _ = w.WriteString(excerptIndent + line) // #nosec
+				_ = w.WriteByte('\n')                      // #nosec
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func records(c config, res result, seqs []*wordseq.Sequence) []record {
+	ret := make([]record, len(seqs))
+	for i, seq := range seqs {
+		ret[i] = newRecord(c, res, seq, i+1)
+	}
+	return ret
+}
+
+// writeJSON writes the sequences as a json array, or when there are multiple
+// cutoffs, an array of sections
+func writeJSON(out io.Writer, c config, results []result) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	if len(c.TopN) == 1 {
+		ret := []record{}
+		for _, res := range results {
+			ret = append(ret, records(c, res, res.Seqs)...)
+		}
+		return enc.Encode(ret)
+	}
+
+	ret := []section{}
+	for _, res := range results {
+		for i, sec := range sections(res.Seqs, c.TopN) {
+			s := section{
+				File:      res.File,
+				N:         c.TopN[i],
+				Sequences: records(c, res, sec),
+			}
+
+			if len(c.SequenceSize) > 1 {
+				s.Size = res.Size
+			}
+
+			ret = append(ret, s)
+		}
+	}
+
+	return enc.Encode(ret)
+}
+
+// ndjsonWriter writes each sequence as a json object on its own line as soon as
+// it is available so that results don't have to be buffered. When there are
+// multiple cutoffs, each object includes the smallest cutoff, n, whose section
+// it belongs to. n is omitted when it only belongs to the unlimited cutoff, 0.
+type ndjsonWriter struct {
+	c    config
+	w    *bufio.Writer
+	enc  *json.Encoder
+	res  result
+	rank int
+}
+
+func newNDJSONWriter(out io.Writer, c config) *ndjsonWriter {
+	w := bufio.NewWriter(out)
+
+	return &ndjsonWriter{
+		c:   c,
+		w:   w,
+		enc: json.NewEncoder(w),
+	}
+}
+
+// Start begins the sequences of res, which are then given to Write
+func (w *ndjsonWriter) Start(res result) {
+	w.res = res
+	w.rank = 0
+}
+
+func (w *ndjsonWriter) Write(seq *wordseq.Sequence) error {
+	w.rank++
+
+	r := newRecord(w.c, w.res, seq, w.rank)
+
+	if len(w.c.TopN) > 1 {
+		for _, n := range w.c.TopN {
+			if n != 0 && n >= w.rank && (r.N == 0 || n < r.N) {
+				r.N = n
+			}
+		}
+	}
+
+	return w.enc.Encode(r)
+}
+
+func (w *ndjsonWriter) Flush() error {
+	return w.w.Flush()
+}
+
+// writeCSV writes the sequences with a header row and a column per word. When
+// there are multiple cutoffs, a leading n column identifies the section, with
+// multiple sequence sizes, a size column identifies the size, and with
+// -per-file, a file column identifies the file.
+func writeCSV(out io.Writer, comma rune, c config, results []result) error {
+	w := csv.NewWriter(out)
+	w.Comma = comma
+
+	multi := len(c.TopN) > 1
+	sizes := len(c.SequenceSize) > 1
+
+	var header []string
+	if c.PerFile {
+		header = append(header, "file")
+	}
+	if sizes {
+		header = append(header, "size")
+	}
+	if multi {
+		header = append(header, "n")
+	}
+	header = append(header, "count")
+	if c.PerMillion {
+		header = append(header, "per_million")
+	}
+	if c.Percent {
+		header = append(header, "percent")
+	}
+	if c.baseline != nil {
+		header = append(header, "prev_rank", "prev_count")
+	}
+	for i := 1; i <= c.SequenceSize.max(); i++ {
+		header = append(header, fmt.Sprintf("word%d", i))
+	}
+	if c.Context > 0 {
+		header = append(header, "context")
+	}
+
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, res := range results {
+		for i, sec := range sections(res.Seqs, c.TopN) {
+			for _, seq := range sec {
+				var row []string
+				if c.PerFile {
+					row = append(row, res.File)
+				}
+				if sizes {
+					row = append(row, strconv.Itoa(res.Size))
+				}
+				if multi {
+					row = append(row, strconv.Itoa(c.TopN[i]))
+				}
+				row = append(row, strconv.Itoa(seq.Count))
+				if c.PerMillion {
+					row = append(row, strconv.FormatFloat(seq.PerMillion(), 'f', -1, 64))
+				}
+				if c.Percent {
+					row = append(row, strconv.FormatFloat(seq.Percent(), 'f', -1, 64))
+				}
+				if c.baseline != nil {
+					// sequences that are new leave them empty
+					if prev, ok := c.baseline.lookup(res.File, seq.Words); ok {
+						row = append(row, strconv.Itoa(prev.Rank), strconv.Itoa(prev.Count))
+					} else {
+						row = append(row, "", "")
+					}
+				}
+				row = append(row, seq.Words...)
+
+				// smaller sequences leave the remaining word columns empty
+				for j := len(seq.Words); j < c.SequenceSize.max(); j++ {
+					row = append(row, "")
+				}
+				if c.Context > 0 {
+					row = append(row, seq.Context)
+				}
+
+				if err := w.Write(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// writeStats writes a summary of the content counted by totals, which has a
+// Counter for each sequence size
+func writeStats(out io.Writer, totals []*wordseq.Counter, langs []stopwords.Share, elapsed time.Duration) error {
+	w := tabwriter.NewWriter(out, 0, 0, 1, ' ', 0)
+
+	if len(totals) > 0 {
+		fmt.Fprintf(w, "words:\t%d\n", totals[0].Words())
+	}
+
+	for _, total := range totals {
+		var prefix string
+		if len(totals) > 1 {
+			prefix = fmt.Sprintf("size %d ", total.SeqSize())
+		}
+
+		fmt.Fprintf(w, "%ssequences:\t%d\n", prefix, total.Total())
+		fmt.Fprintf(w, "%sunique sequences:\t%d\n", prefix, total.Len())
+	}
+
+	if len(totals) > 0 {
+		fmt.Fprintf(w, "bytes:\t%d\n", totals[0].Bytes())
+	}
+
+	if len(langs) > 0 {
+		fmt.Fprintf(w, "languages:\t%s\n", languagesString(langs))
+	}
+
+	fmt.Fprintf(w, "elapsed:\t%v\n", elapsed.Round(time.Millisecond))
+
+	return w.Flush()
+}
+
+// createOutput returns where the results are written, stdout or the -o file.
+// finish must be called with any error that occurred while writing them, which
+// it returns, and leaves the -o file as it was if there was one. Output to a
+// terminal is buffered so that it can be paged if it doesn't fit.
+func createOutput(c config) (out io.Writer, finish func(error) error, err error) {
+	if height, ok := pagerHeight(c); ok {
+		var buf bytes.Buffer
+		return &buf, func(err error) error {
+			if err != nil {
+				_, _ = os.Stdout.Write(buf.Bytes()) // #nosec
+				return err
+			}
+			return page(os.Stdout, buf.Bytes(), height)
+		}, nil
+	}
+
+	if c.OutputFile == "" {
+		return os.Stdout, func(err error) error { return err }, nil
+	}
+
+	f, err := createAtomic(c.OutputFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := bufio.NewWriter(f)
+
+	return w, func(err error) error {
+		if err == nil {
+			if err = w.Flush(); err == nil {
+				err = f.Commit()
+			}
+		}
+
+		if err != nil {
+			f.Abort()
+		}
+
+		return err
+	}, nil
+}
+
+// atomicFile is written to a temporary file that only replaces the named file
+// once it is committed so that interrupted runs don't leave partial output
+type atomicFile struct {
+	*os.File
+	name string
+}
+
+func createAtomic(name string) (*atomicFile, error) {
+	f, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".")
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomicFile{File: f, name: name}, nil
+}
+
+// Commit replaces the named file with what has been written. If it fails, Abort
+// should still be called.
+func (f *atomicFile) Commit() error {
+	mode := os.FileMode(0644)
+	if fi, err := os.Stat(f.name); err == nil {
+		mode = fi.Mode().Perm()
+	}
+
+	if err := f.Chmod(mode); err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.File.Name(), f.name)
+}
+
+// Abort discards what has been written, leaving the named file untouched
+func (f *atomicFile) Abort() {
+	_ = f.Close()                // #nosec
+	_ = os.Remove(f.File.Name()) // #nosec
+}
@@ -0,0 +1,645 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"jrubin.io/nr/stopwords"
+	"jrubin.io/nr/wordseq"
+)
+
+func TestSections(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c a b c d a b c d e"), 3, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cutoffs := []int{1, 3, 1000, 0}
+	got := sections(seqs, cutoffs)
+
+	if len(got) != len(cutoffs) {
+		t.Fatalf("got %d sections, expected %d", len(got), len(cutoffs))
+	}
+
+	for i, n := range []int{1, 3, len(seqs), len(seqs)} {
+		if len(got[i]) != n {
+			t.Errorf("section %d has %d sequences, expected %d", i, len(got[i]), n)
+		}
+
+		for j := range got[i] {
+			if got[i][j] != seqs[j] {
+				t.Errorf("section %d is not a prefix of the ranked results", i)
+			}
+		}
+	}
+
+	if got[0][0].Count != 3 || strings.Join(got[0][0].Words, " ") != "a b c" {
+		t.Error("first section didn't contain the most frequent sequence")
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c a b c"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{TopN: intsFlag{100}, Output: "json"}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []record
+	if err = json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("got %d records, expected 3", len(got))
+	}
+
+	if got[0].Count != 2 || strings.Join(got[0].Words, " ") != "a b c" {
+		t.Errorf("unexpected first record: %+v", got[0])
+	}
+
+	if got[0].PerMillion != nil || got[0].Percent != nil {
+		t.Error("per_million and percent should be omitted unless requested")
+	}
+
+	// multiple cutoffs produce sections
+	c.TopN = intsFlag{1, 2}
+	c.PerMillion = true
+	c.Percent = true
+	buf.Reset()
+
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var secs []section
+	if err = json.Unmarshal(buf.Bytes(), &secs); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(secs) != 2 || secs[0].N != 1 || len(secs[0].Sequences) != 1 || len(secs[1].Sequences) != 2 {
+		t.Errorf("unexpected sections: %+v", secs)
+	}
+
+	if pm := secs[0].Sequences[0].PerMillion; pm == nil || *pm != 500000 {
+		t.Error("per_million was not included")
+	}
+
+	if pct := secs[0].Sequences[0].Percent; pct == nil || *pct != 50 {
+		t.Error("percent was not included")
+	}
+
+	// no results is an empty array, not null
+	c.TopN = intsFlag{100}
+	buf.Reset()
+
+	if err = writeResults(&buf, c, []result{{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("%s != []", buf.String())
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader(`"a" b, "c" "a" b c`), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		c      config
+		expect string
+	}{{
+		c: config{TopN: intsFlag{100}, SequenceSize: sizesFlag{3}, Output: "csv"},
+		expect: "count,word1,word2,word3\n" +
+			"2,a,b,c\n" +
+			"1,b,c,a\n" +
+			"1,c,a,b\n",
+	}, {
+		c: config{TopN: intsFlag{1, 2}, SequenceSize: sizesFlag{3}, Output: "tsv", PerMillion: true},
+		expect: "n\tcount\tper_million\tword1\tword2\tword3\n" +
+			"1\t2\t500000\ta\tb\tc\n" +
+			"2\t2\t500000\ta\tb\tc\n" +
+			"2\t1\t250000\tb\tc\ta\n",
+	}, {
+		c: config{TopN: intsFlag{100}, SequenceSize: sizesFlag{3}, Output: "csv", Percent: true},
+		expect: "count,percent,word1,word2,word3\n" +
+			"2,50,a,b,c\n" +
+			"1,25,b,c,a\n" +
+			"1,25,c,a,b\n",
+	}} {
+		var buf bytes.Buffer
+		if err = writeResults(&buf, v.c, []result{{Seqs: seqs}}); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.String() != v.expect {
+			t.Errorf("%q != %q", buf.String(), v.expect)
+		}
+	}
+}
+
+func TestWriteCSVQuoting(t *testing.T) {
+	seqs := []*wordseq.Sequence{{
+		Words:   []string{"a,b", `"c"`},
+		Count:   1,
+		Context: "x a,b \"c\" y",
+	}}
+
+	c := config{TopN: intsFlag{100}, SequenceSize: sizesFlag{2}, Output: "csv", Context: 1}
+
+	var buf bytes.Buffer
+	if err := writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "count,word1,word2,context\n" +
+		`1,"a,b","""c""","x a,b ""c"" y"` + "\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	c := config{TopN: intsFlag{1, 3}, Output: "ndjson"}
+
+	seqs, err := wordseq.Process(strings.NewReader("a b c a b c d e"), 3, c.TopN.max())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != len(seqs) {
+		t.Fatalf("got %d lines, expected %d", len(lines), len(seqs))
+	}
+
+	for i, line := range lines {
+		var r record
+		if err = json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Count != seqs[i].Count || strings.Join(r.Words, " ") != strings.Join(seqs[i].Words, " ") {
+			t.Errorf("line %d: unexpected record %+v", i, r)
+		}
+
+		expectN := 3
+		if i == 0 {
+			expectN = 1
+		}
+
+		if r.N != expectN {
+			t.Errorf("line %d: n(%d) != %d", i, r.N, expectN)
+		}
+	}
+}
+
+func TestRunNDJSON(t *testing.T) {
+	dir := t.TempDir()
+
+	text := filepath.Join(dir, "a.txt")
+	if err := ioutil.WriteFile(text, []byte("b a b c b a"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// count-desc is written as the counter ranks it, the others once ranked
+	for _, v := range []struct {
+		sort   string
+		expect string
+	}{
+		{"count-desc", `{"count":3,"words":["b"]}` + "\n" + `{"count":2,"words":["a"]}` + "\n"},
+		{"count-asc", `{"count":1,"words":["c"]}` + "\n" + `{"count":2,"words":["a"]}` + "\n"},
+		{"lex", `{"count":2,"words":["a"]}` + "\n" + `{"count":3,"words":["b"]}` + "\n"},
+	} {
+		c := config{
+			SequenceSize: sizesFlag{1},
+			TopN:         intsFlag{2},
+			Output:       "ndjson",
+			Sort:         v.sort,
+			Color:        "never",
+			Overflow:     "truncate",
+			Sample:       1,
+			Encoding:     encodingFlag{all: "utf-8"},
+			OutputFile:   filepath.Join(dir, "out.ndjson"),
+			FailIfEmpty:  true,
+		}
+
+		if err := run(c, text); err != nil {
+			t.Fatalf("%s: %v", v.sort, err)
+		}
+
+		got, err := ioutil.ReadFile(c.OutputFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("%s: %q != %q", v.sort, got, v.expect)
+		}
+	}
+}
+
+func TestWriteTemplate(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c a b c"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{
+		TopN:   intsFlag{100},
+		Format: `{{.Rank}}. {{join .Words "-"}} x{{.Count}} ({{printf "%.0f" .Percent}}%)`,
+	}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "1. a-b-c x2 (50%)\n" +
+		"2. b-c-a x1 (25%)\n" +
+		"3. c-a-b x1 (25%)\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	c.Format = "{{.Count"
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err == nil {
+		t.Error("expected error for invalid template")
+	}
+}
+
+func TestBar(t *testing.T) {
+	tests := []struct {
+		count, max int
+		expect     string
+	}{
+		{10, 10, "████████████████████"},
+		{5, 10, "██████████          "},
+		{1, 10, "██                  "},
+		{1, 16, "█▎                  "},
+		{0, 10, "                    "},
+	}
+
+	for _, test := range tests {
+		if got := bar(test.count, test.max); got != test.expect {
+			t.Errorf("%d/%d: %q != %q", test.count, test.max, got, test.expect)
+		}
+	}
+}
+
+func TestWriteBars(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c a b c a b c d"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{TopN: intsFlag{100}, Bars: true}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := " 3  ████████████████████ [a b c]\n" +
+		" 2  █████████████▎       [b c a]\n" +
+		" 2  █████████████▎       [c a b]\n" +
+		" 1  ██████▋              [b c d]\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
+
+func TestWriteShowContext(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("x a b y a b z"), 2, 1, wordseq.WithContext(1), wordseq.WithContexts(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := config{TopN: intsFlag{1}, ShowContext: 2}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := " 2 [a b]\n" +
+		"    x a b y\n" +
+		"    y a b z\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	// the context of the first is only shown with -context
+	c.Output = "json"
+	buf.Reset()
+	if err = writeResults(&buf, c, []result{{Seqs: seqs}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []record
+	if err = json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 || got[0].Context != "" || strings.Join(got[0].Contexts, "|") != "x a b y|y a b z" {
+		t.Errorf("unexpected records: %+v", got)
+	}
+}
+
+func TestWritePerFile(t *testing.T) {
+	a, err := wordseq.Process(strings.NewReader("a b c a b c"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := wordseq.Process(strings.NewReader("d e f"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := []result{
+		{File: "a.txt", Seqs: a[:1]},
+		{File: "b.txt", Seqs: b},
+		{Seqs: a[:1]},
+	}
+
+	c := config{TopN: intsFlag{1}, SequenceSize: sizesFlag{3}, PerFile: true}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "a.txt:\n 2 [a b c]\n\n" +
+		"b.txt:\n 1 [d e f]\n\n" +
+		"total:\n 2 [a b c]\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "csv"
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expect = "file,count,word1,word2,word3\n" +
+		"a.txt,2,a,b,c\n" +
+		"b.txt,1,d,e,f\n" +
+		",2,a,b,c\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "ndjson"
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expect = `{"file":"a.txt","count":2,"words":["a","b","c"]}` + "\n" +
+		`{"file":"b.txt","count":1,"words":["d","e","f"]}` + "\n" +
+		`{"count":2,"words":["a","b","c"]}` + "\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
+
+func TestAtomicFile(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "out.txt")
+
+	if err := ioutil.WriteFile(fn, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := createAtomic(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = f.WriteString("partial"); err != nil {
+		t.Fatal(err)
+	}
+	f.Abort()
+
+	check := func(expect string) {
+		t.Helper()
+
+		data, err := ioutil.ReadFile(fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != expect {
+			t.Errorf("%q != %q", string(data), expect)
+		}
+
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(files) != 1 {
+			t.Errorf("temporary file was left behind")
+		}
+	}
+
+	check("old")
+
+	if f, err = createAtomic(fn); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = f.WriteString("new"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = f.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	check("new")
+}
+
+func TestWriteSizes(t *testing.T) {
+	var results []result
+	for size := 1; size <= 2; size++ {
+		seqs, err := wordseq.Process(strings.NewReader("a b a b"), size, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, result{Size: size, Seqs: seqs})
+	}
+
+	c := config{TopN: intsFlag{1}, SequenceSize: sizesFlag{1, 2}}
+
+	var buf bytes.Buffer
+	if err := writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "size 1:\n 2 [a]\n\n" +
+		"size 2:\n 2 [a b]\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "csv"
+	if err := writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expect = "size,count,word1,word2\n" +
+		"1,2,a,\n" +
+		"2,2,a,b\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "ndjson"
+	if err := writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expect = `{"size":1,"count":2,"words":["a"]}` + "\n" +
+		`{"size":2,"count":2,"words":["a","b"]}` + "\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
+
+func TestWriteStats(t *testing.T) {
+	var totals []*wordseq.Counter
+	for size := 1; size <= 2; size++ {
+		c, err := wordseq.NewCounter(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		totals = append(totals, c)
+	}
+
+	if err := wordseq.AddAll(strings.NewReader("a b a"), totals...); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeStats(&buf, totals[:1], nil, 1500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "words:            3\n" +
+		"sequences:        3\n" +
+		"unique sequences: 2\n" +
+		"bytes:            5\n" +
+		"elapsed:          1.5s\n"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	langs := []stopwords.Share{{Lang: "en", Fraction: 0.9}, {Lang: "fr", Fraction: 0.1}}
+	if err := writeStats(&buf, totals, langs, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "size 2 unique sequences: 2\n") {
+		t.Errorf("missing stats for each size: %q", buf.String())
+	}
+
+	if !strings.Contains(buf.String(), "languages:               en 90%, fr 10%\n") {
+		t.Errorf("missing languages: %q", buf.String())
+	}
+}
+
+func TestWritePartial(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := []result{{Seqs: seqs, Partial: true}}
+	c := config{TopN: intsFlag{100}}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := "partial:\n 1 [a b c]\n"; buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "ndjson"
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := `{"partial":true,"count":1,"words":["a","b","c"]}` + "\n"; buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
+
+func TestWriteApproximate(t *testing.T) {
+	seqs, err := wordseq.Process(strings.NewReader("a b c"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := []result{{Seqs: seqs, Partial: true, Approximate: true}}
+	c := config{TopN: intsFlag{100}}
+
+	var buf bytes.Buffer
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := "partial approximate:\n 1 [a b c]\n"; buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+
+	buf.Reset()
+	c.Output = "ndjson"
+	if err = writeResults(&buf, c, results); err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := `{"partial":true,"approximate":true,"count":1,"words":["a","b","c"]}` + "\n"; buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+
+	"golang.org/x/term"
+)
+
+// pagerHeight returns the height of the terminal if output written to stdout
+// should be shown through a pager when it doesn't fit
+func pagerHeight(c config) (int, bool) {
+	if c.NoPager || c.OutputFile != "" || c.Watch || c.Follow || c.ListenRaw != "" || c.Stream != "" || !isTerminal(os.Stdout) {
+		return 0, false
+	}
+
+	_, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || height == 0 {
+		return 0, false
+	}
+
+	return height, true
+}
+
+// page writes buf to out, through $PAGER, or less, if it has more lines than
+// fit in height. As with git, LESS defaults to FRX so that colors are shown
+// and the output is left on the screen.
+func page(out io.Writer, buf []byte, height int) error {
+	pager, ok := os.LookupEnv("PAGER")
+	if !ok {
+		pager = "less"
+	}
+
+	if bytes.Count(buf, []byte{'\n'}) < height || pager == "" || pager == "cat" {
+		_, err := out.Write(buf)
+		return err
+	}
+
+	cmd := exec.Command("sh", "-c", pager) // #nosec
+	cmd.Stdin = bytes.NewReader(buf)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+
+	// an interrupt is for the pager, which may ignore it
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("pager: %v", err)
+	}
+
+	return nil
+}
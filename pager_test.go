@@ -0,0 +1,81 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func setenv(t *testing.T, key, value string, set bool) {
+	t.Helper()
+
+	if v, ok := os.LookupEnv(key); ok {
+		t.Cleanup(func() { _ = os.Setenv(key, v) }) // #nosec
+	} else {
+		t.Cleanup(func() { _ = os.Unsetenv(key) }) // #nosec
+	}
+
+	var err error
+	if set {
+		err = os.Setenv(key, value)
+	} else {
+		err = os.Unsetenv(key)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		pager  string
+		less   string
+		text   string
+		height int
+		expect string
+	}{
+		// short output isn't paged
+		{"tr a-z A-Z", "", "a\nb\n", 3, "a\nb\n"},
+		{"tr a-z A-Z", "", "a\nb\nc\n", 3, "A\nB\nC\n"},
+		{"cat", "", "a\nb\nc\n", 3, "a\nb\nc\n"},
+		{"", "", "a\nb\nc\n", 3, "a\nb\nc\n"},
+		{`printf "$LESS "; cat`, "", "a\nb\nc\n", 1, "FRX a\nb\nc\n"},
+		{`printf "$LESS "; cat`, "S", "a\nb\nc\n", 1, "S a\nb\nc\n"},
+	}
+
+	for _, test := range tests {
+		setenv(t, "PAGER", test.pager, true)
+		setenv(t, "LESS", test.less, test.less != "")
+
+		var buf bytes.Buffer
+		if err := page(&buf, []byte(test.text), test.height); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.String() != test.expect {
+			t.Errorf("%q: %q != %q", test.pager, buf.String(), test.expect)
+		}
+	}
+
+	setenv(t, "PAGER", "exit 1", true)
+	if err := page(&bytes.Buffer{}, []byte("a\nb\n"), 1); err == nil {
+		t.Error("expected error from pager")
+	}
+}
+
+func TestPagerHeight(t *testing.T) {
+	for _, c := range []config{
+		{NoPager: true},
+		{OutputFile: "out.txt"},
+		{Watch: true},
+		{Follow: true},
+	} {
+		if _, ok := pagerHeight(c); ok {
+			t.Errorf("%+v shouldn't be paged", c)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// codeQuote is the syntax of a string or character literal
+type codeQuote struct {
+	open, close string
+	escapes     bool
+	multiline   bool
+
+	// emit is false for literals that are read past but not extracted, such
+	// as the character literals of c
+	emit bool
+}
+
+// codeSyntax is the syntax of the comments and literals of a language
+type codeSyntax struct {
+	line   []string
+	block  [][2]string
+	quotes []codeQuote
+}
+
+var (
+	cSyntax = &codeSyntax{
+		line:  []string{"//"},
+		block: [][2]string{{"/*", "*/"}},
+		quotes: []codeQuote{
+			{open: `"`, close: `"`, escapes: true, emit: true},
+			{open: "`", close: "`", multiline: true, emit: true},
+			{open: "'", close: "'", escapes: true},
+		},
+	}
+
+	// scriptSyntax is that of c, but with strings in single quotes too
+	scriptSyntax = &codeSyntax{
+		line:  []string{"//"},
+		block: [][2]string{{"/*", "*/"}},
+		quotes: []codeQuote{
+			{open: `"`, close: `"`, escapes: true, emit: true},
+			{open: "`", close: "`", escapes: true, multiline: true, emit: true},
+			{open: "'", close: "'", escapes: true, emit: true},
+		},
+	}
+
+	pythonSyntax = &codeSyntax{
+		line: []string{"#"},
+		quotes: []codeQuote{
+			{open: `"""`, close: `"""`, escapes: true, multiline: true, emit: true},
+			{open: `'''`, close: `'''`, escapes: true, multiline: true, emit: true},
+			{open: `"`, close: `"`, escapes: true, emit: true},
+			{open: "'", close: "'", escapes: true, emit: true},
+		},
+	}
+
+	hashSyntax = &codeSyntax{
+		line: []string{"#"},
+		quotes: []codeQuote{
+			{open: `"`, close: `"`, escapes: true, emit: true},
+			{open: "'", close: "'", emit: true},
+		},
+	}
+
+	sqlSyntax = &codeSyntax{
+		line:  []string{"--"},
+		block: [][2]string{{"/*", "*/"}},
+		quotes: []codeQuote{
+			{open: "'", close: "'", emit: true},
+			{open: `"`, close: `"`},
+		},
+	}
+
+	luaSyntax = &codeSyntax{
+		line:  []string{"--"},
+		block: [][2]string{{"--[[", "]]"}},
+		quotes: []codeQuote{
+			{open: `"`, close: `"`, escapes: true, emit: true},
+			{open: "'", close: "'", escapes: true, emit: true},
+		},
+	}
+
+	haskellSyntax = &codeSyntax{
+		line:  []string{"--"},
+		block: [][2]string{{"{-", "-}"}},
+		quotes: []codeQuote{
+			{open: `"`, close: `"`, escapes: true, emit: true},
+		},
+	}
+)
+
+// codeLanguages are the syntaxes of the languages by file extension
+var codeLanguages = map[string]*codeSyntax{}
+
+func init() {
+	for syntax, exts := range map[*codeSyntax]string{
+		cSyntax:       ".c .h .cc .cpp .cxx .hpp .hh .m .mm .java .go .rs .swift .kt .kts .scala .cs .proto .zig",
+		scriptSyntax:  ".js .jsx .mjs .ts .tsx .php .dart .groovy .css .scss .less",
+		pythonSyntax:  ".py .pyw .pyi",
+		hashSyntax:    ".sh .bash .zsh .rb .pl .pm .r .yaml .yml .toml .mk .cmake .ps1 .tf .ex .exs .jl .nim makefile dockerfile",
+		sqlSyntax:     ".sql",
+		luaSyntax:     ".lua",
+		haskellSyntax: ".hs .elm",
+	} {
+		for _, ext := range strings.Fields(exts) {
+			codeLanguages[ext] = syntax
+		}
+	}
+}
+
+// codeLanguage returns the syntax of the language of the file fn, which is that
+// of c when it isn't known
+func codeLanguage(fn string) *codeSyntax {
+	base := strings.ToLower(filepath.Base(fn))
+	if syntax, ok := codeLanguages[base]; ok {
+		return syntax
+	}
+
+	if syntax, ok := codeLanguages[filepath.Ext(base)]; ok {
+		return syntax
+	}
+
+	return cSyntax
+}
+
+// codeMaxDelim is the length of the longest delimiter of every syntax
+const codeMaxDelim = 4
+
+// Code returns a reader of the comments and string literals of the source code
+// read from r, each on its own line. The language is chosen by the extension of
+// fn, such as .go or .py, and is c like when it isn't known. Escape sequences
+// in strings are replaced by a space.
+func Code(r io.Reader, fn string) io.Reader {
+	return &code{
+		Reader: bufio.NewReader(r),
+		syntax: codeLanguage(fn),
+	}
+}
+
+// the parts of source code
+const (
+	codeOther = iota
+	codeLine
+	codeBlock
+	codeString
+)
+
+type code struct {
+	*bufio.Reader
+	syntax *codeSyntax
+	buf    bytes.Buffer
+	err    error
+
+	state int
+	end   string
+	quote codeQuote
+}
+
+func (c *code) Read(p []byte) (int, error) {
+	for c.buf.Len() == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		c.fill()
+	}
+
+	return c.buf.Read(p)
+}
+
+// consume discards the next n bytes
+func (c *code) consume(n int) {
+	_, _ = c.Discard(n) // #nosec
+}
+
+// fill reads from the source until a comment or literal has been extracted or
+// the source ends
+func (c *code) fill() {
+	for c.buf.Len() == 0 || c.state != codeOther {
+		next, err := c.Peek(codeMaxDelim)
+		if len(next) == 0 {
+			if c.state != codeOther {
+				_ = c.buf.WriteByte('\n') // #nosec
+				c.state = codeOther
+			}
+
+			if err == nil {
+				err = io.EOF
+			}
+			c.err = err
+			return
+		}
+
+		r, size := utf8.DecodeRune(next)
+
+		switch c.state {
+		case codeOther:
+			c.start(next, size)
+		case codeLine:
+			c.consume(size)
+			if r == '\n' {
+				_ = c.buf.WriteByte('\n') // #nosec
+				c.state = codeOther
+				return
+			}
+			_, _ = c.buf.Write(next[:size]) // #nosec
+		case codeBlock:
+			if bytes.HasPrefix(next, []byte(c.end)) {
+				c.consume(len(c.end))
+				_ = c.buf.WriteByte('\n') // #nosec
+				c.state = codeOther
+				return
+			}
+			c.consume(size)
+			_, _ = c.buf.Write(next[:size]) // #nosec
+		case codeString:
+			if c.string(next, r, size) {
+				return
+			}
+		}
+	}
+}
+
+// start begins the comment or literal that next starts with, if any, or
+// otherwise discards its first rune of the given size
+func (c *code) start(next []byte, size int) {
+	for _, b := range c.syntax.block {
+		if bytes.HasPrefix(next, []byte(b[0])) {
+			c.consume(len(b[0]))
+			c.state, c.end = codeBlock, b[1]
+			return
+		}
+	}
+
+	for _, l := range c.syntax.line {
+		if bytes.HasPrefix(next, []byte(l)) {
+			c.consume(len(l))
+			c.state = codeLine
+			return
+		}
+	}
+
+	for _, q := range c.syntax.quotes {
+		if bytes.HasPrefix(next, []byte(q.open)) {
+			c.consume(len(q.open))
+			c.state, c.quote = codeString, q
+			return
+		}
+	}
+
+	c.consume(size)
+}
+
+// string reads the next rune r, of the given size, of a literal, reporting
+// whether the literal ended
+func (c *code) string(next []byte, r rune, size int) bool {
+	q := c.quote
+
+	switch {
+	case bytes.HasPrefix(next, []byte(q.close)):
+		c.consume(len(q.close))
+	case r == '\n' && !q.multiline:
+		// unterminated
+		c.consume(size)
+	case r == '\\' && q.escapes:
+		c.consume(size)
+		if _, size = utf8.DecodeRune(next[size:]); size > 0 {
+			c.consume(size)
+		}
+		if q.emit {
+			_ = c.buf.WriteByte(' ') // #nosec
+		}
+		return false
+	default:
+		c.consume(size)
+		if q.emit {
+			_, _ = c.buf.Write(next[:size]) // #nosec
+		}
+		return false
+	}
+
+	c.state = codeOther
+	if q.emit {
+		_ = c.buf.WriteByte('\n') // #nosec
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	for _, v := range []struct {
+		fn, in, expect string
+	}{
+		{"a.go", "", ""},
+		{"a.go", "x := y + z\n", ""},
+		{"a.go", "// Package a does things\npackage a\n", " Package a does things\n"},
+		{"a.go", `log.Printf("could not open %s: %v\n", fn, err) // why`, "could not open %s: %v \n why\n"},
+		{"a.go", "/* block\n   comment */ x = '\"' + `raw\nstring`", " block\n   comment \nraw\nstring\n"},
+		{"a.c", `s = "a \"quoted\" word"; c = 'x';`, "a  quoted  word\n"},
+		{"a.js", "let s = 'single' // note", "single\n note\n"},
+		{"unknown", "/* c like */", " c like \n"},
+		{"a.py", "# comment\ndef f():\n    \"\"\"Doc\n    string.\"\"\"\n    return 'it''s'\n", " comment\nDoc\n    string.\nit\ns\n"},
+		{"build.sh", "echo \"hello $name\" # greet\n", "hello $name\n greet\n"},
+		{"Makefile", "all: # the default\n", " the default\n"},
+		{"q.sql", "SELECT 'text' -- note\n/* more */", "text\n note\n more \n"},
+		{"a.lua", "--[[ long\ncomment ]] print(\"hi\") -- short", " long\ncomment \nhi\n short\n"},
+		{"a.hs", "{- block -} main = putStrLn \"hi\" -- end", " block \nhi\n end\n"},
+		{"a.go", `s := "unterminated` + "\nnext", "unterminated\n"},
+		{"a.go", "// no newline at the end", " no newline at the end\n"},
+		{"a.go", `"café ☕"`, "café ☕\n"},
+	} {
+		got, err := ioutil.ReadAll(Code(strings.NewReader(v.in), v.fn))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("%s %q: %q != %q", v.fn, v.in, got, v.expect)
+		}
+	}
+}
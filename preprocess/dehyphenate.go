@@ -0,0 +1,110 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Dehyphenate returns a reader that rejoins words that were split across lines
+// with a hyphen, as is common in OCR'd text, so that "inter-\nnational" is read
+// as "international".
+//
+// A hyphen is only considered a line-wrap hyphen when it directly follows a
+// letter, is the last character on the line and the next line starts with a
+// lower case letter. Hyphens anywhere else, such as "well- known" or
+// "Jean-\nPaul", are left untouched.
+func Dehyphenate(r io.Reader) io.Reader {
+	return &dehyphenator{
+		Reader: bufio.NewReader(r),
+	}
+}
+
+type dehyphenator struct {
+	*bufio.Reader
+	buf bytes.Buffer
+	err error
+
+	// a line that was read ahead but not consumed
+	peeked  bool
+	next    string
+	nextErr error
+}
+
+func (d *dehyphenator) Read(p []byte) (int, error) {
+	for d.buf.Len() == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+
+	return d.buf.Read(p)
+}
+
+func (d *dehyphenator) readLine() (string, error) {
+	if d.peeked {
+		d.peeked = false
+		return d.next, d.nextErr
+	}
+
+	return d.ReadString('\n')
+}
+
+func (d *dehyphenator) unreadLine(line string, err error) {
+	d.peeked = true
+	d.next = line
+	d.nextErr = err
+}
+
+func (d *dehyphenator) fill() {
+	line, err := d.readLine()
+
+	for err == nil {
+		stem, ok := wrapped(line)
+		if !ok {
+			break
+		}
+
+		next, nextErr := d.readLine()
+		rest := strings.TrimLeft(next, " \t")
+
+		if r, _ := utf8.DecodeRuneInString(rest); !unicode.IsLower(r) {
+			d.unreadLine(next, nextErr)
+			break
+		}
+
+		line, err = stem+rest, nextErr
+	}
+
+	_, _ = d.buf.WriteString(line) // #nosec
+	d.err = err
+}
+
+func isHyphen(r rune) bool {
+	return r == '-' || r == '\u2010'
+}
+
+// wrapped reports whether line ends with a word broken by a hyphen and returns
+// the line with the hyphen and line ending removed
+func wrapped(line string) (string, bool) {
+	line = strings.TrimRight(line, " \t\r\n")
+
+	r, size := utf8.DecodeLastRuneInString(line)
+	if !isHyphen(r) {
+		return "", false
+	}
+	stem := line[:len(line)-size]
+
+	if r, _ = utf8.DecodeLastRuneInString(stem); !unicode.IsLetter(r) {
+		return "", false
+	}
+
+	return stem, true
+}
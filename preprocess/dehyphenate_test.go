@@ -0,0 +1,39 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDehyphenate(t *testing.T) {
+	for _, v := range []struct {
+		in, expect string
+	}{
+		{"", ""},
+		{"no hyphens here\n", "no hyphens here\n"},
+		{"inter-\nnational trade\n", "international trade\n"},
+		{"inter-\r\n  national trade", "international trade"},
+		{"a well- known fact\n", "a well- known fact\n"},
+		{"a well-known fact\n", "a well-known fact\n"},
+		{"Jean-\nPaul\n", "Jean-\nPaul\n"},
+		{"page 12-\n13\n", "page 12-\n13\n"},
+		{"dash -\nnext\n", "dash -\nnext\n"},
+		{"trailing-\n", "trailing-\n"},
+		{"trailing-", "trailing-"},
+		{"super-\ncali-\nfragilistic\n", "supercalifragilistic\n"},
+		{"Not-\nA wrap, but-\nthis is\n", "Not-\nA wrap, butthis is\n"},
+	} {
+		got, err := ioutil.ReadAll(Dehyphenate(strings.NewReader(v.in)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("%q: %q != %q", v.in, got, v.expect)
+		}
+	}
+}
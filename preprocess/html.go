@@ -0,0 +1,122 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// elements whose content is never displayed
+var hiddenElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Template: true,
+	atom.Noscript: true,
+}
+
+// elements that separate their content from the surrounding text
+var blockElements = map[atom.Atom]bool{
+	atom.Address:    true,
+	atom.Article:    true,
+	atom.Aside:      true,
+	atom.Blockquote: true,
+	atom.Br:         true,
+	atom.Caption:    true,
+	atom.Dd:         true,
+	atom.Div:        true,
+	atom.Dl:         true,
+	atom.Dt:         true,
+	atom.Fieldset:   true,
+	atom.Figcaption: true,
+	atom.Figure:     true,
+	atom.Footer:     true,
+	atom.Form:       true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Header:     true,
+	atom.Hr:         true,
+	atom.Li:         true,
+	atom.Main:       true,
+	atom.Nav:        true,
+	atom.Ol:         true,
+	atom.Option:     true,
+	atom.P:          true,
+	atom.Pre:        true,
+	atom.Section:    true,
+	atom.Table:      true,
+	atom.Td:         true,
+	atom.Th:         true,
+	atom.Title:      true,
+	atom.Tr:         true,
+	atom.Ul:         true,
+}
+
+// HTML returns a reader of only the visible text of the html document read
+// from r. Markup, comments, scripts and styles are removed, entities are
+// decoded and block level elements are separated by newlines.
+func HTML(r io.Reader) io.Reader {
+	return &htmlText{
+		z: html.NewTokenizer(r),
+	}
+}
+
+type htmlText struct {
+	z      *html.Tokenizer
+	buf    bytes.Buffer
+	err    error
+	hidden int
+}
+
+func (h *htmlText) Read(p []byte) (int, error) {
+	for h.buf.Len() == 0 {
+		if h.err != nil {
+			return 0, h.err
+		}
+		h.next()
+	}
+
+	return h.buf.Read(p)
+}
+
+func (h *htmlText) next() {
+	switch h.z.Next() {
+	case html.ErrorToken:
+		h.err = h.z.Err()
+	case html.TextToken:
+		if h.hidden == 0 {
+			_, _ = h.buf.Write(h.z.Text()) // #nosec
+		}
+	case html.StartTagToken:
+		name, _ := h.z.TagName()
+		a := atom.Lookup(name)
+		if hiddenElements[a] {
+			h.hidden++
+		}
+		if blockElements[a] && h.hidden == 0 {
+			_ = h.buf.WriteByte('\n') // #nosec
+		}
+	case html.EndTagToken:
+		name, _ := h.z.TagName()
+		a := atom.Lookup(name)
+		if hiddenElements[a] && h.hidden > 0 {
+			h.hidden--
+		}
+		if blockElements[a] && h.hidden == 0 {
+			_ = h.buf.WriteByte('\n') // #nosec
+		}
+	case html.SelfClosingTagToken:
+		name, _ := h.z.TagName()
+		if blockElements[atom.Lookup(name)] && h.hidden == 0 {
+			_ = h.buf.WriteByte('\n') // #nosec
+		}
+	}
+}
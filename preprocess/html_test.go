@@ -0,0 +1,38 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	for _, v := range []struct {
+		in, expect string
+	}{
+		{"", ""},
+		{"plain text", "plain text"},
+		{"<b>bold</b>face", "boldface"},
+		{"<p>one</p><p>two</p>", "\none\n\ntwo\n"},
+		{"a<br>b<br/>c", "a\nb\nc"},
+		{"fish &amp; chips &lt;3", "fish & chips <3"},
+		{"<!-- comment -->text", "text"},
+		{`<html><head><title>Title</title><style>p { color: red }</style>` +
+			`<script>var x = "<p>no</p>";</script></head>` +
+			`<body><noscript>enable js</noscript><span>Body</span> text</body></html>`,
+			"\nTitle\nBody text"},
+		{"<template><p>hidden</p></template>shown", "shown"},
+	} {
+		got, err := ioutil.ReadAll(HTML(strings.NewReader(v.in)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("%q: %q != %q", v.in, got, v.expect)
+		}
+	}
+}
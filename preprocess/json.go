@@ -0,0 +1,126 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JSONField returns a reader of the string found at path in each json record
+// read from r, one per line. Records are either consecutive json values (e.g.
+// json lines) or the elements of a top level array. The path is a dot separated
+// list of object keys and array indexes, such as "body.paragraphs.0". Records
+// where the path doesn't lead to a string are skipped.
+func JSONField(r io.Reader, path string) io.Reader {
+	return &jsonField{
+		r:    r,
+		path: strings.Split(path, "."),
+	}
+}
+
+type jsonField struct {
+	r       io.Reader
+	path    []string
+	dec     *json.Decoder
+	inArray bool
+	buf     bytes.Buffer
+	err     error
+}
+
+func (j *jsonField) Read(p []byte) (int, error) {
+	for j.buf.Len() == 0 {
+		if j.err != nil {
+			return 0, j.err
+		}
+		j.fill()
+	}
+
+	return j.buf.Read(p)
+}
+
+// start prepares the decoder, streaming the elements of a top level array
+// rather than decoding it all at once
+func (j *jsonField) start() error {
+	br := bufio.NewReader(j.r)
+
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			break
+		}
+
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte() // #nosec
+			continue
+		case '[':
+			j.inArray = true
+		}
+		break
+	}
+
+	j.dec = json.NewDecoder(br)
+
+	if j.inArray {
+		if _, err := j.dec.Token(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (j *jsonField) fill() {
+	if j.dec == nil {
+		if j.err = j.start(); j.err != nil {
+			return
+		}
+	}
+
+	if j.inArray && !j.dec.More() {
+		// consume the closing ]
+		if _, j.err = j.dec.Token(); j.err != nil {
+			return
+		}
+		j.inArray = false
+	}
+
+	var v interface{}
+	if j.err = j.dec.Decode(&v); j.err != nil {
+		return
+	}
+
+	if s, ok := lookup(v, j.path); ok {
+		_, _ = j.buf.WriteString(s) // #nosec
+		_ = j.buf.WriteByte('\n')   // #nosec
+	}
+}
+
+func lookup(v interface{}, path []string) (string, bool) {
+	for _, key := range path {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = t[key]; !ok {
+				return "", false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(t) {
+				return "", false
+			}
+			v = t[i]
+		default:
+			return "", false
+		}
+	}
+
+	s, ok := v.(string)
+	return s, ok
+}
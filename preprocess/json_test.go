@@ -0,0 +1,39 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestJSONField(t *testing.T) {
+	for _, v := range []struct {
+		in, path, expect string
+	}{
+		{"", "text", ""},
+		{`{"text": "one"}` + "\n" + `{"text": "two"}` + "\n", "text", "one\ntwo\n"},
+		{`{"text": "one"}{"other": "x"}{"text": 3}{"text": "two"}`, "text", "one\ntwo\n"},
+		{` [{"text": "one"}, {"text": "two"}] `, "text", "one\ntwo\n"},
+		{`[]`, "text", ""},
+		{`{"a": {"b": ["x", {"c": "deep"}]}}`, "a.b.1.c", "deep\n"},
+		{`{"a": {"b": ["x", "y"]}}`, "a.b.5", ""},
+		{`{"a": "x"}`, "a.b", ""},
+		{`{"text": "line\nbreak"}`, "text", "line\nbreak\n"},
+	} {
+		got, err := ioutil.ReadAll(JSONField(strings.NewReader(v.in), v.path))
+		if err != nil {
+			t.Fatalf("%q: %v", v.in, err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("%q: %q != %q", v.in, got, v.expect)
+		}
+	}
+
+	if _, err := ioutil.ReadAll(JSONField(strings.NewReader(`{"text": `), "text")); err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
@@ -0,0 +1,103 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const months = `(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)`
+
+// logPrefix matches a timestamp or log level at the start of a line, possibly
+// bracketed, along with the space that follows it
+var logPrefix = regexp.MustCompile(`^\s*(?:` +
+	// 2006-01-02T15:04:05.000Z07:00, 2006-01-02 15:04:05,000 and 2006/01/02 15:04:05
+	`\[?\d{4}[-/]\d{2}[-/]\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?\]?` +
+	// Jan  2 15:04:05, as in syslog
+	`|\[?` + months + ` +\d{1,2} \d{2}:\d{2}:\d{2}(?:\.\d+)?\]?` +
+	// 02/Jan/2006:15:04:05 -0700, as in web server access logs
+	`|\[?\d{2}/` + months + `/\d{4}:\d{2}:\d{2}:\d{2}(?: [+-]\d{4})?\]?` +
+	// 15:04:05.000
+	`|\[?\d{2}:\d{2}:\d{2}(?:[.,]\d+)?\]?` +
+	// seconds or milliseconds since the epoch
+	`|\[?\d{10}(?:\d{3}|\.\d+)?\]?` +
+	// the time and level of logfmt
+	`|(?:time|ts|level|lvl)=(?:"[^"]*"|\S+)` +
+	// the level, e.g. INFO, [warn] or error:
+	`|[\[(<]?(?i:trace|debug|info|notice|warn|warning|error|err|fatal|crit|critical|panic)[\])>]?:?` +
+	`)(?:\s+|$)`)
+
+// hexID matches a uuid, a 0x prefixed hex number or a run of hex digits that
+// has a digit
+var hexID = regexp.MustCompile(`\b(?:` +
+	`[[:xdigit:]]{8}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{12}` +
+	`|0[xX][[:xdigit:]]+` +
+	`|[[:xdigit:]]*\d[[:xdigit:]]*` +
+	`)\b`)
+
+// minHexID is the shortest run of hex digits, without a prefix, that is
+// considered an id rather than a number or a word
+const minHexID = 8
+
+// Logs returns a reader that removes the timestamps and log levels from the
+// start of each line of log files read from r, and the hex ids, such as uuids,
+// hashes and addresses, from anywhere in them, so that the lines are read as
+// the templates of the messages they were made from.
+func Logs(r io.Reader) io.Reader {
+	return &logs{
+		Reader: bufio.NewReader(r),
+	}
+}
+
+type logs struct {
+	*bufio.Reader
+	buf bytes.Buffer
+	err error
+}
+
+func (l *logs) Read(p []byte) (int, error) {
+	for l.buf.Len() == 0 {
+		if l.err != nil {
+			return 0, l.err
+		}
+		l.fill()
+	}
+
+	return l.buf.Read(p)
+}
+
+func (l *logs) fill() {
+	line, err := l.ReadString('\n')
+	l.err = err
+
+	// the line ending is kept as it is
+	text := strings.TrimRight(line, "\r\n")
+	end := line[len(text):]
+
+	_, _ = l.buf.WriteString(stripLogLine(text)) // #nosec
+	_, _ = l.buf.WriteString(end)                // #nosec
+}
+
+// stripLogLine returns line without its leading timestamps and log levels and
+// with its hex ids removed
+func stripLogLine(line string) string {
+	for {
+		loc := logPrefix.FindStringIndex(line)
+		if loc == nil || loc[1] == 0 {
+			break
+		}
+		line = line[loc[1]:]
+	}
+
+	return hexID.ReplaceAllStringFunc(line, func(id string) string {
+		if len(id) < minHexID && !strings.ContainsAny(id, "-xX") {
+			return id
+		}
+		return ""
+	})
+}
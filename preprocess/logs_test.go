@@ -0,0 +1,57 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestStripLogLine(t *testing.T) {
+	for _, v := range []struct {
+		in, expect string
+	}{
+		{"", ""},
+		{"no prefix here", "no prefix here"},
+		{"2018-03-04T05:06:07.890Z INFO server started", "server started"},
+		{"2018-03-04 05:06:07,890 [WARN] disk almost full", "disk almost full"},
+		{"2018/03/04 05:06:07 listening on :8080", "listening on :8080"},
+		{"Mar  4 05:06:07 host sshd[123]: accepted", "host sshd[123]: accepted"},
+		{`127.0.0.1 - - [04/Mar/2018:05:06:07 -0700] "GET / HTTP/1.1" 200`, `127.0.0.1 - - [04/Mar/2018:05:06:07 -0700] "GET / HTTP/1.1" 200`},
+		{"[05:06:07.123] error: request failed", "request failed"},
+		{"1520139967123 fatal: timeout", "timeout"},
+		{`time="2018-03-04T05:06:07Z" level=info msg="user logged in"`, `msg="user logged in"`},
+		{"ERROR request 5f3c1a2b9e failed", "request  failed"},
+		{"session 123e4567-e89b-12d3-a456-426614174000 expired", "session  expired"},
+		{"panic at 0xc000123456 in worker", "at  in worker"},
+		{"deadbeef is a word, 404 is a status", "deadbeef is a word, 404 is a status"},
+		{"information is not a level", "information is not a level"},
+	} {
+		if got := stripLogLine(v.in); got != v.expect {
+			t.Errorf("%q: %q != %q", v.in, got, v.expect)
+		}
+	}
+}
+
+func TestLogs(t *testing.T) {
+	const in = "2018-03-04T05:06:07Z INFO user 42 logged in\r\n" +
+		"2018-03-04T05:06:08Z DEBUG cache miss for a1b2c3d4e5f6\n" +
+		"\n" +
+		"2018-03-04T05:06:09Z INFO user 43 logged in"
+
+	const expect = "user 42 logged in\r\n" +
+		"cache miss for \n" +
+		"\n" +
+		"user 43 logged in"
+
+	got, err := ioutil.ReadAll(Logs(strings.NewReader(in)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != expect {
+		t.Errorf("%q != %q", got, expect)
+	}
+}
@@ -0,0 +1,139 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// mdReplacement is a pattern of markdown syntax and what it is replaced with
+type mdReplacement struct {
+	re   *regexp.Regexp
+	repl string
+}
+
+// the syntax that may appear within a line of markdown, in the order it is
+// removed
+var mdInline = []mdReplacement{
+	// html comments and tags
+	{regexp.MustCompile(`<!--.*?-->`), ""},
+	{regexp.MustCompile(`</?[a-zA-Z][a-zA-Z0-9-]*(?:\s[^>]*)?/?>`), " "},
+
+	// code spans keep their text
+	{regexp.MustCompile("`+([^`]+?)`+"), "$1"},
+
+	// images and links keep their text, but not where they lead
+	{regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`), "$1"},
+	{regexp.MustCompile(`!?\[([^\]]*)\]\[[^\]]*\]`), "$1"},
+	{regexp.MustCompile(`<(?:https?|ftp|mailto):[^>]*>`), " "},
+	{regexp.MustCompile(`\b(?:https?|ftp)://\S+`), " "},
+
+	// emphasis and strikethrough
+	{regexp.MustCompile(`\*\*([^*]+)\*\*`), "$1"},
+	{regexp.MustCompile(`\b__([^_]+)__\b`), "$1"},
+	{regexp.MustCompile(`\*([^*\s](?:[^*]*[^*\s])?)\*`), "$1"},
+	{regexp.MustCompile(`\b_([^_\s](?:[^_]*[^_\s])?)_\b`), "$1"},
+	{regexp.MustCompile(`~~([^~]+)~~`), "$1"},
+}
+
+var (
+	// mdFence starts or ends a fenced code block
+	mdFence = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+
+	// mdRemoved are lines that are only syntax: reference link definitions,
+	// thematic breaks, setext heading underlines and table delimiter rows
+	mdRemoved = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:\s*\S+.*$` +
+		`|^ {0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$` +
+		`|^ {0,3}(?:=+|-+)\s*$` +
+		`|^\s*\|?\s*:?-+:?\s*(?:\|\s*:?-+:?\s*)+\|?\s*$`)
+
+	// mdPrefix is the syntax that starts a line: block quotes, headings, list
+	// items and task list boxes
+	mdPrefix = regexp.MustCompile(`^\s*(?:>\s?)*\s*(?:#{1,6}\s+|(?:[-*+]|\d{1,9}[.)])\s+(?:\[[ xX]\]\s+)?)?`)
+
+	// mdClosingHashes optionally end a heading
+	mdClosingHashes = regexp.MustCompile(`\s+#+\s*$`)
+)
+
+// Markdown returns a reader of the text of the markdown read from r, without
+// its formatting syntax. Fenced code blocks are removed, as are the urls of
+// links and images, whose text is kept.
+func Markdown(r io.Reader) io.Reader {
+	return &markdown{
+		Reader: bufio.NewReader(r),
+	}
+}
+
+type markdown struct {
+	*bufio.Reader
+	buf bytes.Buffer
+	err error
+
+	// fence is the marker of the fenced code block being skipped, if any
+	fence string
+}
+
+func (m *markdown) Read(p []byte) (int, error) {
+	for m.buf.Len() == 0 {
+		if m.err != nil {
+			return 0, m.err
+		}
+		m.fill()
+	}
+
+	return m.buf.Read(p)
+}
+
+func (m *markdown) fill() {
+	line, err := m.ReadString('\n')
+	m.err = err
+
+	// the line ending is kept as it is
+	text := strings.TrimRight(line, "\r\n")
+	end := line[len(text):]
+
+	_, _ = m.buf.WriteString(m.strip(text)) // #nosec
+	_, _ = m.buf.WriteString(end)           // #nosec
+}
+
+// strip returns the text of a line of markdown
+func (m *markdown) strip(line string) string {
+	// a fence is closed by one of the same character that is at least as long
+	if f := mdFence.FindStringSubmatch(line); f != nil {
+		switch {
+		case m.fence == "":
+			m.fence = f[1]
+			return ""
+		case f[1][0] == m.fence[0] && len(f[1]) >= len(m.fence) && strings.TrimSpace(line[len(f[0]):]) == "":
+			m.fence = ""
+			return ""
+		}
+	}
+
+	if m.fence != "" || mdRemoved.MatchString(line) {
+		return ""
+	}
+
+	if loc := mdPrefix.FindStringIndex(line); loc != nil {
+		if strings.Contains(line[:loc[1]], "#") {
+			line = mdClosingHashes.ReplaceAllString(line, "")
+		}
+		line = line[loc[1]:]
+	}
+
+	for _, r := range mdInline {
+		line = r.re.ReplaceAllString(line, r.repl)
+	}
+
+	// table cells are separated by spaces instead
+	if strings.HasPrefix(strings.TrimSpace(line), "|") {
+		line = strings.TrimSpace(strings.Replace(line, "|", " ", -1))
+	}
+
+	return line
+}
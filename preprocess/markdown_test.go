@@ -0,0 +1,45 @@
+package preprocess
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	for _, v := range []struct {
+		in, expect string
+	}{
+		{"", ""},
+		{"plain text\n", "plain text\n"},
+		{"# Title #\n## Sub heading\n", "Title\nSub heading\n"},
+		{"Title\n=====\n", "Title\n\n"},
+		{"see [the docs](https://example.com/docs) now", "see the docs now"},
+		{"![a logo](logo.png \"title\")", "a logo"},
+		{"a [reference][1] link\n\n[1]: https://example.com\n", "a reference link\n\n\n"},
+		{"visit <https://example.com> or https://example.org/x?y=z today", "visit   or   today"},
+		{"**bold**, *em*, __strong__, _under_ and ~~gone~~", "bold, em, strong, under and gone"},
+		{"snake_case_name stays", "snake_case_name stays"},
+		{"2 * 3 * 4", "2 * 3 * 4"},
+		{"use `go build` here", "use go build here"},
+		{"before\n```go\nfunc main() {}\n```\nafter\n", "before\n\n\n\nafter\n"},
+		{"~~~~\n```\nstill code\n~~~~\nout\n", "\n\n\n\nout\n"},
+		{"> quoted *text*\n> > nested\n", "quoted text\nnested\n"},
+		{"- one\n* two\n+ three\n1. four\n2) five\n- [x] done\n", "one\ntwo\nthree\nfour\nfive\ndone\n"},
+		{"---\n***\n", "\n\n"},
+		{"| a | b |\n|---|:-:|\n| c | d |\n", "a   b\n\nc   d\n"},
+		{"line<br>break <!-- hidden --> <b>bold</b>\r\n", "line break   bold \r\n"},
+	} {
+		got, err := ioutil.ReadAll(Markdown(strings.NewReader(v.in)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != v.expect {
+			t.Errorf("%q: %q != %q", v.in, got, v.expect)
+		}
+	}
+}
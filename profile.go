@@ -0,0 +1,90 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// startProfiles starts the cpu profile and execution trace selected by c,
+// returning a function that stops them and writes the memory profile, if one
+// was selected too
+func startProfiles(c config) (func() error, error) {
+	var stops []func() error
+
+	stop := func() error {
+		var err error
+		for i := len(stops) - 1; i >= 0; i-- {
+			if serr := stops[i](); err == nil {
+				err = serr
+			}
+		}
+		return err
+	}
+
+	if c.CPUProfile != "" {
+		f, err := os.Create(c.CPUProfile)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = pprof.StartCPUProfile(f); err != nil {
+			_ = f.Close() // #nosec
+			return nil, err
+		}
+
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if c.Trace != "" {
+		f, err := os.Create(c.Trace)
+		if err != nil {
+			_ = stop() // #nosec
+			return nil, err
+		}
+
+		if err = trace.Start(f); err != nil {
+			_ = f.Close() // #nosec
+			_ = stop()    // #nosec
+			return nil, err
+		}
+
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if c.MemProfile != "" {
+		stops = append(stops, func() error {
+			return writeMemProfile(c.MemProfile)
+		})
+	}
+
+	return stop, nil
+}
+
+// writeMemProfile writes the profile of the memory allocated so far to fn
+func writeMemProfile(fn string) error {
+	f, err := os.Create(fn)
+	if err != nil {
+		return err
+	}
+
+	// the profile is of the memory in use as of the last collection
+	runtime.GC()
+
+	if err = pprof.WriteHeapProfile(f); err != nil {
+		_ = f.Close() // #nosec
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,45 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStartProfiles(t *testing.T) {
+	dir := t.TempDir()
+
+	c := config{
+		CPUProfile: filepath.Join(dir, "cpu.pprof"),
+		MemProfile: filepath.Join(dir, "mem.pprof"),
+		Trace:      filepath.Join(dir, "trace.out"),
+	}
+
+	stop, err := startProfiles(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fn := range []string{c.CPUProfile, c.MemProfile, c.Trace} {
+		fi, err := os.Stat(fn)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if fi.Size() == 0 {
+			t.Errorf("%s is empty", fn)
+		}
+	}
+
+	if _, err = startProfiles(config{CPUProfile: filepath.Join(dir, "missing", "cpu.pprof")}); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
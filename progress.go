@@ -0,0 +1,164 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// progressSize is the total size of the input above which progress is
+// reported, when stderr is a terminal, without -progress
+const progressSize = 1 << 30
+
+// progress periodically reports how much of the input has been read
+type progress struct {
+	// read and words are updated atomically by the workers
+	read  int64
+	words int64
+
+	out   io.Writer
+	tty   bool
+	size  int64 // total size of the input, 0 if unknown
+	start time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// inputSize returns the total size of files, or 0 if it can't be known in
+// advance, such as when stdin or urls are read
+func inputSize(files []string) int64 {
+	var size int64
+	for _, fn := range files {
+		if fn == "-" || isURL(fn) {
+			return 0
+		}
+
+		fi, err := os.Stat(fn)
+		if err != nil || !fi.Mode().IsRegular() {
+			return 0
+		}
+
+		size += fi.Size()
+	}
+	return size
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func newProgress(out io.Writer, tty bool, size int64) *progress {
+	return &progress{
+		out:   out,
+		tty:   tty,
+		size:  size,
+		start: time.Now(),
+		done:  make(chan struct{}),
+	}
+}
+
+// reader reports the bytes read from r. p may be nil, in which case r is
+// returned as is.
+func (p *progress) reader(r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &progressReader{Reader: r, p: p}
+}
+
+func (p *progress) word(string) {
+	atomic.AddInt64(&p.words, 1)
+}
+
+// Start reports progress every interval until Stop is called
+func (p *progress) Start(interval time.Duration) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-t.C:
+				p.report()
+			case <-p.done:
+				if p.tty {
+					// clear the line
+					_, _ = fmt.Fprint(p.out, "\r\x1b[K") // #nosec
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (p *progress) Stop() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+func (p *progress) report() {
+	elapsed := time.Since(p.start)
+	read := atomic.LoadInt64(&p.read)
+	words := atomic.LoadInt64(&p.words)
+
+	msg := fmt.Sprintf("%s read, %.0f words/s", byteSize(read), float64(words)/elapsed.Seconds())
+
+	// compressed input can be larger than the size on disk
+	if p.size > 0 && read <= p.size {
+		msg = fmt.Sprintf("%s / %s (%.1f%%), %.0f words/s",
+			byteSize(read), byteSize(p.size),
+			float64(read)*100/float64(p.size),
+			float64(words)/elapsed.Seconds(),
+		)
+
+		if read > 0 {
+			eta := time.Duration(float64(elapsed) * float64(p.size-read) / float64(read))
+			msg += fmt.Sprintf(", ETA %v", eta.Round(time.Second))
+		}
+	}
+
+	if p.tty {
+		_, _ = fmt.Fprintf(p.out, "\r\x1b[K%s", msg) // #nosec
+		return
+	}
+
+	_, _ = fmt.Fprintln(p.out, msg) // #nosec
+}
+
+// byteSize formats n bytes using binary units
+func byteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+type progressReader struct {
+	io.Reader
+	p *progress
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	atomic.AddInt64(&r.p.read, int64(n))
+	return n, err
+}
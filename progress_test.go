@@ -0,0 +1,62 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestByteSize(t *testing.T) {
+	for _, v := range []struct {
+		n      int64
+		expect string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KiB"},
+		{3 << 30, "3.0 GiB"},
+	} {
+		if got := byteSize(v.n); got != v.expect {
+			t.Errorf("%d: %s != %s", v.n, got, v.expect)
+		}
+	}
+}
+
+func TestProgress(t *testing.T) {
+	dir := t.TempDir()
+	mkfiles(t, dir, "a.txt", "b.txt")
+
+	files := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")}
+	if size := inputSize(files); size != 10 {
+		t.Errorf("size(%d) != 10", size)
+	}
+
+	if size := inputSize(append(files, "-")); size != 0 {
+		t.Errorf("size(%d) != 0 with stdin", size)
+	}
+
+	var buf bytes.Buffer
+	p := newProgress(&buf, false, 10)
+
+	if _, err := ioutil.ReadAll(p.reader(strings.NewReader("hello"))); err != nil {
+		t.Fatal(err)
+	}
+	p.word("hello")
+
+	p.report()
+
+	if !strings.HasPrefix(buf.String(), "5 B / 10 B (50.0%), ") || !strings.Contains(buf.String(), "ETA") {
+		t.Errorf("unexpected progress: %q", buf.String())
+	}
+
+	var nilProgress *progress
+	r := strings.NewReader("")
+	if nilProgress.reader(r) != r {
+		t.Error("nil progress should not wrap the reader")
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"time"
+)
+
+// sampleReader reads a random fraction, rate, of the lines of r
+type sampleReader struct {
+	r    *bufio.Reader
+	rate float64
+	rng  *rand.Rand
+	line []byte
+	err  error
+}
+
+func (s *sampleReader) Read(b []byte) (int, error) {
+	for len(s.line) == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+
+		var line []byte
+		line, s.err = s.r.ReadBytes('\n')
+		if len(line) > 0 && s.rng.Float64() < s.rate {
+			s.line = line
+		}
+	}
+
+	n := copy(b, s.line)
+	s.line = s.line[n:]
+	return n, nil
+}
+
+// sample returns r, the content of the file fn, or with -sample, a random
+// fraction of its lines. Each file has its own random source, derived from
+// -seed and its name, so that the same lines are chosen no matter the order in
+// which the files are read.
+func sample(fn string, r io.Reader, c config) io.Reader {
+	if c.Sample <= 0 || c.Sample >= 1 {
+		return r
+	}
+
+	seed := c.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fn)) // #nosec
+
+	return &sampleReader{
+		r:    bufio.NewReader(r),
+		rate: c.Sample,
+		rng:  rand.New(rand.NewSource(seed ^ int64(h.Sum64()))), // #nosec
+	}
+}
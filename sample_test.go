@@ -0,0 +1,58 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func sampled(t *testing.T, fn, text string, c config) string {
+	t.Helper()
+
+	b, err := ioutil.ReadAll(sample(fn, strings.NewReader(text), c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestSample(t *testing.T) {
+	var lines []string
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	text := strings.Join(lines, "\n")
+
+	for _, rate := range []float64{0, 1} {
+		if got := sampled(t, "a", text, config{Sample: rate}); got != text {
+			t.Errorf("%v: every line should be read", rate)
+		}
+	}
+
+	c := config{Sample: 0.1, Seed: 1}
+	got := sampled(t, "a", text, c)
+
+	// every line that was read is whole
+	n := strings.Count(got, "\n")
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		if !strings.HasPrefix(line, "line ") {
+			t.Errorf("unexpected line %q", line)
+		}
+	}
+
+	if n < 50 || n > 150 {
+		t.Errorf("%d lines is far from a tenth", n)
+	}
+
+	if again := sampled(t, "a", text, c); again != got {
+		t.Error("the same seed should sample the same lines")
+	}
+
+	if other := sampled(t, "b", text, c); other == got {
+		t.Error("each file should sample different lines")
+	}
+}
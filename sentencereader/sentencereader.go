@@ -0,0 +1,246 @@
+package sentencereader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// UnicodeVersion is the version of the Unicode Character Database that the
+// sentence break property tables were made from
+const UnicodeVersion = "17.0.0"
+
+// SentenceReader is an interface wrapping a basic ReadSentence method.
+//
+// ReadSentence reads a single sentence, returning the sentence, including the
+// spaces and line break that end it, or any error encountered. At the end of
+// the input it will return an empty sentence and io.EOF.
+type SentenceReader interface {
+	ReadSentence() (string, error)
+}
+
+// New returns a new SentenceReader
+func New(r io.Reader) SentenceReader {
+	return &sentenceReader{
+		Reader: bufio.NewReader(r),
+	}
+}
+
+// sentenceReader takes an input io.Reader and parses it into sentences using
+// the Unicode sentence-splitting algorithm in
+// <URL:http://unicode.org/reports/tr29/#Sentence_Boundaries>.
+type sentenceReader struct {
+	*bufio.Reader
+	Buf bytes.Buffer
+
+	// last and prev are the classes of the last two runes of Buf, ignoring
+	// Extend and Format, and lastLiteral that of the last rune including them
+	last, prev, lastLiteral class
+
+	// term is aTerm or sTerm when Buf ends with SATerm Close* Sp*, and spaced
+	// is then set once Sp has been read
+	term   class
+	spaced bool
+}
+
+// class is the sentence break property of a rune
+type class int
+
+const (
+	other class = iota
+	cr
+	lf
+	sep
+	extend
+	format
+	sp
+	lower
+	upper
+	oLetter
+	numeric
+	aTerm
+	sTerm
+	closePunct
+	sContinue
+)
+
+func classOf(r rune) class {
+	switch {
+	case r == '\r':
+		return cr
+	case r == '\n':
+		return lf
+	case unicode.In(r, tableSep):
+		return sep
+	case unicode.In(r, tableExtend):
+		return extend
+	case unicode.In(r, tableFormat):
+		return format
+	case unicode.In(r, tableSp):
+		return sp
+	case unicode.In(r, tableLower):
+		return lower
+	case unicode.In(r, tableUpper):
+		return upper
+	case unicode.In(r, tableOLetter):
+		return oLetter
+	case unicode.In(r, tableNumeric):
+		return numeric
+	case unicode.In(r, tableATerm):
+		return aTerm
+	case unicode.In(r, tableSTerm):
+		return sTerm
+	case unicode.In(r, tableClose):
+		return closePunct
+	case unicode.In(r, tableSContinue):
+		return sContinue
+	}
+	return other
+}
+
+func paraSep(c class) bool {
+	return c == sep || c == cr || c == lf
+}
+
+func saTerm(c class) bool {
+	return c == aTerm || c == sTerm
+}
+
+// ReadSentence returns a single sentence from a sentenceReader's source.
+func (sr *sentenceReader) ReadSentence() (string, error) {
+	for {
+		r, _, err := sr.ReadRune()
+		if err == io.EOF && sr.Buf.Len() > 0 {
+			return sr.emit(), nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		c := classOf(r)
+
+		if sr.Buf.Len() == 0 || sr.joins(c) {
+			sr.push(r, c)
+			continue
+		}
+
+		sentence := sr.emit()
+		sr.push(r, c)
+		return sentence, nil
+	}
+}
+
+// emit returns the sentence in Buf and starts the next
+func (sr *sentenceReader) emit() string {
+	sentence := sr.Buf.String()
+	sr.Buf.Reset()
+	sr.last, sr.prev, sr.lastLiteral = other, other, other
+	sr.term, sr.spaced = other, false
+	return sentence
+}
+
+// push adds r, of class c, to the sentence in Buf
+func (sr *sentenceReader) push(r rune, c class) {
+	_, _ = sr.Buf.WriteRune(r) // #nosec
+
+	sr.lastLiteral = c
+	if c == extend || c == format {
+		return
+	}
+
+	sr.prev, sr.last = sr.last, c
+
+	switch {
+	case saTerm(c):
+		sr.term, sr.spaced = c, false
+	case sr.term != other && c == closePunct && !sr.spaced:
+	case sr.term != other && c == sp:
+		sr.spaced = true
+	default:
+		sr.term = other
+	}
+}
+
+// joins reports whether a rune of class c continues the sentence in Buf
+func (sr *sentenceReader) joins(c class) bool {
+	switch {
+	// Break after paragraph separators, but not within CRLF.
+	case sr.lastLiteral == cr && c == lf:
+		// SB3	CR	×	LF
+		return true
+	case paraSep(sr.lastLiteral):
+		// SB4	ParaSep	÷
+		return false
+
+	// Ignore Format and Extend characters, except after sot, ParaSep, and
+	// within CRLF.
+	case c == extend || c == format:
+		// SB5	X (Extend | Format)*	→	X
+		return true
+
+	// Do not break after a full stop in certain contexts.
+	case sr.term == other:
+		// SB998	Any	×	Any
+		return true
+	case sr.last == aTerm && c == numeric:
+		// SB6	ATerm	×	Numeric
+		return true
+	case (sr.prev == upper || sr.prev == lower) && sr.last == aTerm && c == upper:
+		// SB7	(Upper | Lower) ATerm	×	Upper
+		return true
+	case sr.term == aTerm && sr.lowerFollows(c):
+		// SB8	ATerm Close* Sp*	×	( ¬(OLetter | Upper | Lower | ParaSep | SATerm) )* Lower
+		return true
+	case c == sContinue || saTerm(c):
+		// SB8a	SATerm Close* Sp*	×	(SContinue | SATerm)
+		return true
+
+	// Break after sentence terminators, but include closing punctuation,
+	// trailing spaces, and any paragraph separator.
+	case c == closePunct && !sr.spaced:
+		// SB9	SATerm Close*	×	(Close | Sp | ParaSep)
+		return true
+	case c == sp || paraSep(c):
+		// SB10	SATerm Close* Sp*	×	(Sp | ParaSep)
+		return true
+	default:
+		// SB11	SATerm Close* Sp* ParaSep?	÷
+		return false
+	}
+}
+
+// lowerFollows reports whether a Lower is next, starting with the rune of class
+// c just read, before any OLetter, Upper, ParaSep or SATerm. As many runes as
+// the buffer holds are looked at.
+func (sr *sentenceReader) lowerFollows(c class) bool {
+	for size := 64; ; size *= 2 {
+		next, err := sr.Peek(size)
+
+		for cur := c; ; {
+			switch {
+			case cur == lower:
+				return true
+			case cur == oLetter || cur == upper || paraSep(cur) || saTerm(cur):
+				return false
+			}
+
+			if !utf8.FullRune(next) {
+				break
+			}
+
+			r, n := utf8.DecodeRune(next)
+			next = next[n:]
+			cur = classOf(r)
+		}
+
+		if err != nil {
+			return false
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package sentencereader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadSentence(t *testing.T) {
+	for _, test := range []struct {
+		str       string
+		sentences []string
+	}{
+		{"", nil},
+		{"foo", []string{"foo"}},
+		{"This is a test. This is another.", []string{"This is a test. ", "This is another."}},
+
+		// http://unicode.org/reports/tr29/#SB3
+		// http://unicode.org/reports/tr29/#SB4
+		{"foo\r\nbar", []string{"foo\r\n", "bar"}},
+		{"foo\nbar baz", []string{"foo\n", "bar ", "baz"}},
+
+		// http://unicode.org/reports/tr29/#SB5
+		{"é. Bar", []string{"é. ", "Bar"}},
+		{"foo.́ Bar", []string{"foo.́ ", "Bar"}},
+
+		// http://unicode.org/reports/tr29/#SB6
+		{"It is 3.14 or so.", []string{"It is 3.14 or so."}},
+
+		// http://unicode.org/reports/tr29/#SB7
+		{"The U.S.A. Is big.", []string{"The U.S.A. ", "Is big."}},
+		{"The U.S.Army", []string{"The U.S.Army"}},
+
+		// http://unicode.org/reports/tr29/#SB8
+		{"He said etc. and left.", []string{"He said etc. and left."}},
+		{"See (p. 5) for more.", []string{"See (p. 5) for more."}},
+		{"Go (home.) now", []string{"Go (home.) now"}},
+		{"It ended. 5 Went", []string{"It ended. ", "5 Went"}},
+
+		// http://unicode.org/reports/tr29/#SB8a
+		{"Wait, what?! No.", []string{"Wait, what?! ", "No."}},
+		{"Foo., bar", []string{"Foo., bar"}},
+
+		// http://unicode.org/reports/tr29/#SB9
+		// http://unicode.org/reports/tr29/#SB10
+		{"\"Hello!\" He left.", []string{"\"Hello!\" ", "He left."}},
+		{"Hello.)  \nBye", []string{"Hello.)  \n", "Bye"}},
+		{"The end.\r\n", []string{"The end.\r\n"}},
+
+		// http://unicode.org/reports/tr29/#SB11
+		{"これはテスト。次です。", []string{"これはテスト。", "次です。"}},
+		{"Item 3.Next", []string{"Item 3.", "Next"}},
+	} {
+		sr := New(strings.NewReader(test.str))
+
+		var sentences []string
+		for {
+			sentence, err := sr.ReadSentence()
+			if err == io.EOF {
+				if sentence != "" {
+					t.Errorf("%q: sentence %q with io.EOF", test.str, sentence)
+				}
+				break
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sentences = append(sentences, sentence)
+		}
+
+		if !reflect.DeepEqual(sentences, test.sentences) {
+			t.Errorf("%q: %q != %q", test.str, sentences, test.sentences)
+		}
+	}
+}
@@ -0,0 +1,2589 @@
+package sentencereader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode"
+
+var (
+	tableATerm = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x002E, Hi: 0x002E, Stride: 1},
+			{Lo: 0x2024, Hi: 0x2024, Stride: 1},
+			{Lo: 0xFE52, Hi: 0xFE52, Stride: 1},
+			{Lo: 0xFF0E, Hi: 0xFF0E, Stride: 1},
+		},
+	}
+
+	tableClose = &unicode.RangeTable{
+		LatinOffset: 8,
+		R16: []unicode.Range16{
+			{Lo: 0x0022, Hi: 0x0022, Stride: 1},
+			{Lo: 0x0027, Hi: 0x0029, Stride: 1},
+			{Lo: 0x005B, Hi: 0x005B, Stride: 1},
+			{Lo: 0x005D, Hi: 0x005D, Stride: 1},
+			{Lo: 0x007B, Hi: 0x007B, Stride: 1},
+			{Lo: 0x007D, Hi: 0x007D, Stride: 1},
+			{Lo: 0x00AB, Hi: 0x00AB, Stride: 1},
+			{Lo: 0x00BB, Hi: 0x00BB, Stride: 1},
+			{Lo: 0x0F3A, Hi: 0x0F3D, Stride: 1},
+			{Lo: 0x169B, Hi: 0x169C, Stride: 1},
+			{Lo: 0x2018, Hi: 0x201F, Stride: 1},
+			{Lo: 0x2039, Hi: 0x203A, Stride: 1},
+			{Lo: 0x2045, Hi: 0x2046, Stride: 1},
+			{Lo: 0x207D, Hi: 0x207E, Stride: 1},
+			{Lo: 0x208D, Hi: 0x208E, Stride: 1},
+			{Lo: 0x2308, Hi: 0x230B, Stride: 1},
+			{Lo: 0x2329, Hi: 0x232A, Stride: 1},
+			{Lo: 0x275B, Hi: 0x2760, Stride: 1},
+			{Lo: 0x2768, Hi: 0x2775, Stride: 1},
+			{Lo: 0x27C5, Hi: 0x27C6, Stride: 1},
+			{Lo: 0x27E6, Hi: 0x27EF, Stride: 1},
+			{Lo: 0x2983, Hi: 0x2998, Stride: 1},
+			{Lo: 0x29D8, Hi: 0x29DB, Stride: 1},
+			{Lo: 0x29FC, Hi: 0x29FD, Stride: 1},
+			{Lo: 0x2E02, Hi: 0x2E05, Stride: 1},
+			{Lo: 0x2E09, Hi: 0x2E0D, Stride: 1},
+			{Lo: 0x2E1C, Hi: 0x2E1D, Stride: 1},
+			{Lo: 0x2E20, Hi: 0x2E29, Stride: 1},
+			{Lo: 0x2E42, Hi: 0x2E42, Stride: 1},
+			{Lo: 0x2E55, Hi: 0x2E5C, Stride: 1},
+			{Lo: 0x3008, Hi: 0x3011, Stride: 1},
+			{Lo: 0x3014, Hi: 0x301B, Stride: 1},
+			{Lo: 0x301D, Hi: 0x301F, Stride: 1},
+			{Lo: 0xFD3E, Hi: 0xFD3F, Stride: 1},
+			{Lo: 0xFE17, Hi: 0xFE18, Stride: 1},
+			{Lo: 0xFE35, Hi: 0xFE44, Stride: 1},
+			{Lo: 0xFE47, Hi: 0xFE48, Stride: 1},
+			{Lo: 0xFE59, Hi: 0xFE5E, Stride: 1},
+			{Lo: 0xFF08, Hi: 0xFF09, Stride: 1},
+			{Lo: 0xFF3B, Hi: 0xFF3B, Stride: 1},
+			{Lo: 0xFF3D, Hi: 0xFF3D, Stride: 1},
+			{Lo: 0xFF5B, Hi: 0xFF5B, Stride: 1},
+			{Lo: 0xFF5D, Hi: 0xFF5D, Stride: 1},
+			{Lo: 0xFF5F, Hi: 0xFF60, Stride: 1},
+			{Lo: 0xFF62, Hi: 0xFF63, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1F676, Hi: 0x1F678, Stride: 1},
+		},
+	}
+
+	tableExtend = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0300, Hi: 0x036F, Stride: 1},
+			{Lo: 0x0483, Hi: 0x0489, Stride: 1},
+			{Lo: 0x0591, Hi: 0x05BD, Stride: 1},
+			{Lo: 0x05BF, Hi: 0x05BF, Stride: 1},
+			{Lo: 0x05C1, Hi: 0x05C2, Stride: 1},
+			{Lo: 0x05C4, Hi: 0x05C5, Stride: 1},
+			{Lo: 0x05C7, Hi: 0x05C7, Stride: 1},
+			{Lo: 0x0610, Hi: 0x061A, Stride: 1},
+			{Lo: 0x064B, Hi: 0x065F, Stride: 1},
+			{Lo: 0x0670, Hi: 0x0670, Stride: 1},
+			{Lo: 0x06D6, Hi: 0x06DC, Stride: 1},
+			{Lo: 0x06DF, Hi: 0x06E4, Stride: 1},
+			{Lo: 0x06E7, Hi: 0x06E8, Stride: 1},
+			{Lo: 0x06EA, Hi: 0x06ED, Stride: 1},
+			{Lo: 0x0711, Hi: 0x0711, Stride: 1},
+			{Lo: 0x0730, Hi: 0x074A, Stride: 1},
+			{Lo: 0x07A6, Hi: 0x07B0, Stride: 1},
+			{Lo: 0x07EB, Hi: 0x07F3, Stride: 1},
+			{Lo: 0x07FD, Hi: 0x07FD, Stride: 1},
+			{Lo: 0x0816, Hi: 0x0819, Stride: 1},
+			{Lo: 0x081B, Hi: 0x0823, Stride: 1},
+			{Lo: 0x0825, Hi: 0x0827, Stride: 1},
+			{Lo: 0x0829, Hi: 0x082D, Stride: 1},
+			{Lo: 0x0859, Hi: 0x085B, Stride: 1},
+			{Lo: 0x0897, Hi: 0x089F, Stride: 1},
+			{Lo: 0x08CA, Hi: 0x08E1, Stride: 1},
+			{Lo: 0x08E3, Hi: 0x0903, Stride: 1},
+			{Lo: 0x093A, Hi: 0x093C, Stride: 1},
+			{Lo: 0x093E, Hi: 0x094F, Stride: 1},
+			{Lo: 0x0951, Hi: 0x0957, Stride: 1},
+			{Lo: 0x0962, Hi: 0x0963, Stride: 1},
+			{Lo: 0x0981, Hi: 0x0983, Stride: 1},
+			{Lo: 0x09BC, Hi: 0x09BC, Stride: 1},
+			{Lo: 0x09BE, Hi: 0x09C4, Stride: 1},
+			{Lo: 0x09C7, Hi: 0x09C8, Stride: 1},
+			{Lo: 0x09CB, Hi: 0x09CD, Stride: 1},
+			{Lo: 0x09D7, Hi: 0x09D7, Stride: 1},
+			{Lo: 0x09E2, Hi: 0x09E3, Stride: 1},
+			{Lo: 0x09FE, Hi: 0x09FE, Stride: 1},
+			{Lo: 0x0A01, Hi: 0x0A03, Stride: 1},
+			{Lo: 0x0A3C, Hi: 0x0A3C, Stride: 1},
+			{Lo: 0x0A3E, Hi: 0x0A42, Stride: 1},
+			{Lo: 0x0A47, Hi: 0x0A48, Stride: 1},
+			{Lo: 0x0A4B, Hi: 0x0A4D, Stride: 1},
+			{Lo: 0x0A51, Hi: 0x0A51, Stride: 1},
+			{Lo: 0x0A70, Hi: 0x0A71, Stride: 1},
+			{Lo: 0x0A75, Hi: 0x0A75, Stride: 1},
+			{Lo: 0x0A81, Hi: 0x0A83, Stride: 1},
+			{Lo: 0x0ABC, Hi: 0x0ABC, Stride: 1},
+			{Lo: 0x0ABE, Hi: 0x0AC5, Stride: 1},
+			{Lo: 0x0AC7, Hi: 0x0AC9, Stride: 1},
+			{Lo: 0x0ACB, Hi: 0x0ACD, Stride: 1},
+			{Lo: 0x0AE2, Hi: 0x0AE3, Stride: 1},
+			{Lo: 0x0AFA, Hi: 0x0AFF, Stride: 1},
+			{Lo: 0x0B01, Hi: 0x0B03, Stride: 1},
+			{Lo: 0x0B3C, Hi: 0x0B3C, Stride: 1},
+			{Lo: 0x0B3E, Hi: 0x0B44, Stride: 1},
+			{Lo: 0x0B47, Hi: 0x0B48, Stride: 1},
+			{Lo: 0x0B4B, Hi: 0x0B4D, Stride: 1},
+			{Lo: 0x0B55, Hi: 0x0B57, Stride: 1},
+			{Lo: 0x0B62, Hi: 0x0B63, Stride: 1},
+			{Lo: 0x0B82, Hi: 0x0B82, Stride: 1},
+			{Lo: 0x0BBE, Hi: 0x0BC2, Stride: 1},
+			{Lo: 0x0BC6, Hi: 0x0BC8, Stride: 1},
+			{Lo: 0x0BCA, Hi: 0x0BCD, Stride: 1},
+			{Lo: 0x0BD7, Hi: 0x0BD7, Stride: 1},
+			{Lo: 0x0C00, Hi: 0x0C04, Stride: 1},
+			{Lo: 0x0C3C, Hi: 0x0C3C, Stride: 1},
+			{Lo: 0x0C3E, Hi: 0x0C44, Stride: 1},
+			{Lo: 0x0C46, Hi: 0x0C48, Stride: 1},
+			{Lo: 0x0C4A, Hi: 0x0C4D, Stride: 1},
+			{Lo: 0x0C55, Hi: 0x0C56, Stride: 1},
+			{Lo: 0x0C62, Hi: 0x0C63, Stride: 1},
+			{Lo: 0x0C81, Hi: 0x0C83, Stride: 1},
+			{Lo: 0x0CBC, Hi: 0x0CBC, Stride: 1},
+			{Lo: 0x0CBE, Hi: 0x0CC4, Stride: 1},
+			{Lo: 0x0CC6, Hi: 0x0CC8, Stride: 1},
+			{Lo: 0x0CCA, Hi: 0x0CCD, Stride: 1},
+			{Lo: 0x0CD5, Hi: 0x0CD6, Stride: 1},
+			{Lo: 0x0CE2, Hi: 0x0CE3, Stride: 1},
+			{Lo: 0x0CF3, Hi: 0x0CF3, Stride: 1},
+			{Lo: 0x0D00, Hi: 0x0D03, Stride: 1},
+			{Lo: 0x0D3B, Hi: 0x0D3C, Stride: 1},
+			{Lo: 0x0D3E, Hi: 0x0D44, Stride: 1},
+			{Lo: 0x0D46, Hi: 0x0D48, Stride: 1},
+			{Lo: 0x0D4A, Hi: 0x0D4D, Stride: 1},
+			{Lo: 0x0D57, Hi: 0x0D57, Stride: 1},
+			{Lo: 0x0D62, Hi: 0x0D63, Stride: 1},
+			{Lo: 0x0D81, Hi: 0x0D83, Stride: 1},
+			{Lo: 0x0DCA, Hi: 0x0DCA, Stride: 1},
+			{Lo: 0x0DCF, Hi: 0x0DD4, Stride: 1},
+			{Lo: 0x0DD6, Hi: 0x0DD6, Stride: 1},
+			{Lo: 0x0DD8, Hi: 0x0DDF, Stride: 1},
+			{Lo: 0x0DF2, Hi: 0x0DF3, Stride: 1},
+			{Lo: 0x0E31, Hi: 0x0E31, Stride: 1},
+			{Lo: 0x0E34, Hi: 0x0E3A, Stride: 1},
+			{Lo: 0x0E47, Hi: 0x0E4E, Stride: 1},
+			{Lo: 0x0EB1, Hi: 0x0EB1, Stride: 1},
+			{Lo: 0x0EB4, Hi: 0x0EBC, Stride: 1},
+			{Lo: 0x0EC8, Hi: 0x0ECE, Stride: 1},
+			{Lo: 0x0F18, Hi: 0x0F19, Stride: 1},
+			{Lo: 0x0F35, Hi: 0x0F35, Stride: 1},
+			{Lo: 0x0F37, Hi: 0x0F37, Stride: 1},
+			{Lo: 0x0F39, Hi: 0x0F39, Stride: 1},
+			{Lo: 0x0F3E, Hi: 0x0F3F, Stride: 1},
+			{Lo: 0x0F71, Hi: 0x0F84, Stride: 1},
+			{Lo: 0x0F86, Hi: 0x0F87, Stride: 1},
+			{Lo: 0x0F8D, Hi: 0x0F97, Stride: 1},
+			{Lo: 0x0F99, Hi: 0x0FBC, Stride: 1},
+			{Lo: 0x0FC6, Hi: 0x0FC6, Stride: 1},
+			{Lo: 0x102B, Hi: 0x103E, Stride: 1},
+			{Lo: 0x1056, Hi: 0x1059, Stride: 1},
+			{Lo: 0x105E, Hi: 0x1060, Stride: 1},
+			{Lo: 0x1062, Hi: 0x1064, Stride: 1},
+			{Lo: 0x1067, Hi: 0x106D, Stride: 1},
+			{Lo: 0x1071, Hi: 0x1074, Stride: 1},
+			{Lo: 0x1082, Hi: 0x108D, Stride: 1},
+			{Lo: 0x108F, Hi: 0x108F, Stride: 1},
+			{Lo: 0x109A, Hi: 0x109D, Stride: 1},
+			{Lo: 0x135D, Hi: 0x135F, Stride: 1},
+			{Lo: 0x1712, Hi: 0x1715, Stride: 1},
+			{Lo: 0x1732, Hi: 0x1734, Stride: 1},
+			{Lo: 0x1752, Hi: 0x1753, Stride: 1},
+			{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+			{Lo: 0x17B4, Hi: 0x17D3, Stride: 1},
+			{Lo: 0x17DD, Hi: 0x17DD, Stride: 1},
+			{Lo: 0x180B, Hi: 0x180D, Stride: 1},
+			{Lo: 0x180F, Hi: 0x180F, Stride: 1},
+			{Lo: 0x1885, Hi: 0x1886, Stride: 1},
+			{Lo: 0x18A9, Hi: 0x18A9, Stride: 1},
+			{Lo: 0x1920, Hi: 0x192B, Stride: 1},
+			{Lo: 0x1930, Hi: 0x193B, Stride: 1},
+			{Lo: 0x1A17, Hi: 0x1A1B, Stride: 1},
+			{Lo: 0x1A55, Hi: 0x1A5E, Stride: 1},
+			{Lo: 0x1A60, Hi: 0x1A7C, Stride: 1},
+			{Lo: 0x1A7F, Hi: 0x1A7F, Stride: 1},
+			{Lo: 0x1AB0, Hi: 0x1ADD, Stride: 1},
+			{Lo: 0x1AE0, Hi: 0x1AEB, Stride: 1},
+			{Lo: 0x1B00, Hi: 0x1B04, Stride: 1},
+			{Lo: 0x1B34, Hi: 0x1B44, Stride: 1},
+			{Lo: 0x1B6B, Hi: 0x1B73, Stride: 1},
+			{Lo: 0x1B80, Hi: 0x1B82, Stride: 1},
+			{Lo: 0x1BA1, Hi: 0x1BAD, Stride: 1},
+			{Lo: 0x1BE6, Hi: 0x1BF3, Stride: 1},
+			{Lo: 0x1C24, Hi: 0x1C37, Stride: 1},
+			{Lo: 0x1CD0, Hi: 0x1CD2, Stride: 1},
+			{Lo: 0x1CD4, Hi: 0x1CE8, Stride: 1},
+			{Lo: 0x1CED, Hi: 0x1CED, Stride: 1},
+			{Lo: 0x1CF4, Hi: 0x1CF4, Stride: 1},
+			{Lo: 0x1CF7, Hi: 0x1CF9, Stride: 1},
+			{Lo: 0x1DC0, Hi: 0x1DFF, Stride: 1},
+			{Lo: 0x200C, Hi: 0x200D, Stride: 1},
+			{Lo: 0x20D0, Hi: 0x20F0, Stride: 1},
+			{Lo: 0x2CEF, Hi: 0x2CF1, Stride: 1},
+			{Lo: 0x2D7F, Hi: 0x2D7F, Stride: 1},
+			{Lo: 0x2DE0, Hi: 0x2DFF, Stride: 1},
+			{Lo: 0x302A, Hi: 0x302F, Stride: 1},
+			{Lo: 0x3099, Hi: 0x309A, Stride: 1},
+			{Lo: 0xA66F, Hi: 0xA672, Stride: 1},
+			{Lo: 0xA674, Hi: 0xA67D, Stride: 1},
+			{Lo: 0xA69E, Hi: 0xA69F, Stride: 1},
+			{Lo: 0xA6F0, Hi: 0xA6F1, Stride: 1},
+			{Lo: 0xA802, Hi: 0xA802, Stride: 1},
+			{Lo: 0xA806, Hi: 0xA806, Stride: 1},
+			{Lo: 0xA80B, Hi: 0xA80B, Stride: 1},
+			{Lo: 0xA823, Hi: 0xA827, Stride: 1},
+			{Lo: 0xA82C, Hi: 0xA82C, Stride: 1},
+			{Lo: 0xA880, Hi: 0xA881, Stride: 1},
+			{Lo: 0xA8B4, Hi: 0xA8C5, Stride: 1},
+			{Lo: 0xA8E0, Hi: 0xA8F1, Stride: 1},
+			{Lo: 0xA8FF, Hi: 0xA8FF, Stride: 1},
+			{Lo: 0xA926, Hi: 0xA92D, Stride: 1},
+			{Lo: 0xA947, Hi: 0xA953, Stride: 1},
+			{Lo: 0xA980, Hi: 0xA983, Stride: 1},
+			{Lo: 0xA9B3, Hi: 0xA9C0, Stride: 1},
+			{Lo: 0xA9E5, Hi: 0xA9E5, Stride: 1},
+			{Lo: 0xAA29, Hi: 0xAA36, Stride: 1},
+			{Lo: 0xAA43, Hi: 0xAA43, Stride: 1},
+			{Lo: 0xAA4C, Hi: 0xAA4D, Stride: 1},
+			{Lo: 0xAA7B, Hi: 0xAA7D, Stride: 1},
+			{Lo: 0xAAB0, Hi: 0xAAB0, Stride: 1},
+			{Lo: 0xAAB2, Hi: 0xAAB4, Stride: 1},
+			{Lo: 0xAAB7, Hi: 0xAAB8, Stride: 1},
+			{Lo: 0xAABE, Hi: 0xAABF, Stride: 1},
+			{Lo: 0xAAC1, Hi: 0xAAC1, Stride: 1},
+			{Lo: 0xAAEB, Hi: 0xAAEF, Stride: 1},
+			{Lo: 0xAAF5, Hi: 0xAAF6, Stride: 1},
+			{Lo: 0xABE3, Hi: 0xABEA, Stride: 1},
+			{Lo: 0xABEC, Hi: 0xABED, Stride: 1},
+			{Lo: 0xFB1E, Hi: 0xFB1E, Stride: 1},
+			{Lo: 0xFE00, Hi: 0xFE0F, Stride: 1},
+			{Lo: 0xFE20, Hi: 0xFE2F, Stride: 1},
+			{Lo: 0xFF9E, Hi: 0xFF9F, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x101FD, Hi: 0x101FD, Stride: 1},
+			{Lo: 0x102E0, Hi: 0x102E0, Stride: 1},
+			{Lo: 0x10376, Hi: 0x1037A, Stride: 1},
+			{Lo: 0x10A01, Hi: 0x10A03, Stride: 1},
+			{Lo: 0x10A05, Hi: 0x10A06, Stride: 1},
+			{Lo: 0x10A0C, Hi: 0x10A0F, Stride: 1},
+			{Lo: 0x10A38, Hi: 0x10A3A, Stride: 1},
+			{Lo: 0x10A3F, Hi: 0x10A3F, Stride: 1},
+			{Lo: 0x10AE5, Hi: 0x10AE6, Stride: 1},
+			{Lo: 0x10D24, Hi: 0x10D27, Stride: 1},
+			{Lo: 0x10D69, Hi: 0x10D6D, Stride: 1},
+			{Lo: 0x10EAB, Hi: 0x10EAC, Stride: 1},
+			{Lo: 0x10EFA, Hi: 0x10EFF, Stride: 1},
+			{Lo: 0x10F46, Hi: 0x10F50, Stride: 1},
+			{Lo: 0x10F82, Hi: 0x10F85, Stride: 1},
+			{Lo: 0x11000, Hi: 0x11002, Stride: 1},
+			{Lo: 0x11038, Hi: 0x11046, Stride: 1},
+			{Lo: 0x11070, Hi: 0x11070, Stride: 1},
+			{Lo: 0x11073, Hi: 0x11074, Stride: 1},
+			{Lo: 0x1107F, Hi: 0x11082, Stride: 1},
+			{Lo: 0x110B0, Hi: 0x110BA, Stride: 1},
+			{Lo: 0x110C2, Hi: 0x110C2, Stride: 1},
+			{Lo: 0x11100, Hi: 0x11102, Stride: 1},
+			{Lo: 0x11127, Hi: 0x11134, Stride: 1},
+			{Lo: 0x11145, Hi: 0x11146, Stride: 1},
+			{Lo: 0x11173, Hi: 0x11173, Stride: 1},
+			{Lo: 0x11180, Hi: 0x11182, Stride: 1},
+			{Lo: 0x111B3, Hi: 0x111C0, Stride: 1},
+			{Lo: 0x111C9, Hi: 0x111CC, Stride: 1},
+			{Lo: 0x111CE, Hi: 0x111CF, Stride: 1},
+			{Lo: 0x1122C, Hi: 0x11237, Stride: 1},
+			{Lo: 0x1123E, Hi: 0x1123E, Stride: 1},
+			{Lo: 0x11241, Hi: 0x11241, Stride: 1},
+			{Lo: 0x112DF, Hi: 0x112EA, Stride: 1},
+			{Lo: 0x11300, Hi: 0x11303, Stride: 1},
+			{Lo: 0x1133B, Hi: 0x1133C, Stride: 1},
+			{Lo: 0x1133E, Hi: 0x11344, Stride: 1},
+			{Lo: 0x11347, Hi: 0x11348, Stride: 1},
+			{Lo: 0x1134B, Hi: 0x1134D, Stride: 1},
+			{Lo: 0x11357, Hi: 0x11357, Stride: 1},
+			{Lo: 0x11362, Hi: 0x11363, Stride: 1},
+			{Lo: 0x11366, Hi: 0x1136C, Stride: 1},
+			{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+			{Lo: 0x113B8, Hi: 0x113C0, Stride: 1},
+			{Lo: 0x113C2, Hi: 0x113C2, Stride: 1},
+			{Lo: 0x113C5, Hi: 0x113C5, Stride: 1},
+			{Lo: 0x113C7, Hi: 0x113CA, Stride: 1},
+			{Lo: 0x113CC, Hi: 0x113D0, Stride: 1},
+			{Lo: 0x113D2, Hi: 0x113D2, Stride: 1},
+			{Lo: 0x113E1, Hi: 0x113E2, Stride: 1},
+			{Lo: 0x11435, Hi: 0x11446, Stride: 1},
+			{Lo: 0x1145E, Hi: 0x1145E, Stride: 1},
+			{Lo: 0x114B0, Hi: 0x114C3, Stride: 1},
+			{Lo: 0x115AF, Hi: 0x115B5, Stride: 1},
+			{Lo: 0x115B8, Hi: 0x115C0, Stride: 1},
+			{Lo: 0x115DC, Hi: 0x115DD, Stride: 1},
+			{Lo: 0x11630, Hi: 0x11640, Stride: 1},
+			{Lo: 0x116AB, Hi: 0x116B7, Stride: 1},
+			{Lo: 0x1171D, Hi: 0x1172B, Stride: 1},
+			{Lo: 0x1182C, Hi: 0x1183A, Stride: 1},
+			{Lo: 0x11930, Hi: 0x11935, Stride: 1},
+			{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+			{Lo: 0x1193B, Hi: 0x1193E, Stride: 1},
+			{Lo: 0x11940, Hi: 0x11940, Stride: 1},
+			{Lo: 0x11942, Hi: 0x11943, Stride: 1},
+			{Lo: 0x119D1, Hi: 0x119D7, Stride: 1},
+			{Lo: 0x119DA, Hi: 0x119E0, Stride: 1},
+			{Lo: 0x119E4, Hi: 0x119E4, Stride: 1},
+			{Lo: 0x11A01, Hi: 0x11A0A, Stride: 1},
+			{Lo: 0x11A33, Hi: 0x11A39, Stride: 1},
+			{Lo: 0x11A3B, Hi: 0x11A3E, Stride: 1},
+			{Lo: 0x11A47, Hi: 0x11A47, Stride: 1},
+			{Lo: 0x11A51, Hi: 0x11A5B, Stride: 1},
+			{Lo: 0x11A8A, Hi: 0x11A99, Stride: 1},
+			{Lo: 0x11B60, Hi: 0x11B67, Stride: 1},
+			{Lo: 0x11C2F, Hi: 0x11C36, Stride: 1},
+			{Lo: 0x11C38, Hi: 0x11C3F, Stride: 1},
+			{Lo: 0x11C92, Hi: 0x11CA7, Stride: 1},
+			{Lo: 0x11CA9, Hi: 0x11CB6, Stride: 1},
+			{Lo: 0x11D31, Hi: 0x11D36, Stride: 1},
+			{Lo: 0x11D3A, Hi: 0x11D3A, Stride: 1},
+			{Lo: 0x11D3C, Hi: 0x11D3D, Stride: 1},
+			{Lo: 0x11D3F, Hi: 0x11D45, Stride: 1},
+			{Lo: 0x11D47, Hi: 0x11D47, Stride: 1},
+			{Lo: 0x11D8A, Hi: 0x11D8E, Stride: 1},
+			{Lo: 0x11D90, Hi: 0x11D91, Stride: 1},
+			{Lo: 0x11D93, Hi: 0x11D97, Stride: 1},
+			{Lo: 0x11EF3, Hi: 0x11EF6, Stride: 1},
+			{Lo: 0x11F00, Hi: 0x11F01, Stride: 1},
+			{Lo: 0x11F03, Hi: 0x11F03, Stride: 1},
+			{Lo: 0x11F34, Hi: 0x11F3A, Stride: 1},
+			{Lo: 0x11F3E, Hi: 0x11F42, Stride: 1},
+			{Lo: 0x11F5A, Hi: 0x11F5A, Stride: 1},
+			{Lo: 0x13440, Hi: 0x13440, Stride: 1},
+			{Lo: 0x13447, Hi: 0x13455, Stride: 1},
+			{Lo: 0x1611E, Hi: 0x1612F, Stride: 1},
+			{Lo: 0x16AF0, Hi: 0x16AF4, Stride: 1},
+			{Lo: 0x16B30, Hi: 0x16B36, Stride: 1},
+			{Lo: 0x16F4F, Hi: 0x16F4F, Stride: 1},
+			{Lo: 0x16F51, Hi: 0x16F87, Stride: 1},
+			{Lo: 0x16F8F, Hi: 0x16F92, Stride: 1},
+			{Lo: 0x16FE4, Hi: 0x16FE4, Stride: 1},
+			{Lo: 0x16FF0, Hi: 0x16FF1, Stride: 1},
+			{Lo: 0x1BC9D, Hi: 0x1BC9E, Stride: 1},
+			{Lo: 0x1CF00, Hi: 0x1CF2D, Stride: 1},
+			{Lo: 0x1CF30, Hi: 0x1CF46, Stride: 1},
+			{Lo: 0x1D165, Hi: 0x1D169, Stride: 1},
+			{Lo: 0x1D16D, Hi: 0x1D172, Stride: 1},
+			{Lo: 0x1D17B, Hi: 0x1D182, Stride: 1},
+			{Lo: 0x1D185, Hi: 0x1D18B, Stride: 1},
+			{Lo: 0x1D1AA, Hi: 0x1D1AD, Stride: 1},
+			{Lo: 0x1D242, Hi: 0x1D244, Stride: 1},
+			{Lo: 0x1DA00, Hi: 0x1DA36, Stride: 1},
+			{Lo: 0x1DA3B, Hi: 0x1DA6C, Stride: 1},
+			{Lo: 0x1DA75, Hi: 0x1DA75, Stride: 1},
+			{Lo: 0x1DA84, Hi: 0x1DA84, Stride: 1},
+			{Lo: 0x1DA9B, Hi: 0x1DA9F, Stride: 1},
+			{Lo: 0x1DAA1, Hi: 0x1DAAF, Stride: 1},
+			{Lo: 0x1E000, Hi: 0x1E006, Stride: 1},
+			{Lo: 0x1E008, Hi: 0x1E018, Stride: 1},
+			{Lo: 0x1E01B, Hi: 0x1E021, Stride: 1},
+			{Lo: 0x1E023, Hi: 0x1E024, Stride: 1},
+			{Lo: 0x1E026, Hi: 0x1E02A, Stride: 1},
+			{Lo: 0x1E08F, Hi: 0x1E08F, Stride: 1},
+			{Lo: 0x1E130, Hi: 0x1E136, Stride: 1},
+			{Lo: 0x1E2AE, Hi: 0x1E2AE, Stride: 1},
+			{Lo: 0x1E2EC, Hi: 0x1E2EF, Stride: 1},
+			{Lo: 0x1E4EC, Hi: 0x1E4EF, Stride: 1},
+			{Lo: 0x1E5EE, Hi: 0x1E5EF, Stride: 1},
+			{Lo: 0x1E6E3, Hi: 0x1E6E3, Stride: 1},
+			{Lo: 0x1E6E6, Hi: 0x1E6E6, Stride: 1},
+			{Lo: 0x1E6EE, Hi: 0x1E6EF, Stride: 1},
+			{Lo: 0x1E6F5, Hi: 0x1E6F5, Stride: 1},
+			{Lo: 0x1E8D0, Hi: 0x1E8D6, Stride: 1},
+			{Lo: 0x1E944, Hi: 0x1E94A, Stride: 1},
+			{Lo: 0xE0020, Hi: 0xE007F, Stride: 1},
+			{Lo: 0xE0100, Hi: 0xE01EF, Stride: 1},
+		},
+	}
+
+	tableFormat = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x00AD, Hi: 0x00AD, Stride: 1},
+			{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+			{Lo: 0x061C, Hi: 0x061C, Stride: 1},
+			{Lo: 0x06DD, Hi: 0x06DD, Stride: 1},
+			{Lo: 0x070F, Hi: 0x070F, Stride: 1},
+			{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+			{Lo: 0x08E2, Hi: 0x08E2, Stride: 1},
+			{Lo: 0x180E, Hi: 0x180E, Stride: 1},
+			{Lo: 0x200B, Hi: 0x200B, Stride: 1},
+			{Lo: 0x200E, Hi: 0x200F, Stride: 1},
+			{Lo: 0x202A, Hi: 0x202E, Stride: 1},
+			{Lo: 0x2060, Hi: 0x2064, Stride: 1},
+			{Lo: 0x2066, Hi: 0x206F, Stride: 1},
+			{Lo: 0xFEFF, Hi: 0xFEFF, Stride: 1},
+			{Lo: 0xFFF9, Hi: 0xFFFB, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x110BD, Hi: 0x110BD, Stride: 1},
+			{Lo: 0x110CD, Hi: 0x110CD, Stride: 1},
+			{Lo: 0x13430, Hi: 0x1343F, Stride: 1},
+			{Lo: 0x1BCA0, Hi: 0x1BCA3, Stride: 1},
+			{Lo: 0x1D173, Hi: 0x1D17A, Stride: 1},
+			{Lo: 0xE0001, Hi: 0xE0001, Stride: 1},
+			{Lo: 0xE0020, Hi: 0xE007F, Stride: 1},
+		},
+	}
+
+	tableLower = &unicode.RangeTable{
+		LatinOffset: 6,
+		R16: []unicode.Range16{
+			{Lo: 0x0061, Hi: 0x007A, Stride: 1},
+			{Lo: 0x00AA, Hi: 0x00AA, Stride: 1},
+			{Lo: 0x00B5, Hi: 0x00B5, Stride: 1},
+			{Lo: 0x00BA, Hi: 0x00BA, Stride: 1},
+			{Lo: 0x00DF, Hi: 0x00F6, Stride: 1},
+			{Lo: 0x00F8, Hi: 0x00FF, Stride: 1},
+			{Lo: 0x0101, Hi: 0x0101, Stride: 1},
+			{Lo: 0x0103, Hi: 0x0103, Stride: 1},
+			{Lo: 0x0105, Hi: 0x0105, Stride: 1},
+			{Lo: 0x0107, Hi: 0x0107, Stride: 1},
+			{Lo: 0x0109, Hi: 0x0109, Stride: 1},
+			{Lo: 0x010B, Hi: 0x010B, Stride: 1},
+			{Lo: 0x010D, Hi: 0x010D, Stride: 1},
+			{Lo: 0x010F, Hi: 0x010F, Stride: 1},
+			{Lo: 0x0111, Hi: 0x0111, Stride: 1},
+			{Lo: 0x0113, Hi: 0x0113, Stride: 1},
+			{Lo: 0x0115, Hi: 0x0115, Stride: 1},
+			{Lo: 0x0117, Hi: 0x0117, Stride: 1},
+			{Lo: 0x0119, Hi: 0x0119, Stride: 1},
+			{Lo: 0x011B, Hi: 0x011B, Stride: 1},
+			{Lo: 0x011D, Hi: 0x011D, Stride: 1},
+			{Lo: 0x011F, Hi: 0x011F, Stride: 1},
+			{Lo: 0x0121, Hi: 0x0121, Stride: 1},
+			{Lo: 0x0123, Hi: 0x0123, Stride: 1},
+			{Lo: 0x0125, Hi: 0x0125, Stride: 1},
+			{Lo: 0x0127, Hi: 0x0127, Stride: 1},
+			{Lo: 0x0129, Hi: 0x0129, Stride: 1},
+			{Lo: 0x012B, Hi: 0x012B, Stride: 1},
+			{Lo: 0x012D, Hi: 0x012D, Stride: 1},
+			{Lo: 0x012F, Hi: 0x012F, Stride: 1},
+			{Lo: 0x0131, Hi: 0x0131, Stride: 1},
+			{Lo: 0x0133, Hi: 0x0133, Stride: 1},
+			{Lo: 0x0135, Hi: 0x0135, Stride: 1},
+			{Lo: 0x0137, Hi: 0x0138, Stride: 1},
+			{Lo: 0x013A, Hi: 0x013A, Stride: 1},
+			{Lo: 0x013C, Hi: 0x013C, Stride: 1},
+			{Lo: 0x013E, Hi: 0x013E, Stride: 1},
+			{Lo: 0x0140, Hi: 0x0140, Stride: 1},
+			{Lo: 0x0142, Hi: 0x0142, Stride: 1},
+			{Lo: 0x0144, Hi: 0x0144, Stride: 1},
+			{Lo: 0x0146, Hi: 0x0146, Stride: 1},
+			{Lo: 0x0148, Hi: 0x0149, Stride: 1},
+			{Lo: 0x014B, Hi: 0x014B, Stride: 1},
+			{Lo: 0x014D, Hi: 0x014D, Stride: 1},
+			{Lo: 0x014F, Hi: 0x014F, Stride: 1},
+			{Lo: 0x0151, Hi: 0x0151, Stride: 1},
+			{Lo: 0x0153, Hi: 0x0153, Stride: 1},
+			{Lo: 0x0155, Hi: 0x0155, Stride: 1},
+			{Lo: 0x0157, Hi: 0x0157, Stride: 1},
+			{Lo: 0x0159, Hi: 0x0159, Stride: 1},
+			{Lo: 0x015B, Hi: 0x015B, Stride: 1},
+			{Lo: 0x015D, Hi: 0x015D, Stride: 1},
+			{Lo: 0x015F, Hi: 0x015F, Stride: 1},
+			{Lo: 0x0161, Hi: 0x0161, Stride: 1},
+			{Lo: 0x0163, Hi: 0x0163, Stride: 1},
+			{Lo: 0x0165, Hi: 0x0165, Stride: 1},
+			{Lo: 0x0167, Hi: 0x0167, Stride: 1},
+			{Lo: 0x0169, Hi: 0x0169, Stride: 1},
+			{Lo: 0x016B, Hi: 0x016B, Stride: 1},
+			{Lo: 0x016D, Hi: 0x016D, Stride: 1},
+			{Lo: 0x016F, Hi: 0x016F, Stride: 1},
+			{Lo: 0x0171, Hi: 0x0171, Stride: 1},
+			{Lo: 0x0173, Hi: 0x0173, Stride: 1},
+			{Lo: 0x0175, Hi: 0x0175, Stride: 1},
+			{Lo: 0x0177, Hi: 0x0177, Stride: 1},
+			{Lo: 0x017A, Hi: 0x017A, Stride: 1},
+			{Lo: 0x017C, Hi: 0x017C, Stride: 1},
+			{Lo: 0x017E, Hi: 0x0180, Stride: 1},
+			{Lo: 0x0183, Hi: 0x0183, Stride: 1},
+			{Lo: 0x0185, Hi: 0x0185, Stride: 1},
+			{Lo: 0x0188, Hi: 0x0188, Stride: 1},
+			{Lo: 0x018C, Hi: 0x018D, Stride: 1},
+			{Lo: 0x0192, Hi: 0x0192, Stride: 1},
+			{Lo: 0x0195, Hi: 0x0195, Stride: 1},
+			{Lo: 0x0199, Hi: 0x019B, Stride: 1},
+			{Lo: 0x019E, Hi: 0x019E, Stride: 1},
+			{Lo: 0x01A1, Hi: 0x01A1, Stride: 1},
+			{Lo: 0x01A3, Hi: 0x01A3, Stride: 1},
+			{Lo: 0x01A5, Hi: 0x01A5, Stride: 1},
+			{Lo: 0x01A8, Hi: 0x01A8, Stride: 1},
+			{Lo: 0x01AA, Hi: 0x01AB, Stride: 1},
+			{Lo: 0x01AD, Hi: 0x01AD, Stride: 1},
+			{Lo: 0x01B0, Hi: 0x01B0, Stride: 1},
+			{Lo: 0x01B4, Hi: 0x01B4, Stride: 1},
+			{Lo: 0x01B6, Hi: 0x01B6, Stride: 1},
+			{Lo: 0x01B9, Hi: 0x01BA, Stride: 1},
+			{Lo: 0x01BD, Hi: 0x01BF, Stride: 1},
+			{Lo: 0x01C6, Hi: 0x01C6, Stride: 1},
+			{Lo: 0x01C9, Hi: 0x01C9, Stride: 1},
+			{Lo: 0x01CC, Hi: 0x01CC, Stride: 1},
+			{Lo: 0x01CE, Hi: 0x01CE, Stride: 1},
+			{Lo: 0x01D0, Hi: 0x01D0, Stride: 1},
+			{Lo: 0x01D2, Hi: 0x01D2, Stride: 1},
+			{Lo: 0x01D4, Hi: 0x01D4, Stride: 1},
+			{Lo: 0x01D6, Hi: 0x01D6, Stride: 1},
+			{Lo: 0x01D8, Hi: 0x01D8, Stride: 1},
+			{Lo: 0x01DA, Hi: 0x01DA, Stride: 1},
+			{Lo: 0x01DC, Hi: 0x01DD, Stride: 1},
+			{Lo: 0x01DF, Hi: 0x01DF, Stride: 1},
+			{Lo: 0x01E1, Hi: 0x01E1, Stride: 1},
+			{Lo: 0x01E3, Hi: 0x01E3, Stride: 1},
+			{Lo: 0x01E5, Hi: 0x01E5, Stride: 1},
+			{Lo: 0x01E7, Hi: 0x01E7, Stride: 1},
+			{Lo: 0x01E9, Hi: 0x01E9, Stride: 1},
+			{Lo: 0x01EB, Hi: 0x01EB, Stride: 1},
+			{Lo: 0x01ED, Hi: 0x01ED, Stride: 1},
+			{Lo: 0x01EF, Hi: 0x01F0, Stride: 1},
+			{Lo: 0x01F3, Hi: 0x01F3, Stride: 1},
+			{Lo: 0x01F5, Hi: 0x01F5, Stride: 1},
+			{Lo: 0x01F9, Hi: 0x01F9, Stride: 1},
+			{Lo: 0x01FB, Hi: 0x01FB, Stride: 1},
+			{Lo: 0x01FD, Hi: 0x01FD, Stride: 1},
+			{Lo: 0x01FF, Hi: 0x01FF, Stride: 1},
+			{Lo: 0x0201, Hi: 0x0201, Stride: 1},
+			{Lo: 0x0203, Hi: 0x0203, Stride: 1},
+			{Lo: 0x0205, Hi: 0x0205, Stride: 1},
+			{Lo: 0x0207, Hi: 0x0207, Stride: 1},
+			{Lo: 0x0209, Hi: 0x0209, Stride: 1},
+			{Lo: 0x020B, Hi: 0x020B, Stride: 1},
+			{Lo: 0x020D, Hi: 0x020D, Stride: 1},
+			{Lo: 0x020F, Hi: 0x020F, Stride: 1},
+			{Lo: 0x0211, Hi: 0x0211, Stride: 1},
+			{Lo: 0x0213, Hi: 0x0213, Stride: 1},
+			{Lo: 0x0215, Hi: 0x0215, Stride: 1},
+			{Lo: 0x0217, Hi: 0x0217, Stride: 1},
+			{Lo: 0x0219, Hi: 0x0219, Stride: 1},
+			{Lo: 0x021B, Hi: 0x021B, Stride: 1},
+			{Lo: 0x021D, Hi: 0x021D, Stride: 1},
+			{Lo: 0x021F, Hi: 0x021F, Stride: 1},
+			{Lo: 0x0221, Hi: 0x0221, Stride: 1},
+			{Lo: 0x0223, Hi: 0x0223, Stride: 1},
+			{Lo: 0x0225, Hi: 0x0225, Stride: 1},
+			{Lo: 0x0227, Hi: 0x0227, Stride: 1},
+			{Lo: 0x0229, Hi: 0x0229, Stride: 1},
+			{Lo: 0x022B, Hi: 0x022B, Stride: 1},
+			{Lo: 0x022D, Hi: 0x022D, Stride: 1},
+			{Lo: 0x022F, Hi: 0x022F, Stride: 1},
+			{Lo: 0x0231, Hi: 0x0231, Stride: 1},
+			{Lo: 0x0233, Hi: 0x0239, Stride: 1},
+			{Lo: 0x023C, Hi: 0x023C, Stride: 1},
+			{Lo: 0x023F, Hi: 0x0240, Stride: 1},
+			{Lo: 0x0242, Hi: 0x0242, Stride: 1},
+			{Lo: 0x0247, Hi: 0x0247, Stride: 1},
+			{Lo: 0x0249, Hi: 0x0249, Stride: 1},
+			{Lo: 0x024B, Hi: 0x024B, Stride: 1},
+			{Lo: 0x024D, Hi: 0x024D, Stride: 1},
+			{Lo: 0x024F, Hi: 0x0293, Stride: 1},
+			{Lo: 0x0296, Hi: 0x02B8, Stride: 1},
+			{Lo: 0x02C0, Hi: 0x02C1, Stride: 1},
+			{Lo: 0x02E0, Hi: 0x02E4, Stride: 1},
+			{Lo: 0x0371, Hi: 0x0371, Stride: 1},
+			{Lo: 0x0373, Hi: 0x0373, Stride: 1},
+			{Lo: 0x0377, Hi: 0x0377, Stride: 1},
+			{Lo: 0x037A, Hi: 0x037D, Stride: 1},
+			{Lo: 0x0390, Hi: 0x0390, Stride: 1},
+			{Lo: 0x03AC, Hi: 0x03CE, Stride: 1},
+			{Lo: 0x03D0, Hi: 0x03D1, Stride: 1},
+			{Lo: 0x03D5, Hi: 0x03D7, Stride: 1},
+			{Lo: 0x03D9, Hi: 0x03D9, Stride: 1},
+			{Lo: 0x03DB, Hi: 0x03DB, Stride: 1},
+			{Lo: 0x03DD, Hi: 0x03DD, Stride: 1},
+			{Lo: 0x03DF, Hi: 0x03DF, Stride: 1},
+			{Lo: 0x03E1, Hi: 0x03E1, Stride: 1},
+			{Lo: 0x03E3, Hi: 0x03E3, Stride: 1},
+			{Lo: 0x03E5, Hi: 0x03E5, Stride: 1},
+			{Lo: 0x03E7, Hi: 0x03E7, Stride: 1},
+			{Lo: 0x03E9, Hi: 0x03E9, Stride: 1},
+			{Lo: 0x03EB, Hi: 0x03EB, Stride: 1},
+			{Lo: 0x03ED, Hi: 0x03ED, Stride: 1},
+			{Lo: 0x03EF, Hi: 0x03F3, Stride: 1},
+			{Lo: 0x03F5, Hi: 0x03F5, Stride: 1},
+			{Lo: 0x03F8, Hi: 0x03F8, Stride: 1},
+			{Lo: 0x03FB, Hi: 0x03FC, Stride: 1},
+			{Lo: 0x0430, Hi: 0x045F, Stride: 1},
+			{Lo: 0x0461, Hi: 0x0461, Stride: 1},
+			{Lo: 0x0463, Hi: 0x0463, Stride: 1},
+			{Lo: 0x0465, Hi: 0x0465, Stride: 1},
+			{Lo: 0x0467, Hi: 0x0467, Stride: 1},
+			{Lo: 0x0469, Hi: 0x0469, Stride: 1},
+			{Lo: 0x046B, Hi: 0x046B, Stride: 1},
+			{Lo: 0x046D, Hi: 0x046D, Stride: 1},
+			{Lo: 0x046F, Hi: 0x046F, Stride: 1},
+			{Lo: 0x0471, Hi: 0x0471, Stride: 1},
+			{Lo: 0x0473, Hi: 0x0473, Stride: 1},
+			{Lo: 0x0475, Hi: 0x0475, Stride: 1},
+			{Lo: 0x0477, Hi: 0x0477, Stride: 1},
+			{Lo: 0x0479, Hi: 0x0479, Stride: 1},
+			{Lo: 0x047B, Hi: 0x047B, Stride: 1},
+			{Lo: 0x047D, Hi: 0x047D, Stride: 1},
+			{Lo: 0x047F, Hi: 0x047F, Stride: 1},
+			{Lo: 0x0481, Hi: 0x0481, Stride: 1},
+			{Lo: 0x048B, Hi: 0x048B, Stride: 1},
+			{Lo: 0x048D, Hi: 0x048D, Stride: 1},
+			{Lo: 0x048F, Hi: 0x048F, Stride: 1},
+			{Lo: 0x0491, Hi: 0x0491, Stride: 1},
+			{Lo: 0x0493, Hi: 0x0493, Stride: 1},
+			{Lo: 0x0495, Hi: 0x0495, Stride: 1},
+			{Lo: 0x0497, Hi: 0x0497, Stride: 1},
+			{Lo: 0x0499, Hi: 0x0499, Stride: 1},
+			{Lo: 0x049B, Hi: 0x049B, Stride: 1},
+			{Lo: 0x049D, Hi: 0x049D, Stride: 1},
+			{Lo: 0x049F, Hi: 0x049F, Stride: 1},
+			{Lo: 0x04A1, Hi: 0x04A1, Stride: 1},
+			{Lo: 0x04A3, Hi: 0x04A3, Stride: 1},
+			{Lo: 0x04A5, Hi: 0x04A5, Stride: 1},
+			{Lo: 0x04A7, Hi: 0x04A7, Stride: 1},
+			{Lo: 0x04A9, Hi: 0x04A9, Stride: 1},
+			{Lo: 0x04AB, Hi: 0x04AB, Stride: 1},
+			{Lo: 0x04AD, Hi: 0x04AD, Stride: 1},
+			{Lo: 0x04AF, Hi: 0x04AF, Stride: 1},
+			{Lo: 0x04B1, Hi: 0x04B1, Stride: 1},
+			{Lo: 0x04B3, Hi: 0x04B3, Stride: 1},
+			{Lo: 0x04B5, Hi: 0x04B5, Stride: 1},
+			{Lo: 0x04B7, Hi: 0x04B7, Stride: 1},
+			{Lo: 0x04B9, Hi: 0x04B9, Stride: 1},
+			{Lo: 0x04BB, Hi: 0x04BB, Stride: 1},
+			{Lo: 0x04BD, Hi: 0x04BD, Stride: 1},
+			{Lo: 0x04BF, Hi: 0x04BF, Stride: 1},
+			{Lo: 0x04C2, Hi: 0x04C2, Stride: 1},
+			{Lo: 0x04C4, Hi: 0x04C4, Stride: 1},
+			{Lo: 0x04C6, Hi: 0x04C6, Stride: 1},
+			{Lo: 0x04C8, Hi: 0x04C8, Stride: 1},
+			{Lo: 0x04CA, Hi: 0x04CA, Stride: 1},
+			{Lo: 0x04CC, Hi: 0x04CC, Stride: 1},
+			{Lo: 0x04CE, Hi: 0x04CF, Stride: 1},
+			{Lo: 0x04D1, Hi: 0x04D1, Stride: 1},
+			{Lo: 0x04D3, Hi: 0x04D3, Stride: 1},
+			{Lo: 0x04D5, Hi: 0x04D5, Stride: 1},
+			{Lo: 0x04D7, Hi: 0x04D7, Stride: 1},
+			{Lo: 0x04D9, Hi: 0x04D9, Stride: 1},
+			{Lo: 0x04DB, Hi: 0x04DB, Stride: 1},
+			{Lo: 0x04DD, Hi: 0x04DD, Stride: 1},
+			{Lo: 0x04DF, Hi: 0x04DF, Stride: 1},
+			{Lo: 0x04E1, Hi: 0x04E1, Stride: 1},
+			{Lo: 0x04E3, Hi: 0x04E3, Stride: 1},
+			{Lo: 0x04E5, Hi: 0x04E5, Stride: 1},
+			{Lo: 0x04E7, Hi: 0x04E7, Stride: 1},
+			{Lo: 0x04E9, Hi: 0x04E9, Stride: 1},
+			{Lo: 0x04EB, Hi: 0x04EB, Stride: 1},
+			{Lo: 0x04ED, Hi: 0x04ED, Stride: 1},
+			{Lo: 0x04EF, Hi: 0x04EF, Stride: 1},
+			{Lo: 0x04F1, Hi: 0x04F1, Stride: 1},
+			{Lo: 0x04F3, Hi: 0x04F3, Stride: 1},
+			{Lo: 0x04F5, Hi: 0x04F5, Stride: 1},
+			{Lo: 0x04F7, Hi: 0x04F7, Stride: 1},
+			{Lo: 0x04F9, Hi: 0x04F9, Stride: 1},
+			{Lo: 0x04FB, Hi: 0x04FB, Stride: 1},
+			{Lo: 0x04FD, Hi: 0x04FD, Stride: 1},
+			{Lo: 0x04FF, Hi: 0x04FF, Stride: 1},
+			{Lo: 0x0501, Hi: 0x0501, Stride: 1},
+			{Lo: 0x0503, Hi: 0x0503, Stride: 1},
+			{Lo: 0x0505, Hi: 0x0505, Stride: 1},
+			{Lo: 0x0507, Hi: 0x0507, Stride: 1},
+			{Lo: 0x0509, Hi: 0x0509, Stride: 1},
+			{Lo: 0x050B, Hi: 0x050B, Stride: 1},
+			{Lo: 0x050D, Hi: 0x050D, Stride: 1},
+			{Lo: 0x050F, Hi: 0x050F, Stride: 1},
+			{Lo: 0x0511, Hi: 0x0511, Stride: 1},
+			{Lo: 0x0513, Hi: 0x0513, Stride: 1},
+			{Lo: 0x0515, Hi: 0x0515, Stride: 1},
+			{Lo: 0x0517, Hi: 0x0517, Stride: 1},
+			{Lo: 0x0519, Hi: 0x0519, Stride: 1},
+			{Lo: 0x051B, Hi: 0x051B, Stride: 1},
+			{Lo: 0x051D, Hi: 0x051D, Stride: 1},
+			{Lo: 0x051F, Hi: 0x051F, Stride: 1},
+			{Lo: 0x0521, Hi: 0x0521, Stride: 1},
+			{Lo: 0x0523, Hi: 0x0523, Stride: 1},
+			{Lo: 0x0525, Hi: 0x0525, Stride: 1},
+			{Lo: 0x0527, Hi: 0x0527, Stride: 1},
+			{Lo: 0x0529, Hi: 0x0529, Stride: 1},
+			{Lo: 0x052B, Hi: 0x052B, Stride: 1},
+			{Lo: 0x052D, Hi: 0x052D, Stride: 1},
+			{Lo: 0x052F, Hi: 0x052F, Stride: 1},
+			{Lo: 0x0560, Hi: 0x0588, Stride: 1},
+			{Lo: 0x10D0, Hi: 0x10FA, Stride: 1},
+			{Lo: 0x10FC, Hi: 0x10FF, Stride: 1},
+			{Lo: 0x13F8, Hi: 0x13FD, Stride: 1},
+			{Lo: 0x1C80, Hi: 0x1C88, Stride: 1},
+			{Lo: 0x1C8A, Hi: 0x1C8A, Stride: 1},
+			{Lo: 0x1D00, Hi: 0x1DBF, Stride: 1},
+			{Lo: 0x1E01, Hi: 0x1E01, Stride: 1},
+			{Lo: 0x1E03, Hi: 0x1E03, Stride: 1},
+			{Lo: 0x1E05, Hi: 0x1E05, Stride: 1},
+			{Lo: 0x1E07, Hi: 0x1E07, Stride: 1},
+			{Lo: 0x1E09, Hi: 0x1E09, Stride: 1},
+			{Lo: 0x1E0B, Hi: 0x1E0B, Stride: 1},
+			{Lo: 0x1E0D, Hi: 0x1E0D, Stride: 1},
+			{Lo: 0x1E0F, Hi: 0x1E0F, Stride: 1},
+			{Lo: 0x1E11, Hi: 0x1E11, Stride: 1},
+			{Lo: 0x1E13, Hi: 0x1E13, Stride: 1},
+			{Lo: 0x1E15, Hi: 0x1E15, Stride: 1},
+			{Lo: 0x1E17, Hi: 0x1E17, Stride: 1},
+			{Lo: 0x1E19, Hi: 0x1E19, Stride: 1},
+			{Lo: 0x1E1B, Hi: 0x1E1B, Stride: 1},
+			{Lo: 0x1E1D, Hi: 0x1E1D, Stride: 1},
+			{Lo: 0x1E1F, Hi: 0x1E1F, Stride: 1},
+			{Lo: 0x1E21, Hi: 0x1E21, Stride: 1},
+			{Lo: 0x1E23, Hi: 0x1E23, Stride: 1},
+			{Lo: 0x1E25, Hi: 0x1E25, Stride: 1},
+			{Lo: 0x1E27, Hi: 0x1E27, Stride: 1},
+			{Lo: 0x1E29, Hi: 0x1E29, Stride: 1},
+			{Lo: 0x1E2B, Hi: 0x1E2B, Stride: 1},
+			{Lo: 0x1E2D, Hi: 0x1E2D, Stride: 1},
+			{Lo: 0x1E2F, Hi: 0x1E2F, Stride: 1},
+			{Lo: 0x1E31, Hi: 0x1E31, Stride: 1},
+			{Lo: 0x1E33, Hi: 0x1E33, Stride: 1},
+			{Lo: 0x1E35, Hi: 0x1E35, Stride: 1},
+			{Lo: 0x1E37, Hi: 0x1E37, Stride: 1},
+			{Lo: 0x1E39, Hi: 0x1E39, Stride: 1},
+			{Lo: 0x1E3B, Hi: 0x1E3B, Stride: 1},
+			{Lo: 0x1E3D, Hi: 0x1E3D, Stride: 1},
+			{Lo: 0x1E3F, Hi: 0x1E3F, Stride: 1},
+			{Lo: 0x1E41, Hi: 0x1E41, Stride: 1},
+			{Lo: 0x1E43, Hi: 0x1E43, Stride: 1},
+			{Lo: 0x1E45, Hi: 0x1E45, Stride: 1},
+			{Lo: 0x1E47, Hi: 0x1E47, Stride: 1},
+			{Lo: 0x1E49, Hi: 0x1E49, Stride: 1},
+			{Lo: 0x1E4B, Hi: 0x1E4B, Stride: 1},
+			{Lo: 0x1E4D, Hi: 0x1E4D, Stride: 1},
+			{Lo: 0x1E4F, Hi: 0x1E4F, Stride: 1},
+			{Lo: 0x1E51, Hi: 0x1E51, Stride: 1},
+			{Lo: 0x1E53, Hi: 0x1E53, Stride: 1},
+			{Lo: 0x1E55, Hi: 0x1E55, Stride: 1},
+			{Lo: 0x1E57, Hi: 0x1E57, Stride: 1},
+			{Lo: 0x1E59, Hi: 0x1E59, Stride: 1},
+			{Lo: 0x1E5B, Hi: 0x1E5B, Stride: 1},
+			{Lo: 0x1E5D, Hi: 0x1E5D, Stride: 1},
+			{Lo: 0x1E5F, Hi: 0x1E5F, Stride: 1},
+			{Lo: 0x1E61, Hi: 0x1E61, Stride: 1},
+			{Lo: 0x1E63, Hi: 0x1E63, Stride: 1},
+			{Lo: 0x1E65, Hi: 0x1E65, Stride: 1},
+			{Lo: 0x1E67, Hi: 0x1E67, Stride: 1},
+			{Lo: 0x1E69, Hi: 0x1E69, Stride: 1},
+			{Lo: 0x1E6B, Hi: 0x1E6B, Stride: 1},
+			{Lo: 0x1E6D, Hi: 0x1E6D, Stride: 1},
+			{Lo: 0x1E6F, Hi: 0x1E6F, Stride: 1},
+			{Lo: 0x1E71, Hi: 0x1E71, Stride: 1},
+			{Lo: 0x1E73, Hi: 0x1E73, Stride: 1},
+			{Lo: 0x1E75, Hi: 0x1E75, Stride: 1},
+			{Lo: 0x1E77, Hi: 0x1E77, Stride: 1},
+			{Lo: 0x1E79, Hi: 0x1E79, Stride: 1},
+			{Lo: 0x1E7B, Hi: 0x1E7B, Stride: 1},
+			{Lo: 0x1E7D, Hi: 0x1E7D, Stride: 1},
+			{Lo: 0x1E7F, Hi: 0x1E7F, Stride: 1},
+			{Lo: 0x1E81, Hi: 0x1E81, Stride: 1},
+			{Lo: 0x1E83, Hi: 0x1E83, Stride: 1},
+			{Lo: 0x1E85, Hi: 0x1E85, Stride: 1},
+			{Lo: 0x1E87, Hi: 0x1E87, Stride: 1},
+			{Lo: 0x1E89, Hi: 0x1E89, Stride: 1},
+			{Lo: 0x1E8B, Hi: 0x1E8B, Stride: 1},
+			{Lo: 0x1E8D, Hi: 0x1E8D, Stride: 1},
+			{Lo: 0x1E8F, Hi: 0x1E8F, Stride: 1},
+			{Lo: 0x1E91, Hi: 0x1E91, Stride: 1},
+			{Lo: 0x1E93, Hi: 0x1E93, Stride: 1},
+			{Lo: 0x1E95, Hi: 0x1E9D, Stride: 1},
+			{Lo: 0x1E9F, Hi: 0x1E9F, Stride: 1},
+			{Lo: 0x1EA1, Hi: 0x1EA1, Stride: 1},
+			{Lo: 0x1EA3, Hi: 0x1EA3, Stride: 1},
+			{Lo: 0x1EA5, Hi: 0x1EA5, Stride: 1},
+			{Lo: 0x1EA7, Hi: 0x1EA7, Stride: 1},
+			{Lo: 0x1EA9, Hi: 0x1EA9, Stride: 1},
+			{Lo: 0x1EAB, Hi: 0x1EAB, Stride: 1},
+			{Lo: 0x1EAD, Hi: 0x1EAD, Stride: 1},
+			{Lo: 0x1EAF, Hi: 0x1EAF, Stride: 1},
+			{Lo: 0x1EB1, Hi: 0x1EB1, Stride: 1},
+			{Lo: 0x1EB3, Hi: 0x1EB3, Stride: 1},
+			{Lo: 0x1EB5, Hi: 0x1EB5, Stride: 1},
+			{Lo: 0x1EB7, Hi: 0x1EB7, Stride: 1},
+			{Lo: 0x1EB9, Hi: 0x1EB9, Stride: 1},
+			{Lo: 0x1EBB, Hi: 0x1EBB, Stride: 1},
+			{Lo: 0x1EBD, Hi: 0x1EBD, Stride: 1},
+			{Lo: 0x1EBF, Hi: 0x1EBF, Stride: 1},
+			{Lo: 0x1EC1, Hi: 0x1EC1, Stride: 1},
+			{Lo: 0x1EC3, Hi: 0x1EC3, Stride: 1},
+			{Lo: 0x1EC5, Hi: 0x1EC5, Stride: 1},
+			{Lo: 0x1EC7, Hi: 0x1EC7, Stride: 1},
+			{Lo: 0x1EC9, Hi: 0x1EC9, Stride: 1},
+			{Lo: 0x1ECB, Hi: 0x1ECB, Stride: 1},
+			{Lo: 0x1ECD, Hi: 0x1ECD, Stride: 1},
+			{Lo: 0x1ECF, Hi: 0x1ECF, Stride: 1},
+			{Lo: 0x1ED1, Hi: 0x1ED1, Stride: 1},
+			{Lo: 0x1ED3, Hi: 0x1ED3, Stride: 1},
+			{Lo: 0x1ED5, Hi: 0x1ED5, Stride: 1},
+			{Lo: 0x1ED7, Hi: 0x1ED7, Stride: 1},
+			{Lo: 0x1ED9, Hi: 0x1ED9, Stride: 1},
+			{Lo: 0x1EDB, Hi: 0x1EDB, Stride: 1},
+			{Lo: 0x1EDD, Hi: 0x1EDD, Stride: 1},
+			{Lo: 0x1EDF, Hi: 0x1EDF, Stride: 1},
+			{Lo: 0x1EE1, Hi: 0x1EE1, Stride: 1},
+			{Lo: 0x1EE3, Hi: 0x1EE3, Stride: 1},
+			{Lo: 0x1EE5, Hi: 0x1EE5, Stride: 1},
+			{Lo: 0x1EE7, Hi: 0x1EE7, Stride: 1},
+			{Lo: 0x1EE9, Hi: 0x1EE9, Stride: 1},
+			{Lo: 0x1EEB, Hi: 0x1EEB, Stride: 1},
+			{Lo: 0x1EED, Hi: 0x1EED, Stride: 1},
+			{Lo: 0x1EEF, Hi: 0x1EEF, Stride: 1},
+			{Lo: 0x1EF1, Hi: 0x1EF1, Stride: 1},
+			{Lo: 0x1EF3, Hi: 0x1EF3, Stride: 1},
+			{Lo: 0x1EF5, Hi: 0x1EF5, Stride: 1},
+			{Lo: 0x1EF7, Hi: 0x1EF7, Stride: 1},
+			{Lo: 0x1EF9, Hi: 0x1EF9, Stride: 1},
+			{Lo: 0x1EFB, Hi: 0x1EFB, Stride: 1},
+			{Lo: 0x1EFD, Hi: 0x1EFD, Stride: 1},
+			{Lo: 0x1EFF, Hi: 0x1F07, Stride: 1},
+			{Lo: 0x1F10, Hi: 0x1F15, Stride: 1},
+			{Lo: 0x1F20, Hi: 0x1F27, Stride: 1},
+			{Lo: 0x1F30, Hi: 0x1F37, Stride: 1},
+			{Lo: 0x1F40, Hi: 0x1F45, Stride: 1},
+			{Lo: 0x1F50, Hi: 0x1F57, Stride: 1},
+			{Lo: 0x1F60, Hi: 0x1F67, Stride: 1},
+			{Lo: 0x1F70, Hi: 0x1F7D, Stride: 1},
+			{Lo: 0x1F80, Hi: 0x1F87, Stride: 1},
+			{Lo: 0x1F90, Hi: 0x1F97, Stride: 1},
+			{Lo: 0x1FA0, Hi: 0x1FA7, Stride: 1},
+			{Lo: 0x1FB0, Hi: 0x1FB4, Stride: 1},
+			{Lo: 0x1FB6, Hi: 0x1FB7, Stride: 1},
+			{Lo: 0x1FBE, Hi: 0x1FBE, Stride: 1},
+			{Lo: 0x1FC2, Hi: 0x1FC4, Stride: 1},
+			{Lo: 0x1FC6, Hi: 0x1FC7, Stride: 1},
+			{Lo: 0x1FD0, Hi: 0x1FD3, Stride: 1},
+			{Lo: 0x1FD6, Hi: 0x1FD7, Stride: 1},
+			{Lo: 0x1FE0, Hi: 0x1FE7, Stride: 1},
+			{Lo: 0x1FF2, Hi: 0x1FF4, Stride: 1},
+			{Lo: 0x1FF6, Hi: 0x1FF7, Stride: 1},
+			{Lo: 0x2071, Hi: 0x2071, Stride: 1},
+			{Lo: 0x207F, Hi: 0x207F, Stride: 1},
+			{Lo: 0x2090, Hi: 0x209C, Stride: 1},
+			{Lo: 0x210A, Hi: 0x210A, Stride: 1},
+			{Lo: 0x210E, Hi: 0x210F, Stride: 1},
+			{Lo: 0x2113, Hi: 0x2113, Stride: 1},
+			{Lo: 0x212F, Hi: 0x212F, Stride: 1},
+			{Lo: 0x2134, Hi: 0x2134, Stride: 1},
+			{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+			{Lo: 0x213C, Hi: 0x213D, Stride: 1},
+			{Lo: 0x2146, Hi: 0x2149, Stride: 1},
+			{Lo: 0x214E, Hi: 0x214E, Stride: 1},
+			{Lo: 0x2170, Hi: 0x217F, Stride: 1},
+			{Lo: 0x2184, Hi: 0x2184, Stride: 1},
+			{Lo: 0x24D0, Hi: 0x24E9, Stride: 1},
+			{Lo: 0x2C30, Hi: 0x2C5F, Stride: 1},
+			{Lo: 0x2C61, Hi: 0x2C61, Stride: 1},
+			{Lo: 0x2C65, Hi: 0x2C66, Stride: 1},
+			{Lo: 0x2C68, Hi: 0x2C68, Stride: 1},
+			{Lo: 0x2C6A, Hi: 0x2C6A, Stride: 1},
+			{Lo: 0x2C6C, Hi: 0x2C6C, Stride: 1},
+			{Lo: 0x2C71, Hi: 0x2C71, Stride: 1},
+			{Lo: 0x2C73, Hi: 0x2C74, Stride: 1},
+			{Lo: 0x2C76, Hi: 0x2C7D, Stride: 1},
+			{Lo: 0x2C81, Hi: 0x2C81, Stride: 1},
+			{Lo: 0x2C83, Hi: 0x2C83, Stride: 1},
+			{Lo: 0x2C85, Hi: 0x2C85, Stride: 1},
+			{Lo: 0x2C87, Hi: 0x2C87, Stride: 1},
+			{Lo: 0x2C89, Hi: 0x2C89, Stride: 1},
+			{Lo: 0x2C8B, Hi: 0x2C8B, Stride: 1},
+			{Lo: 0x2C8D, Hi: 0x2C8D, Stride: 1},
+			{Lo: 0x2C8F, Hi: 0x2C8F, Stride: 1},
+			{Lo: 0x2C91, Hi: 0x2C91, Stride: 1},
+			{Lo: 0x2C93, Hi: 0x2C93, Stride: 1},
+			{Lo: 0x2C95, Hi: 0x2C95, Stride: 1},
+			{Lo: 0x2C97, Hi: 0x2C97, Stride: 1},
+			{Lo: 0x2C99, Hi: 0x2C99, Stride: 1},
+			{Lo: 0x2C9B, Hi: 0x2C9B, Stride: 1},
+			{Lo: 0x2C9D, Hi: 0x2C9D, Stride: 1},
+			{Lo: 0x2C9F, Hi: 0x2C9F, Stride: 1},
+			{Lo: 0x2CA1, Hi: 0x2CA1, Stride: 1},
+			{Lo: 0x2CA3, Hi: 0x2CA3, Stride: 1},
+			{Lo: 0x2CA5, Hi: 0x2CA5, Stride: 1},
+			{Lo: 0x2CA7, Hi: 0x2CA7, Stride: 1},
+			{Lo: 0x2CA9, Hi: 0x2CA9, Stride: 1},
+			{Lo: 0x2CAB, Hi: 0x2CAB, Stride: 1},
+			{Lo: 0x2CAD, Hi: 0x2CAD, Stride: 1},
+			{Lo: 0x2CAF, Hi: 0x2CAF, Stride: 1},
+			{Lo: 0x2CB1, Hi: 0x2CB1, Stride: 1},
+			{Lo: 0x2CB3, Hi: 0x2CB3, Stride: 1},
+			{Lo: 0x2CB5, Hi: 0x2CB5, Stride: 1},
+			{Lo: 0x2CB7, Hi: 0x2CB7, Stride: 1},
+			{Lo: 0x2CB9, Hi: 0x2CB9, Stride: 1},
+			{Lo: 0x2CBB, Hi: 0x2CBB, Stride: 1},
+			{Lo: 0x2CBD, Hi: 0x2CBD, Stride: 1},
+			{Lo: 0x2CBF, Hi: 0x2CBF, Stride: 1},
+			{Lo: 0x2CC1, Hi: 0x2CC1, Stride: 1},
+			{Lo: 0x2CC3, Hi: 0x2CC3, Stride: 1},
+			{Lo: 0x2CC5, Hi: 0x2CC5, Stride: 1},
+			{Lo: 0x2CC7, Hi: 0x2CC7, Stride: 1},
+			{Lo: 0x2CC9, Hi: 0x2CC9, Stride: 1},
+			{Lo: 0x2CCB, Hi: 0x2CCB, Stride: 1},
+			{Lo: 0x2CCD, Hi: 0x2CCD, Stride: 1},
+			{Lo: 0x2CCF, Hi: 0x2CCF, Stride: 1},
+			{Lo: 0x2CD1, Hi: 0x2CD1, Stride: 1},
+			{Lo: 0x2CD3, Hi: 0x2CD3, Stride: 1},
+			{Lo: 0x2CD5, Hi: 0x2CD5, Stride: 1},
+			{Lo: 0x2CD7, Hi: 0x2CD7, Stride: 1},
+			{Lo: 0x2CD9, Hi: 0x2CD9, Stride: 1},
+			{Lo: 0x2CDB, Hi: 0x2CDB, Stride: 1},
+			{Lo: 0x2CDD, Hi: 0x2CDD, Stride: 1},
+			{Lo: 0x2CDF, Hi: 0x2CDF, Stride: 1},
+			{Lo: 0x2CE1, Hi: 0x2CE1, Stride: 1},
+			{Lo: 0x2CE3, Hi: 0x2CE4, Stride: 1},
+			{Lo: 0x2CEC, Hi: 0x2CEC, Stride: 1},
+			{Lo: 0x2CEE, Hi: 0x2CEE, Stride: 1},
+			{Lo: 0x2CF3, Hi: 0x2CF3, Stride: 1},
+			{Lo: 0x2D00, Hi: 0x2D25, Stride: 1},
+			{Lo: 0x2D27, Hi: 0x2D27, Stride: 1},
+			{Lo: 0x2D2D, Hi: 0x2D2D, Stride: 1},
+			{Lo: 0xA641, Hi: 0xA641, Stride: 1},
+			{Lo: 0xA643, Hi: 0xA643, Stride: 1},
+			{Lo: 0xA645, Hi: 0xA645, Stride: 1},
+			{Lo: 0xA647, Hi: 0xA647, Stride: 1},
+			{Lo: 0xA649, Hi: 0xA649, Stride: 1},
+			{Lo: 0xA64B, Hi: 0xA64B, Stride: 1},
+			{Lo: 0xA64D, Hi: 0xA64D, Stride: 1},
+			{Lo: 0xA64F, Hi: 0xA64F, Stride: 1},
+			{Lo: 0xA651, Hi: 0xA651, Stride: 1},
+			{Lo: 0xA653, Hi: 0xA653, Stride: 1},
+			{Lo: 0xA655, Hi: 0xA655, Stride: 1},
+			{Lo: 0xA657, Hi: 0xA657, Stride: 1},
+			{Lo: 0xA659, Hi: 0xA659, Stride: 1},
+			{Lo: 0xA65B, Hi: 0xA65B, Stride: 1},
+			{Lo: 0xA65D, Hi: 0xA65D, Stride: 1},
+			{Lo: 0xA65F, Hi: 0xA65F, Stride: 1},
+			{Lo: 0xA661, Hi: 0xA661, Stride: 1},
+			{Lo: 0xA663, Hi: 0xA663, Stride: 1},
+			{Lo: 0xA665, Hi: 0xA665, Stride: 1},
+			{Lo: 0xA667, Hi: 0xA667, Stride: 1},
+			{Lo: 0xA669, Hi: 0xA669, Stride: 1},
+			{Lo: 0xA66B, Hi: 0xA66B, Stride: 1},
+			{Lo: 0xA66D, Hi: 0xA66D, Stride: 1},
+			{Lo: 0xA681, Hi: 0xA681, Stride: 1},
+			{Lo: 0xA683, Hi: 0xA683, Stride: 1},
+			{Lo: 0xA685, Hi: 0xA685, Stride: 1},
+			{Lo: 0xA687, Hi: 0xA687, Stride: 1},
+			{Lo: 0xA689, Hi: 0xA689, Stride: 1},
+			{Lo: 0xA68B, Hi: 0xA68B, Stride: 1},
+			{Lo: 0xA68D, Hi: 0xA68D, Stride: 1},
+			{Lo: 0xA68F, Hi: 0xA68F, Stride: 1},
+			{Lo: 0xA691, Hi: 0xA691, Stride: 1},
+			{Lo: 0xA693, Hi: 0xA693, Stride: 1},
+			{Lo: 0xA695, Hi: 0xA695, Stride: 1},
+			{Lo: 0xA697, Hi: 0xA697, Stride: 1},
+			{Lo: 0xA699, Hi: 0xA699, Stride: 1},
+			{Lo: 0xA69B, Hi: 0xA69D, Stride: 1},
+			{Lo: 0xA723, Hi: 0xA723, Stride: 1},
+			{Lo: 0xA725, Hi: 0xA725, Stride: 1},
+			{Lo: 0xA727, Hi: 0xA727, Stride: 1},
+			{Lo: 0xA729, Hi: 0xA729, Stride: 1},
+			{Lo: 0xA72B, Hi: 0xA72B, Stride: 1},
+			{Lo: 0xA72D, Hi: 0xA72D, Stride: 1},
+			{Lo: 0xA72F, Hi: 0xA731, Stride: 1},
+			{Lo: 0xA733, Hi: 0xA733, Stride: 1},
+			{Lo: 0xA735, Hi: 0xA735, Stride: 1},
+			{Lo: 0xA737, Hi: 0xA737, Stride: 1},
+			{Lo: 0xA739, Hi: 0xA739, Stride: 1},
+			{Lo: 0xA73B, Hi: 0xA73B, Stride: 1},
+			{Lo: 0xA73D, Hi: 0xA73D, Stride: 1},
+			{Lo: 0xA73F, Hi: 0xA73F, Stride: 1},
+			{Lo: 0xA741, Hi: 0xA741, Stride: 1},
+			{Lo: 0xA743, Hi: 0xA743, Stride: 1},
+			{Lo: 0xA745, Hi: 0xA745, Stride: 1},
+			{Lo: 0xA747, Hi: 0xA747, Stride: 1},
+			{Lo: 0xA749, Hi: 0xA749, Stride: 1},
+			{Lo: 0xA74B, Hi: 0xA74B, Stride: 1},
+			{Lo: 0xA74D, Hi: 0xA74D, Stride: 1},
+			{Lo: 0xA74F, Hi: 0xA74F, Stride: 1},
+			{Lo: 0xA751, Hi: 0xA751, Stride: 1},
+			{Lo: 0xA753, Hi: 0xA753, Stride: 1},
+			{Lo: 0xA755, Hi: 0xA755, Stride: 1},
+			{Lo: 0xA757, Hi: 0xA757, Stride: 1},
+			{Lo: 0xA759, Hi: 0xA759, Stride: 1},
+			{Lo: 0xA75B, Hi: 0xA75B, Stride: 1},
+			{Lo: 0xA75D, Hi: 0xA75D, Stride: 1},
+			{Lo: 0xA75F, Hi: 0xA75F, Stride: 1},
+			{Lo: 0xA761, Hi: 0xA761, Stride: 1},
+			{Lo: 0xA763, Hi: 0xA763, Stride: 1},
+			{Lo: 0xA765, Hi: 0xA765, Stride: 1},
+			{Lo: 0xA767, Hi: 0xA767, Stride: 1},
+			{Lo: 0xA769, Hi: 0xA769, Stride: 1},
+			{Lo: 0xA76B, Hi: 0xA76B, Stride: 1},
+			{Lo: 0xA76D, Hi: 0xA76D, Stride: 1},
+			{Lo: 0xA76F, Hi: 0xA778, Stride: 1},
+			{Lo: 0xA77A, Hi: 0xA77A, Stride: 1},
+			{Lo: 0xA77C, Hi: 0xA77C, Stride: 1},
+			{Lo: 0xA77F, Hi: 0xA77F, Stride: 1},
+			{Lo: 0xA781, Hi: 0xA781, Stride: 1},
+			{Lo: 0xA783, Hi: 0xA783, Stride: 1},
+			{Lo: 0xA785, Hi: 0xA785, Stride: 1},
+			{Lo: 0xA787, Hi: 0xA787, Stride: 1},
+			{Lo: 0xA78C, Hi: 0xA78C, Stride: 1},
+			{Lo: 0xA78E, Hi: 0xA78E, Stride: 1},
+			{Lo: 0xA791, Hi: 0xA791, Stride: 1},
+			{Lo: 0xA793, Hi: 0xA795, Stride: 1},
+			{Lo: 0xA797, Hi: 0xA797, Stride: 1},
+			{Lo: 0xA799, Hi: 0xA799, Stride: 1},
+			{Lo: 0xA79B, Hi: 0xA79B, Stride: 1},
+			{Lo: 0xA79D, Hi: 0xA79D, Stride: 1},
+			{Lo: 0xA79F, Hi: 0xA79F, Stride: 1},
+			{Lo: 0xA7A1, Hi: 0xA7A1, Stride: 1},
+			{Lo: 0xA7A3, Hi: 0xA7A3, Stride: 1},
+			{Lo: 0xA7A5, Hi: 0xA7A5, Stride: 1},
+			{Lo: 0xA7A7, Hi: 0xA7A7, Stride: 1},
+			{Lo: 0xA7A9, Hi: 0xA7A9, Stride: 1},
+			{Lo: 0xA7AF, Hi: 0xA7AF, Stride: 1},
+			{Lo: 0xA7B5, Hi: 0xA7B5, Stride: 1},
+			{Lo: 0xA7B7, Hi: 0xA7B7, Stride: 1},
+			{Lo: 0xA7B9, Hi: 0xA7B9, Stride: 1},
+			{Lo: 0xA7BB, Hi: 0xA7BB, Stride: 1},
+			{Lo: 0xA7BD, Hi: 0xA7BD, Stride: 1},
+			{Lo: 0xA7BF, Hi: 0xA7BF, Stride: 1},
+			{Lo: 0xA7C1, Hi: 0xA7C1, Stride: 1},
+			{Lo: 0xA7C3, Hi: 0xA7C3, Stride: 1},
+			{Lo: 0xA7C8, Hi: 0xA7C8, Stride: 1},
+			{Lo: 0xA7CA, Hi: 0xA7CA, Stride: 1},
+			{Lo: 0xA7CD, Hi: 0xA7CD, Stride: 1},
+			{Lo: 0xA7CF, Hi: 0xA7CF, Stride: 1},
+			{Lo: 0xA7D1, Hi: 0xA7D1, Stride: 1},
+			{Lo: 0xA7D3, Hi: 0xA7D3, Stride: 1},
+			{Lo: 0xA7D5, Hi: 0xA7D5, Stride: 1},
+			{Lo: 0xA7D7, Hi: 0xA7D7, Stride: 1},
+			{Lo: 0xA7D9, Hi: 0xA7D9, Stride: 1},
+			{Lo: 0xA7DB, Hi: 0xA7DB, Stride: 1},
+			{Lo: 0xA7F1, Hi: 0xA7F4, Stride: 1},
+			{Lo: 0xA7F6, Hi: 0xA7F6, Stride: 1},
+			{Lo: 0xA7F8, Hi: 0xA7FA, Stride: 1},
+			{Lo: 0xAB30, Hi: 0xAB5A, Stride: 1},
+			{Lo: 0xAB5C, Hi: 0xAB69, Stride: 1},
+			{Lo: 0xAB70, Hi: 0xABBF, Stride: 1},
+			{Lo: 0xFB00, Hi: 0xFB06, Stride: 1},
+			{Lo: 0xFB13, Hi: 0xFB17, Stride: 1},
+			{Lo: 0xFF41, Hi: 0xFF5A, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10428, Hi: 0x1044F, Stride: 1},
+			{Lo: 0x104D8, Hi: 0x104FB, Stride: 1},
+			{Lo: 0x10597, Hi: 0x105A1, Stride: 1},
+			{Lo: 0x105A3, Hi: 0x105B1, Stride: 1},
+			{Lo: 0x105B3, Hi: 0x105B9, Stride: 1},
+			{Lo: 0x105BB, Hi: 0x105BC, Stride: 1},
+			{Lo: 0x10780, Hi: 0x10780, Stride: 1},
+			{Lo: 0x10783, Hi: 0x10785, Stride: 1},
+			{Lo: 0x10787, Hi: 0x107B0, Stride: 1},
+			{Lo: 0x107B2, Hi: 0x107BA, Stride: 1},
+			{Lo: 0x10CC0, Hi: 0x10CF2, Stride: 1},
+			{Lo: 0x10D70, Hi: 0x10D85, Stride: 1},
+			{Lo: 0x118C0, Hi: 0x118DF, Stride: 1},
+			{Lo: 0x16E60, Hi: 0x16E7F, Stride: 1},
+			{Lo: 0x16EBB, Hi: 0x16ED3, Stride: 1},
+			{Lo: 0x1D41A, Hi: 0x1D433, Stride: 1},
+			{Lo: 0x1D44E, Hi: 0x1D454, Stride: 1},
+			{Lo: 0x1D456, Hi: 0x1D467, Stride: 1},
+			{Lo: 0x1D482, Hi: 0x1D49B, Stride: 1},
+			{Lo: 0x1D4B6, Hi: 0x1D4B9, Stride: 1},
+			{Lo: 0x1D4BB, Hi: 0x1D4BB, Stride: 1},
+			{Lo: 0x1D4BD, Hi: 0x1D4C3, Stride: 1},
+			{Lo: 0x1D4C5, Hi: 0x1D4CF, Stride: 1},
+			{Lo: 0x1D4EA, Hi: 0x1D503, Stride: 1},
+			{Lo: 0x1D51E, Hi: 0x1D537, Stride: 1},
+			{Lo: 0x1D552, Hi: 0x1D56B, Stride: 1},
+			{Lo: 0x1D586, Hi: 0x1D59F, Stride: 1},
+			{Lo: 0x1D5BA, Hi: 0x1D5D3, Stride: 1},
+			{Lo: 0x1D5EE, Hi: 0x1D607, Stride: 1},
+			{Lo: 0x1D622, Hi: 0x1D63B, Stride: 1},
+			{Lo: 0x1D656, Hi: 0x1D66F, Stride: 1},
+			{Lo: 0x1D68A, Hi: 0x1D6A5, Stride: 1},
+			{Lo: 0x1D6C2, Hi: 0x1D6DA, Stride: 1},
+			{Lo: 0x1D6DC, Hi: 0x1D6E1, Stride: 1},
+			{Lo: 0x1D6FC, Hi: 0x1D714, Stride: 1},
+			{Lo: 0x1D716, Hi: 0x1D71B, Stride: 1},
+			{Lo: 0x1D736, Hi: 0x1D74E, Stride: 1},
+			{Lo: 0x1D750, Hi: 0x1D755, Stride: 1},
+			{Lo: 0x1D770, Hi: 0x1D788, Stride: 1},
+			{Lo: 0x1D78A, Hi: 0x1D78F, Stride: 1},
+			{Lo: 0x1D7AA, Hi: 0x1D7C2, Stride: 1},
+			{Lo: 0x1D7C4, Hi: 0x1D7C9, Stride: 1},
+			{Lo: 0x1D7CB, Hi: 0x1D7CB, Stride: 1},
+			{Lo: 0x1DF00, Hi: 0x1DF09, Stride: 1},
+			{Lo: 0x1DF0B, Hi: 0x1DF1E, Stride: 1},
+			{Lo: 0x1DF25, Hi: 0x1DF2A, Stride: 1},
+			{Lo: 0x1E030, Hi: 0x1E06D, Stride: 1},
+			{Lo: 0x1E922, Hi: 0x1E943, Stride: 1},
+		},
+	}
+
+	tableNumeric = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+			{Lo: 0x0660, Hi: 0x0669, Stride: 1},
+			{Lo: 0x066B, Hi: 0x066C, Stride: 1},
+			{Lo: 0x06F0, Hi: 0x06F9, Stride: 1},
+			{Lo: 0x07C0, Hi: 0x07C9, Stride: 1},
+			{Lo: 0x0966, Hi: 0x096F, Stride: 1},
+			{Lo: 0x09E6, Hi: 0x09EF, Stride: 1},
+			{Lo: 0x0A66, Hi: 0x0A6F, Stride: 1},
+			{Lo: 0x0AE6, Hi: 0x0AEF, Stride: 1},
+			{Lo: 0x0B66, Hi: 0x0B6F, Stride: 1},
+			{Lo: 0x0BE6, Hi: 0x0BEF, Stride: 1},
+			{Lo: 0x0C66, Hi: 0x0C6F, Stride: 1},
+			{Lo: 0x0CE6, Hi: 0x0CEF, Stride: 1},
+			{Lo: 0x0D66, Hi: 0x0D6F, Stride: 1},
+			{Lo: 0x0DE6, Hi: 0x0DEF, Stride: 1},
+			{Lo: 0x0E50, Hi: 0x0E59, Stride: 1},
+			{Lo: 0x0ED0, Hi: 0x0ED9, Stride: 1},
+			{Lo: 0x0F20, Hi: 0x0F29, Stride: 1},
+			{Lo: 0x1040, Hi: 0x1049, Stride: 1},
+			{Lo: 0x1090, Hi: 0x1099, Stride: 1},
+			{Lo: 0x17E0, Hi: 0x17E9, Stride: 1},
+			{Lo: 0x1810, Hi: 0x1819, Stride: 1},
+			{Lo: 0x1946, Hi: 0x194F, Stride: 1},
+			{Lo: 0x19D0, Hi: 0x19D9, Stride: 1},
+			{Lo: 0x1A80, Hi: 0x1A89, Stride: 1},
+			{Lo: 0x1A90, Hi: 0x1A99, Stride: 1},
+			{Lo: 0x1B50, Hi: 0x1B59, Stride: 1},
+			{Lo: 0x1BB0, Hi: 0x1BB9, Stride: 1},
+			{Lo: 0x1C40, Hi: 0x1C49, Stride: 1},
+			{Lo: 0x1C50, Hi: 0x1C59, Stride: 1},
+			{Lo: 0xA620, Hi: 0xA629, Stride: 1},
+			{Lo: 0xA8D0, Hi: 0xA8D9, Stride: 1},
+			{Lo: 0xA900, Hi: 0xA909, Stride: 1},
+			{Lo: 0xA9D0, Hi: 0xA9D9, Stride: 1},
+			{Lo: 0xA9F0, Hi: 0xA9F9, Stride: 1},
+			{Lo: 0xAA50, Hi: 0xAA59, Stride: 1},
+			{Lo: 0xABF0, Hi: 0xABF9, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x104A0, Hi: 0x104A9, Stride: 1},
+			{Lo: 0x10D30, Hi: 0x10D39, Stride: 1},
+			{Lo: 0x10D40, Hi: 0x10D49, Stride: 1},
+			{Lo: 0x11066, Hi: 0x1106F, Stride: 1},
+			{Lo: 0x110F0, Hi: 0x110F9, Stride: 1},
+			{Lo: 0x11136, Hi: 0x1113F, Stride: 1},
+			{Lo: 0x111D0, Hi: 0x111D9, Stride: 1},
+			{Lo: 0x112F0, Hi: 0x112F9, Stride: 1},
+			{Lo: 0x11450, Hi: 0x11459, Stride: 1},
+			{Lo: 0x114D0, Hi: 0x114D9, Stride: 1},
+			{Lo: 0x11650, Hi: 0x11659, Stride: 1},
+			{Lo: 0x116C0, Hi: 0x116C9, Stride: 1},
+			{Lo: 0x116D0, Hi: 0x116E3, Stride: 1},
+			{Lo: 0x11730, Hi: 0x11739, Stride: 1},
+			{Lo: 0x118E0, Hi: 0x118E9, Stride: 1},
+			{Lo: 0x11950, Hi: 0x11959, Stride: 1},
+			{Lo: 0x11BF0, Hi: 0x11BF9, Stride: 1},
+			{Lo: 0x11C50, Hi: 0x11C59, Stride: 1},
+			{Lo: 0x11D50, Hi: 0x11D59, Stride: 1},
+			{Lo: 0x11DA0, Hi: 0x11DA9, Stride: 1},
+			{Lo: 0x11DE0, Hi: 0x11DE9, Stride: 1},
+			{Lo: 0x11F50, Hi: 0x11F59, Stride: 1},
+			{Lo: 0x16130, Hi: 0x16139, Stride: 1},
+			{Lo: 0x16A60, Hi: 0x16A69, Stride: 1},
+			{Lo: 0x16AC0, Hi: 0x16AC9, Stride: 1},
+			{Lo: 0x16B50, Hi: 0x16B59, Stride: 1},
+			{Lo: 0x16D70, Hi: 0x16D79, Stride: 1},
+			{Lo: 0x1CCF0, Hi: 0x1CCF9, Stride: 1},
+			{Lo: 0x1D7CE, Hi: 0x1D7FF, Stride: 1},
+			{Lo: 0x1E140, Hi: 0x1E149, Stride: 1},
+			{Lo: 0x1E2F0, Hi: 0x1E2F9, Stride: 1},
+			{Lo: 0x1E4F0, Hi: 0x1E4F9, Stride: 1},
+			{Lo: 0x1E5F1, Hi: 0x1E5FA, Stride: 1},
+			{Lo: 0x1E950, Hi: 0x1E959, Stride: 1},
+			{Lo: 0x1FBF0, Hi: 0x1FBF9, Stride: 1},
+		},
+	}
+
+	tableOLetter = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x00A0, Hi: 0x00A0, Stride: 1},
+			{Lo: 0x01BB, Hi: 0x01BB, Stride: 1},
+			{Lo: 0x01C0, Hi: 0x01C3, Stride: 1},
+			{Lo: 0x0294, Hi: 0x0295, Stride: 1},
+			{Lo: 0x02B9, Hi: 0x02BF, Stride: 1},
+			{Lo: 0x02C6, Hi: 0x02D1, Stride: 1},
+			{Lo: 0x02EC, Hi: 0x02EC, Stride: 1},
+			{Lo: 0x02EE, Hi: 0x02EE, Stride: 1},
+			{Lo: 0x0374, Hi: 0x0374, Stride: 1},
+			{Lo: 0x0559, Hi: 0x0559, Stride: 1},
+			{Lo: 0x05D0, Hi: 0x05EA, Stride: 1},
+			{Lo: 0x05EF, Hi: 0x05F3, Stride: 1},
+			{Lo: 0x0620, Hi: 0x064A, Stride: 1},
+			{Lo: 0x066E, Hi: 0x066F, Stride: 1},
+			{Lo: 0x0671, Hi: 0x06D3, Stride: 1},
+			{Lo: 0x06D5, Hi: 0x06D5, Stride: 1},
+			{Lo: 0x06E5, Hi: 0x06E6, Stride: 1},
+			{Lo: 0x06EE, Hi: 0x06EF, Stride: 1},
+			{Lo: 0x06FA, Hi: 0x06FC, Stride: 1},
+			{Lo: 0x06FF, Hi: 0x06FF, Stride: 1},
+			{Lo: 0x0710, Hi: 0x0710, Stride: 1},
+			{Lo: 0x0712, Hi: 0x072F, Stride: 1},
+			{Lo: 0x074D, Hi: 0x07A5, Stride: 1},
+			{Lo: 0x07B1, Hi: 0x07B1, Stride: 1},
+			{Lo: 0x07CA, Hi: 0x07EA, Stride: 1},
+			{Lo: 0x07F4, Hi: 0x07F5, Stride: 1},
+			{Lo: 0x07FA, Hi: 0x07FA, Stride: 1},
+			{Lo: 0x0800, Hi: 0x0815, Stride: 1},
+			{Lo: 0x081A, Hi: 0x081A, Stride: 1},
+			{Lo: 0x0824, Hi: 0x0824, Stride: 1},
+			{Lo: 0x0828, Hi: 0x0828, Stride: 1},
+			{Lo: 0x0840, Hi: 0x0858, Stride: 1},
+			{Lo: 0x0860, Hi: 0x086A, Stride: 1},
+			{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+			{Lo: 0x0889, Hi: 0x088F, Stride: 1},
+			{Lo: 0x08A0, Hi: 0x08C9, Stride: 1},
+			{Lo: 0x0904, Hi: 0x0939, Stride: 1},
+			{Lo: 0x093D, Hi: 0x093D, Stride: 1},
+			{Lo: 0x0950, Hi: 0x0950, Stride: 1},
+			{Lo: 0x0958, Hi: 0x0961, Stride: 1},
+			{Lo: 0x0971, Hi: 0x0980, Stride: 1},
+			{Lo: 0x0985, Hi: 0x098C, Stride: 1},
+			{Lo: 0x098F, Hi: 0x0990, Stride: 1},
+			{Lo: 0x0993, Hi: 0x09A8, Stride: 1},
+			{Lo: 0x09AA, Hi: 0x09B0, Stride: 1},
+			{Lo: 0x09B2, Hi: 0x09B2, Stride: 1},
+			{Lo: 0x09B6, Hi: 0x09B9, Stride: 1},
+			{Lo: 0x09BD, Hi: 0x09BD, Stride: 1},
+			{Lo: 0x09CE, Hi: 0x09CE, Stride: 1},
+			{Lo: 0x09DC, Hi: 0x09DD, Stride: 1},
+			{Lo: 0x09DF, Hi: 0x09E1, Stride: 1},
+			{Lo: 0x09F0, Hi: 0x09F1, Stride: 1},
+			{Lo: 0x09FC, Hi: 0x09FC, Stride: 1},
+			{Lo: 0x0A05, Hi: 0x0A0A, Stride: 1},
+			{Lo: 0x0A0F, Hi: 0x0A10, Stride: 1},
+			{Lo: 0x0A13, Hi: 0x0A28, Stride: 1},
+			{Lo: 0x0A2A, Hi: 0x0A30, Stride: 1},
+			{Lo: 0x0A32, Hi: 0x0A33, Stride: 1},
+			{Lo: 0x0A35, Hi: 0x0A36, Stride: 1},
+			{Lo: 0x0A38, Hi: 0x0A39, Stride: 1},
+			{Lo: 0x0A59, Hi: 0x0A5C, Stride: 1},
+			{Lo: 0x0A5E, Hi: 0x0A5E, Stride: 1},
+			{Lo: 0x0A72, Hi: 0x0A74, Stride: 1},
+			{Lo: 0x0A85, Hi: 0x0A8D, Stride: 1},
+			{Lo: 0x0A8F, Hi: 0x0A91, Stride: 1},
+			{Lo: 0x0A93, Hi: 0x0AA8, Stride: 1},
+			{Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1},
+			{Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
+			{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1},
+			{Lo: 0x0ABD, Hi: 0x0ABD, Stride: 1},
+			{Lo: 0x0AD0, Hi: 0x0AD0, Stride: 1},
+			{Lo: 0x0AE0, Hi: 0x0AE1, Stride: 1},
+			{Lo: 0x0AF9, Hi: 0x0AF9, Stride: 1},
+			{Lo: 0x0B05, Hi: 0x0B0C, Stride: 1},
+			{Lo: 0x0B0F, Hi: 0x0B10, Stride: 1},
+			{Lo: 0x0B13, Hi: 0x0B28, Stride: 1},
+			{Lo: 0x0B2A, Hi: 0x0B30, Stride: 1},
+			{Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
+			{Lo: 0x0B35, Hi: 0x0B39, Stride: 1},
+			{Lo: 0x0B3D, Hi: 0x0B3D, Stride: 1},
+			{Lo: 0x0B5C, Hi: 0x0B5D, Stride: 1},
+			{Lo: 0x0B5F, Hi: 0x0B61, Stride: 1},
+			{Lo: 0x0B71, Hi: 0x0B71, Stride: 1},
+			{Lo: 0x0B83, Hi: 0x0B83, Stride: 1},
+			{Lo: 0x0B85, Hi: 0x0B8A, Stride: 1},
+			{Lo: 0x0B8E, Hi: 0x0B90, Stride: 1},
+			{Lo: 0x0B92, Hi: 0x0B95, Stride: 1},
+			{Lo: 0x0B99, Hi: 0x0B9A, Stride: 1},
+			{Lo: 0x0B9C, Hi: 0x0B9C, Stride: 1},
+			{Lo: 0x0B9E, Hi: 0x0B9F, Stride: 1},
+			{Lo: 0x0BA3, Hi: 0x0BA4, Stride: 1},
+			{Lo: 0x0BA8, Hi: 0x0BAA, Stride: 1},
+			{Lo: 0x0BAE, Hi: 0x0BB9, Stride: 1},
+			{Lo: 0x0BD0, Hi: 0x0BD0, Stride: 1},
+			{Lo: 0x0C05, Hi: 0x0C0C, Stride: 1},
+			{Lo: 0x0C0E, Hi: 0x0C10, Stride: 1},
+			{Lo: 0x0C12, Hi: 0x0C28, Stride: 1},
+			{Lo: 0x0C2A, Hi: 0x0C39, Stride: 1},
+			{Lo: 0x0C3D, Hi: 0x0C3D, Stride: 1},
+			{Lo: 0x0C58, Hi: 0x0C5A, Stride: 1},
+			{Lo: 0x0C5C, Hi: 0x0C5D, Stride: 1},
+			{Lo: 0x0C60, Hi: 0x0C61, Stride: 1},
+			{Lo: 0x0C80, Hi: 0x0C80, Stride: 1},
+			{Lo: 0x0C85, Hi: 0x0C8C, Stride: 1},
+			{Lo: 0x0C8E, Hi: 0x0C90, Stride: 1},
+			{Lo: 0x0C92, Hi: 0x0CA8, Stride: 1},
+			{Lo: 0x0CAA, Hi: 0x0CB3, Stride: 1},
+			{Lo: 0x0CB5, Hi: 0x0CB9, Stride: 1},
+			{Lo: 0x0CBD, Hi: 0x0CBD, Stride: 1},
+			{Lo: 0x0CDC, Hi: 0x0CDE, Stride: 1},
+			{Lo: 0x0CE0, Hi: 0x0CE1, Stride: 1},
+			{Lo: 0x0CF1, Hi: 0x0CF2, Stride: 1},
+			{Lo: 0x0D04, Hi: 0x0D0C, Stride: 1},
+			{Lo: 0x0D0E, Hi: 0x0D10, Stride: 1},
+			{Lo: 0x0D12, Hi: 0x0D3A, Stride: 1},
+			{Lo: 0x0D3D, Hi: 0x0D3D, Stride: 1},
+			{Lo: 0x0D4E, Hi: 0x0D4E, Stride: 1},
+			{Lo: 0x0D54, Hi: 0x0D56, Stride: 1},
+			{Lo: 0x0D5F, Hi: 0x0D61, Stride: 1},
+			{Lo: 0x0D7A, Hi: 0x0D7F, Stride: 1},
+			{Lo: 0x0D85, Hi: 0x0D96, Stride: 1},
+			{Lo: 0x0D9A, Hi: 0x0DB1, Stride: 1},
+			{Lo: 0x0DB3, Hi: 0x0DBB, Stride: 1},
+			{Lo: 0x0DBD, Hi: 0x0DBD, Stride: 1},
+			{Lo: 0x0DC0, Hi: 0x0DC6, Stride: 1},
+			{Lo: 0x0E01, Hi: 0x0E30, Stride: 1},
+			{Lo: 0x0E32, Hi: 0x0E33, Stride: 1},
+			{Lo: 0x0E40, Hi: 0x0E46, Stride: 1},
+			{Lo: 0x0E81, Hi: 0x0E82, Stride: 1},
+			{Lo: 0x0E84, Hi: 0x0E84, Stride: 1},
+			{Lo: 0x0E86, Hi: 0x0E8A, Stride: 1},
+			{Lo: 0x0E8C, Hi: 0x0EA3, Stride: 1},
+			{Lo: 0x0EA5, Hi: 0x0EA5, Stride: 1},
+			{Lo: 0x0EA7, Hi: 0x0EB0, Stride: 1},
+			{Lo: 0x0EB2, Hi: 0x0EB3, Stride: 1},
+			{Lo: 0x0EBD, Hi: 0x0EBD, Stride: 1},
+			{Lo: 0x0EC0, Hi: 0x0EC4, Stride: 1},
+			{Lo: 0x0EC6, Hi: 0x0EC6, Stride: 1},
+			{Lo: 0x0EDC, Hi: 0x0EDF, Stride: 1},
+			{Lo: 0x0F00, Hi: 0x0F00, Stride: 1},
+			{Lo: 0x0F40, Hi: 0x0F47, Stride: 1},
+			{Lo: 0x0F49, Hi: 0x0F6C, Stride: 1},
+			{Lo: 0x0F88, Hi: 0x0F8C, Stride: 1},
+			{Lo: 0x1000, Hi: 0x102A, Stride: 1},
+			{Lo: 0x103F, Hi: 0x103F, Stride: 1},
+			{Lo: 0x1050, Hi: 0x1055, Stride: 1},
+			{Lo: 0x105A, Hi: 0x105D, Stride: 1},
+			{Lo: 0x1061, Hi: 0x1061, Stride: 1},
+			{Lo: 0x1065, Hi: 0x1066, Stride: 1},
+			{Lo: 0x106E, Hi: 0x1070, Stride: 1},
+			{Lo: 0x1075, Hi: 0x1081, Stride: 1},
+			{Lo: 0x108E, Hi: 0x108E, Stride: 1},
+			{Lo: 0x1100, Hi: 0x1248, Stride: 1},
+			{Lo: 0x124A, Hi: 0x124D, Stride: 1},
+			{Lo: 0x1250, Hi: 0x1256, Stride: 1},
+			{Lo: 0x1258, Hi: 0x1258, Stride: 1},
+			{Lo: 0x125A, Hi: 0x125D, Stride: 1},
+			{Lo: 0x1260, Hi: 0x1288, Stride: 1},
+			{Lo: 0x128A, Hi: 0x128D, Stride: 1},
+			{Lo: 0x1290, Hi: 0x12B0, Stride: 1},
+			{Lo: 0x12B2, Hi: 0x12B5, Stride: 1},
+			{Lo: 0x12B8, Hi: 0x12BE, Stride: 1},
+			{Lo: 0x12C0, Hi: 0x12C0, Stride: 1},
+			{Lo: 0x12C2, Hi: 0x12C5, Stride: 1},
+			{Lo: 0x12C8, Hi: 0x12D6, Stride: 1},
+			{Lo: 0x12D8, Hi: 0x1310, Stride: 1},
+			{Lo: 0x1312, Hi: 0x1315, Stride: 1},
+			{Lo: 0x1318, Hi: 0x135A, Stride: 1},
+			{Lo: 0x1380, Hi: 0x138F, Stride: 1},
+			{Lo: 0x1401, Hi: 0x166C, Stride: 1},
+			{Lo: 0x166F, Hi: 0x167F, Stride: 1},
+			{Lo: 0x1681, Hi: 0x169A, Stride: 1},
+			{Lo: 0x16A0, Hi: 0x16EA, Stride: 1},
+			{Lo: 0x16EE, Hi: 0x16F8, Stride: 1},
+			{Lo: 0x1700, Hi: 0x1711, Stride: 1},
+			{Lo: 0x171F, Hi: 0x1731, Stride: 1},
+			{Lo: 0x1740, Hi: 0x1751, Stride: 1},
+			{Lo: 0x1760, Hi: 0x176C, Stride: 1},
+			{Lo: 0x176E, Hi: 0x1770, Stride: 1},
+			{Lo: 0x1780, Hi: 0x17B3, Stride: 1},
+			{Lo: 0x17D7, Hi: 0x17D7, Stride: 1},
+			{Lo: 0x17DC, Hi: 0x17DC, Stride: 1},
+			{Lo: 0x1820, Hi: 0x1878, Stride: 1},
+			{Lo: 0x1880, Hi: 0x1884, Stride: 1},
+			{Lo: 0x1887, Hi: 0x18A8, Stride: 1},
+			{Lo: 0x18AA, Hi: 0x18AA, Stride: 1},
+			{Lo: 0x18B0, Hi: 0x18F5, Stride: 1},
+			{Lo: 0x1900, Hi: 0x191E, Stride: 1},
+			{Lo: 0x1950, Hi: 0x196D, Stride: 1},
+			{Lo: 0x1970, Hi: 0x1974, Stride: 1},
+			{Lo: 0x1980, Hi: 0x19AB, Stride: 1},
+			{Lo: 0x19B0, Hi: 0x19C9, Stride: 1},
+			{Lo: 0x1A00, Hi: 0x1A16, Stride: 1},
+			{Lo: 0x1A20, Hi: 0x1A54, Stride: 1},
+			{Lo: 0x1AA7, Hi: 0x1AA7, Stride: 1},
+			{Lo: 0x1B05, Hi: 0x1B33, Stride: 1},
+			{Lo: 0x1B45, Hi: 0x1B4C, Stride: 1},
+			{Lo: 0x1B83, Hi: 0x1BA0, Stride: 1},
+			{Lo: 0x1BAE, Hi: 0x1BAF, Stride: 1},
+			{Lo: 0x1BBA, Hi: 0x1BE5, Stride: 1},
+			{Lo: 0x1C00, Hi: 0x1C23, Stride: 1},
+			{Lo: 0x1C4D, Hi: 0x1C4F, Stride: 1},
+			{Lo: 0x1C5A, Hi: 0x1C7D, Stride: 1},
+			{Lo: 0x1CE9, Hi: 0x1CEC, Stride: 1},
+			{Lo: 0x1CEE, Hi: 0x1CF3, Stride: 1},
+			{Lo: 0x1CF5, Hi: 0x1CF6, Stride: 1},
+			{Lo: 0x1CFA, Hi: 0x1CFA, Stride: 1},
+			{Lo: 0x2135, Hi: 0x2138, Stride: 1},
+			{Lo: 0x2180, Hi: 0x2182, Stride: 1},
+			{Lo: 0x2185, Hi: 0x2188, Stride: 1},
+			{Lo: 0x2D30, Hi: 0x2D67, Stride: 1},
+			{Lo: 0x2D6F, Hi: 0x2D6F, Stride: 1},
+			{Lo: 0x2D80, Hi: 0x2D96, Stride: 1},
+			{Lo: 0x2DA0, Hi: 0x2DA6, Stride: 1},
+			{Lo: 0x2DA8, Hi: 0x2DAE, Stride: 1},
+			{Lo: 0x2DB0, Hi: 0x2DB6, Stride: 1},
+			{Lo: 0x2DB8, Hi: 0x2DBE, Stride: 1},
+			{Lo: 0x2DC0, Hi: 0x2DC6, Stride: 1},
+			{Lo: 0x2DC8, Hi: 0x2DCE, Stride: 1},
+			{Lo: 0x2DD0, Hi: 0x2DD6, Stride: 1},
+			{Lo: 0x2DD8, Hi: 0x2DDE, Stride: 1},
+			{Lo: 0x2E2F, Hi: 0x2E2F, Stride: 1},
+			{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+			{Lo: 0x3021, Hi: 0x3029, Stride: 1},
+			{Lo: 0x3031, Hi: 0x3035, Stride: 1},
+			{Lo: 0x3038, Hi: 0x303C, Stride: 1},
+			{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+			{Lo: 0x309D, Hi: 0x309F, Stride: 1},
+			{Lo: 0x30A1, Hi: 0x30FA, Stride: 1},
+			{Lo: 0x30FC, Hi: 0x30FF, Stride: 1},
+			{Lo: 0x3105, Hi: 0x312F, Stride: 1},
+			{Lo: 0x3131, Hi: 0x318E, Stride: 1},
+			{Lo: 0x31A0, Hi: 0x31BF, Stride: 1},
+			{Lo: 0x31F0, Hi: 0x31FF, Stride: 1},
+			{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+			{Lo: 0x4E00, Hi: 0xA48C, Stride: 1},
+			{Lo: 0xA4D0, Hi: 0xA4FD, Stride: 1},
+			{Lo: 0xA500, Hi: 0xA60C, Stride: 1},
+			{Lo: 0xA610, Hi: 0xA61F, Stride: 1},
+			{Lo: 0xA62A, Hi: 0xA62B, Stride: 1},
+			{Lo: 0xA66E, Hi: 0xA66E, Stride: 1},
+			{Lo: 0xA67F, Hi: 0xA67F, Stride: 1},
+			{Lo: 0xA6A0, Hi: 0xA6EF, Stride: 1},
+			{Lo: 0xA717, Hi: 0xA71F, Stride: 1},
+			{Lo: 0xA788, Hi: 0xA788, Stride: 1},
+			{Lo: 0xA78F, Hi: 0xA78F, Stride: 1},
+			{Lo: 0xA7F7, Hi: 0xA7F7, Stride: 1},
+			{Lo: 0xA7FB, Hi: 0xA801, Stride: 1},
+			{Lo: 0xA803, Hi: 0xA805, Stride: 1},
+			{Lo: 0xA807, Hi: 0xA80A, Stride: 1},
+			{Lo: 0xA80C, Hi: 0xA822, Stride: 1},
+			{Lo: 0xA840, Hi: 0xA873, Stride: 1},
+			{Lo: 0xA882, Hi: 0xA8B3, Stride: 1},
+			{Lo: 0xA8F2, Hi: 0xA8F7, Stride: 1},
+			{Lo: 0xA8FB, Hi: 0xA8FB, Stride: 1},
+			{Lo: 0xA8FD, Hi: 0xA8FE, Stride: 1},
+			{Lo: 0xA90A, Hi: 0xA925, Stride: 1},
+			{Lo: 0xA930, Hi: 0xA946, Stride: 1},
+			{Lo: 0xA960, Hi: 0xA97C, Stride: 1},
+			{Lo: 0xA984, Hi: 0xA9B2, Stride: 1},
+			{Lo: 0xA9CF, Hi: 0xA9CF, Stride: 1},
+			{Lo: 0xA9E0, Hi: 0xA9E4, Stride: 1},
+			{Lo: 0xA9E6, Hi: 0xA9EF, Stride: 1},
+			{Lo: 0xA9FA, Hi: 0xA9FE, Stride: 1},
+			{Lo: 0xAA00, Hi: 0xAA28, Stride: 1},
+			{Lo: 0xAA40, Hi: 0xAA42, Stride: 1},
+			{Lo: 0xAA44, Hi: 0xAA4B, Stride: 1},
+			{Lo: 0xAA60, Hi: 0xAA76, Stride: 1},
+			{Lo: 0xAA7A, Hi: 0xAA7A, Stride: 1},
+			{Lo: 0xAA7E, Hi: 0xAAAF, Stride: 1},
+			{Lo: 0xAAB1, Hi: 0xAAB1, Stride: 1},
+			{Lo: 0xAAB5, Hi: 0xAAB6, Stride: 1},
+			{Lo: 0xAAB9, Hi: 0xAABD, Stride: 1},
+			{Lo: 0xAAC0, Hi: 0xAAC0, Stride: 1},
+			{Lo: 0xAAC2, Hi: 0xAAC2, Stride: 1},
+			{Lo: 0xAADB, Hi: 0xAADD, Stride: 1},
+			{Lo: 0xAAE0, Hi: 0xAAEA, Stride: 1},
+			{Lo: 0xAAF2, Hi: 0xAAF4, Stride: 1},
+			{Lo: 0xAB01, Hi: 0xAB06, Stride: 1},
+			{Lo: 0xAB09, Hi: 0xAB0E, Stride: 1},
+			{Lo: 0xAB11, Hi: 0xAB16, Stride: 1},
+			{Lo: 0xAB20, Hi: 0xAB26, Stride: 1},
+			{Lo: 0xAB28, Hi: 0xAB2E, Stride: 1},
+			{Lo: 0xABC0, Hi: 0xABE2, Stride: 1},
+			{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+			{Lo: 0xD7B0, Hi: 0xD7C6, Stride: 1},
+			{Lo: 0xD7CB, Hi: 0xD7FB, Stride: 1},
+			{Lo: 0xF900, Hi: 0xFA6D, Stride: 1},
+			{Lo: 0xFA70, Hi: 0xFAD9, Stride: 1},
+			{Lo: 0xFB1D, Hi: 0xFB1D, Stride: 1},
+			{Lo: 0xFB1F, Hi: 0xFB28, Stride: 1},
+			{Lo: 0xFB2A, Hi: 0xFB36, Stride: 1},
+			{Lo: 0xFB38, Hi: 0xFB3C, Stride: 1},
+			{Lo: 0xFB3E, Hi: 0xFB3E, Stride: 1},
+			{Lo: 0xFB40, Hi: 0xFB41, Stride: 1},
+			{Lo: 0xFB43, Hi: 0xFB44, Stride: 1},
+			{Lo: 0xFB46, Hi: 0xFBB1, Stride: 1},
+			{Lo: 0xFBD3, Hi: 0xFD3D, Stride: 1},
+			{Lo: 0xFD50, Hi: 0xFD8F, Stride: 1},
+			{Lo: 0xFD92, Hi: 0xFDC7, Stride: 1},
+			{Lo: 0xFDF0, Hi: 0xFDFB, Stride: 1},
+			{Lo: 0xFE70, Hi: 0xFE74, Stride: 1},
+			{Lo: 0xFE76, Hi: 0xFEFC, Stride: 1},
+			{Lo: 0xFF66, Hi: 0xFF9D, Stride: 1},
+			{Lo: 0xFFA0, Hi: 0xFFBE, Stride: 1},
+			{Lo: 0xFFC2, Hi: 0xFFC7, Stride: 1},
+			{Lo: 0xFFCA, Hi: 0xFFCF, Stride: 1},
+			{Lo: 0xFFD2, Hi: 0xFFD7, Stride: 1},
+			{Lo: 0xFFDA, Hi: 0xFFDC, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10000, Hi: 0x1000B, Stride: 1},
+			{Lo: 0x1000D, Hi: 0x10026, Stride: 1},
+			{Lo: 0x10028, Hi: 0x1003A, Stride: 1},
+			{Lo: 0x1003C, Hi: 0x1003D, Stride: 1},
+			{Lo: 0x1003F, Hi: 0x1004D, Stride: 1},
+			{Lo: 0x10050, Hi: 0x1005D, Stride: 1},
+			{Lo: 0x10080, Hi: 0x100FA, Stride: 1},
+			{Lo: 0x10140, Hi: 0x10174, Stride: 1},
+			{Lo: 0x10280, Hi: 0x1029C, Stride: 1},
+			{Lo: 0x102A0, Hi: 0x102D0, Stride: 1},
+			{Lo: 0x10300, Hi: 0x1031F, Stride: 1},
+			{Lo: 0x1032D, Hi: 0x1034A, Stride: 1},
+			{Lo: 0x10350, Hi: 0x10375, Stride: 1},
+			{Lo: 0x10380, Hi: 0x1039D, Stride: 1},
+			{Lo: 0x103A0, Hi: 0x103C3, Stride: 1},
+			{Lo: 0x103C8, Hi: 0x103CF, Stride: 1},
+			{Lo: 0x103D1, Hi: 0x103D5, Stride: 1},
+			{Lo: 0x10450, Hi: 0x1049D, Stride: 1},
+			{Lo: 0x10500, Hi: 0x10527, Stride: 1},
+			{Lo: 0x10530, Hi: 0x10563, Stride: 1},
+			{Lo: 0x105C0, Hi: 0x105F3, Stride: 1},
+			{Lo: 0x10600, Hi: 0x10736, Stride: 1},
+			{Lo: 0x10740, Hi: 0x10755, Stride: 1},
+			{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+			{Lo: 0x10781, Hi: 0x10782, Stride: 1},
+			{Lo: 0x10800, Hi: 0x10805, Stride: 1},
+			{Lo: 0x10808, Hi: 0x10808, Stride: 1},
+			{Lo: 0x1080A, Hi: 0x10835, Stride: 1},
+			{Lo: 0x10837, Hi: 0x10838, Stride: 1},
+			{Lo: 0x1083C, Hi: 0x1083C, Stride: 1},
+			{Lo: 0x1083F, Hi: 0x10855, Stride: 1},
+			{Lo: 0x10860, Hi: 0x10876, Stride: 1},
+			{Lo: 0x10880, Hi: 0x1089E, Stride: 1},
+			{Lo: 0x108E0, Hi: 0x108F2, Stride: 1},
+			{Lo: 0x108F4, Hi: 0x108F5, Stride: 1},
+			{Lo: 0x10900, Hi: 0x10915, Stride: 1},
+			{Lo: 0x10920, Hi: 0x10939, Stride: 1},
+			{Lo: 0x10940, Hi: 0x10959, Stride: 1},
+			{Lo: 0x10980, Hi: 0x109B7, Stride: 1},
+			{Lo: 0x109BE, Hi: 0x109BF, Stride: 1},
+			{Lo: 0x10A00, Hi: 0x10A00, Stride: 1},
+			{Lo: 0x10A10, Hi: 0x10A13, Stride: 1},
+			{Lo: 0x10A15, Hi: 0x10A17, Stride: 1},
+			{Lo: 0x10A19, Hi: 0x10A35, Stride: 1},
+			{Lo: 0x10A60, Hi: 0x10A7C, Stride: 1},
+			{Lo: 0x10A80, Hi: 0x10A9C, Stride: 1},
+			{Lo: 0x10AC0, Hi: 0x10AC7, Stride: 1},
+			{Lo: 0x10AC9, Hi: 0x10AE4, Stride: 1},
+			{Lo: 0x10B00, Hi: 0x10B35, Stride: 1},
+			{Lo: 0x10B40, Hi: 0x10B55, Stride: 1},
+			{Lo: 0x10B60, Hi: 0x10B72, Stride: 1},
+			{Lo: 0x10B80, Hi: 0x10B91, Stride: 1},
+			{Lo: 0x10C00, Hi: 0x10C48, Stride: 1},
+			{Lo: 0x10D00, Hi: 0x10D23, Stride: 1},
+			{Lo: 0x10D4A, Hi: 0x10D4F, Stride: 1},
+			{Lo: 0x10D6F, Hi: 0x10D6F, Stride: 1},
+			{Lo: 0x10E80, Hi: 0x10EA9, Stride: 1},
+			{Lo: 0x10EB0, Hi: 0x10EB1, Stride: 1},
+			{Lo: 0x10EC2, Hi: 0x10EC7, Stride: 1},
+			{Lo: 0x10F00, Hi: 0x10F1C, Stride: 1},
+			{Lo: 0x10F27, Hi: 0x10F27, Stride: 1},
+			{Lo: 0x10F30, Hi: 0x10F45, Stride: 1},
+			{Lo: 0x10F70, Hi: 0x10F81, Stride: 1},
+			{Lo: 0x10FB0, Hi: 0x10FC4, Stride: 1},
+			{Lo: 0x10FE0, Hi: 0x10FF6, Stride: 1},
+			{Lo: 0x11003, Hi: 0x11037, Stride: 1},
+			{Lo: 0x11071, Hi: 0x11072, Stride: 1},
+			{Lo: 0x11075, Hi: 0x11075, Stride: 1},
+			{Lo: 0x11083, Hi: 0x110AF, Stride: 1},
+			{Lo: 0x110D0, Hi: 0x110E8, Stride: 1},
+			{Lo: 0x11103, Hi: 0x11126, Stride: 1},
+			{Lo: 0x11144, Hi: 0x11144, Stride: 1},
+			{Lo: 0x11147, Hi: 0x11147, Stride: 1},
+			{Lo: 0x11150, Hi: 0x11172, Stride: 1},
+			{Lo: 0x11176, Hi: 0x11176, Stride: 1},
+			{Lo: 0x11183, Hi: 0x111B2, Stride: 1},
+			{Lo: 0x111C1, Hi: 0x111C4, Stride: 1},
+			{Lo: 0x111DA, Hi: 0x111DA, Stride: 1},
+			{Lo: 0x111DC, Hi: 0x111DC, Stride: 1},
+			{Lo: 0x11200, Hi: 0x11211, Stride: 1},
+			{Lo: 0x11213, Hi: 0x1122B, Stride: 1},
+			{Lo: 0x1123F, Hi: 0x11240, Stride: 1},
+			{Lo: 0x11280, Hi: 0x11286, Stride: 1},
+			{Lo: 0x11288, Hi: 0x11288, Stride: 1},
+			{Lo: 0x1128A, Hi: 0x1128D, Stride: 1},
+			{Lo: 0x1128F, Hi: 0x1129D, Stride: 1},
+			{Lo: 0x1129F, Hi: 0x112A8, Stride: 1},
+			{Lo: 0x112B0, Hi: 0x112DE, Stride: 1},
+			{Lo: 0x11305, Hi: 0x1130C, Stride: 1},
+			{Lo: 0x1130F, Hi: 0x11310, Stride: 1},
+			{Lo: 0x11313, Hi: 0x11328, Stride: 1},
+			{Lo: 0x1132A, Hi: 0x11330, Stride: 1},
+			{Lo: 0x11332, Hi: 0x11333, Stride: 1},
+			{Lo: 0x11335, Hi: 0x11339, Stride: 1},
+			{Lo: 0x1133D, Hi: 0x1133D, Stride: 1},
+			{Lo: 0x11350, Hi: 0x11350, Stride: 1},
+			{Lo: 0x1135D, Hi: 0x11361, Stride: 1},
+			{Lo: 0x11380, Hi: 0x11389, Stride: 1},
+			{Lo: 0x1138B, Hi: 0x1138B, Stride: 1},
+			{Lo: 0x1138E, Hi: 0x1138E, Stride: 1},
+			{Lo: 0x11390, Hi: 0x113B5, Stride: 1},
+			{Lo: 0x113B7, Hi: 0x113B7, Stride: 1},
+			{Lo: 0x113D1, Hi: 0x113D1, Stride: 1},
+			{Lo: 0x113D3, Hi: 0x113D3, Stride: 1},
+			{Lo: 0x11400, Hi: 0x11434, Stride: 1},
+			{Lo: 0x11447, Hi: 0x1144A, Stride: 1},
+			{Lo: 0x1145F, Hi: 0x11461, Stride: 1},
+			{Lo: 0x11480, Hi: 0x114AF, Stride: 1},
+			{Lo: 0x114C4, Hi: 0x114C5, Stride: 1},
+			{Lo: 0x114C7, Hi: 0x114C7, Stride: 1},
+			{Lo: 0x11580, Hi: 0x115AE, Stride: 1},
+			{Lo: 0x115D8, Hi: 0x115DB, Stride: 1},
+			{Lo: 0x11600, Hi: 0x1162F, Stride: 1},
+			{Lo: 0x11644, Hi: 0x11644, Stride: 1},
+			{Lo: 0x11680, Hi: 0x116AA, Stride: 1},
+			{Lo: 0x116B8, Hi: 0x116B8, Stride: 1},
+			{Lo: 0x11700, Hi: 0x1171A, Stride: 1},
+			{Lo: 0x11740, Hi: 0x11746, Stride: 1},
+			{Lo: 0x11800, Hi: 0x1182B, Stride: 1},
+			{Lo: 0x118FF, Hi: 0x11906, Stride: 1},
+			{Lo: 0x11909, Hi: 0x11909, Stride: 1},
+			{Lo: 0x1190C, Hi: 0x11913, Stride: 1},
+			{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+			{Lo: 0x11918, Hi: 0x1192F, Stride: 1},
+			{Lo: 0x1193F, Hi: 0x1193F, Stride: 1},
+			{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+			{Lo: 0x119A0, Hi: 0x119A7, Stride: 1},
+			{Lo: 0x119AA, Hi: 0x119D0, Stride: 1},
+			{Lo: 0x119E1, Hi: 0x119E1, Stride: 1},
+			{Lo: 0x119E3, Hi: 0x119E3, Stride: 1},
+			{Lo: 0x11A00, Hi: 0x11A00, Stride: 1},
+			{Lo: 0x11A0B, Hi: 0x11A32, Stride: 1},
+			{Lo: 0x11A3A, Hi: 0x11A3A, Stride: 1},
+			{Lo: 0x11A50, Hi: 0x11A50, Stride: 1},
+			{Lo: 0x11A5C, Hi: 0x11A89, Stride: 1},
+			{Lo: 0x11A9D, Hi: 0x11A9D, Stride: 1},
+			{Lo: 0x11AB0, Hi: 0x11AF8, Stride: 1},
+			{Lo: 0x11BC0, Hi: 0x11BE0, Stride: 1},
+			{Lo: 0x11C00, Hi: 0x11C08, Stride: 1},
+			{Lo: 0x11C0A, Hi: 0x11C2E, Stride: 1},
+			{Lo: 0x11C40, Hi: 0x11C40, Stride: 1},
+			{Lo: 0x11C72, Hi: 0x11C8F, Stride: 1},
+			{Lo: 0x11D00, Hi: 0x11D06, Stride: 1},
+			{Lo: 0x11D08, Hi: 0x11D09, Stride: 1},
+			{Lo: 0x11D0B, Hi: 0x11D30, Stride: 1},
+			{Lo: 0x11D46, Hi: 0x11D46, Stride: 1},
+			{Lo: 0x11D60, Hi: 0x11D65, Stride: 1},
+			{Lo: 0x11D67, Hi: 0x11D68, Stride: 1},
+			{Lo: 0x11D6A, Hi: 0x11D89, Stride: 1},
+			{Lo: 0x11D98, Hi: 0x11D98, Stride: 1},
+			{Lo: 0x11DB0, Hi: 0x11DDB, Stride: 1},
+			{Lo: 0x11EE0, Hi: 0x11EF2, Stride: 1},
+			{Lo: 0x11F02, Hi: 0x11F02, Stride: 1},
+			{Lo: 0x11F04, Hi: 0x11F10, Stride: 1},
+			{Lo: 0x11F12, Hi: 0x11F33, Stride: 1},
+			{Lo: 0x11FB0, Hi: 0x11FB0, Stride: 1},
+			{Lo: 0x12000, Hi: 0x12399, Stride: 1},
+			{Lo: 0x12400, Hi: 0x1246E, Stride: 1},
+			{Lo: 0x12480, Hi: 0x12543, Stride: 1},
+			{Lo: 0x12F90, Hi: 0x12FF0, Stride: 1},
+			{Lo: 0x13000, Hi: 0x1342F, Stride: 1},
+			{Lo: 0x13441, Hi: 0x13446, Stride: 1},
+			{Lo: 0x13460, Hi: 0x143FA, Stride: 1},
+			{Lo: 0x14400, Hi: 0x14646, Stride: 1},
+			{Lo: 0x16100, Hi: 0x1611D, Stride: 1},
+			{Lo: 0x16800, Hi: 0x16A38, Stride: 1},
+			{Lo: 0x16A40, Hi: 0x16A5E, Stride: 1},
+			{Lo: 0x16A70, Hi: 0x16ABE, Stride: 1},
+			{Lo: 0x16AD0, Hi: 0x16AED, Stride: 1},
+			{Lo: 0x16B00, Hi: 0x16B2F, Stride: 1},
+			{Lo: 0x16B40, Hi: 0x16B43, Stride: 1},
+			{Lo: 0x16B63, Hi: 0x16B77, Stride: 1},
+			{Lo: 0x16B7D, Hi: 0x16B8F, Stride: 1},
+			{Lo: 0x16D40, Hi: 0x16D6C, Stride: 1},
+			{Lo: 0x16F00, Hi: 0x16F4A, Stride: 1},
+			{Lo: 0x16F50, Hi: 0x16F50, Stride: 1},
+			{Lo: 0x16F93, Hi: 0x16F9F, Stride: 1},
+			{Lo: 0x16FE0, Hi: 0x16FE1, Stride: 1},
+			{Lo: 0x16FE3, Hi: 0x16FE3, Stride: 1},
+			{Lo: 0x16FF2, Hi: 0x16FF6, Stride: 1},
+			{Lo: 0x17000, Hi: 0x18CD5, Stride: 1},
+			{Lo: 0x18CFF, Hi: 0x18D1E, Stride: 1},
+			{Lo: 0x18D80, Hi: 0x18DF2, Stride: 1},
+			{Lo: 0x1AFF0, Hi: 0x1AFF3, Stride: 1},
+			{Lo: 0x1AFF5, Hi: 0x1AFFB, Stride: 1},
+			{Lo: 0x1AFFD, Hi: 0x1AFFE, Stride: 1},
+			{Lo: 0x1B000, Hi: 0x1B122, Stride: 1},
+			{Lo: 0x1B132, Hi: 0x1B132, Stride: 1},
+			{Lo: 0x1B150, Hi: 0x1B152, Stride: 1},
+			{Lo: 0x1B155, Hi: 0x1B155, Stride: 1},
+			{Lo: 0x1B164, Hi: 0x1B167, Stride: 1},
+			{Lo: 0x1B170, Hi: 0x1B2FB, Stride: 1},
+			{Lo: 0x1BC00, Hi: 0x1BC6A, Stride: 1},
+			{Lo: 0x1BC70, Hi: 0x1BC7C, Stride: 1},
+			{Lo: 0x1BC80, Hi: 0x1BC88, Stride: 1},
+			{Lo: 0x1BC90, Hi: 0x1BC99, Stride: 1},
+			{Lo: 0x1DF0A, Hi: 0x1DF0A, Stride: 1},
+			{Lo: 0x1E100, Hi: 0x1E12C, Stride: 1},
+			{Lo: 0x1E137, Hi: 0x1E13D, Stride: 1},
+			{Lo: 0x1E14E, Hi: 0x1E14E, Stride: 1},
+			{Lo: 0x1E290, Hi: 0x1E2AD, Stride: 1},
+			{Lo: 0x1E2C0, Hi: 0x1E2EB, Stride: 1},
+			{Lo: 0x1E4D0, Hi: 0x1E4EB, Stride: 1},
+			{Lo: 0x1E5D0, Hi: 0x1E5ED, Stride: 1},
+			{Lo: 0x1E5F0, Hi: 0x1E5F0, Stride: 1},
+			{Lo: 0x1E6C0, Hi: 0x1E6DE, Stride: 1},
+			{Lo: 0x1E6E0, Hi: 0x1E6E2, Stride: 1},
+			{Lo: 0x1E6E4, Hi: 0x1E6E5, Stride: 1},
+			{Lo: 0x1E6E7, Hi: 0x1E6ED, Stride: 1},
+			{Lo: 0x1E6F0, Hi: 0x1E6F4, Stride: 1},
+			{Lo: 0x1E6FE, Hi: 0x1E6FF, Stride: 1},
+			{Lo: 0x1E7E0, Hi: 0x1E7E6, Stride: 1},
+			{Lo: 0x1E7E8, Hi: 0x1E7EB, Stride: 1},
+			{Lo: 0x1E7ED, Hi: 0x1E7EE, Stride: 1},
+			{Lo: 0x1E7F0, Hi: 0x1E7FE, Stride: 1},
+			{Lo: 0x1E800, Hi: 0x1E8C4, Stride: 1},
+			{Lo: 0x1E94B, Hi: 0x1E94B, Stride: 1},
+			{Lo: 0x1EE00, Hi: 0x1EE03, Stride: 1},
+			{Lo: 0x1EE05, Hi: 0x1EE1F, Stride: 1},
+			{Lo: 0x1EE21, Hi: 0x1EE22, Stride: 1},
+			{Lo: 0x1EE24, Hi: 0x1EE24, Stride: 1},
+			{Lo: 0x1EE27, Hi: 0x1EE27, Stride: 1},
+			{Lo: 0x1EE29, Hi: 0x1EE32, Stride: 1},
+			{Lo: 0x1EE34, Hi: 0x1EE37, Stride: 1},
+			{Lo: 0x1EE39, Hi: 0x1EE39, Stride: 1},
+			{Lo: 0x1EE3B, Hi: 0x1EE3B, Stride: 1},
+			{Lo: 0x1EE42, Hi: 0x1EE42, Stride: 1},
+			{Lo: 0x1EE47, Hi: 0x1EE47, Stride: 1},
+			{Lo: 0x1EE49, Hi: 0x1EE49, Stride: 1},
+			{Lo: 0x1EE4B, Hi: 0x1EE4B, Stride: 1},
+			{Lo: 0x1EE4D, Hi: 0x1EE4F, Stride: 1},
+			{Lo: 0x1EE51, Hi: 0x1EE52, Stride: 1},
+			{Lo: 0x1EE54, Hi: 0x1EE54, Stride: 1},
+			{Lo: 0x1EE57, Hi: 0x1EE57, Stride: 1},
+			{Lo: 0x1EE59, Hi: 0x1EE59, Stride: 1},
+			{Lo: 0x1EE5B, Hi: 0x1EE5B, Stride: 1},
+			{Lo: 0x1EE5D, Hi: 0x1EE5D, Stride: 1},
+			{Lo: 0x1EE5F, Hi: 0x1EE5F, Stride: 1},
+			{Lo: 0x1EE61, Hi: 0x1EE62, Stride: 1},
+			{Lo: 0x1EE64, Hi: 0x1EE64, Stride: 1},
+			{Lo: 0x1EE67, Hi: 0x1EE6A, Stride: 1},
+			{Lo: 0x1EE6C, Hi: 0x1EE72, Stride: 1},
+			{Lo: 0x1EE74, Hi: 0x1EE77, Stride: 1},
+			{Lo: 0x1EE79, Hi: 0x1EE7C, Stride: 1},
+			{Lo: 0x1EE7E, Hi: 0x1EE7E, Stride: 1},
+			{Lo: 0x1EE80, Hi: 0x1EE89, Stride: 1},
+			{Lo: 0x1EE8B, Hi: 0x1EE9B, Stride: 1},
+			{Lo: 0x1EEA1, Hi: 0x1EEA3, Stride: 1},
+			{Lo: 0x1EEA5, Hi: 0x1EEA9, Stride: 1},
+			{Lo: 0x1EEAB, Hi: 0x1EEBB, Stride: 1},
+			{Lo: 0x20000, Hi: 0x2A6DF, Stride: 1},
+			{Lo: 0x2A700, Hi: 0x2B81D, Stride: 1},
+			{Lo: 0x2B820, Hi: 0x2CEAD, Stride: 1},
+			{Lo: 0x2CEB0, Hi: 0x2EBE0, Stride: 1},
+			{Lo: 0x2EBF0, Hi: 0x2EE5D, Stride: 1},
+			{Lo: 0x2F800, Hi: 0x2FA1D, Stride: 1},
+			{Lo: 0x30000, Hi: 0x3134A, Stride: 1},
+			{Lo: 0x31350, Hi: 0x33479, Stride: 1},
+		},
+	}
+
+	tableSContinue = &unicode.RangeTable{
+		LatinOffset: 2,
+		R16: []unicode.Range16{
+			{Lo: 0x002C, Hi: 0x002D, Stride: 1},
+			{Lo: 0x003A, Hi: 0x003A, Stride: 1},
+			{Lo: 0x055D, Hi: 0x055D, Stride: 1},
+			{Lo: 0x060C, Hi: 0x060D, Stride: 1},
+			{Lo: 0x07F8, Hi: 0x07F8, Stride: 1},
+			{Lo: 0x1802, Hi: 0x1802, Stride: 1},
+			{Lo: 0x1808, Hi: 0x1808, Stride: 1},
+			{Lo: 0x2013, Hi: 0x2014, Stride: 1},
+			{Lo: 0x3001, Hi: 0x3001, Stride: 1},
+			{Lo: 0xFE10, Hi: 0xFE11, Stride: 1},
+			{Lo: 0xFE13, Hi: 0xFE13, Stride: 1},
+			{Lo: 0xFE31, Hi: 0xFE32, Stride: 1},
+			{Lo: 0xFE50, Hi: 0xFE51, Stride: 1},
+			{Lo: 0xFE55, Hi: 0xFE55, Stride: 1},
+			{Lo: 0xFE58, Hi: 0xFE58, Stride: 1},
+			{Lo: 0xFE63, Hi: 0xFE63, Stride: 1},
+			{Lo: 0xFF0C, Hi: 0xFF0D, Stride: 1},
+			{Lo: 0xFF1A, Hi: 0xFF1A, Stride: 1},
+			{Lo: 0xFF64, Hi: 0xFF64, Stride: 1},
+		},
+	}
+
+	tableSTerm = &unicode.RangeTable{
+		LatinOffset: 2,
+		R16: []unicode.Range16{
+			{Lo: 0x0021, Hi: 0x0021, Stride: 1},
+			{Lo: 0x003F, Hi: 0x003F, Stride: 1},
+			{Lo: 0x0589, Hi: 0x0589, Stride: 1},
+			{Lo: 0x061D, Hi: 0x061F, Stride: 1},
+			{Lo: 0x06D4, Hi: 0x06D4, Stride: 1},
+			{Lo: 0x0700, Hi: 0x0702, Stride: 1},
+			{Lo: 0x07F9, Hi: 0x07F9, Stride: 1},
+			{Lo: 0x0837, Hi: 0x0837, Stride: 1},
+			{Lo: 0x0839, Hi: 0x0839, Stride: 1},
+			{Lo: 0x083D, Hi: 0x083E, Stride: 1},
+			{Lo: 0x0964, Hi: 0x0965, Stride: 1},
+			{Lo: 0x104A, Hi: 0x104B, Stride: 1},
+			{Lo: 0x1362, Hi: 0x1362, Stride: 1},
+			{Lo: 0x1367, Hi: 0x1368, Stride: 1},
+			{Lo: 0x166E, Hi: 0x166E, Stride: 1},
+			{Lo: 0x1735, Hi: 0x1736, Stride: 1},
+			{Lo: 0x17D4, Hi: 0x17D5, Stride: 1},
+			{Lo: 0x1803, Hi: 0x1803, Stride: 1},
+			{Lo: 0x1809, Hi: 0x1809, Stride: 1},
+			{Lo: 0x1944, Hi: 0x1945, Stride: 1},
+			{Lo: 0x1AA8, Hi: 0x1AAB, Stride: 1},
+			{Lo: 0x1B4E, Hi: 0x1B4F, Stride: 1},
+			{Lo: 0x1B5A, Hi: 0x1B5B, Stride: 1},
+			{Lo: 0x1B5E, Hi: 0x1B5F, Stride: 1},
+			{Lo: 0x1B7D, Hi: 0x1B7F, Stride: 1},
+			{Lo: 0x1C3B, Hi: 0x1C3C, Stride: 1},
+			{Lo: 0x1C7E, Hi: 0x1C7F, Stride: 1},
+			{Lo: 0x203C, Hi: 0x203D, Stride: 1},
+			{Lo: 0x2047, Hi: 0x2049, Stride: 1},
+			{Lo: 0x2CF9, Hi: 0x2CFB, Stride: 1},
+			{Lo: 0x2E2E, Hi: 0x2E2E, Stride: 1},
+			{Lo: 0x2E3C, Hi: 0x2E3C, Stride: 1},
+			{Lo: 0x2E53, Hi: 0x2E54, Stride: 1},
+			{Lo: 0x3002, Hi: 0x3002, Stride: 1},
+			{Lo: 0xA4FF, Hi: 0xA4FF, Stride: 1},
+			{Lo: 0xA60E, Hi: 0xA60F, Stride: 1},
+			{Lo: 0xA6F3, Hi: 0xA6F3, Stride: 1},
+			{Lo: 0xA6F7, Hi: 0xA6F7, Stride: 1},
+			{Lo: 0xA876, Hi: 0xA877, Stride: 1},
+			{Lo: 0xA8CE, Hi: 0xA8CF, Stride: 1},
+			{Lo: 0xA92F, Hi: 0xA92F, Stride: 1},
+			{Lo: 0xA9C8, Hi: 0xA9C9, Stride: 1},
+			{Lo: 0xAA5D, Hi: 0xAA5F, Stride: 1},
+			{Lo: 0xAAF0, Hi: 0xAAF1, Stride: 1},
+			{Lo: 0xABEB, Hi: 0xABEB, Stride: 1},
+			{Lo: 0xFE12, Hi: 0xFE12, Stride: 1},
+			{Lo: 0xFE15, Hi: 0xFE16, Stride: 1},
+			{Lo: 0xFE56, Hi: 0xFE57, Stride: 1},
+			{Lo: 0xFF01, Hi: 0xFF01, Stride: 1},
+			{Lo: 0xFF1F, Hi: 0xFF1F, Stride: 1},
+			{Lo: 0xFF61, Hi: 0xFF61, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10A56, Hi: 0x10A57, Stride: 1},
+			{Lo: 0x10F55, Hi: 0x10F59, Stride: 1},
+			{Lo: 0x10F86, Hi: 0x10F89, Stride: 1},
+			{Lo: 0x11047, Hi: 0x11048, Stride: 1},
+			{Lo: 0x110BE, Hi: 0x110C1, Stride: 1},
+			{Lo: 0x11141, Hi: 0x11143, Stride: 1},
+			{Lo: 0x111C5, Hi: 0x111C6, Stride: 1},
+			{Lo: 0x111CD, Hi: 0x111CD, Stride: 1},
+			{Lo: 0x111DE, Hi: 0x111DF, Stride: 1},
+			{Lo: 0x11238, Hi: 0x11239, Stride: 1},
+			{Lo: 0x1123B, Hi: 0x1123C, Stride: 1},
+			{Lo: 0x112A9, Hi: 0x112A9, Stride: 1},
+			{Lo: 0x113D4, Hi: 0x113D5, Stride: 1},
+			{Lo: 0x1144B, Hi: 0x1144C, Stride: 1},
+			{Lo: 0x115C2, Hi: 0x115C3, Stride: 1},
+			{Lo: 0x115C9, Hi: 0x115D7, Stride: 1},
+			{Lo: 0x11641, Hi: 0x11642, Stride: 1},
+			{Lo: 0x1173C, Hi: 0x1173E, Stride: 1},
+			{Lo: 0x11944, Hi: 0x11944, Stride: 1},
+			{Lo: 0x11946, Hi: 0x11946, Stride: 1},
+			{Lo: 0x11A42, Hi: 0x11A43, Stride: 1},
+			{Lo: 0x11A9B, Hi: 0x11A9C, Stride: 1},
+			{Lo: 0x11C41, Hi: 0x11C42, Stride: 1},
+			{Lo: 0x11EF7, Hi: 0x11EF8, Stride: 1},
+			{Lo: 0x11F43, Hi: 0x11F44, Stride: 1},
+			{Lo: 0x16A6E, Hi: 0x16A6F, Stride: 1},
+			{Lo: 0x16AF5, Hi: 0x16AF5, Stride: 1},
+			{Lo: 0x16B37, Hi: 0x16B38, Stride: 1},
+			{Lo: 0x16B44, Hi: 0x16B44, Stride: 1},
+			{Lo: 0x16D6E, Hi: 0x16D6F, Stride: 1},
+			{Lo: 0x16E98, Hi: 0x16E98, Stride: 1},
+			{Lo: 0x1BC9F, Hi: 0x1BC9F, Stride: 1},
+			{Lo: 0x1DA88, Hi: 0x1DA88, Stride: 1},
+		},
+	}
+
+	tableSep = &unicode.RangeTable{
+		LatinOffset: 1,
+		R16: []unicode.Range16{
+			{Lo: 0x0085, Hi: 0x0085, Stride: 1},
+			{Lo: 0x2028, Hi: 0x2029, Stride: 1},
+		},
+	}
+
+	tableSp = &unicode.RangeTable{
+		LatinOffset: 4,
+		R16: []unicode.Range16{
+			{Lo: 0x0009, Hi: 0x0009, Stride: 1},
+			{Lo: 0x000B, Hi: 0x000C, Stride: 1},
+			{Lo: 0x0020, Hi: 0x0020, Stride: 1},
+			{Lo: 0x00A0, Hi: 0x00A0, Stride: 1},
+			{Lo: 0x1680, Hi: 0x1680, Stride: 1},
+			{Lo: 0x2000, Hi: 0x200A, Stride: 1},
+			{Lo: 0x202F, Hi: 0x202F, Stride: 1},
+			{Lo: 0x205F, Hi: 0x205F, Stride: 1},
+			{Lo: 0x3000, Hi: 0x3000, Stride: 1},
+		},
+	}
+
+	tableUpper = &unicode.RangeTable{
+		LatinOffset: 3,
+		R16: []unicode.Range16{
+			{Lo: 0x0041, Hi: 0x005A, Stride: 1},
+			{Lo: 0x00C0, Hi: 0x00D6, Stride: 1},
+			{Lo: 0x00D8, Hi: 0x00DE, Stride: 1},
+			{Lo: 0x0100, Hi: 0x0100, Stride: 1},
+			{Lo: 0x0102, Hi: 0x0102, Stride: 1},
+			{Lo: 0x0104, Hi: 0x0104, Stride: 1},
+			{Lo: 0x0106, Hi: 0x0106, Stride: 1},
+			{Lo: 0x0108, Hi: 0x0108, Stride: 1},
+			{Lo: 0x010A, Hi: 0x010A, Stride: 1},
+			{Lo: 0x010C, Hi: 0x010C, Stride: 1},
+			{Lo: 0x010E, Hi: 0x010E, Stride: 1},
+			{Lo: 0x0110, Hi: 0x0110, Stride: 1},
+			{Lo: 0x0112, Hi: 0x0112, Stride: 1},
+			{Lo: 0x0114, Hi: 0x0114, Stride: 1},
+			{Lo: 0x0116, Hi: 0x0116, Stride: 1},
+			{Lo: 0x0118, Hi: 0x0118, Stride: 1},
+			{Lo: 0x011A, Hi: 0x011A, Stride: 1},
+			{Lo: 0x011C, Hi: 0x011C, Stride: 1},
+			{Lo: 0x011E, Hi: 0x011E, Stride: 1},
+			{Lo: 0x0120, Hi: 0x0120, Stride: 1},
+			{Lo: 0x0122, Hi: 0x0122, Stride: 1},
+			{Lo: 0x0124, Hi: 0x0124, Stride: 1},
+			{Lo: 0x0126, Hi: 0x0126, Stride: 1},
+			{Lo: 0x0128, Hi: 0x0128, Stride: 1},
+			{Lo: 0x012A, Hi: 0x012A, Stride: 1},
+			{Lo: 0x012C, Hi: 0x012C, Stride: 1},
+			{Lo: 0x012E, Hi: 0x012E, Stride: 1},
+			{Lo: 0x0130, Hi: 0x0130, Stride: 1},
+			{Lo: 0x0132, Hi: 0x0132, Stride: 1},
+			{Lo: 0x0134, Hi: 0x0134, Stride: 1},
+			{Lo: 0x0136, Hi: 0x0136, Stride: 1},
+			{Lo: 0x0139, Hi: 0x0139, Stride: 1},
+			{Lo: 0x013B, Hi: 0x013B, Stride: 1},
+			{Lo: 0x013D, Hi: 0x013D, Stride: 1},
+			{Lo: 0x013F, Hi: 0x013F, Stride: 1},
+			{Lo: 0x0141, Hi: 0x0141, Stride: 1},
+			{Lo: 0x0143, Hi: 0x0143, Stride: 1},
+			{Lo: 0x0145, Hi: 0x0145, Stride: 1},
+			{Lo: 0x0147, Hi: 0x0147, Stride: 1},
+			{Lo: 0x014A, Hi: 0x014A, Stride: 1},
+			{Lo: 0x014C, Hi: 0x014C, Stride: 1},
+			{Lo: 0x014E, Hi: 0x014E, Stride: 1},
+			{Lo: 0x0150, Hi: 0x0150, Stride: 1},
+			{Lo: 0x0152, Hi: 0x0152, Stride: 1},
+			{Lo: 0x0154, Hi: 0x0154, Stride: 1},
+			{Lo: 0x0156, Hi: 0x0156, Stride: 1},
+			{Lo: 0x0158, Hi: 0x0158, Stride: 1},
+			{Lo: 0x015A, Hi: 0x015A, Stride: 1},
+			{Lo: 0x015C, Hi: 0x015C, Stride: 1},
+			{Lo: 0x015E, Hi: 0x015E, Stride: 1},
+			{Lo: 0x0160, Hi: 0x0160, Stride: 1},
+			{Lo: 0x0162, Hi: 0x0162, Stride: 1},
+			{Lo: 0x0164, Hi: 0x0164, Stride: 1},
+			{Lo: 0x0166, Hi: 0x0166, Stride: 1},
+			{Lo: 0x0168, Hi: 0x0168, Stride: 1},
+			{Lo: 0x016A, Hi: 0x016A, Stride: 1},
+			{Lo: 0x016C, Hi: 0x016C, Stride: 1},
+			{Lo: 0x016E, Hi: 0x016E, Stride: 1},
+			{Lo: 0x0170, Hi: 0x0170, Stride: 1},
+			{Lo: 0x0172, Hi: 0x0172, Stride: 1},
+			{Lo: 0x0174, Hi: 0x0174, Stride: 1},
+			{Lo: 0x0176, Hi: 0x0176, Stride: 1},
+			{Lo: 0x0178, Hi: 0x0179, Stride: 1},
+			{Lo: 0x017B, Hi: 0x017B, Stride: 1},
+			{Lo: 0x017D, Hi: 0x017D, Stride: 1},
+			{Lo: 0x0181, Hi: 0x0182, Stride: 1},
+			{Lo: 0x0184, Hi: 0x0184, Stride: 1},
+			{Lo: 0x0186, Hi: 0x0187, Stride: 1},
+			{Lo: 0x0189, Hi: 0x018B, Stride: 1},
+			{Lo: 0x018E, Hi: 0x0191, Stride: 1},
+			{Lo: 0x0193, Hi: 0x0194, Stride: 1},
+			{Lo: 0x0196, Hi: 0x0198, Stride: 1},
+			{Lo: 0x019C, Hi: 0x019D, Stride: 1},
+			{Lo: 0x019F, Hi: 0x01A0, Stride: 1},
+			{Lo: 0x01A2, Hi: 0x01A2, Stride: 1},
+			{Lo: 0x01A4, Hi: 0x01A4, Stride: 1},
+			{Lo: 0x01A6, Hi: 0x01A7, Stride: 1},
+			{Lo: 0x01A9, Hi: 0x01A9, Stride: 1},
+			{Lo: 0x01AC, Hi: 0x01AC, Stride: 1},
+			{Lo: 0x01AE, Hi: 0x01AF, Stride: 1},
+			{Lo: 0x01B1, Hi: 0x01B3, Stride: 1},
+			{Lo: 0x01B5, Hi: 0x01B5, Stride: 1},
+			{Lo: 0x01B7, Hi: 0x01B8, Stride: 1},
+			{Lo: 0x01BC, Hi: 0x01BC, Stride: 1},
+			{Lo: 0x01C4, Hi: 0x01C5, Stride: 1},
+			{Lo: 0x01C7, Hi: 0x01C8, Stride: 1},
+			{Lo: 0x01CA, Hi: 0x01CB, Stride: 1},
+			{Lo: 0x01CD, Hi: 0x01CD, Stride: 1},
+			{Lo: 0x01CF, Hi: 0x01CF, Stride: 1},
+			{Lo: 0x01D1, Hi: 0x01D1, Stride: 1},
+			{Lo: 0x01D3, Hi: 0x01D3, Stride: 1},
+			{Lo: 0x01D5, Hi: 0x01D5, Stride: 1},
+			{Lo: 0x01D7, Hi: 0x01D7, Stride: 1},
+			{Lo: 0x01D9, Hi: 0x01D9, Stride: 1},
+			{Lo: 0x01DB, Hi: 0x01DB, Stride: 1},
+			{Lo: 0x01DE, Hi: 0x01DE, Stride: 1},
+			{Lo: 0x01E0, Hi: 0x01E0, Stride: 1},
+			{Lo: 0x01E2, Hi: 0x01E2, Stride: 1},
+			{Lo: 0x01E4, Hi: 0x01E4, Stride: 1},
+			{Lo: 0x01E6, Hi: 0x01E6, Stride: 1},
+			{Lo: 0x01E8, Hi: 0x01E8, Stride: 1},
+			{Lo: 0x01EA, Hi: 0x01EA, Stride: 1},
+			{Lo: 0x01EC, Hi: 0x01EC, Stride: 1},
+			{Lo: 0x01EE, Hi: 0x01EE, Stride: 1},
+			{Lo: 0x01F1, Hi: 0x01F2, Stride: 1},
+			{Lo: 0x01F4, Hi: 0x01F4, Stride: 1},
+			{Lo: 0x01F6, Hi: 0x01F8, Stride: 1},
+			{Lo: 0x01FA, Hi: 0x01FA, Stride: 1},
+			{Lo: 0x01FC, Hi: 0x01FC, Stride: 1},
+			{Lo: 0x01FE, Hi: 0x01FE, Stride: 1},
+			{Lo: 0x0200, Hi: 0x0200, Stride: 1},
+			{Lo: 0x0202, Hi: 0x0202, Stride: 1},
+			{Lo: 0x0204, Hi: 0x0204, Stride: 1},
+			{Lo: 0x0206, Hi: 0x0206, Stride: 1},
+			{Lo: 0x0208, Hi: 0x0208, Stride: 1},
+			{Lo: 0x020A, Hi: 0x020A, Stride: 1},
+			{Lo: 0x020C, Hi: 0x020C, Stride: 1},
+			{Lo: 0x020E, Hi: 0x020E, Stride: 1},
+			{Lo: 0x0210, Hi: 0x0210, Stride: 1},
+			{Lo: 0x0212, Hi: 0x0212, Stride: 1},
+			{Lo: 0x0214, Hi: 0x0214, Stride: 1},
+			{Lo: 0x0216, Hi: 0x0216, Stride: 1},
+			{Lo: 0x0218, Hi: 0x0218, Stride: 1},
+			{Lo: 0x021A, Hi: 0x021A, Stride: 1},
+			{Lo: 0x021C, Hi: 0x021C, Stride: 1},
+			{Lo: 0x021E, Hi: 0x021E, Stride: 1},
+			{Lo: 0x0220, Hi: 0x0220, Stride: 1},
+			{Lo: 0x0222, Hi: 0x0222, Stride: 1},
+			{Lo: 0x0224, Hi: 0x0224, Stride: 1},
+			{Lo: 0x0226, Hi: 0x0226, Stride: 1},
+			{Lo: 0x0228, Hi: 0x0228, Stride: 1},
+			{Lo: 0x022A, Hi: 0x022A, Stride: 1},
+			{Lo: 0x022C, Hi: 0x022C, Stride: 1},
+			{Lo: 0x022E, Hi: 0x022E, Stride: 1},
+			{Lo: 0x0230, Hi: 0x0230, Stride: 1},
+			{Lo: 0x0232, Hi: 0x0232, Stride: 1},
+			{Lo: 0x023A, Hi: 0x023B, Stride: 1},
+			{Lo: 0x023D, Hi: 0x023E, Stride: 1},
+			{Lo: 0x0241, Hi: 0x0241, Stride: 1},
+			{Lo: 0x0243, Hi: 0x0246, Stride: 1},
+			{Lo: 0x0248, Hi: 0x0248, Stride: 1},
+			{Lo: 0x024A, Hi: 0x024A, Stride: 1},
+			{Lo: 0x024C, Hi: 0x024C, Stride: 1},
+			{Lo: 0x024E, Hi: 0x024E, Stride: 1},
+			{Lo: 0x0370, Hi: 0x0370, Stride: 1},
+			{Lo: 0x0372, Hi: 0x0372, Stride: 1},
+			{Lo: 0x0376, Hi: 0x0376, Stride: 1},
+			{Lo: 0x037F, Hi: 0x037F, Stride: 1},
+			{Lo: 0x0386, Hi: 0x0386, Stride: 1},
+			{Lo: 0x0388, Hi: 0x038A, Stride: 1},
+			{Lo: 0x038C, Hi: 0x038C, Stride: 1},
+			{Lo: 0x038E, Hi: 0x038F, Stride: 1},
+			{Lo: 0x0391, Hi: 0x03A1, Stride: 1},
+			{Lo: 0x03A3, Hi: 0x03AB, Stride: 1},
+			{Lo: 0x03CF, Hi: 0x03CF, Stride: 1},
+			{Lo: 0x03D2, Hi: 0x03D4, Stride: 1},
+			{Lo: 0x03D8, Hi: 0x03D8, Stride: 1},
+			{Lo: 0x03DA, Hi: 0x03DA, Stride: 1},
+			{Lo: 0x03DC, Hi: 0x03DC, Stride: 1},
+			{Lo: 0x03DE, Hi: 0x03DE, Stride: 1},
+			{Lo: 0x03E0, Hi: 0x03E0, Stride: 1},
+			{Lo: 0x03E2, Hi: 0x03E2, Stride: 1},
+			{Lo: 0x03E4, Hi: 0x03E4, Stride: 1},
+			{Lo: 0x03E6, Hi: 0x03E6, Stride: 1},
+			{Lo: 0x03E8, Hi: 0x03E8, Stride: 1},
+			{Lo: 0x03EA, Hi: 0x03EA, Stride: 1},
+			{Lo: 0x03EC, Hi: 0x03EC, Stride: 1},
+			{Lo: 0x03EE, Hi: 0x03EE, Stride: 1},
+			{Lo: 0x03F4, Hi: 0x03F4, Stride: 1},
+			{Lo: 0x03F7, Hi: 0x03F7, Stride: 1},
+			{Lo: 0x03F9, Hi: 0x03FA, Stride: 1},
+			{Lo: 0x03FD, Hi: 0x042F, Stride: 1},
+			{Lo: 0x0460, Hi: 0x0460, Stride: 1},
+			{Lo: 0x0462, Hi: 0x0462, Stride: 1},
+			{Lo: 0x0464, Hi: 0x0464, Stride: 1},
+			{Lo: 0x0466, Hi: 0x0466, Stride: 1},
+			{Lo: 0x0468, Hi: 0x0468, Stride: 1},
+			{Lo: 0x046A, Hi: 0x046A, Stride: 1},
+			{Lo: 0x046C, Hi: 0x046C, Stride: 1},
+			{Lo: 0x046E, Hi: 0x046E, Stride: 1},
+			{Lo: 0x0470, Hi: 0x0470, Stride: 1},
+			{Lo: 0x0472, Hi: 0x0472, Stride: 1},
+			{Lo: 0x0474, Hi: 0x0474, Stride: 1},
+			{Lo: 0x0476, Hi: 0x0476, Stride: 1},
+			{Lo: 0x0478, Hi: 0x0478, Stride: 1},
+			{Lo: 0x047A, Hi: 0x047A, Stride: 1},
+			{Lo: 0x047C, Hi: 0x047C, Stride: 1},
+			{Lo: 0x047E, Hi: 0x047E, Stride: 1},
+			{Lo: 0x0480, Hi: 0x0480, Stride: 1},
+			{Lo: 0x048A, Hi: 0x048A, Stride: 1},
+			{Lo: 0x048C, Hi: 0x048C, Stride: 1},
+			{Lo: 0x048E, Hi: 0x048E, Stride: 1},
+			{Lo: 0x0490, Hi: 0x0490, Stride: 1},
+			{Lo: 0x0492, Hi: 0x0492, Stride: 1},
+			{Lo: 0x0494, Hi: 0x0494, Stride: 1},
+			{Lo: 0x0496, Hi: 0x0496, Stride: 1},
+			{Lo: 0x0498, Hi: 0x0498, Stride: 1},
+			{Lo: 0x049A, Hi: 0x049A, Stride: 1},
+			{Lo: 0x049C, Hi: 0x049C, Stride: 1},
+			{Lo: 0x049E, Hi: 0x049E, Stride: 1},
+			{Lo: 0x04A0, Hi: 0x04A0, Stride: 1},
+			{Lo: 0x04A2, Hi: 0x04A2, Stride: 1},
+			{Lo: 0x04A4, Hi: 0x04A4, Stride: 1},
+			{Lo: 0x04A6, Hi: 0x04A6, Stride: 1},
+			{Lo: 0x04A8, Hi: 0x04A8, Stride: 1},
+			{Lo: 0x04AA, Hi: 0x04AA, Stride: 1},
+			{Lo: 0x04AC, Hi: 0x04AC, Stride: 1},
+			{Lo: 0x04AE, Hi: 0x04AE, Stride: 1},
+			{Lo: 0x04B0, Hi: 0x04B0, Stride: 1},
+			{Lo: 0x04B2, Hi: 0x04B2, Stride: 1},
+			{Lo: 0x04B4, Hi: 0x04B4, Stride: 1},
+			{Lo: 0x04B6, Hi: 0x04B6, Stride: 1},
+			{Lo: 0x04B8, Hi: 0x04B8, Stride: 1},
+			{Lo: 0x04BA, Hi: 0x04BA, Stride: 1},
+			{Lo: 0x04BC, Hi: 0x04BC, Stride: 1},
+			{Lo: 0x04BE, Hi: 0x04BE, Stride: 1},
+			{Lo: 0x04C0, Hi: 0x04C1, Stride: 1},
+			{Lo: 0x04C3, Hi: 0x04C3, Stride: 1},
+			{Lo: 0x04C5, Hi: 0x04C5, Stride: 1},
+			{Lo: 0x04C7, Hi: 0x04C7, Stride: 1},
+			{Lo: 0x04C9, Hi: 0x04C9, Stride: 1},
+			{Lo: 0x04CB, Hi: 0x04CB, Stride: 1},
+			{Lo: 0x04CD, Hi: 0x04CD, Stride: 1},
+			{Lo: 0x04D0, Hi: 0x04D0, Stride: 1},
+			{Lo: 0x04D2, Hi: 0x04D2, Stride: 1},
+			{Lo: 0x04D4, Hi: 0x04D4, Stride: 1},
+			{Lo: 0x04D6, Hi: 0x04D6, Stride: 1},
+			{Lo: 0x04D8, Hi: 0x04D8, Stride: 1},
+			{Lo: 0x04DA, Hi: 0x04DA, Stride: 1},
+			{Lo: 0x04DC, Hi: 0x04DC, Stride: 1},
+			{Lo: 0x04DE, Hi: 0x04DE, Stride: 1},
+			{Lo: 0x04E0, Hi: 0x04E0, Stride: 1},
+			{Lo: 0x04E2, Hi: 0x04E2, Stride: 1},
+			{Lo: 0x04E4, Hi: 0x04E4, Stride: 1},
+			{Lo: 0x04E6, Hi: 0x04E6, Stride: 1},
+			{Lo: 0x04E8, Hi: 0x04E8, Stride: 1},
+			{Lo: 0x04EA, Hi: 0x04EA, Stride: 1},
+			{Lo: 0x04EC, Hi: 0x04EC, Stride: 1},
+			{Lo: 0x04EE, Hi: 0x04EE, Stride: 1},
+			{Lo: 0x04F0, Hi: 0x04F0, Stride: 1},
+			{Lo: 0x04F2, Hi: 0x04F2, Stride: 1},
+			{Lo: 0x04F4, Hi: 0x04F4, Stride: 1},
+			{Lo: 0x04F6, Hi: 0x04F6, Stride: 1},
+			{Lo: 0x04F8, Hi: 0x04F8, Stride: 1},
+			{Lo: 0x04FA, Hi: 0x04FA, Stride: 1},
+			{Lo: 0x04FC, Hi: 0x04FC, Stride: 1},
+			{Lo: 0x04FE, Hi: 0x04FE, Stride: 1},
+			{Lo: 0x0500, Hi: 0x0500, Stride: 1},
+			{Lo: 0x0502, Hi: 0x0502, Stride: 1},
+			{Lo: 0x0504, Hi: 0x0504, Stride: 1},
+			{Lo: 0x0506, Hi: 0x0506, Stride: 1},
+			{Lo: 0x0508, Hi: 0x0508, Stride: 1},
+			{Lo: 0x050A, Hi: 0x050A, Stride: 1},
+			{Lo: 0x050C, Hi: 0x050C, Stride: 1},
+			{Lo: 0x050E, Hi: 0x050E, Stride: 1},
+			{Lo: 0x0510, Hi: 0x0510, Stride: 1},
+			{Lo: 0x0512, Hi: 0x0512, Stride: 1},
+			{Lo: 0x0514, Hi: 0x0514, Stride: 1},
+			{Lo: 0x0516, Hi: 0x0516, Stride: 1},
+			{Lo: 0x0518, Hi: 0x0518, Stride: 1},
+			{Lo: 0x051A, Hi: 0x051A, Stride: 1},
+			{Lo: 0x051C, Hi: 0x051C, Stride: 1},
+			{Lo: 0x051E, Hi: 0x051E, Stride: 1},
+			{Lo: 0x0520, Hi: 0x0520, Stride: 1},
+			{Lo: 0x0522, Hi: 0x0522, Stride: 1},
+			{Lo: 0x0524, Hi: 0x0524, Stride: 1},
+			{Lo: 0x0526, Hi: 0x0526, Stride: 1},
+			{Lo: 0x0528, Hi: 0x0528, Stride: 1},
+			{Lo: 0x052A, Hi: 0x052A, Stride: 1},
+			{Lo: 0x052C, Hi: 0x052C, Stride: 1},
+			{Lo: 0x052E, Hi: 0x052E, Stride: 1},
+			{Lo: 0x0531, Hi: 0x0556, Stride: 1},
+			{Lo: 0x10A0, Hi: 0x10C5, Stride: 1},
+			{Lo: 0x10C7, Hi: 0x10C7, Stride: 1},
+			{Lo: 0x10CD, Hi: 0x10CD, Stride: 1},
+			{Lo: 0x13A0, Hi: 0x13F5, Stride: 1},
+			{Lo: 0x1C89, Hi: 0x1C89, Stride: 1},
+			{Lo: 0x1C90, Hi: 0x1CBA, Stride: 1},
+			{Lo: 0x1CBD, Hi: 0x1CBF, Stride: 1},
+			{Lo: 0x1E00, Hi: 0x1E00, Stride: 1},
+			{Lo: 0x1E02, Hi: 0x1E02, Stride: 1},
+			{Lo: 0x1E04, Hi: 0x1E04, Stride: 1},
+			{Lo: 0x1E06, Hi: 0x1E06, Stride: 1},
+			{Lo: 0x1E08, Hi: 0x1E08, Stride: 1},
+			{Lo: 0x1E0A, Hi: 0x1E0A, Stride: 1},
+			{Lo: 0x1E0C, Hi: 0x1E0C, Stride: 1},
+			{Lo: 0x1E0E, Hi: 0x1E0E, Stride: 1},
+			{Lo: 0x1E10, Hi: 0x1E10, Stride: 1},
+			{Lo: 0x1E12, Hi: 0x1E12, Stride: 1},
+			{Lo: 0x1E14, Hi: 0x1E14, Stride: 1},
+			{Lo: 0x1E16, Hi: 0x1E16, Stride: 1},
+			{Lo: 0x1E18, Hi: 0x1E18, Stride: 1},
+			{Lo: 0x1E1A, Hi: 0x1E1A, Stride: 1},
+			{Lo: 0x1E1C, Hi: 0x1E1C, Stride: 1},
+			{Lo: 0x1E1E, Hi: 0x1E1E, Stride: 1},
+			{Lo: 0x1E20, Hi: 0x1E20, Stride: 1},
+			{Lo: 0x1E22, Hi: 0x1E22, Stride: 1},
+			{Lo: 0x1E24, Hi: 0x1E24, Stride: 1},
+			{Lo: 0x1E26, Hi: 0x1E26, Stride: 1},
+			{Lo: 0x1E28, Hi: 0x1E28, Stride: 1},
+			{Lo: 0x1E2A, Hi: 0x1E2A, Stride: 1},
+			{Lo: 0x1E2C, Hi: 0x1E2C, Stride: 1},
+			{Lo: 0x1E2E, Hi: 0x1E2E, Stride: 1},
+			{Lo: 0x1E30, Hi: 0x1E30, Stride: 1},
+			{Lo: 0x1E32, Hi: 0x1E32, Stride: 1},
+			{Lo: 0x1E34, Hi: 0x1E34, Stride: 1},
+			{Lo: 0x1E36, Hi: 0x1E36, Stride: 1},
+			{Lo: 0x1E38, Hi: 0x1E38, Stride: 1},
+			{Lo: 0x1E3A, Hi: 0x1E3A, Stride: 1},
+			{Lo: 0x1E3C, Hi: 0x1E3C, Stride: 1},
+			{Lo: 0x1E3E, Hi: 0x1E3E, Stride: 1},
+			{Lo: 0x1E40, Hi: 0x1E40, Stride: 1},
+			{Lo: 0x1E42, Hi: 0x1E42, Stride: 1},
+			{Lo: 0x1E44, Hi: 0x1E44, Stride: 1},
+			{Lo: 0x1E46, Hi: 0x1E46, Stride: 1},
+			{Lo: 0x1E48, Hi: 0x1E48, Stride: 1},
+			{Lo: 0x1E4A, Hi: 0x1E4A, Stride: 1},
+			{Lo: 0x1E4C, Hi: 0x1E4C, Stride: 1},
+			{Lo: 0x1E4E, Hi: 0x1E4E, Stride: 1},
+			{Lo: 0x1E50, Hi: 0x1E50, Stride: 1},
+			{Lo: 0x1E52, Hi: 0x1E52, Stride: 1},
+			{Lo: 0x1E54, Hi: 0x1E54, Stride: 1},
+			{Lo: 0x1E56, Hi: 0x1E56, Stride: 1},
+			{Lo: 0x1E58, Hi: 0x1E58, Stride: 1},
+			{Lo: 0x1E5A, Hi: 0x1E5A, Stride: 1},
+			{Lo: 0x1E5C, Hi: 0x1E5C, Stride: 1},
+			{Lo: 0x1E5E, Hi: 0x1E5E, Stride: 1},
+			{Lo: 0x1E60, Hi: 0x1E60, Stride: 1},
+			{Lo: 0x1E62, Hi: 0x1E62, Stride: 1},
+			{Lo: 0x1E64, Hi: 0x1E64, Stride: 1},
+			{Lo: 0x1E66, Hi: 0x1E66, Stride: 1},
+			{Lo: 0x1E68, Hi: 0x1E68, Stride: 1},
+			{Lo: 0x1E6A, Hi: 0x1E6A, Stride: 1},
+			{Lo: 0x1E6C, Hi: 0x1E6C, Stride: 1},
+			{Lo: 0x1E6E, Hi: 0x1E6E, Stride: 1},
+			{Lo: 0x1E70, Hi: 0x1E70, Stride: 1},
+			{Lo: 0x1E72, Hi: 0x1E72, Stride: 1},
+			{Lo: 0x1E74, Hi: 0x1E74, Stride: 1},
+			{Lo: 0x1E76, Hi: 0x1E76, Stride: 1},
+			{Lo: 0x1E78, Hi: 0x1E78, Stride: 1},
+			{Lo: 0x1E7A, Hi: 0x1E7A, Stride: 1},
+			{Lo: 0x1E7C, Hi: 0x1E7C, Stride: 1},
+			{Lo: 0x1E7E, Hi: 0x1E7E, Stride: 1},
+			{Lo: 0x1E80, Hi: 0x1E80, Stride: 1},
+			{Lo: 0x1E82, Hi: 0x1E82, Stride: 1},
+			{Lo: 0x1E84, Hi: 0x1E84, Stride: 1},
+			{Lo: 0x1E86, Hi: 0x1E86, Stride: 1},
+			{Lo: 0x1E88, Hi: 0x1E88, Stride: 1},
+			{Lo: 0x1E8A, Hi: 0x1E8A, Stride: 1},
+			{Lo: 0x1E8C, Hi: 0x1E8C, Stride: 1},
+			{Lo: 0x1E8E, Hi: 0x1E8E, Stride: 1},
+			{Lo: 0x1E90, Hi: 0x1E90, Stride: 1},
+			{Lo: 0x1E92, Hi: 0x1E92, Stride: 1},
+			{Lo: 0x1E94, Hi: 0x1E94, Stride: 1},
+			{Lo: 0x1E9E, Hi: 0x1E9E, Stride: 1},
+			{Lo: 0x1EA0, Hi: 0x1EA0, Stride: 1},
+			{Lo: 0x1EA2, Hi: 0x1EA2, Stride: 1},
+			{Lo: 0x1EA4, Hi: 0x1EA4, Stride: 1},
+			{Lo: 0x1EA6, Hi: 0x1EA6, Stride: 1},
+			{Lo: 0x1EA8, Hi: 0x1EA8, Stride: 1},
+			{Lo: 0x1EAA, Hi: 0x1EAA, Stride: 1},
+			{Lo: 0x1EAC, Hi: 0x1EAC, Stride: 1},
+			{Lo: 0x1EAE, Hi: 0x1EAE, Stride: 1},
+			{Lo: 0x1EB0, Hi: 0x1EB0, Stride: 1},
+			{Lo: 0x1EB2, Hi: 0x1EB2, Stride: 1},
+			{Lo: 0x1EB4, Hi: 0x1EB4, Stride: 1},
+			{Lo: 0x1EB6, Hi: 0x1EB6, Stride: 1},
+			{Lo: 0x1EB8, Hi: 0x1EB8, Stride: 1},
+			{Lo: 0x1EBA, Hi: 0x1EBA, Stride: 1},
+			{Lo: 0x1EBC, Hi: 0x1EBC, Stride: 1},
+			{Lo: 0x1EBE, Hi: 0x1EBE, Stride: 1},
+			{Lo: 0x1EC0, Hi: 0x1EC0, Stride: 1},
+			{Lo: 0x1EC2, Hi: 0x1EC2, Stride: 1},
+			{Lo: 0x1EC4, Hi: 0x1EC4, Stride: 1},
+			{Lo: 0x1EC6, Hi: 0x1EC6, Stride: 1},
+			{Lo: 0x1EC8, Hi: 0x1EC8, Stride: 1},
+			{Lo: 0x1ECA, Hi: 0x1ECA, Stride: 1},
+			{Lo: 0x1ECC, Hi: 0x1ECC, Stride: 1},
+			{Lo: 0x1ECE, Hi: 0x1ECE, Stride: 1},
+			{Lo: 0x1ED0, Hi: 0x1ED0, Stride: 1},
+			{Lo: 0x1ED2, Hi: 0x1ED2, Stride: 1},
+			{Lo: 0x1ED4, Hi: 0x1ED4, Stride: 1},
+			{Lo: 0x1ED6, Hi: 0x1ED6, Stride: 1},
+			{Lo: 0x1ED8, Hi: 0x1ED8, Stride: 1},
+			{Lo: 0x1EDA, Hi: 0x1EDA, Stride: 1},
+			{Lo: 0x1EDC, Hi: 0x1EDC, Stride: 1},
+			{Lo: 0x1EDE, Hi: 0x1EDE, Stride: 1},
+			{Lo: 0x1EE0, Hi: 0x1EE0, Stride: 1},
+			{Lo: 0x1EE2, Hi: 0x1EE2, Stride: 1},
+			{Lo: 0x1EE4, Hi: 0x1EE4, Stride: 1},
+			{Lo: 0x1EE6, Hi: 0x1EE6, Stride: 1},
+			{Lo: 0x1EE8, Hi: 0x1EE8, Stride: 1},
+			{Lo: 0x1EEA, Hi: 0x1EEA, Stride: 1},
+			{Lo: 0x1EEC, Hi: 0x1EEC, Stride: 1},
+			{Lo: 0x1EEE, Hi: 0x1EEE, Stride: 1},
+			{Lo: 0x1EF0, Hi: 0x1EF0, Stride: 1},
+			{Lo: 0x1EF2, Hi: 0x1EF2, Stride: 1},
+			{Lo: 0x1EF4, Hi: 0x1EF4, Stride: 1},
+			{Lo: 0x1EF6, Hi: 0x1EF6, Stride: 1},
+			{Lo: 0x1EF8, Hi: 0x1EF8, Stride: 1},
+			{Lo: 0x1EFA, Hi: 0x1EFA, Stride: 1},
+			{Lo: 0x1EFC, Hi: 0x1EFC, Stride: 1},
+			{Lo: 0x1EFE, Hi: 0x1EFE, Stride: 1},
+			{Lo: 0x1F08, Hi: 0x1F0F, Stride: 1},
+			{Lo: 0x1F18, Hi: 0x1F1D, Stride: 1},
+			{Lo: 0x1F28, Hi: 0x1F2F, Stride: 1},
+			{Lo: 0x1F38, Hi: 0x1F3F, Stride: 1},
+			{Lo: 0x1F48, Hi: 0x1F4D, Stride: 1},
+			{Lo: 0x1F59, Hi: 0x1F59, Stride: 1},
+			{Lo: 0x1F5B, Hi: 0x1F5B, Stride: 1},
+			{Lo: 0x1F5D, Hi: 0x1F5D, Stride: 1},
+			{Lo: 0x1F5F, Hi: 0x1F5F, Stride: 1},
+			{Lo: 0x1F68, Hi: 0x1F6F, Stride: 1},
+			{Lo: 0x1F88, Hi: 0x1F8F, Stride: 1},
+			{Lo: 0x1F98, Hi: 0x1F9F, Stride: 1},
+			{Lo: 0x1FA8, Hi: 0x1FAF, Stride: 1},
+			{Lo: 0x1FB8, Hi: 0x1FBC, Stride: 1},
+			{Lo: 0x1FC8, Hi: 0x1FCC, Stride: 1},
+			{Lo: 0x1FD8, Hi: 0x1FDB, Stride: 1},
+			{Lo: 0x1FE8, Hi: 0x1FEC, Stride: 1},
+			{Lo: 0x1FF8, Hi: 0x1FFC, Stride: 1},
+			{Lo: 0x2102, Hi: 0x2102, Stride: 1},
+			{Lo: 0x2107, Hi: 0x2107, Stride: 1},
+			{Lo: 0x210B, Hi: 0x210D, Stride: 1},
+			{Lo: 0x2110, Hi: 0x2112, Stride: 1},
+			{Lo: 0x2115, Hi: 0x2115, Stride: 1},
+			{Lo: 0x2119, Hi: 0x211D, Stride: 1},
+			{Lo: 0x2124, Hi: 0x2124, Stride: 1},
+			{Lo: 0x2126, Hi: 0x2126, Stride: 1},
+			{Lo: 0x2128, Hi: 0x2128, Stride: 1},
+			{Lo: 0x212A, Hi: 0x212D, Stride: 1},
+			{Lo: 0x2130, Hi: 0x2133, Stride: 1},
+			{Lo: 0x213E, Hi: 0x213F, Stride: 1},
+			{Lo: 0x2145, Hi: 0x2145, Stride: 1},
+			{Lo: 0x2160, Hi: 0x216F, Stride: 1},
+			{Lo: 0x2183, Hi: 0x2183, Stride: 1},
+			{Lo: 0x24B6, Hi: 0x24CF, Stride: 1},
+			{Lo: 0x2C00, Hi: 0x2C2F, Stride: 1},
+			{Lo: 0x2C60, Hi: 0x2C60, Stride: 1},
+			{Lo: 0x2C62, Hi: 0x2C64, Stride: 1},
+			{Lo: 0x2C67, Hi: 0x2C67, Stride: 1},
+			{Lo: 0x2C69, Hi: 0x2C69, Stride: 1},
+			{Lo: 0x2C6B, Hi: 0x2C6B, Stride: 1},
+			{Lo: 0x2C6D, Hi: 0x2C70, Stride: 1},
+			{Lo: 0x2C72, Hi: 0x2C72, Stride: 1},
+			{Lo: 0x2C75, Hi: 0x2C75, Stride: 1},
+			{Lo: 0x2C7E, Hi: 0x2C80, Stride: 1},
+			{Lo: 0x2C82, Hi: 0x2C82, Stride: 1},
+			{Lo: 0x2C84, Hi: 0x2C84, Stride: 1},
+			{Lo: 0x2C86, Hi: 0x2C86, Stride: 1},
+			{Lo: 0x2C88, Hi: 0x2C88, Stride: 1},
+			{Lo: 0x2C8A, Hi: 0x2C8A, Stride: 1},
+			{Lo: 0x2C8C, Hi: 0x2C8C, Stride: 1},
+			{Lo: 0x2C8E, Hi: 0x2C8E, Stride: 1},
+			{Lo: 0x2C90, Hi: 0x2C90, Stride: 1},
+			{Lo: 0x2C92, Hi: 0x2C92, Stride: 1},
+			{Lo: 0x2C94, Hi: 0x2C94, Stride: 1},
+			{Lo: 0x2C96, Hi: 0x2C96, Stride: 1},
+			{Lo: 0x2C98, Hi: 0x2C98, Stride: 1},
+			{Lo: 0x2C9A, Hi: 0x2C9A, Stride: 1},
+			{Lo: 0x2C9C, Hi: 0x2C9C, Stride: 1},
+			{Lo: 0x2C9E, Hi: 0x2C9E, Stride: 1},
+			{Lo: 0x2CA0, Hi: 0x2CA0, Stride: 1},
+			{Lo: 0x2CA2, Hi: 0x2CA2, Stride: 1},
+			{Lo: 0x2CA4, Hi: 0x2CA4, Stride: 1},
+			{Lo: 0x2CA6, Hi: 0x2CA6, Stride: 1},
+			{Lo: 0x2CA8, Hi: 0x2CA8, Stride: 1},
+			{Lo: 0x2CAA, Hi: 0x2CAA, Stride: 1},
+			{Lo: 0x2CAC, Hi: 0x2CAC, Stride: 1},
+			{Lo: 0x2CAE, Hi: 0x2CAE, Stride: 1},
+			{Lo: 0x2CB0, Hi: 0x2CB0, Stride: 1},
+			{Lo: 0x2CB2, Hi: 0x2CB2, Stride: 1},
+			{Lo: 0x2CB4, Hi: 0x2CB4, Stride: 1},
+			{Lo: 0x2CB6, Hi: 0x2CB6, Stride: 1},
+			{Lo: 0x2CB8, Hi: 0x2CB8, Stride: 1},
+			{Lo: 0x2CBA, Hi: 0x2CBA, Stride: 1},
+			{Lo: 0x2CBC, Hi: 0x2CBC, Stride: 1},
+			{Lo: 0x2CBE, Hi: 0x2CBE, Stride: 1},
+			{Lo: 0x2CC0, Hi: 0x2CC0, Stride: 1},
+			{Lo: 0x2CC2, Hi: 0x2CC2, Stride: 1},
+			{Lo: 0x2CC4, Hi: 0x2CC4, Stride: 1},
+			{Lo: 0x2CC6, Hi: 0x2CC6, Stride: 1},
+			{Lo: 0x2CC8, Hi: 0x2CC8, Stride: 1},
+			{Lo: 0x2CCA, Hi: 0x2CCA, Stride: 1},
+			{Lo: 0x2CCC, Hi: 0x2CCC, Stride: 1},
+			{Lo: 0x2CCE, Hi: 0x2CCE, Stride: 1},
+			{Lo: 0x2CD0, Hi: 0x2CD0, Stride: 1},
+			{Lo: 0x2CD2, Hi: 0x2CD2, Stride: 1},
+			{Lo: 0x2CD4, Hi: 0x2CD4, Stride: 1},
+			{Lo: 0x2CD6, Hi: 0x2CD6, Stride: 1},
+			{Lo: 0x2CD8, Hi: 0x2CD8, Stride: 1},
+			{Lo: 0x2CDA, Hi: 0x2CDA, Stride: 1},
+			{Lo: 0x2CDC, Hi: 0x2CDC, Stride: 1},
+			{Lo: 0x2CDE, Hi: 0x2CDE, Stride: 1},
+			{Lo: 0x2CE0, Hi: 0x2CE0, Stride: 1},
+			{Lo: 0x2CE2, Hi: 0x2CE2, Stride: 1},
+			{Lo: 0x2CEB, Hi: 0x2CEB, Stride: 1},
+			{Lo: 0x2CED, Hi: 0x2CED, Stride: 1},
+			{Lo: 0x2CF2, Hi: 0x2CF2, Stride: 1},
+			{Lo: 0xA640, Hi: 0xA640, Stride: 1},
+			{Lo: 0xA642, Hi: 0xA642, Stride: 1},
+			{Lo: 0xA644, Hi: 0xA644, Stride: 1},
+			{Lo: 0xA646, Hi: 0xA646, Stride: 1},
+			{Lo: 0xA648, Hi: 0xA648, Stride: 1},
+			{Lo: 0xA64A, Hi: 0xA64A, Stride: 1},
+			{Lo: 0xA64C, Hi: 0xA64C, Stride: 1},
+			{Lo: 0xA64E, Hi: 0xA64E, Stride: 1},
+			{Lo: 0xA650, Hi: 0xA650, Stride: 1},
+			{Lo: 0xA652, Hi: 0xA652, Stride: 1},
+			{Lo: 0xA654, Hi: 0xA654, Stride: 1},
+			{Lo: 0xA656, Hi: 0xA656, Stride: 1},
+			{Lo: 0xA658, Hi: 0xA658, Stride: 1},
+			{Lo: 0xA65A, Hi: 0xA65A, Stride: 1},
+			{Lo: 0xA65C, Hi: 0xA65C, Stride: 1},
+			{Lo: 0xA65E, Hi: 0xA65E, Stride: 1},
+			{Lo: 0xA660, Hi: 0xA660, Stride: 1},
+			{Lo: 0xA662, Hi: 0xA662, Stride: 1},
+			{Lo: 0xA664, Hi: 0xA664, Stride: 1},
+			{Lo: 0xA666, Hi: 0xA666, Stride: 1},
+			{Lo: 0xA668, Hi: 0xA668, Stride: 1},
+			{Lo: 0xA66A, Hi: 0xA66A, Stride: 1},
+			{Lo: 0xA66C, Hi: 0xA66C, Stride: 1},
+			{Lo: 0xA680, Hi: 0xA680, Stride: 1},
+			{Lo: 0xA682, Hi: 0xA682, Stride: 1},
+			{Lo: 0xA684, Hi: 0xA684, Stride: 1},
+			{Lo: 0xA686, Hi: 0xA686, Stride: 1},
+			{Lo: 0xA688, Hi: 0xA688, Stride: 1},
+			{Lo: 0xA68A, Hi: 0xA68A, Stride: 1},
+			{Lo: 0xA68C, Hi: 0xA68C, Stride: 1},
+			{Lo: 0xA68E, Hi: 0xA68E, Stride: 1},
+			{Lo: 0xA690, Hi: 0xA690, Stride: 1},
+			{Lo: 0xA692, Hi: 0xA692, Stride: 1},
+			{Lo: 0xA694, Hi: 0xA694, Stride: 1},
+			{Lo: 0xA696, Hi: 0xA696, Stride: 1},
+			{Lo: 0xA698, Hi: 0xA698, Stride: 1},
+			{Lo: 0xA69A, Hi: 0xA69A, Stride: 1},
+			{Lo: 0xA722, Hi: 0xA722, Stride: 1},
+			{Lo: 0xA724, Hi: 0xA724, Stride: 1},
+			{Lo: 0xA726, Hi: 0xA726, Stride: 1},
+			{Lo: 0xA728, Hi: 0xA728, Stride: 1},
+			{Lo: 0xA72A, Hi: 0xA72A, Stride: 1},
+			{Lo: 0xA72C, Hi: 0xA72C, Stride: 1},
+			{Lo: 0xA72E, Hi: 0xA72E, Stride: 1},
+			{Lo: 0xA732, Hi: 0xA732, Stride: 1},
+			{Lo: 0xA734, Hi: 0xA734, Stride: 1},
+			{Lo: 0xA736, Hi: 0xA736, Stride: 1},
+			{Lo: 0xA738, Hi: 0xA738, Stride: 1},
+			{Lo: 0xA73A, Hi: 0xA73A, Stride: 1},
+			{Lo: 0xA73C, Hi: 0xA73C, Stride: 1},
+			{Lo: 0xA73E, Hi: 0xA73E, Stride: 1},
+			{Lo: 0xA740, Hi: 0xA740, Stride: 1},
+			{Lo: 0xA742, Hi: 0xA742, Stride: 1},
+			{Lo: 0xA744, Hi: 0xA744, Stride: 1},
+			{Lo: 0xA746, Hi: 0xA746, Stride: 1},
+			{Lo: 0xA748, Hi: 0xA748, Stride: 1},
+			{Lo: 0xA74A, Hi: 0xA74A, Stride: 1},
+			{Lo: 0xA74C, Hi: 0xA74C, Stride: 1},
+			{Lo: 0xA74E, Hi: 0xA74E, Stride: 1},
+			{Lo: 0xA750, Hi: 0xA750, Stride: 1},
+			{Lo: 0xA752, Hi: 0xA752, Stride: 1},
+			{Lo: 0xA754, Hi: 0xA754, Stride: 1},
+			{Lo: 0xA756, Hi: 0xA756, Stride: 1},
+			{Lo: 0xA758, Hi: 0xA758, Stride: 1},
+			{Lo: 0xA75A, Hi: 0xA75A, Stride: 1},
+			{Lo: 0xA75C, Hi: 0xA75C, Stride: 1},
+			{Lo: 0xA75E, Hi: 0xA75E, Stride: 1},
+			{Lo: 0xA760, Hi: 0xA760, Stride: 1},
+			{Lo: 0xA762, Hi: 0xA762, Stride: 1},
+			{Lo: 0xA764, Hi: 0xA764, Stride: 1},
+			{Lo: 0xA766, Hi: 0xA766, Stride: 1},
+			{Lo: 0xA768, Hi: 0xA768, Stride: 1},
+			{Lo: 0xA76A, Hi: 0xA76A, Stride: 1},
+			{Lo: 0xA76C, Hi: 0xA76C, Stride: 1},
+			{Lo: 0xA76E, Hi: 0xA76E, Stride: 1},
+			{Lo: 0xA779, Hi: 0xA779, Stride: 1},
+			{Lo: 0xA77B, Hi: 0xA77B, Stride: 1},
+			{Lo: 0xA77D, Hi: 0xA77E, Stride: 1},
+			{Lo: 0xA780, Hi: 0xA780, Stride: 1},
+			{Lo: 0xA782, Hi: 0xA782, Stride: 1},
+			{Lo: 0xA784, Hi: 0xA784, Stride: 1},
+			{Lo: 0xA786, Hi: 0xA786, Stride: 1},
+			{Lo: 0xA78B, Hi: 0xA78B, Stride: 1},
+			{Lo: 0xA78D, Hi: 0xA78D, Stride: 1},
+			{Lo: 0xA790, Hi: 0xA790, Stride: 1},
+			{Lo: 0xA792, Hi: 0xA792, Stride: 1},
+			{Lo: 0xA796, Hi: 0xA796, Stride: 1},
+			{Lo: 0xA798, Hi: 0xA798, Stride: 1},
+			{Lo: 0xA79A, Hi: 0xA79A, Stride: 1},
+			{Lo: 0xA79C, Hi: 0xA79C, Stride: 1},
+			{Lo: 0xA79E, Hi: 0xA79E, Stride: 1},
+			{Lo: 0xA7A0, Hi: 0xA7A0, Stride: 1},
+			{Lo: 0xA7A2, Hi: 0xA7A2, Stride: 1},
+			{Lo: 0xA7A4, Hi: 0xA7A4, Stride: 1},
+			{Lo: 0xA7A6, Hi: 0xA7A6, Stride: 1},
+			{Lo: 0xA7A8, Hi: 0xA7A8, Stride: 1},
+			{Lo: 0xA7AA, Hi: 0xA7AE, Stride: 1},
+			{Lo: 0xA7B0, Hi: 0xA7B4, Stride: 1},
+			{Lo: 0xA7B6, Hi: 0xA7B6, Stride: 1},
+			{Lo: 0xA7B8, Hi: 0xA7B8, Stride: 1},
+			{Lo: 0xA7BA, Hi: 0xA7BA, Stride: 1},
+			{Lo: 0xA7BC, Hi: 0xA7BC, Stride: 1},
+			{Lo: 0xA7BE, Hi: 0xA7BE, Stride: 1},
+			{Lo: 0xA7C0, Hi: 0xA7C0, Stride: 1},
+			{Lo: 0xA7C2, Hi: 0xA7C2, Stride: 1},
+			{Lo: 0xA7C4, Hi: 0xA7C7, Stride: 1},
+			{Lo: 0xA7C9, Hi: 0xA7C9, Stride: 1},
+			{Lo: 0xA7CB, Hi: 0xA7CC, Stride: 1},
+			{Lo: 0xA7CE, Hi: 0xA7CE, Stride: 1},
+			{Lo: 0xA7D0, Hi: 0xA7D0, Stride: 1},
+			{Lo: 0xA7D2, Hi: 0xA7D2, Stride: 1},
+			{Lo: 0xA7D4, Hi: 0xA7D4, Stride: 1},
+			{Lo: 0xA7D6, Hi: 0xA7D6, Stride: 1},
+			{Lo: 0xA7D8, Hi: 0xA7D8, Stride: 1},
+			{Lo: 0xA7DA, Hi: 0xA7DA, Stride: 1},
+			{Lo: 0xA7DC, Hi: 0xA7DC, Stride: 1},
+			{Lo: 0xA7F5, Hi: 0xA7F5, Stride: 1},
+			{Lo: 0xFF21, Hi: 0xFF3A, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10400, Hi: 0x10427, Stride: 1},
+			{Lo: 0x104B0, Hi: 0x104D3, Stride: 1},
+			{Lo: 0x10570, Hi: 0x1057A, Stride: 1},
+			{Lo: 0x1057C, Hi: 0x1058A, Stride: 1},
+			{Lo: 0x1058C, Hi: 0x10592, Stride: 1},
+			{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+			{Lo: 0x10C80, Hi: 0x10CB2, Stride: 1},
+			{Lo: 0x10D50, Hi: 0x10D65, Stride: 1},
+			{Lo: 0x118A0, Hi: 0x118BF, Stride: 1},
+			{Lo: 0x16E40, Hi: 0x16E5F, Stride: 1},
+			{Lo: 0x16EA0, Hi: 0x16EB8, Stride: 1},
+			{Lo: 0x1D400, Hi: 0x1D419, Stride: 1},
+			{Lo: 0x1D434, Hi: 0x1D44D, Stride: 1},
+			{Lo: 0x1D468, Hi: 0x1D481, Stride: 1},
+			{Lo: 0x1D49C, Hi: 0x1D49C, Stride: 1},
+			{Lo: 0x1D49E, Hi: 0x1D49F, Stride: 1},
+			{Lo: 0x1D4A2, Hi: 0x1D4A2, Stride: 1},
+			{Lo: 0x1D4A5, Hi: 0x1D4A6, Stride: 1},
+			{Lo: 0x1D4A9, Hi: 0x1D4AC, Stride: 1},
+			{Lo: 0x1D4AE, Hi: 0x1D4B5, Stride: 1},
+			{Lo: 0x1D4D0, Hi: 0x1D4E9, Stride: 1},
+			{Lo: 0x1D504, Hi: 0x1D505, Stride: 1},
+			{Lo: 0x1D507, Hi: 0x1D50A, Stride: 1},
+			{Lo: 0x1D50D, Hi: 0x1D514, Stride: 1},
+			{Lo: 0x1D516, Hi: 0x1D51C, Stride: 1},
+			{Lo: 0x1D538, Hi: 0x1D539, Stride: 1},
+			{Lo: 0x1D53B, Hi: 0x1D53E, Stride: 1},
+			{Lo: 0x1D540, Hi: 0x1D544, Stride: 1},
+			{Lo: 0x1D546, Hi: 0x1D546, Stride: 1},
+			{Lo: 0x1D54A, Hi: 0x1D550, Stride: 1},
+			{Lo: 0x1D56C, Hi: 0x1D585, Stride: 1},
+			{Lo: 0x1D5A0, Hi: 0x1D5B9, Stride: 1},
+			{Lo: 0x1D5D4, Hi: 0x1D5ED, Stride: 1},
+			{Lo: 0x1D608, Hi: 0x1D621, Stride: 1},
+			{Lo: 0x1D63C, Hi: 0x1D655, Stride: 1},
+			{Lo: 0x1D670, Hi: 0x1D689, Stride: 1},
+			{Lo: 0x1D6A8, Hi: 0x1D6C0, Stride: 1},
+			{Lo: 0x1D6E2, Hi: 0x1D6FA, Stride: 1},
+			{Lo: 0x1D71C, Hi: 0x1D734, Stride: 1},
+			{Lo: 0x1D756, Hi: 0x1D76E, Stride: 1},
+			{Lo: 0x1D790, Hi: 0x1D7A8, Stride: 1},
+			{Lo: 0x1D7CA, Hi: 0x1D7CA, Stride: 1},
+			{Lo: 0x1E900, Hi: 0x1E921, Stride: 1},
+			{Lo: 0x1F130, Hi: 0x1F149, Stride: 1},
+			{Lo: 0x1F150, Hi: 0x1F169, Stride: 1},
+			{Lo: 0x1F170, Hi: 0x1F189, Stride: 1},
+		},
+	}
+)
@@ -0,0 +1,282 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"errors"
+	"flag"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc"
+	"jrubin.io/nr/nrpb"
+	"jrubin.io/nr/wordseq"
+)
+
+const serveUsage = `Usage of %[1]s serve:
+
+	%[1]s serve [flags]
+
+	Serves an http api that counts the text posted to it. The body of a
+	POST to /count is counted on its own and its most frequent sequences
+	are returned as json, as with '%[1]s count -output json'. The body of
+	a POST to /add is added to running totals, whose latest results are
+	returned by a GET of /results and shown at /, as with -http.
+	The charset of a body's Content-Type is honored, otherwise its
+	encoding is detected. Bodies larger than -max-body are rejected with
+	413 Request Entity Too Large.
+
+	With -grpc, the same api is also served over grpc, as the Counter
+	service of nrpb/nr.proto, to which the text is streamed in chunks.
+
+flags:
+`
+
+// serveFlags adds the flags of the serve command
+func serveFlags(fs *flag.FlagSet, c *config) {
+	inputFlags(fs, c)
+	sequenceFlags(fs, c)
+	filterFlags(fs, c)
+
+	c.TopN = intsFlag{100}
+	fs.Var(
+		&c.TopN,
+		"n",
+		"only return the top n sequences with the highest frequency count, 0 returns all of them, a comma separated `list` returns a section for each",
+	)
+
+	fs.StringVar(
+		&c.Sort,
+		"sort",
+		"count-desc",
+		"`order` of the sequences, one of: count-desc, count-asc (so that -n returns the rarest), lex (the most frequent, ordered by their words)",
+	)
+
+	fs.StringVar(
+		&c.HTTP,
+		"http",
+		"localhost:8080",
+		"serve the api at this `address`, or not over http if empty",
+	)
+
+	fs.StringVar(
+		&c.GRPC,
+		"grpc",
+		"",
+		"also serve the api over grpc at this `address`",
+	)
+
+	c.MaxBody = 32 << 20
+	fs.Var(
+		&c.MaxBody,
+		"max-body",
+		"reject bodies posted to /count or /add that are larger than this `size`, e.g. 1g, 0 accepts any size",
+	)
+}
+
+// server counts the text posted to it
+type server struct {
+	c    config
+	fc   fileCounter
+	dash *dashboard
+
+	// totals are the counts of everything posted to /add
+	mu     sync.Mutex
+	totals []*wordseq.Counter
+}
+
+func newServer(c config) (*server, error) {
+	// the results are always json, so the text output's flags aren't used
+	c.Output, c.Overflow, c.Color = "json", "truncate", "never"
+	if err := checkOutput(&c); err != nil {
+		return nil, err
+	}
+
+	opts, err := counterOptions(c)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &server{
+		c:    c,
+		fc:   fileCounter{c: c, opts: opts},
+		dash: newDashboard(),
+	}
+
+	if s.totals, err = s.fc.newCounters(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// count returns the counts of the text of in
+func (s *server) count(in *input) ([]*wordseq.Counter, error) {
+	counters, err := s.fc.newCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := decode("request", in, s.c)
+	if err != nil {
+		return nil, err
+	}
+
+	text = sample("request", prepare("request", text, s.c), s.c)
+
+	if err = wordseq.AddAll(text, counters...); err != nil {
+		return nil, err
+	}
+
+	return counters, nil
+}
+
+// add adds counters to the running totals
+func (s *server) add(counters []*wordseq.Counter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, counter := range counters {
+		s.totals[i].Merge(counter)
+	}
+
+	results, err := s.results(s.totals)
+	if err != nil {
+		return err
+	}
+
+	return s.dash.update(s.c, results)
+}
+
+// results returns the ranked sequences of counters
+func (s *server) results(counters []*wordseq.Counter) ([]result, error) {
+	results := make([]result, len(counters))
+	for i, counter := range counters {
+		var err error
+		if results[i], err = rank(s.c, "", counter); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// write writes the results of counters to w as json
+func (s *server) write(w http.ResponseWriter, counters []*wordseq.Counter) {
+	results, err := s.results(counters)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = writeResults(w, s.c, results) // #nosec
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := http.MethodPost
+	switch r.URL.Path {
+	case "/count", "/add":
+	case "/results":
+		method = http.MethodGet
+	default:
+		s.dash.ServeHTTP(w, r)
+		return
+	}
+
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.URL.Path == "/results" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.write(w, s.totals)
+		return
+	}
+
+	if s.c.MaxBody > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, int64(s.c.MaxBody))
+	}
+
+	in := &input{Reader: r.Body, Closer: r.Body}
+	if _, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil {
+		in.charset = params["charset"]
+	}
+
+	counters, err := s.count(in)
+	if err != nil {
+		status := http.StatusBadRequest
+
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if r.URL.Path == "/count" {
+		s.write(w, counters)
+		return
+	}
+
+	if err = s.add(counters); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// runServe runs the serve command
+func runServe(c config, args []string) error {
+	if len(args) > 0 {
+		return usageErrorf("serve doesn't read input files, text is posted to it")
+	}
+
+	if c.HTTP == "" && c.GRPC == "" {
+		return usageErrorf("serve requires -http or -grpc")
+	}
+
+	s, err := newServer(c)
+	if err != nil {
+		return err
+	}
+
+	if c.GRPC != "" {
+		ln, err := net.Listen("tcp", c.GRPC)
+		if err != nil {
+			return err
+		}
+
+		gs := grpc.NewServer()
+		nrpb.RegisterCounterServer(gs, grpcServer{s: s})
+
+		infof("serving grpc at %s", ln.Addr())
+
+		if c.HTTP == "" {
+			return gs.Serve(ln)
+		}
+
+		go func() {
+			log.Fatalf("%+v", gs.Serve(ln))
+		}()
+	}
+
+	ln, err := net.Listen("tcp", c.HTTP)
+	if err != nil {
+		return err
+	}
+
+	infof("serving at http://%s/", ln.Addr())
+
+	return http.Serve(ln, s)
+}
@@ -0,0 +1,90 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServe(t *testing.T) {
+	s, err := newServer(config{
+		SequenceSize: sizesFlag{2},
+		DetectBytes:  1024,
+		Sample:       1,
+		TopN:         intsFlag{1},
+		Sort:         "count-desc",
+		MaxBody:      64,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	// get returns the sequences of the response to a request of path
+	get := func(method, path, body string, status int) []record {
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != status {
+			t.Fatalf("%s %s: status(%d) != %d", method, path, resp.StatusCode, status)
+		}
+
+		if status != http.StatusOK {
+			return nil
+		}
+
+		var got []record
+		if err = json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	got := get(http.MethodPost, "/count", "a b a b c", http.StatusOK)
+	if len(got) != 1 || got[0].Count != 2 || strings.Join(got[0].Words, " ") != "a b" {
+		t.Errorf("unexpected counts: %+v", got)
+	}
+
+	// /count doesn't affect the totals
+	if got = get(http.MethodGet, "/results", "", http.StatusOK); len(got) != 0 {
+		t.Errorf("unexpected totals: %+v", got)
+	}
+
+	get(http.MethodPost, "/add", "x y", http.StatusNoContent)
+	get(http.MethodPost, "/add", "w x y", http.StatusNoContent)
+
+	got = get(http.MethodGet, "/results", "", http.StatusOK)
+	if len(got) != 1 || got[0].Count != 2 || strings.Join(got[0].Words, " ") != "x y" {
+		t.Errorf("unexpected totals: %+v", got)
+	}
+
+	// neither endpoint reads more than -max-body
+	large := strings.Repeat("a b ", 17)
+	get(http.MethodPost, "/count", large, http.StatusRequestEntityTooLarge)
+	get(http.MethodPost, "/add", large, http.StatusRequestEntityTooLarge)
+	get(http.MethodPost, "/count", large[:64], http.StatusOK)
+
+	get(http.MethodGet, "/count", "", http.StatusMethodNotAllowed)
+	get(http.MethodPost, "/results", "", http.StatusMethodNotAllowed)
+	get(http.MethodGet, "/missing", "", http.StatusNotFound)
+
+	if err = runServe(config{}, nil); exitCode(err) != exitUsage {
+		t.Errorf("expected usage error without -http or -grpc, got %v", err)
+	}
+}
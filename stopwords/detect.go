@@ -0,0 +1,141 @@
+package stopwords
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A Share is the part of text that is in a language
+type Share struct {
+	Lang     string
+	Fraction float64
+}
+
+// minHits is the number of words only in the list of a language that must be
+// found for it to be detected, so that the words it shares with others don't
+// detect it on their own
+const minHits = 2
+
+// A Detector guesses the languages of text from how often it uses the words of
+// each built in list. A word in the lists of several languages counts toward
+// each of them in proportion to the words found that are only in their list,
+// and a language is only detected once minHits of those have been found. A
+// Detector isn't safe for concurrent use.
+type Detector struct {
+	// langs are the languages with each word in their list
+	langs map[string][]string
+
+	// exclusive is the number of words found of each language that are in no
+	// other list, and shared the number of times each word that is in several
+	// lists was found
+	exclusive map[string]int
+	shared    map[string]int
+}
+
+// detectorLangs are the languages of each word of the built in lists, they are
+// only read once
+var (
+	detectorLangs map[string][]string
+	detectorOnce  sync.Once
+)
+
+// NewDetector returns a Detector of the languages with a built in list
+func NewDetector() *Detector {
+	detectorOnce.Do(func() {
+		detectorLangs = map[string][]string{}
+		for _, lang := range Languages() {
+			words, err := Language(lang)
+			if err != nil {
+				continue
+			}
+
+			for _, word := range words {
+				detectorLangs[word] = append(detectorLangs[word], lang)
+			}
+		}
+	})
+
+	return &Detector{
+		langs:     detectorLangs,
+		exclusive: map[string]int{},
+		shared:    map[string]int{},
+	}
+}
+
+// Add adds a word of the text
+func (d *Detector) Add(word string) {
+	word = strings.ToLower(word)
+	switch langs := d.langs[word]; len(langs) {
+	case 0:
+	case 1:
+		d.exclusive[langs[0]]++
+	default:
+		d.shared[word]++
+	}
+}
+
+// Merge adds the words added to o
+func (d *Detector) Merge(o *Detector) {
+	for lang, n := range o.exclusive {
+		d.exclusive[lang] += n
+	}
+
+	for word, n := range o.shared {
+		d.shared[word] += n
+	}
+}
+
+// Languages returns the share of the text in each language that was found in
+// it, the most likely first
+func (d *Detector) Languages() []Share {
+	scores := map[string]float64{}
+	for lang, n := range d.exclusive {
+		if n >= minHits {
+			scores[lang] = float64(n)
+		}
+	}
+
+	// shared words are split between the languages that were detected by the
+	// words only in their lists, by how many of those were found
+	for word, n := range d.shared {
+		var hits int
+		for _, lang := range d.langs[word] {
+			if _, ok := scores[lang]; ok {
+				hits += d.exclusive[lang]
+			}
+		}
+
+		if hits == 0 {
+			continue
+		}
+
+		for _, lang := range d.langs[word] {
+			if _, ok := scores[lang]; ok {
+				scores[lang] += float64(n) * float64(d.exclusive[lang]) / float64(hits)
+			}
+		}
+	}
+
+	var total float64
+	for _, score := range scores {
+		total += score
+	}
+
+	ret := make([]Share, 0, len(scores))
+	for lang, score := range scores {
+		ret = append(ret, Share{Lang: lang, Fraction: score / total})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Fraction != ret[j].Fraction {
+			return ret[i].Fraction > ret[j].Fraction
+		}
+		return ret[i].Lang < ret[j].Lang
+	})
+
+	return ret
+}
@@ -0,0 +1,81 @@
+// Package stopwords provides lists of common words, such as "the" and "of",
+// that are usually excluded when counting word sequences
+package stopwords
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+//go:embed lists/*.txt
+var lists embed.FS
+
+// Languages returns the codes of the languages with a built in list
+func Languages() []string {
+	entries, err := lists.ReadDir("lists")
+	if err != nil {
+		return nil
+	}
+
+	ret := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ret = append(ret, strings.TrimSuffix(e.Name(), ".txt"))
+	}
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Language returns the built in list for the language with the given code, e.g.
+// "en"
+func Language(lang string) ([]string, error) {
+	f, err := lists.Open(path.Join("lists", strings.ToLower(lang)+".txt"))
+	if err != nil {
+		return nil, fmt.Errorf("no stopwords for language: %s, valid values are: %s",
+			lang, strings.Join(Languages(), ", "))
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// ReadFile returns the list in the file fn
+func ReadFile(fn string) ([]string, error) {
+	f, err := os.Open(fn) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Read returns a list of one word per line. Blank lines and lines starting with
+// '#' are ignored.
+func Read(r io.Reader) ([]string, error) {
+	var ret []string
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ret = append(ret, line)
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,90 @@
+package stopwords
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLanguage(t *testing.T) {
+	langs := Languages()
+	if len(langs) == 0 {
+		t.Fatal("no built in languages")
+	}
+
+	for _, lang := range langs {
+		words, err := Language(lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(words) == 0 {
+			t.Errorf("%s: empty list", lang)
+		}
+
+		for _, word := range words {
+			if word != strings.ToLower(word) || strings.ContainsAny(word, " \t") {
+				t.Errorf("%s: invalid word: %q", lang, word)
+			}
+		}
+	}
+
+	words, err := Language("EN")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(words[:3], " ") != "a about above" {
+		t.Errorf("unexpected english words: %v", words[:3])
+	}
+
+	if _, err = Language("xx"); err == nil {
+		t.Error("expected error for unknown language")
+	}
+}
+
+func TestRead(t *testing.T) {
+	words, err := Read(strings.NewReader("# comment\nthe\n\n  of \nand\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(words, ",") != "the,of,and" {
+		t.Errorf("%v != [the of and]", words)
+	}
+}
+
+func TestDetector(t *testing.T) {
+	for _, v := range []struct {
+		texts  []string
+		expect string
+	}{
+		{[]string{"the cat sat on the mat and it was happy"}, "en"},
+		{[]string{"le chat est sur le tapis et il est content"}, "fr"},
+		{[]string{"der Hund ist nicht in dem Haus und"}, "de"},
+		{[]string{"the cat sat on the mat", "le chat est sur le tapis"}, "fr en"},
+		{[]string{"This is a short text. It is in English, which shares words with Dutch."}, "en"},
+		{[]string{"the"}, ""},
+		{[]string{"zzz"}, ""},
+	} {
+		d := NewDetector()
+		for _, text := range v.texts {
+			o := NewDetector()
+			for _, word := range strings.Fields(text) {
+				o.Add(word)
+			}
+			d.Merge(o)
+		}
+
+		var langs []string
+		for _, share := range d.Languages() {
+			langs = append(langs, share.Lang)
+		}
+
+		if got := strings.Join(langs, " "); got != v.expect {
+			t.Errorf("%v: %q != %q (%v)", v.texts, got, v.expect, d.Languages())
+		}
+	}
+}
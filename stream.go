@@ -0,0 +1,153 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// the delays before reconnecting to a -stream that ended, which double after
+// each failure
+const (
+	streamMinDelay = time.Second
+	streamMaxDelay = time.Minute
+)
+
+// maxStreamLine is the longest line of a -stream
+const maxStreamLine = 1 << 24
+
+// dialStream connects to addr, either an http or https url, or
+// unix:/path/to/socket or tcp:host:port, returning the stream and the charset
+// it declares, if any
+func dialStream(ctx context.Context, addr string) (io.ReadCloser, string, error) {
+	if isURL(addr) {
+		req, err := newRequest(ctx, addr)
+		if err != nil {
+			return nil, "", err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close() // #nosec
+			return nil, "", fmt.Errorf("%s", resp.Status)
+		}
+
+		var charset string
+		if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+			charset = params["charset"]
+		}
+
+		return resp.Body, charset, nil
+	}
+
+	network, address := "tcp", strings.TrimPrefix(addr, "tcp:")
+	if path := strings.TrimPrefix(addr, "unix:"); path != addr {
+		network, address = "unix", path
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the connection isn't ended by ctx once it has been made
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close() // #nosec
+	}()
+
+	return conn, "", nil
+}
+
+// stream counts each line read from addr into the counters, as a separate
+// message, reconnecting whenever the stream ends, until fc.stop is closed. fn
+// is then called with the counters.
+func (fl *follower) stream(fc *fileCounter, addr string, fn func(string, []*wordseq.Counter) error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-fc.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	delay := streamMinDelay
+	for {
+		connected, err := fl.countStream(ctx, fc, addr)
+
+		if ctx.Err() != nil {
+			return fn("", fl.counters)
+		}
+
+		if connected {
+			delay = streamMinDelay
+		}
+
+		if err == nil {
+			err = io.EOF
+		}
+		log.Printf("%s: %v, reconnecting in %s", addr, err, delay)
+
+		select {
+		case <-ctx.Done():
+			return fn("", fl.counters)
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > streamMaxDelay {
+			delay = streamMaxDelay
+		}
+	}
+}
+
+// countStream adds each line read from a connection to addr to the counters
+// until it ends, reporting whether it connected at all
+func (fl *follower) countStream(ctx context.Context, fc *fileCounter, addr string) (bool, error) {
+	rc, charset, err := dialStream(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	in := &input{Reader: fc.p.reader(unlockedReader{r: rc, mu: &fl.mu}), Closer: nopCloser{}, charset: charset, live: true}
+
+	r, err := decode(addr, in, fc.c)
+	if err != nil {
+		return true, err
+	}
+
+	s := bufio.NewScanner(sample(addr, prepare(addr, r, fc.c), fc.c))
+	s.Buffer(nil, maxStreamLine)
+
+	// sequences don't span messages
+	for s.Scan() {
+		if err = wordseq.AddAll(strings.NewReader(s.Text()), fl.counters...); err != nil {
+			return true, err
+		}
+	}
+
+	return true, s.Err()
+}
@@ -0,0 +1,140 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestDialStream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/topic" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=iso-8859-1")
+		fmt.Fprint(w, "a b\n")
+	}))
+	defer srv.Close()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		fmt.Fprint(conn, "c d\n")
+		_ = conn.Close() // #nosec
+	}()
+
+	for _, v := range []struct {
+		addr    string
+		expect  string
+		charset string
+	}{
+		{srv.URL + "/topic", "a b\n", "iso-8859-1"},
+		{"tcp:" + ln.Addr().String(), "c d\n", ""},
+	} {
+		rc, charset, err := dialStream(context.Background(), v.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := ioutil.ReadAll(rc)
+		_ = rc.Close() // #nosec
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != v.expect || charset != v.charset {
+			t.Errorf("%s: %q, %q != %q, %q", v.addr, data, charset, v.expect, v.charset)
+		}
+	}
+
+	if _, _, err := dialStream(context.Background(), srv.URL+"/missing"); err == nil {
+		t.Error("expected error for missing topic")
+	}
+}
+
+func TestFollowerStream(t *testing.T) {
+	// the first connection is ended by the server, the second by stopping
+	conns := make(chan int, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conns <- 1
+		if len(conns) == 1 {
+			fmt.Fprint(w, "a b c\nd e\n")
+			return
+		}
+
+		fmt.Fprint(w, "a b c\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	stop := make(chan struct{})
+	fc := fileCounter{
+		c: config{
+			SequenceSize: sizesFlag{3},
+			DetectBytes:  1024,
+			Sample:       1,
+		},
+		stop: stop,
+	}
+
+	fl := &follower{}
+	var err error
+	if fl.counters, err = fc.newCounters(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error)
+	var got []*wordseq.Counter
+	go func() {
+		done <- fl.stream(&fc, srv.URL, func(_ string, counters []*wordseq.Counter) error {
+			got = counters
+			return nil
+		})
+	}()
+
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		fl.mu.Lock()
+		n, total := fl.counters[0].Len(), fl.counters[0].Total()
+		fl.mu.Unlock()
+
+		if len(conns) == 2 && n == 1 && total == 2 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the stream")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(stop)
+
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// each line is a separate message, so no sequence spans them
+	if len(got) != 1 || got[0].Len() != 1 {
+		t.Errorf("%v", got)
+	}
+}
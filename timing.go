@@ -0,0 +1,82 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+// timing is the time spent in each stage of counting every file, for -timing
+type timing struct {
+	mu sync.Mutex
+
+	decode, segment, count time.Duration
+
+	bytes int64
+	words int
+}
+
+// timedReader adds the time spent reading from the Reader to d
+type timedReader struct {
+	io.Reader
+	d *time.Duration
+}
+
+func (r timedReader) Read(b []byte) (int, error) {
+	start := time.Now()
+	n, err := r.Reader.Read(b)
+	*r.d += time.Since(start)
+	return n, err
+}
+
+// add adds the time spent counting a file into counter. decode is the time
+// spent reading its decoded content, which is also part of the time spent
+// segmenting it.
+func (t *timing) add(decode time.Duration, st wordseq.Timing, counter *wordseq.Counter) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.decode += decode
+	t.segment += st.Segment - decode
+	t.count += st.Count
+	t.bytes += counter.Bytes()
+	t.words += counter.Words()
+}
+
+// writeTiming writes the time spent in each stage and its throughput. Files
+// counted concurrently have their times summed, so the throughput is that of a
+// single job.
+func writeTiming(out io.Writer, t *timing) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "stage\ttime\tMB/s\twords/s")
+
+	for _, stage := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"decode", t.decode},
+		{"segment", t.segment},
+		{"count", t.count},
+	} {
+		mbs, wps := "-", "-"
+		if s := stage.d.Seconds(); s > 0 {
+			mbs = fmt.Sprintf("%.1f", float64(t.bytes)/1e6/s)
+			wps = fmt.Sprintf("%.0f", float64(t.words)/s)
+		}
+
+		fmt.Fprintf(w, "%s\t%v\t%s\t%s\n", stage.name, stage.d.Round(time.Microsecond), mbs, wps)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,61 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestWriteTiming(t *testing.T) {
+	tm := &timing{
+		decode:  time.Second,
+		segment: 2 * time.Second,
+		bytes:   4e6,
+		words:   1000,
+	}
+
+	var buf bytes.Buffer
+	if err := writeTiming(&buf, tm); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expect := range []string{
+		"stage    time  MB/s  words/s",
+		"decode   1s    4.0   1000",
+		"segment  2s    2.0   500",
+		"count    0s    -     -",
+	} {
+		if !strings.Contains(buf.String(), expect+"\n") {
+			t.Errorf("%q doesn't contain %q", buf.String(), expect)
+		}
+	}
+}
+
+func TestTimingAdd(t *testing.T) {
+	counter, err := wordseq.NewCounter(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = counter.Add(strings.NewReader("a b c")); err != nil {
+		t.Fatal(err)
+	}
+
+	var tm timing
+	tm.add(time.Second, wordseq.Timing{Segment: 3 * time.Second, Count: time.Second}, counter)
+	tm.add(time.Second, wordseq.Timing{Segment: 3 * time.Second, Count: time.Second}, counter)
+
+	if tm.decode != 2*time.Second || tm.segment != 4*time.Second || tm.count != 2*time.Second {
+		t.Errorf("decode(%v), segment(%v), count(%v) != 2s, 4s, 2s", tm.decode, tm.segment, tm.count)
+	}
+
+	if tm.words != 6 || tm.bytes != 10 {
+		t.Errorf("words(%d) != 6 or bytes(%d) != 10", tm.words, tm.bytes)
+	}
+}
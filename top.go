@@ -0,0 +1,545 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+
+	"jrubin.io/nr/wordseq"
+)
+
+const topUsage = `Usage of %[1]s top:
+
+	%[1]s top [flags] file1.txt file2.txt ...
+
+	Counts the sequences of the files, as with count, then shows them
+	in the terminal so that they can be browsed without reading the
+	files again. Input may come from stdin as the keys are read from
+	the terminal.
+
+	keys:
+		up, down, j, k      scroll by a line
+		pgup, pgdn, space   scroll by a page
+		home, end, g, G     go to the first or last sequence
+		/                   filter the sequences by a substring of
+		                    their words, enter keeps the filter and
+		                    esc clears it
+		s                   change the sort order
+		+, -                show 10 more or fewer sequences
+		0                   show all of the sequences
+		tab                 show the next sequence size
+		q, ctrl-c           quit
+
+flags:
+`
+
+// topFlags adds the flags of the top command
+func topFlags(fs *flag.FlagSet, c *config) {
+	inputFlags(fs, c)
+	sequenceFlags(fs, c)
+	filterFlags(fs, c)
+
+	c.TopN = intsFlag{100}
+	fs.Var(
+		&c.TopN,
+		"n",
+		"initial number of sequences shown, 0 shows all of them",
+	)
+
+	fs.StringVar(
+		&c.Sort,
+		"sort",
+		"count-desc",
+		"initial `order` of the sequences, one of: count-desc, count-asc, lex",
+	)
+}
+
+// sortOrders are the orders that the s key cycles through
+var sortOrders = []string{"count-desc", "count-asc", "lex"}
+
+// topStep is how many more or fewer sequences + and - show
+const topStep = 10
+
+// key is a key pressed in the terminal, either a rune or one of the keys below
+type key rune
+
+const (
+	keyUp key = -(iota + 1)
+	keyDown
+	keyPgUp
+	keyPgDn
+	keyHome
+	keyEnd
+	keyUnknown
+)
+
+const (
+	keyTab       key = '\t'
+	keyEnter     key = '\r'
+	keyEsc       key = 0x1b
+	keyBackspace key = 0x7f
+	keyCtrlC     key = 0x03
+)
+
+// readKey reads a key from r, which is a terminal in raw mode
+func readKey(r *bufio.Reader) (key, error) {
+	ch, _, err := r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+
+	switch ch {
+	case '\n':
+		return keyEnter, nil
+	case '\b':
+		return keyBackspace, nil
+	case rune(keyEsc):
+	default:
+		return key(ch), nil
+	}
+
+	// the rest of an escape sequence arrives with the escape, so if nothing
+	// follows it, esc was pressed
+	if r.Buffered() == 0 {
+		return keyEsc, nil
+	}
+
+	if c, _ := r.ReadByte(); c != '[' && c != 'O' { // #nosec
+		return keyUnknown, nil
+	}
+
+	// the parameters of the sequence are ended by a letter or ~
+	var seq []byte
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		seq = append(seq, c)
+		if c == '~' || c >= 'A' && c <= 'Z' {
+			break
+		}
+	}
+
+	switch string(seq) {
+	case "A":
+		return keyUp, nil
+	case "B":
+		return keyDown, nil
+	case "5~":
+		return keyPgUp, nil
+	case "6~":
+		return keyPgDn, nil
+	case "H", "1~", "7~":
+		return keyHome, nil
+	case "F", "4~", "8~":
+		return keyEnd, nil
+	}
+
+	return keyUnknown, nil
+}
+
+// browser is the state of the top command's view of the results
+type browser struct {
+	// results have every sequence of each size, most frequent first
+	results []result
+	cur     int
+
+	sort      string
+	n         int
+	filter    string
+	filtering bool
+
+	// view is the sequences shown, of which offset is the first on the screen
+	view   []*wordseq.Sequence
+	offset int
+
+	// rows is how many sequences fit on the screen
+	rows int
+
+	// less orders the words of sequences
+	less func(a, b []string) bool
+}
+
+func newBrowser(results []result, sort string, n, rows int, less func(a, b []string) bool) *browser {
+	b := &browser{
+		results: results,
+		sort:    sort,
+		n:       n,
+		rows:    rows,
+		less:    less,
+	}
+	b.update()
+	return b
+}
+
+// update selects the sequences shown after the result, filter, sort or n has
+// changed
+func (b *browser) update() {
+	b.view = nil
+	for _, seq := range b.results[b.cur].Seqs {
+		if strings.Contains(strings.Join(seq.Words, " "), b.filter) {
+			b.view = append(b.view, seq)
+		}
+	}
+
+	switch b.sort {
+	case "count-asc":
+		// the n least frequent
+		sort.SliceStable(b.view, func(i, j int) bool {
+			if b.view[i].Count != b.view[j].Count {
+				return b.view[i].Count < b.view[j].Count
+			}
+			return b.less(b.view[i].Words, b.view[j].Words)
+		})
+	}
+
+	if b.n > 0 && len(b.view) > b.n {
+		b.view = b.view[:b.n]
+	}
+
+	if b.sort == "lex" {
+		// the n most frequent, ordered by their words
+		sort.SliceStable(b.view, func(i, j int) bool {
+			return b.less(b.view[i].Words, b.view[j].Words)
+		})
+	}
+
+	b.scroll(0)
+}
+
+// scroll moves the view by delta sequences, keeping the screen full
+func (b *browser) scroll(delta int) {
+	b.offset += delta
+
+	if last := len(b.view) - b.rows; b.offset > last {
+		b.offset = last
+	}
+
+	if b.offset < 0 {
+		b.offset = 0
+	}
+}
+
+// key updates the view for k, returning false if the browser should quit
+func (b *browser) key(k key) bool {
+	if k == keyCtrlC {
+		return false
+	}
+
+	if b.filtering {
+		switch k {
+		case keyEnter:
+			b.filtering = false
+		case keyEsc:
+			b.filtering = false
+			b.filter = ""
+			b.update()
+		case keyBackspace:
+			if b.filter != "" {
+				_, size := utf8.DecodeLastRuneInString(b.filter)
+				b.filter = b.filter[:len(b.filter)-size]
+				b.update()
+			}
+		default:
+			if k >= 0 && unicode.IsPrint(rune(k)) {
+				b.filter += string(rune(k))
+				b.update()
+			}
+		}
+		return true
+	}
+
+	switch k {
+	case 'q':
+		return false
+	case keyUp, 'k':
+		b.scroll(-1)
+	case keyDown, 'j':
+		b.scroll(1)
+	case keyPgUp, 'b':
+		b.scroll(-b.rows)
+	case keyPgDn, ' ':
+		b.scroll(b.rows)
+	case keyHome, 'g':
+		b.scroll(-len(b.view))
+	case keyEnd, 'G':
+		b.scroll(len(b.view))
+	case '/':
+		b.filtering = true
+	case keyEsc:
+		b.filter = ""
+		b.update()
+	case 's':
+		for i, order := range sortOrders {
+			if order == b.sort {
+				b.sort = sortOrders[(i+1)%len(sortOrders)]
+				break
+			}
+		}
+		b.update()
+	case '+', '=':
+		if b.n > 0 {
+			b.n += topStep
+			b.update()
+		}
+	case '-':
+		switch {
+		case b.n == 0:
+			// fewer than are shown now
+			b.n = (len(b.view) - 1) / topStep * topStep
+		case b.n > topStep:
+			b.n -= topStep
+		}
+		if b.n < topStep {
+			b.n = topStep
+		}
+		b.update()
+	case '0':
+		b.n = 0
+		b.update()
+	case keyTab:
+		b.cur = (b.cur + 1) % len(b.results)
+		b.update()
+	}
+
+	return true
+}
+
+// render writes the screen, width columns wide, to out. The lines end with \r\n
+// as the terminal is in raw mode.
+func (b *browser) render(out io.Writer, width int) error {
+	var buf bytes.Buffer
+	line := func(s string) {
+		// long sequences are cut off at the edge of the screen
+		if width > 0 && utf8.RuneCountInString(s) > width {
+			s = string([]rune(s)[:width])
+		}
+		buf.WriteString(s)
+		buf.WriteString("\x1b[K\r\n")
+	}
+
+	res := b.results[b.cur]
+
+	n := "all"
+	if b.n > 0 {
+		n = strconv.Itoa(b.n)
+	}
+
+	header := fmt.Sprintf("%d of %d sequences", len(b.view), len(res.Seqs))
+	if len(b.results) > 1 {
+		header += fmt.Sprintf(", size %d", res.Size)
+	}
+	header += fmt.Sprintf(", sort %s, n %s", b.sort, n)
+	if b.filter != "" {
+		header += fmt.Sprintf(", matching %q", b.filter)
+	}
+
+	buf.WriteString("\x1b[H")
+	line(header)
+
+	// counts are aligned by the widest shown
+	var countWidth int
+	for _, seq := range b.view {
+		if w := len(strconv.Itoa(seq.Count)); w > countWidth {
+			countWidth = w
+		}
+	}
+
+	for i := 0; i < b.rows; i++ {
+		if j := b.offset + i; j < len(b.view) {
+			seq := b.view[j]
+			line(fmt.Sprintf("%*d %v", countWidth, seq.Count, seq.Words))
+			continue
+		}
+		line("")
+	}
+
+	if b.filtering {
+		buf.WriteString("/" + b.filter)
+	} else {
+		buf.WriteString("j/k scroll, / filter, s sort, +/- n, tab size, q quit")
+	}
+	buf.WriteString("\x1b[K")
+
+	_, err := out.Write(buf.Bytes())
+	return err
+}
+
+// runTop runs the top command
+func runTop(c config, args []string) (err error) {
+	if len(c.TopN) != 1 || c.TopN[0] < 0 {
+		return usageErrorf("invalid -n value: %s", c.TopN.String())
+	}
+	n := c.TopN[0]
+
+	valid := false
+	for _, order := range sortOrders {
+		valid = valid || order == c.Sort
+	}
+	if !valid {
+		return usageErrorf("invalid sort order: %s", c.Sort)
+	}
+
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("top requires a terminal: %v", err)
+	}
+	defer tty.Close()
+
+	if c.Jobs < 0 {
+		return usageErrorf("invalid -jobs value: %d", c.Jobs)
+	}
+
+	if c.Jobs == 0 {
+		c.Jobs = runtime.GOMAXPROCS(0)
+	}
+
+	ig, err := newIgnorer(c)
+	if err != nil {
+		return err
+	}
+
+	files, err := inputFiles(c, args, ig)
+	if err != nil {
+		return err
+	}
+	defer ig.reportSkipped()
+
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	opts, err := counterOptions(c)
+	if err != nil {
+		return err
+	}
+
+	fc := fileCounter{c: c, opts: opts}
+
+	if size := inputSize(files); c.Progress || (isTerminal(os.Stderr) && size > progressSize) {
+		fc.p = newProgress(os.Stderr, isTerminal(os.Stderr), size)
+		fc.p.Start(time.Second)
+	}
+
+	totals, err := fc.totals(files)
+
+	if fc.p != nil {
+		fc.p.Stop()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// every sequence is kept so that n can be changed
+	c.TopN = intsFlag{0}
+	c.Sort = "count-desc"
+
+	results := make([]result, len(totals))
+	for i, counter := range totals {
+		if results[i], err = rank(c, "", counter); err != nil {
+			return err
+		}
+	}
+
+	fd := int(tty.Fd())
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = term.Restore(fd, state) }() // #nosec
+
+	// use the alternate screen, without a cursor, and restore the original
+	// when done
+	fmt.Fprint(tty, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(tty, "\x1b[?25h\x1b[?1049l")
+
+	size := func() (int, int) {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			return 80, 24
+		}
+		return width, height
+	}
+
+	width, height := size()
+
+	// the header and the status line take a row each
+	rows := func(height int) int {
+		if height < 3 {
+			return 1
+		}
+		return height - 2
+	}
+
+	// every size collates its words the same way
+	less := wordseq.Less
+	if len(totals) > 0 {
+		less = totals[0].Less
+	}
+
+	b := newBrowser(results, c.Sort, n, rows(height), less)
+
+	keys := make(chan key)
+	errs := make(chan error, 1)
+	go func() {
+		r := bufio.NewReader(tty)
+		for {
+			k, err := readKey(r)
+			if err != nil {
+				errs <- err
+				return
+			}
+			keys <- k
+		}
+	}()
+
+	// the terminal may be resized at any time
+	ticker := time.NewTicker(followPoll)
+	defer ticker.Stop()
+
+	fmt.Fprint(tty, "\x1b[2J")
+
+	for {
+		if err = b.render(tty, width); err != nil {
+			return err
+		}
+
+	wait:
+		select {
+		case k := <-keys:
+			if !b.key(k) {
+				return nil
+			}
+		case err = <-errs:
+			return err
+		case <-ticker.C:
+			w, h := size()
+			if w == width && h == height {
+				goto wait
+			}
+
+			width, height = w, h
+			b.rows = rows(height)
+			b.scroll(0)
+			fmt.Fprint(tty, "\x1b[2J")
+		}
+	}
+}
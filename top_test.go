@@ -0,0 +1,143 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"jrubin.io/nr/wordseq"
+)
+
+func TestReadKey(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("q\x1b[A\x1b[B\x1b[5~\x1b[6~\x1bOH\x1b[F\x1b[3~\r\x7fé\t"))
+
+	expect := []key{'q', keyUp, keyDown, keyPgUp, keyPgDn, keyHome, keyEnd, keyUnknown, keyEnter, keyBackspace, 'é', keyTab}
+
+	for _, e := range expect {
+		k, err := readKey(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if k != e {
+			t.Errorf("%d != %d", k, e)
+		}
+	}
+
+	if _, err := readKey(r); err != io.EOF {
+		t.Errorf("%v != %v", err, io.EOF)
+	}
+
+	// an escape alone is the esc key
+	r = bufio.NewReader(strings.NewReader("\x1b"))
+	if k, err := readKey(r); err != nil || k != keyEsc {
+		t.Errorf("%d, %v != %d", k, err, keyEsc)
+	}
+}
+
+func testBrowserResults() []result {
+	seqs := []*wordseq.Sequence{
+		{Words: []string{"the", "cat"}, Count: 5},
+		{Words: []string{"a", "dog"}, Count: 4},
+		{Words: []string{"the", "dog"}, Count: 3},
+		{Words: []string{"cat", "sat"}, Count: 2},
+		{Words: []string{"sat", "on"}, Count: 1},
+	}
+
+	return []result{
+		{Size: 2, Seqs: seqs},
+		{Size: 3, Seqs: []*wordseq.Sequence{{Words: []string{"the", "cat", "sat"}, Count: 1}}},
+	}
+}
+
+func viewWords(b *browser) []string {
+	ret := make([]string, len(b.view))
+	for i, seq := range b.view {
+		ret[i] = strings.Join(seq.Words, " ")
+	}
+	return ret
+}
+
+func TestBrowser(t *testing.T) {
+	tests := []struct {
+		name   string
+		sort   string
+		n      int
+		keys   string
+		expect []string
+		offset int
+	}{
+		{"initial", "count-desc", 3, "", []string{"the cat", "a dog", "the dog"}, 0},
+		{"all", "count-desc", 3, "0", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 0},
+		{"more", "count-desc", 3, "+", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 0},
+		{"fewer", "count-desc", 0, "-", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 0},
+		{"asc", "count-desc", 3, "s", []string{"sat on", "cat sat", "the dog"}, 0},
+		{"lex", "count-desc", 3, "ss", []string{"a dog", "the cat", "the dog"}, 0},
+		{"wrap sort", "lex", 3, "s", []string{"the cat", "a dog", "the dog"}, 0},
+		{"filter", "count-desc", 0, "/dog\r", []string{"a dog", "the dog"}, 0},
+		{"backspace", "count-desc", 0, "/dogs\x7f", []string{"a dog", "the dog"}, 0},
+		{"clear", "count-desc", 0, "/dog\x1b", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 0},
+		{"filter keys", "count-desc", 0, "/sat\rs", []string{"sat on", "cat sat"}, 0},
+		{"scroll", "count-desc", 0, "jj", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 2},
+		{"scroll past end", "count-desc", 0, "jjjjjk", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 2},
+		{"end", "count-desc", 0, "G", []string{"the cat", "a dog", "the dog", "cat sat", "sat on"}, 3},
+		{"size", "count-desc", 0, "\t", []string{"the cat sat"}, 0},
+	}
+
+	for _, test := range tests {
+		b := newBrowser(testBrowserResults(), test.sort, test.n, 2, wordseq.Less)
+
+		for _, k := range test.keys {
+			if !b.key(key(k)) {
+				t.Fatalf("%s: quit", test.name)
+			}
+		}
+
+		if got := viewWords(b); !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("%s: %q != %q", test.name, got, test.expect)
+		}
+
+		if b.offset != test.offset {
+			t.Errorf("%s: offset %d != %d", test.name, b.offset, test.offset)
+		}
+	}
+
+	b := newBrowser(testBrowserResults(), "count-desc", 0, 2, wordseq.Less)
+	if b.key('/'); !b.key('q') || b.filter != "q" {
+		t.Error("q should be part of the filter")
+	}
+
+	for _, k := range []key{'q', keyCtrlC} {
+		b := newBrowser(testBrowserResults(), "count-desc", 0, 2, wordseq.Less)
+		if b.key(k) {
+			t.Errorf("%d should quit", k)
+		}
+	}
+}
+
+func TestBrowserRender(t *testing.T) {
+	b := newBrowser(testBrowserResults(), "count-desc", 0, 2, wordseq.Less)
+	b.key('/')
+	b.key('t')
+
+	var buf bytes.Buffer
+	if err := b.render(&buf, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	const expect = "\x1b[H" +
+		"4 of 5 sequences, si\x1b[K\r\n" +
+		"5 [the cat]\x1b[K\r\n" +
+		"3 [the dog]\x1b[K\r\n" +
+		"/t\x1b[K"
+
+	if buf.String() != expect {
+		t.Errorf("%q != %q", buf.String(), expect)
+	}
+}
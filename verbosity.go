@@ -0,0 +1,48 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import "log"
+
+// the levels of what is logged, as selected by -q and -v
+const (
+	levelQuiet = iota - 1
+	levelNormal
+	levelVerbose
+)
+
+// verbosity is the level of what is logged, which is set once the flags have
+// been parsed
+var verbosity = levelNormal
+
+// setVerbosity sets the verbosity selected by c
+func setVerbosity(c config) error {
+	switch {
+	case c.Quiet && c.Verbose:
+		return usageErrorf("-q can't be used with -v")
+	case c.Quiet:
+		verbosity = levelQuiet
+	case c.Verbose:
+		verbosity = levelVerbose
+	default:
+		verbosity = levelNormal
+	}
+	return nil
+}
+
+// infof logs what is informative but not a problem, such as the encodings that
+// were detected, unless quiet
+func infof(format string, v ...interface{}) {
+	if verbosity >= levelNormal {
+		log.Printf(format, v...)
+	}
+}
+
+// debugf logs the details of the work being done, such as how long each file
+// took, when verbose
+func debugf(format string, v ...interface{}) {
+	if verbosity >= levelVerbose {
+		log.Printf(format, v...)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestVerbosity(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(ioutil.Discard)
+	defer func() { verbosity = levelNormal }()
+
+	for _, v := range []struct {
+		c      config
+		expect string
+	}{
+		{config{Quiet: true}, ""},
+		{config{}, "info"},
+		{config{Verbose: true}, "info,debug"},
+	} {
+		if err := setVerbosity(v.c); err != nil {
+			t.Fatal(err)
+		}
+
+		buf.Reset()
+		infof("info")
+		debugf("debug")
+
+		var got []string
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line != "" {
+				got = append(got, line[strings.LastIndex(line, " ")+1:])
+			}
+		}
+
+		if strings.Join(got, ",") != v.expect {
+			t.Errorf("%+v: %q != %q", v.c, got, v.expect)
+		}
+	}
+
+	if err := setVerbosity(config{Quiet: true, Verbose: true}); exitCode(err) != exitUsage {
+		t.Errorf("expected usage error, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"text/tabwriter"
+	"unicode"
+
+	"jrubin.io/nr/wordreader"
+)
+
+const versionUsage = `Usage of %[1]s version:
+
+	%[1]s version
+
+	Shows the version of nr, how it was built and the versions of Unicode
+	that it segments words and maps case with. The words that are
+	counted depend on the word break rules of the Unicode version.
+
+flags:
+`
+
+// versionFlags adds the flags of the version command, of which there are none
+func versionFlags(*flag.FlagSet, *config) {}
+
+// version is the version of nr, which may be set when building, e.g. with
+// -ldflags "-X main.version=v1.2.3". Otherwise it is that of the module, when
+// it was built as a dependency.
+var version string
+
+// writeVersion writes the version of nr and what it was built from, as
+// recorded in info if ok
+func writeVersion(out io.Writer, info *debug.BuildInfo, ok bool) error {
+	v := version
+	if v == "" && ok && info.Main.Version != "" {
+		v = info.Main.Version
+	}
+	if v == "" {
+		v = "unknown"
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "version\t%s\n", v)
+	fmt.Fprintf(w, "go\t%s\n", runtime.Version())
+
+	if ok {
+		var revision, modified, built string
+		for _, s := range info.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				revision = s.Value
+			case "vcs.modified":
+				modified = s.Value
+			case "vcs.time":
+				built = s.Value
+			}
+		}
+
+		if revision != "" {
+			if modified == "true" {
+				revision += " (modified)"
+			}
+			fmt.Fprintf(w, "commit\t%s\n", revision)
+		}
+
+		if built != "" {
+			fmt.Fprintf(w, "commit time\t%s\n", built)
+		}
+	}
+
+	fmt.Fprintf(w, "unicode\t%s (word breaks), %s (letters and case)\n", wordreader.UnicodeVersion, unicode.Version)
+
+	return w.Flush()
+}
+
+// runVersion runs the version command
+func runVersion(c config, args []string) error {
+	if len(args) > 0 {
+		return usageErrorf("version takes no arguments")
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	return writeVersion(os.Stdout, info, ok)
+}
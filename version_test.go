@@ -0,0 +1,67 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bytes"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"unicode"
+
+	"jrubin.io/nr/wordreader"
+)
+
+func TestWriteVersion(t *testing.T) {
+	info := &debug.BuildInfo{
+		Main: debug.Module{Path: "jrubin.io/nr", Version: "v1.2.3"},
+		Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "abc123"},
+			{Key: "vcs.modified", Value: "true"},
+			{Key: "vcs.time", Value: "2018-03-04T05:06:07Z"},
+		},
+	}
+
+	unicodeLine := "unicode      " + wordreader.UnicodeVersion + " (word breaks), " + unicode.Version + " (letters and case)\n"
+
+	for _, v := range []struct {
+		name    string
+		version string
+		info    *debug.BuildInfo
+		ok      bool
+		expect  string
+	}{{
+		name: "module",
+		info: info,
+		ok:   true,
+		expect: "version      v1.2.3\n" +
+			"go           " + runtime.Version() + "\n" +
+			"commit       abc123 (modified)\n" +
+			"commit time  2018-03-04T05:06:07Z\n" +
+			unicodeLine,
+	}, {
+		name:    "ldflags",
+		version: "v2.0.0",
+		info:    &debug.BuildInfo{},
+		ok:      true,
+		expect:  "version  v2.0.0\ngo       " + runtime.Version() + "\n" + strings.Replace(unicodeLine, "      ", "  ", 1),
+	}, {
+		name:   "unknown",
+		expect: "version  unknown\ngo       " + runtime.Version() + "\n" + strings.Replace(unicodeLine, "      ", "  ", 1),
+	}} {
+		version = v.version
+
+		var buf bytes.Buffer
+		if err := writeVersion(&buf, v.info, v.ok); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.String() != v.expect {
+			t.Errorf("%s: %q != %q", v.name, buf.String(), v.expect)
+		}
+	}
+
+	version = ""
+}
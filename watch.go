@@ -0,0 +1,167 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDelay is how long to wait for more changes before running again, since
+// saving a file often causes several events
+const watchDelay = 100 * time.Millisecond
+
+// watcher decides which file system events should cause another run
+type watcher struct {
+	c    config
+	args []string
+
+	// files are the inputs of the last run
+	files map[string]bool
+}
+
+// dynamic reports whether new files can become inputs, as with globs and -r
+func (w *watcher) dynamic() bool {
+	if w.c.Recursive {
+		return true
+	}
+
+	for _, arg := range w.args {
+		if hasMeta(arg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// output reports whether fn is written by nr itself, including the temporary
+// files used by -o
+func (w *watcher) output(fn string) bool {
+	for _, out := range []string{w.c.OutputFile, w.c.IDsFile, w.c.VocabFile, w.c.StateFile} {
+		if out == "" {
+			continue
+		}
+
+		if filepath.Clean(fn) == filepath.Clean(out) {
+			return true
+		}
+
+		if filepath.Dir(fn) == filepath.Dir(out) &&
+			strings.HasPrefix(filepath.Base(fn), "."+filepath.Base(out)+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *watcher) relevant(e fsnotify.Event) bool {
+	if e.Op == fsnotify.Chmod || w.output(e.Name) {
+		return false
+	}
+
+	if w.files[filepath.Clean(e.Name)] {
+		return true
+	}
+
+	return e.Op&(fsnotify.Create|fsnotify.Rename) != 0 && w.dynamic()
+}
+
+// watch runs the analysis of args and then again whenever any of them change
+func watch(c config, args []string) error {
+	// the list can only be read from stdin once
+	if c.FilesFrom == "-" {
+		return usageErrorf("-watch can't be used with -files-from -")
+	}
+
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer fw.Close()
+
+	w := watcher{c: c, args: args}
+
+	// clear the screen between runs when showing the results in a terminal
+	clearScreen := c.OutputFile == "" && isTerminal(os.Stdout)
+
+	for i := 0; ; i++ {
+		// run reports the skipped files
+		ig, err := newIgnorer(c)
+		if err != nil {
+			return err
+		}
+
+		files, err := inputFiles(c, args, ig)
+		if err != nil {
+			return err
+		}
+
+		if len(files) == 0 {
+			return usageErrorf("-watch requires input files")
+		}
+
+		// watch the directories so that files replaced when saved are seen
+		w.files = map[string]bool{}
+		for _, fn := range files {
+			if fn == "-" || isURL(fn) {
+				return usageErrorf("-watch can't be used with stdin or urls")
+			}
+
+			w.files[filepath.Clean(fn)] = true
+
+			if err = fw.Add(filepath.Dir(fn)); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case clearScreen:
+			fmt.Print("\x1b[H\x1b[2J")
+		case i > 0 && c.OutputFile == "":
+			fmt.Println()
+		}
+
+		if err = run(c, args...); err != nil {
+			log.Print(err)
+		}
+
+		if err = w.wait(fw); err != nil {
+			return err
+		}
+	}
+}
+
+// wait returns once a relevant change has been made and no more have followed
+// for watchDelay
+func (w *watcher) wait(fw *fsnotify.Watcher) error {
+	var timer <-chan time.Time
+
+	for {
+		select {
+		case e, ok := <-fw.Events:
+			if !ok {
+				return fmt.Errorf("watcher closed")
+			}
+
+			if w.relevant(e) {
+				timer = time.After(watchDelay)
+			}
+		case err, ok := <-fw.Errors:
+			if !ok {
+				return fmt.Errorf("watcher closed")
+			}
+			return err
+		case <-timer:
+			return nil
+		}
+	}
+}
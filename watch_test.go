@@ -0,0 +1,45 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestWatcherRelevant(t *testing.T) {
+	w := watcher{
+		c:     config{OutputFile: "dir/out.txt"},
+		args:  []string{"dir/a.txt"},
+		files: map[string]bool{"dir/a.txt": true},
+	}
+
+	for _, v := range []struct {
+		event   fsnotify.Event
+		dynamic bool
+		expect  bool
+	}{
+		{fsnotify.Event{Name: "dir/a.txt", Op: fsnotify.Write}, false, true},
+		{fsnotify.Event{Name: "dir/./a.txt", Op: fsnotify.Create}, false, true},
+		{fsnotify.Event{Name: "dir/a.txt", Op: fsnotify.Chmod}, false, false},
+		{fsnotify.Event{Name: "dir/b.txt", Op: fsnotify.Write}, false, false},
+		{fsnotify.Event{Name: "dir/b.txt", Op: fsnotify.Create}, false, false},
+		{fsnotify.Event{Name: "dir/b.txt", Op: fsnotify.Create}, true, true},
+		{fsnotify.Event{Name: "dir/out.txt", Op: fsnotify.Create}, true, false},
+		{fsnotify.Event{Name: "dir/.out.txt.123", Op: fsnotify.Create}, true, false},
+	} {
+		w.c.Recursive = v.dynamic
+
+		if got := w.relevant(v.event); got != v.expect {
+			t.Errorf("%v (dynamic %v): %v != %v", v.event, v.dynamic, got, v.expect)
+		}
+	}
+
+	w.c.Recursive = false
+	w.args = []string{"dir/*.txt"}
+	if !w.dynamic() {
+		t.Error("globs should be dynamic")
+	}
+}
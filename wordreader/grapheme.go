@@ -0,0 +1,256 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode"
+)
+
+// GraphemeReader is an interface wrapping a basic ReadGrapheme method.
+//
+// ReadGrapheme reads a single grapheme cluster, a user-perceived character,
+// returning it or any error encountered. At the end of the input it will return
+// an empty grapheme and io.EOF.
+type GraphemeReader interface {
+	ReadGrapheme() (string, error)
+}
+
+// NewGraphemeReader returns a new GraphemeReader
+func NewGraphemeReader(r io.Reader) GraphemeReader {
+	return &graphemeReader{
+		Reader: bufio.NewReader(r),
+	}
+}
+
+// graphemeReader takes an input io.Reader and parses it into grapheme clusters
+// using the Unicode algorithm in
+// <URL:http://unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries>. Its
+// tables were made from the same version of the Unicode Character Database as
+// the word break tables, UnicodeVersion.
+type graphemeReader struct {
+	*bufio.Reader
+	Buf bytes.Buffer
+
+	// last is the class of the last rune in Buf
+	last graphemeClass
+
+	// ris is the number of regional indicators that Buf ends with
+	ris int
+
+	// pict is set when Buf ends with Extended_Pictographic Extend*, and zwj
+	// when that is followed by a ZWJ
+	pict, zwj bool
+
+	// consonant is set when Buf ends with an InCB=Consonant followed by any
+	// InCB=Extend or InCB=Linker, and linker when one of those is a linker
+	consonant, linker bool
+}
+
+// graphemeClass is the grapheme cluster break property of a rune
+type graphemeClass int
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRI
+	gcPrepend
+	gcSpacingMark
+	gcL
+	gcV
+	gcT
+	gcLV
+	gcLVT
+)
+
+// the hangul syllables, which are LV every 28 runes from the first and LVT
+// otherwise
+const (
+	hangulFirst = '가'
+	hangulLast  = '힣'
+	hangulTs    = 28
+)
+
+func graphemeClassOf(r rune) graphemeClass {
+	switch {
+	case r == carriageReturn:
+		return gcCR
+	case r == lineFeed:
+		return gcLF
+	case r == zwj:
+		return gcZWJ
+	case r >= hangulFirst && r <= hangulLast:
+		if (r-hangulFirst)%hangulTs == 0 {
+			return gcLV
+		}
+		return gcLVT
+	case unicode.In(r, tableGraphemeControl):
+		return gcControl
+	case unicode.In(r, tableGraphemeExtend):
+		return gcExtend
+	case ri(r):
+		return gcRI
+	case unicode.In(r, tableGraphemePrepend):
+		return gcPrepend
+	case unicode.In(r, tableGraphemeSpacingMark):
+		return gcSpacingMark
+	case unicode.In(r, tableGraphemeL):
+		return gcL
+	case unicode.In(r, tableGraphemeV):
+		return gcV
+	case unicode.In(r, tableGraphemeT):
+		return gcT
+	}
+	return gcOther
+}
+
+func inCBConsonant(r rune) bool {
+	return unicode.In(r, tableInCBConsonant)
+}
+
+func inCBLinker(r rune) bool {
+	return unicode.In(r, tableInCBLinker)
+}
+
+// ReadGrapheme returns a single grapheme cluster from a graphemeReader's
+// source.
+func (gr *graphemeReader) ReadGrapheme() (string, error) {
+	for {
+		r, _, err := gr.ReadRune()
+		if err == io.EOF && gr.Buf.Len() > 0 {
+			return gr.emit(), nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		c := graphemeClassOf(r)
+
+		if gr.Buf.Len() == 0 || gr.joins(r, c) {
+			gr.push(r, c)
+			continue
+		}
+
+		grapheme := gr.emit()
+		gr.push(r, c)
+		return grapheme, nil
+	}
+}
+
+// emit returns the grapheme cluster in Buf and starts the next
+func (gr *graphemeReader) emit() string {
+	grapheme := gr.Buf.String()
+	gr.Buf.Reset()
+	gr.last, gr.ris = gcOther, 0
+	gr.pict, gr.zwj = false, false
+	gr.consonant, gr.linker = false, false
+	return grapheme
+}
+
+// push adds r, of class c, to the grapheme cluster in Buf
+func (gr *graphemeReader) push(r rune, c graphemeClass) {
+	_, _ = gr.Buf.WriteRune(r) // #nosec
+
+	gr.last = c
+
+	if c == gcRI {
+		gr.ris++
+	} else {
+		gr.ris = 0
+	}
+
+	switch {
+	case extendedPictographic(r):
+		gr.pict, gr.zwj = true, false
+	case gr.pict && c == gcExtend && !gr.zwj:
+	case gr.pict && c == gcZWJ && !gr.zwj:
+		gr.zwj = true
+	default:
+		gr.pict, gr.zwj = false, false
+	}
+
+	switch {
+	case inCBConsonant(r):
+		gr.consonant, gr.linker = true, false
+	case gr.consonant && inCBLinker(r):
+		gr.linker = true
+	case gr.consonant && (c == gcExtend || c == gcZWJ):
+	default:
+		gr.consonant, gr.linker = false, false
+	}
+}
+
+// joins reports whether r, of class c, continues the grapheme cluster in Buf
+func (gr *graphemeReader) joins(r rune, c graphemeClass) bool {
+	last := gr.last
+
+	switch {
+	// Do not break between a CR and LF. Otherwise, break before and after
+	// controls.
+	case last == gcCR && c == gcLF:
+		// GB3	CR	×	LF
+		return true
+	case last == gcControl || last == gcCR || last == gcLF:
+		// GB4	(Control | CR | LF)	÷
+		return false
+	case c == gcControl || c == gcCR || c == gcLF:
+		// GB5	÷	(Control | CR | LF)
+		return false
+
+	// Do not break Hangul syllable or other conjoining sequences.
+	case last == gcL && (c == gcL || c == gcV || c == gcLV || c == gcLVT):
+		// GB6	L	×	(L | V | LV | LVT)
+		return true
+	case (last == gcLV || last == gcV) && (c == gcV || c == gcT):
+		// GB7	(LV | V)	×	(V | T)
+		return true
+	case (last == gcLVT || last == gcT) && c == gcT:
+		// GB8	(LVT | T)	×	T
+		return true
+
+	// Do not break before extending characters or ZWJ.
+	case c == gcExtend || c == gcZWJ:
+		// GB9	×	(Extend | ZWJ)
+		return true
+
+	// Do not break before SpacingMarks, or after Prepend characters.
+	case c == gcSpacingMark:
+		// GB9a	×	SpacingMark
+		return true
+	case last == gcPrepend:
+		// GB9b	Prepend	×
+		return true
+
+	// Do not break within certain combinations with Indic_Conjunct_Break
+	// (InCB)=Linker.
+	case gr.linker && inCBConsonant(r):
+		// GB9c	\p{InCB=Consonant} [\p{InCB=Extend}\p{InCB=Linker}]* \p{InCB=Linker} [\p{InCB=Extend}\p{InCB=Linker}]*	×	\p{InCB=Consonant}
+		return true
+
+	// Do not break within emoji modifier sequences or emoji zwj sequences.
+	case gr.zwj && extendedPictographic(r):
+		// GB11	\p{Extended_Pictographic} Extend* ZWJ	×	\p{Extended_Pictographic}
+		return true
+
+	// Do not break within emoji flag sequences. That is, do not break between
+	// regional indicator (RI) symbols if there is an odd number of RI
+	// characters before the break point.
+	case gr.ris%2 == 1 && c == gcRI:
+		// GB12	sot (RI RI)* RI	×	RI
+		// GB13	[^RI] (RI RI)* RI	×	RI
+		return true
+
+	// Otherwise, break everywhere.
+	default:
+		// GB999	Any	÷	Any
+		return false
+	}
+}
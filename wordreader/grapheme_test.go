@@ -0,0 +1,78 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadGrapheme(t *testing.T) {
+	for _, test := range []struct {
+		str       string
+		graphemes []string
+	}{
+		{"", nil},
+		{"abc", []string{"a", "b", "c"}},
+
+		// http://unicode.org/reports/tr29/#GB3
+		// http://unicode.org/reports/tr29/#GB4
+		// http://unicode.org/reports/tr29/#GB5
+		{"a\r\nb", []string{"a", "\r\n", "b"}},
+		{"\n\r", []string{"\n", "\r"}},
+		{"á\t́", []string{"á", "\t", "́"}},
+
+		// http://unicode.org/reports/tr29/#GB6
+		// http://unicode.org/reports/tr29/#GB7
+		// http://unicode.org/reports/tr29/#GB8
+		{"각", []string{"각"}},
+		{"한국어", []string{"한", "국", "어"}},
+		{"각ᄀ", []string{"각", "ᄀ"}},
+
+		// http://unicode.org/reports/tr29/#GB9
+		// http://unicode.org/reports/tr29/#GB9a
+		// http://unicode.org/reports/tr29/#GB9b
+		{"é̂x", []string{"é̂", "x"}},
+		{"कि", []string{"कि"}},
+		{"؀١", []string{"؀١"}},
+
+		// http://unicode.org/reports/tr29/#GB9c
+		{"क्ष", []string{"क्ष"}},
+		{"किष", []string{"कि", "ष"}},
+
+		// http://unicode.org/reports/tr29/#GB11
+		{"👨‍👩‍👧", []string{"👨‍👩‍👧"}},
+		{"👍🏽👍", []string{"👍🏽", "👍"}},
+		{"a‍👍", []string{"a‍", "👍"}},
+
+		// http://unicode.org/reports/tr29/#GB12
+		// http://unicode.org/reports/tr29/#GB13
+		{"🇺🇸🇫🇷🇩", []string{"🇺🇸", "🇫🇷", "🇩"}},
+	} {
+		gr := NewGraphemeReader(strings.NewReader(test.str))
+
+		var graphemes []string
+		for {
+			grapheme, err := gr.ReadGrapheme()
+			if err == io.EOF {
+				if grapheme != "" {
+					t.Errorf("%q: grapheme %q with io.EOF", test.str, grapheme)
+				}
+				break
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			graphemes = append(graphemes, grapheme)
+		}
+
+		if !reflect.DeepEqual(graphemes, test.graphemes) {
+			t.Errorf("%q: %q != %q", test.str, graphemes, test.graphemes)
+		}
+	}
+}
@@ -0,0 +1,675 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode"
+
+var (
+	tableGraphemeControl = &unicode.RangeTable{
+		LatinOffset: 5,
+		R16: []unicode.Range16{
+			{Lo: 0x0000, Hi: 0x0009, Stride: 1},
+			{Lo: 0x000B, Hi: 0x000C, Stride: 1},
+			{Lo: 0x000E, Hi: 0x001F, Stride: 1},
+			{Lo: 0x007F, Hi: 0x009F, Stride: 1},
+			{Lo: 0x00AD, Hi: 0x00AD, Stride: 1},
+			{Lo: 0x061C, Hi: 0x061C, Stride: 1},
+			{Lo: 0x180E, Hi: 0x180E, Stride: 1},
+			{Lo: 0x200B, Hi: 0x200B, Stride: 1},
+			{Lo: 0x200E, Hi: 0x200F, Stride: 1},
+			{Lo: 0x2028, Hi: 0x202E, Stride: 1},
+			{Lo: 0x2060, Hi: 0x2064, Stride: 1},
+			{Lo: 0x2066, Hi: 0x206F, Stride: 1},
+			{Lo: 0xFEFF, Hi: 0xFEFF, Stride: 1},
+			{Lo: 0xFFF9, Hi: 0xFFFB, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x13430, Hi: 0x1343F, Stride: 1},
+			{Lo: 0x1BCA0, Hi: 0x1BCA3, Stride: 1},
+			{Lo: 0x1D173, Hi: 0x1D17A, Stride: 1},
+			{Lo: 0xE0001, Hi: 0xE0001, Stride: 1},
+		},
+	}
+
+	tableGraphemeExtend = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0300, Hi: 0x036F, Stride: 1},
+			{Lo: 0x0483, Hi: 0x0489, Stride: 1},
+			{Lo: 0x0591, Hi: 0x05BD, Stride: 1},
+			{Lo: 0x05BF, Hi: 0x05BF, Stride: 1},
+			{Lo: 0x05C1, Hi: 0x05C2, Stride: 1},
+			{Lo: 0x05C4, Hi: 0x05C5, Stride: 1},
+			{Lo: 0x05C7, Hi: 0x05C7, Stride: 1},
+			{Lo: 0x0610, Hi: 0x061A, Stride: 1},
+			{Lo: 0x064B, Hi: 0x065F, Stride: 1},
+			{Lo: 0x0670, Hi: 0x0670, Stride: 1},
+			{Lo: 0x06D6, Hi: 0x06DC, Stride: 1},
+			{Lo: 0x06DF, Hi: 0x06E4, Stride: 1},
+			{Lo: 0x06E7, Hi: 0x06E8, Stride: 1},
+			{Lo: 0x06EA, Hi: 0x06ED, Stride: 1},
+			{Lo: 0x0711, Hi: 0x0711, Stride: 1},
+			{Lo: 0x0730, Hi: 0x074A, Stride: 1},
+			{Lo: 0x07A6, Hi: 0x07B0, Stride: 1},
+			{Lo: 0x07EB, Hi: 0x07F3, Stride: 1},
+			{Lo: 0x07FD, Hi: 0x07FD, Stride: 1},
+			{Lo: 0x0816, Hi: 0x0819, Stride: 1},
+			{Lo: 0x081B, Hi: 0x0823, Stride: 1},
+			{Lo: 0x0825, Hi: 0x0827, Stride: 1},
+			{Lo: 0x0829, Hi: 0x082D, Stride: 1},
+			{Lo: 0x0859, Hi: 0x085B, Stride: 1},
+			{Lo: 0x0897, Hi: 0x089F, Stride: 1},
+			{Lo: 0x08CA, Hi: 0x08E1, Stride: 1},
+			{Lo: 0x08E3, Hi: 0x0902, Stride: 1},
+			{Lo: 0x093A, Hi: 0x093A, Stride: 1},
+			{Lo: 0x093C, Hi: 0x093C, Stride: 1},
+			{Lo: 0x0941, Hi: 0x0948, Stride: 1},
+			{Lo: 0x094D, Hi: 0x094D, Stride: 1},
+			{Lo: 0x0951, Hi: 0x0957, Stride: 1},
+			{Lo: 0x0962, Hi: 0x0963, Stride: 1},
+			{Lo: 0x0981, Hi: 0x0981, Stride: 1},
+			{Lo: 0x09BC, Hi: 0x09BC, Stride: 1},
+			{Lo: 0x09BE, Hi: 0x09BE, Stride: 1},
+			{Lo: 0x09C1, Hi: 0x09C4, Stride: 1},
+			{Lo: 0x09CD, Hi: 0x09CD, Stride: 1},
+			{Lo: 0x09D7, Hi: 0x09D7, Stride: 1},
+			{Lo: 0x09E2, Hi: 0x09E3, Stride: 1},
+			{Lo: 0x09FE, Hi: 0x09FE, Stride: 1},
+			{Lo: 0x0A01, Hi: 0x0A02, Stride: 1},
+			{Lo: 0x0A3C, Hi: 0x0A3C, Stride: 1},
+			{Lo: 0x0A41, Hi: 0x0A42, Stride: 1},
+			{Lo: 0x0A47, Hi: 0x0A48, Stride: 1},
+			{Lo: 0x0A4B, Hi: 0x0A4D, Stride: 1},
+			{Lo: 0x0A51, Hi: 0x0A51, Stride: 1},
+			{Lo: 0x0A70, Hi: 0x0A71, Stride: 1},
+			{Lo: 0x0A75, Hi: 0x0A75, Stride: 1},
+			{Lo: 0x0A81, Hi: 0x0A82, Stride: 1},
+			{Lo: 0x0ABC, Hi: 0x0ABC, Stride: 1},
+			{Lo: 0x0AC1, Hi: 0x0AC5, Stride: 1},
+			{Lo: 0x0AC7, Hi: 0x0AC8, Stride: 1},
+			{Lo: 0x0ACD, Hi: 0x0ACD, Stride: 1},
+			{Lo: 0x0AE2, Hi: 0x0AE3, Stride: 1},
+			{Lo: 0x0AFA, Hi: 0x0AFF, Stride: 1},
+			{Lo: 0x0B01, Hi: 0x0B01, Stride: 1},
+			{Lo: 0x0B3C, Hi: 0x0B3C, Stride: 1},
+			{Lo: 0x0B3E, Hi: 0x0B3F, Stride: 1},
+			{Lo: 0x0B41, Hi: 0x0B44, Stride: 1},
+			{Lo: 0x0B4D, Hi: 0x0B4D, Stride: 1},
+			{Lo: 0x0B55, Hi: 0x0B57, Stride: 1},
+			{Lo: 0x0B62, Hi: 0x0B63, Stride: 1},
+			{Lo: 0x0B82, Hi: 0x0B82, Stride: 1},
+			{Lo: 0x0BBE, Hi: 0x0BBE, Stride: 1},
+			{Lo: 0x0BC0, Hi: 0x0BC0, Stride: 1},
+			{Lo: 0x0BCD, Hi: 0x0BCD, Stride: 1},
+			{Lo: 0x0BD7, Hi: 0x0BD7, Stride: 1},
+			{Lo: 0x0C00, Hi: 0x0C00, Stride: 1},
+			{Lo: 0x0C04, Hi: 0x0C04, Stride: 1},
+			{Lo: 0x0C3C, Hi: 0x0C3C, Stride: 1},
+			{Lo: 0x0C3E, Hi: 0x0C40, Stride: 1},
+			{Lo: 0x0C46, Hi: 0x0C48, Stride: 1},
+			{Lo: 0x0C4A, Hi: 0x0C4D, Stride: 1},
+			{Lo: 0x0C55, Hi: 0x0C56, Stride: 1},
+			{Lo: 0x0C62, Hi: 0x0C63, Stride: 1},
+			{Lo: 0x0C81, Hi: 0x0C81, Stride: 1},
+			{Lo: 0x0CBC, Hi: 0x0CBC, Stride: 1},
+			{Lo: 0x0CBF, Hi: 0x0CC0, Stride: 1},
+			{Lo: 0x0CC2, Hi: 0x0CC2, Stride: 1},
+			{Lo: 0x0CC6, Hi: 0x0CC8, Stride: 1},
+			{Lo: 0x0CCA, Hi: 0x0CCD, Stride: 1},
+			{Lo: 0x0CD5, Hi: 0x0CD6, Stride: 1},
+			{Lo: 0x0CE2, Hi: 0x0CE3, Stride: 1},
+			{Lo: 0x0D00, Hi: 0x0D01, Stride: 1},
+			{Lo: 0x0D3B, Hi: 0x0D3C, Stride: 1},
+			{Lo: 0x0D3E, Hi: 0x0D3E, Stride: 1},
+			{Lo: 0x0D41, Hi: 0x0D44, Stride: 1},
+			{Lo: 0x0D4D, Hi: 0x0D4D, Stride: 1},
+			{Lo: 0x0D57, Hi: 0x0D57, Stride: 1},
+			{Lo: 0x0D62, Hi: 0x0D63, Stride: 1},
+			{Lo: 0x0D81, Hi: 0x0D81, Stride: 1},
+			{Lo: 0x0DCA, Hi: 0x0DCA, Stride: 1},
+			{Lo: 0x0DCF, Hi: 0x0DCF, Stride: 1},
+			{Lo: 0x0DD2, Hi: 0x0DD4, Stride: 1},
+			{Lo: 0x0DD6, Hi: 0x0DD6, Stride: 1},
+			{Lo: 0x0DDF, Hi: 0x0DDF, Stride: 1},
+			{Lo: 0x0E31, Hi: 0x0E31, Stride: 1},
+			{Lo: 0x0E34, Hi: 0x0E3A, Stride: 1},
+			{Lo: 0x0E47, Hi: 0x0E4E, Stride: 1},
+			{Lo: 0x0EB1, Hi: 0x0EB1, Stride: 1},
+			{Lo: 0x0EB4, Hi: 0x0EBC, Stride: 1},
+			{Lo: 0x0EC8, Hi: 0x0ECE, Stride: 1},
+			{Lo: 0x0F18, Hi: 0x0F19, Stride: 1},
+			{Lo: 0x0F35, Hi: 0x0F35, Stride: 1},
+			{Lo: 0x0F37, Hi: 0x0F37, Stride: 1},
+			{Lo: 0x0F39, Hi: 0x0F39, Stride: 1},
+			{Lo: 0x0F71, Hi: 0x0F7E, Stride: 1},
+			{Lo: 0x0F80, Hi: 0x0F84, Stride: 1},
+			{Lo: 0x0F86, Hi: 0x0F87, Stride: 1},
+			{Lo: 0x0F8D, Hi: 0x0F97, Stride: 1},
+			{Lo: 0x0F99, Hi: 0x0FBC, Stride: 1},
+			{Lo: 0x0FC6, Hi: 0x0FC6, Stride: 1},
+			{Lo: 0x102D, Hi: 0x1030, Stride: 1},
+			{Lo: 0x1032, Hi: 0x1037, Stride: 1},
+			{Lo: 0x1039, Hi: 0x103A, Stride: 1},
+			{Lo: 0x103D, Hi: 0x103E, Stride: 1},
+			{Lo: 0x1058, Hi: 0x1059, Stride: 1},
+			{Lo: 0x105E, Hi: 0x1060, Stride: 1},
+			{Lo: 0x1071, Hi: 0x1074, Stride: 1},
+			{Lo: 0x1082, Hi: 0x1082, Stride: 1},
+			{Lo: 0x1085, Hi: 0x1086, Stride: 1},
+			{Lo: 0x108D, Hi: 0x108D, Stride: 1},
+			{Lo: 0x109D, Hi: 0x109D, Stride: 1},
+			{Lo: 0x135D, Hi: 0x135F, Stride: 1},
+			{Lo: 0x1712, Hi: 0x1715, Stride: 1},
+			{Lo: 0x1732, Hi: 0x1734, Stride: 1},
+			{Lo: 0x1752, Hi: 0x1753, Stride: 1},
+			{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+			{Lo: 0x17B4, Hi: 0x17B5, Stride: 1},
+			{Lo: 0x17B7, Hi: 0x17BD, Stride: 1},
+			{Lo: 0x17C6, Hi: 0x17C6, Stride: 1},
+			{Lo: 0x17C9, Hi: 0x17D3, Stride: 1},
+			{Lo: 0x17DD, Hi: 0x17DD, Stride: 1},
+			{Lo: 0x180B, Hi: 0x180D, Stride: 1},
+			{Lo: 0x180F, Hi: 0x180F, Stride: 1},
+			{Lo: 0x1885, Hi: 0x1886, Stride: 1},
+			{Lo: 0x18A9, Hi: 0x18A9, Stride: 1},
+			{Lo: 0x1920, Hi: 0x1922, Stride: 1},
+			{Lo: 0x1927, Hi: 0x1928, Stride: 1},
+			{Lo: 0x1932, Hi: 0x1932, Stride: 1},
+			{Lo: 0x1939, Hi: 0x193B, Stride: 1},
+			{Lo: 0x1A17, Hi: 0x1A18, Stride: 1},
+			{Lo: 0x1A1B, Hi: 0x1A1B, Stride: 1},
+			{Lo: 0x1A56, Hi: 0x1A56, Stride: 1},
+			{Lo: 0x1A58, Hi: 0x1A5E, Stride: 1},
+			{Lo: 0x1A60, Hi: 0x1A60, Stride: 1},
+			{Lo: 0x1A62, Hi: 0x1A62, Stride: 1},
+			{Lo: 0x1A65, Hi: 0x1A6C, Stride: 1},
+			{Lo: 0x1A73, Hi: 0x1A7C, Stride: 1},
+			{Lo: 0x1A7F, Hi: 0x1A7F, Stride: 1},
+			{Lo: 0x1AB0, Hi: 0x1ADD, Stride: 1},
+			{Lo: 0x1AE0, Hi: 0x1AEB, Stride: 1},
+			{Lo: 0x1B00, Hi: 0x1B03, Stride: 1},
+			{Lo: 0x1B34, Hi: 0x1B3D, Stride: 1},
+			{Lo: 0x1B42, Hi: 0x1B44, Stride: 1},
+			{Lo: 0x1B6B, Hi: 0x1B73, Stride: 1},
+			{Lo: 0x1B80, Hi: 0x1B81, Stride: 1},
+			{Lo: 0x1BA2, Hi: 0x1BA5, Stride: 1},
+			{Lo: 0x1BA8, Hi: 0x1BAD, Stride: 1},
+			{Lo: 0x1BE6, Hi: 0x1BE6, Stride: 1},
+			{Lo: 0x1BE8, Hi: 0x1BE9, Stride: 1},
+			{Lo: 0x1BED, Hi: 0x1BED, Stride: 1},
+			{Lo: 0x1BEF, Hi: 0x1BF3, Stride: 1},
+			{Lo: 0x1C2C, Hi: 0x1C33, Stride: 1},
+			{Lo: 0x1C36, Hi: 0x1C37, Stride: 1},
+			{Lo: 0x1CD0, Hi: 0x1CD2, Stride: 1},
+			{Lo: 0x1CD4, Hi: 0x1CE0, Stride: 1},
+			{Lo: 0x1CE2, Hi: 0x1CE8, Stride: 1},
+			{Lo: 0x1CED, Hi: 0x1CED, Stride: 1},
+			{Lo: 0x1CF4, Hi: 0x1CF4, Stride: 1},
+			{Lo: 0x1CF8, Hi: 0x1CF9, Stride: 1},
+			{Lo: 0x1DC0, Hi: 0x1DFF, Stride: 1},
+			{Lo: 0x200C, Hi: 0x200C, Stride: 1},
+			{Lo: 0x20D0, Hi: 0x20F0, Stride: 1},
+			{Lo: 0x2CEF, Hi: 0x2CF1, Stride: 1},
+			{Lo: 0x2D7F, Hi: 0x2D7F, Stride: 1},
+			{Lo: 0x2DE0, Hi: 0x2DFF, Stride: 1},
+			{Lo: 0x302A, Hi: 0x302F, Stride: 1},
+			{Lo: 0x3099, Hi: 0x309A, Stride: 1},
+			{Lo: 0xA66F, Hi: 0xA672, Stride: 1},
+			{Lo: 0xA674, Hi: 0xA67D, Stride: 1},
+			{Lo: 0xA69E, Hi: 0xA69F, Stride: 1},
+			{Lo: 0xA6F0, Hi: 0xA6F1, Stride: 1},
+			{Lo: 0xA802, Hi: 0xA802, Stride: 1},
+			{Lo: 0xA806, Hi: 0xA806, Stride: 1},
+			{Lo: 0xA80B, Hi: 0xA80B, Stride: 1},
+			{Lo: 0xA825, Hi: 0xA826, Stride: 1},
+			{Lo: 0xA82C, Hi: 0xA82C, Stride: 1},
+			{Lo: 0xA8C4, Hi: 0xA8C5, Stride: 1},
+			{Lo: 0xA8E0, Hi: 0xA8F1, Stride: 1},
+			{Lo: 0xA8FF, Hi: 0xA8FF, Stride: 1},
+			{Lo: 0xA926, Hi: 0xA92D, Stride: 1},
+			{Lo: 0xA947, Hi: 0xA951, Stride: 1},
+			{Lo: 0xA953, Hi: 0xA953, Stride: 1},
+			{Lo: 0xA980, Hi: 0xA982, Stride: 1},
+			{Lo: 0xA9B3, Hi: 0xA9B3, Stride: 1},
+			{Lo: 0xA9B6, Hi: 0xA9B9, Stride: 1},
+			{Lo: 0xA9BC, Hi: 0xA9BD, Stride: 1},
+			{Lo: 0xA9C0, Hi: 0xA9C0, Stride: 1},
+			{Lo: 0xA9E5, Hi: 0xA9E5, Stride: 1},
+			{Lo: 0xAA29, Hi: 0xAA2E, Stride: 1},
+			{Lo: 0xAA31, Hi: 0xAA32, Stride: 1},
+			{Lo: 0xAA35, Hi: 0xAA36, Stride: 1},
+			{Lo: 0xAA43, Hi: 0xAA43, Stride: 1},
+			{Lo: 0xAA4C, Hi: 0xAA4C, Stride: 1},
+			{Lo: 0xAA7C, Hi: 0xAA7C, Stride: 1},
+			{Lo: 0xAAB0, Hi: 0xAAB0, Stride: 1},
+			{Lo: 0xAAB2, Hi: 0xAAB4, Stride: 1},
+			{Lo: 0xAAB7, Hi: 0xAAB8, Stride: 1},
+			{Lo: 0xAABE, Hi: 0xAABF, Stride: 1},
+			{Lo: 0xAAC1, Hi: 0xAAC1, Stride: 1},
+			{Lo: 0xAAEC, Hi: 0xAAED, Stride: 1},
+			{Lo: 0xAAF6, Hi: 0xAAF6, Stride: 1},
+			{Lo: 0xABE5, Hi: 0xABE5, Stride: 1},
+			{Lo: 0xABE8, Hi: 0xABE8, Stride: 1},
+			{Lo: 0xABED, Hi: 0xABED, Stride: 1},
+			{Lo: 0xFB1E, Hi: 0xFB1E, Stride: 1},
+			{Lo: 0xFE00, Hi: 0xFE0F, Stride: 1},
+			{Lo: 0xFE20, Hi: 0xFE2F, Stride: 1},
+			{Lo: 0xFF9E, Hi: 0xFF9F, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x101FD, Hi: 0x101FD, Stride: 1},
+			{Lo: 0x102E0, Hi: 0x102E0, Stride: 1},
+			{Lo: 0x10376, Hi: 0x1037A, Stride: 1},
+			{Lo: 0x10A01, Hi: 0x10A03, Stride: 1},
+			{Lo: 0x10A05, Hi: 0x10A06, Stride: 1},
+			{Lo: 0x10A0C, Hi: 0x10A0F, Stride: 1},
+			{Lo: 0x10A38, Hi: 0x10A3A, Stride: 1},
+			{Lo: 0x10A3F, Hi: 0x10A3F, Stride: 1},
+			{Lo: 0x10AE5, Hi: 0x10AE6, Stride: 1},
+			{Lo: 0x10D24, Hi: 0x10D27, Stride: 1},
+			{Lo: 0x10D69, Hi: 0x10D6D, Stride: 1},
+			{Lo: 0x10EAB, Hi: 0x10EAC, Stride: 1},
+			{Lo: 0x10EFA, Hi: 0x10EFF, Stride: 1},
+			{Lo: 0x10F46, Hi: 0x10F50, Stride: 1},
+			{Lo: 0x10F82, Hi: 0x10F85, Stride: 1},
+			{Lo: 0x11001, Hi: 0x11001, Stride: 1},
+			{Lo: 0x11038, Hi: 0x11046, Stride: 1},
+			{Lo: 0x11070, Hi: 0x11070, Stride: 1},
+			{Lo: 0x11073, Hi: 0x11074, Stride: 1},
+			{Lo: 0x1107F, Hi: 0x11081, Stride: 1},
+			{Lo: 0x110B3, Hi: 0x110B6, Stride: 1},
+			{Lo: 0x110B9, Hi: 0x110BA, Stride: 1},
+			{Lo: 0x110C2, Hi: 0x110C2, Stride: 1},
+			{Lo: 0x11100, Hi: 0x11102, Stride: 1},
+			{Lo: 0x11127, Hi: 0x1112B, Stride: 1},
+			{Lo: 0x1112D, Hi: 0x11134, Stride: 1},
+			{Lo: 0x11173, Hi: 0x11173, Stride: 1},
+			{Lo: 0x11180, Hi: 0x11181, Stride: 1},
+			{Lo: 0x111B6, Hi: 0x111BE, Stride: 1},
+			{Lo: 0x111C0, Hi: 0x111C0, Stride: 1},
+			{Lo: 0x111C9, Hi: 0x111CC, Stride: 1},
+			{Lo: 0x111CF, Hi: 0x111CF, Stride: 1},
+			{Lo: 0x1122F, Hi: 0x11231, Stride: 1},
+			{Lo: 0x11234, Hi: 0x11237, Stride: 1},
+			{Lo: 0x1123E, Hi: 0x1123E, Stride: 1},
+			{Lo: 0x11241, Hi: 0x11241, Stride: 1},
+			{Lo: 0x112DF, Hi: 0x112DF, Stride: 1},
+			{Lo: 0x112E3, Hi: 0x112EA, Stride: 1},
+			{Lo: 0x11300, Hi: 0x11301, Stride: 1},
+			{Lo: 0x1133B, Hi: 0x1133C, Stride: 1},
+			{Lo: 0x1133E, Hi: 0x1133E, Stride: 1},
+			{Lo: 0x11340, Hi: 0x11340, Stride: 1},
+			{Lo: 0x1134D, Hi: 0x1134D, Stride: 1},
+			{Lo: 0x11357, Hi: 0x11357, Stride: 1},
+			{Lo: 0x11366, Hi: 0x1136C, Stride: 1},
+			{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+			{Lo: 0x113B8, Hi: 0x113B8, Stride: 1},
+			{Lo: 0x113BB, Hi: 0x113C0, Stride: 1},
+			{Lo: 0x113C2, Hi: 0x113C2, Stride: 1},
+			{Lo: 0x113C5, Hi: 0x113C5, Stride: 1},
+			{Lo: 0x113C7, Hi: 0x113C9, Stride: 1},
+			{Lo: 0x113CE, Hi: 0x113D0, Stride: 1},
+			{Lo: 0x113D2, Hi: 0x113D2, Stride: 1},
+			{Lo: 0x113E1, Hi: 0x113E2, Stride: 1},
+			{Lo: 0x11438, Hi: 0x1143F, Stride: 1},
+			{Lo: 0x11442, Hi: 0x11444, Stride: 1},
+			{Lo: 0x11446, Hi: 0x11446, Stride: 1},
+			{Lo: 0x1145E, Hi: 0x1145E, Stride: 1},
+			{Lo: 0x114B0, Hi: 0x114B0, Stride: 1},
+			{Lo: 0x114B3, Hi: 0x114B8, Stride: 1},
+			{Lo: 0x114BA, Hi: 0x114BA, Stride: 1},
+			{Lo: 0x114BD, Hi: 0x114BD, Stride: 1},
+			{Lo: 0x114BF, Hi: 0x114C0, Stride: 1},
+			{Lo: 0x114C2, Hi: 0x114C3, Stride: 1},
+			{Lo: 0x115AF, Hi: 0x115AF, Stride: 1},
+			{Lo: 0x115B2, Hi: 0x115B5, Stride: 1},
+			{Lo: 0x115BC, Hi: 0x115BD, Stride: 1},
+			{Lo: 0x115BF, Hi: 0x115C0, Stride: 1},
+			{Lo: 0x115DC, Hi: 0x115DD, Stride: 1},
+			{Lo: 0x11633, Hi: 0x1163A, Stride: 1},
+			{Lo: 0x1163D, Hi: 0x1163D, Stride: 1},
+			{Lo: 0x1163F, Hi: 0x11640, Stride: 1},
+			{Lo: 0x116AB, Hi: 0x116AB, Stride: 1},
+			{Lo: 0x116AD, Hi: 0x116AD, Stride: 1},
+			{Lo: 0x116B0, Hi: 0x116B7, Stride: 1},
+			{Lo: 0x1171D, Hi: 0x1171D, Stride: 1},
+			{Lo: 0x1171F, Hi: 0x1171F, Stride: 1},
+			{Lo: 0x11722, Hi: 0x11725, Stride: 1},
+			{Lo: 0x11727, Hi: 0x1172B, Stride: 1},
+			{Lo: 0x1182F, Hi: 0x11837, Stride: 1},
+			{Lo: 0x11839, Hi: 0x1183A, Stride: 1},
+			{Lo: 0x11930, Hi: 0x11930, Stride: 1},
+			{Lo: 0x1193B, Hi: 0x1193E, Stride: 1},
+			{Lo: 0x11943, Hi: 0x11943, Stride: 1},
+			{Lo: 0x119D4, Hi: 0x119D7, Stride: 1},
+			{Lo: 0x119DA, Hi: 0x119DB, Stride: 1},
+			{Lo: 0x119E0, Hi: 0x119E0, Stride: 1},
+			{Lo: 0x11A01, Hi: 0x11A0A, Stride: 1},
+			{Lo: 0x11A33, Hi: 0x11A38, Stride: 1},
+			{Lo: 0x11A3B, Hi: 0x11A3E, Stride: 1},
+			{Lo: 0x11A47, Hi: 0x11A47, Stride: 1},
+			{Lo: 0x11A51, Hi: 0x11A56, Stride: 1},
+			{Lo: 0x11A59, Hi: 0x11A5B, Stride: 1},
+			{Lo: 0x11A8A, Hi: 0x11A96, Stride: 1},
+			{Lo: 0x11A98, Hi: 0x11A99, Stride: 1},
+			{Lo: 0x11B60, Hi: 0x11B60, Stride: 1},
+			{Lo: 0x11B62, Hi: 0x11B64, Stride: 1},
+			{Lo: 0x11B66, Hi: 0x11B66, Stride: 1},
+			{Lo: 0x11C30, Hi: 0x11C36, Stride: 1},
+			{Lo: 0x11C38, Hi: 0x11C3D, Stride: 1},
+			{Lo: 0x11C3F, Hi: 0x11C3F, Stride: 1},
+			{Lo: 0x11C92, Hi: 0x11CA7, Stride: 1},
+			{Lo: 0x11CAA, Hi: 0x11CB0, Stride: 1},
+			{Lo: 0x11CB2, Hi: 0x11CB3, Stride: 1},
+			{Lo: 0x11CB5, Hi: 0x11CB6, Stride: 1},
+			{Lo: 0x11D31, Hi: 0x11D36, Stride: 1},
+			{Lo: 0x11D3A, Hi: 0x11D3A, Stride: 1},
+			{Lo: 0x11D3C, Hi: 0x11D3D, Stride: 1},
+			{Lo: 0x11D3F, Hi: 0x11D45, Stride: 1},
+			{Lo: 0x11D47, Hi: 0x11D47, Stride: 1},
+			{Lo: 0x11D90, Hi: 0x11D91, Stride: 1},
+			{Lo: 0x11D95, Hi: 0x11D95, Stride: 1},
+			{Lo: 0x11D97, Hi: 0x11D97, Stride: 1},
+			{Lo: 0x11EF3, Hi: 0x11EF4, Stride: 1},
+			{Lo: 0x11F00, Hi: 0x11F01, Stride: 1},
+			{Lo: 0x11F36, Hi: 0x11F3A, Stride: 1},
+			{Lo: 0x11F40, Hi: 0x11F42, Stride: 1},
+			{Lo: 0x11F5A, Hi: 0x11F5A, Stride: 1},
+			{Lo: 0x13440, Hi: 0x13440, Stride: 1},
+			{Lo: 0x13447, Hi: 0x13455, Stride: 1},
+			{Lo: 0x1611E, Hi: 0x16129, Stride: 1},
+			{Lo: 0x1612D, Hi: 0x1612F, Stride: 1},
+			{Lo: 0x16AF0, Hi: 0x16AF4, Stride: 1},
+			{Lo: 0x16B30, Hi: 0x16B36, Stride: 1},
+			{Lo: 0x16F4F, Hi: 0x16F4F, Stride: 1},
+			{Lo: 0x16F8F, Hi: 0x16F92, Stride: 1},
+			{Lo: 0x16FE4, Hi: 0x16FE4, Stride: 1},
+			{Lo: 0x16FF0, Hi: 0x16FF1, Stride: 1},
+			{Lo: 0x1BC9D, Hi: 0x1BC9E, Stride: 1},
+			{Lo: 0x1CF00, Hi: 0x1CF2D, Stride: 1},
+			{Lo: 0x1CF30, Hi: 0x1CF46, Stride: 1},
+			{Lo: 0x1D165, Hi: 0x1D169, Stride: 1},
+			{Lo: 0x1D16D, Hi: 0x1D172, Stride: 1},
+			{Lo: 0x1D17B, Hi: 0x1D182, Stride: 1},
+			{Lo: 0x1D185, Hi: 0x1D18B, Stride: 1},
+			{Lo: 0x1D1AA, Hi: 0x1D1AD, Stride: 1},
+			{Lo: 0x1D242, Hi: 0x1D244, Stride: 1},
+			{Lo: 0x1DA00, Hi: 0x1DA36, Stride: 1},
+			{Lo: 0x1DA3B, Hi: 0x1DA6C, Stride: 1},
+			{Lo: 0x1DA75, Hi: 0x1DA75, Stride: 1},
+			{Lo: 0x1DA84, Hi: 0x1DA84, Stride: 1},
+			{Lo: 0x1DA9B, Hi: 0x1DA9F, Stride: 1},
+			{Lo: 0x1DAA1, Hi: 0x1DAAF, Stride: 1},
+			{Lo: 0x1E000, Hi: 0x1E006, Stride: 1},
+			{Lo: 0x1E008, Hi: 0x1E018, Stride: 1},
+			{Lo: 0x1E01B, Hi: 0x1E021, Stride: 1},
+			{Lo: 0x1E023, Hi: 0x1E024, Stride: 1},
+			{Lo: 0x1E026, Hi: 0x1E02A, Stride: 1},
+			{Lo: 0x1E08F, Hi: 0x1E08F, Stride: 1},
+			{Lo: 0x1E130, Hi: 0x1E136, Stride: 1},
+			{Lo: 0x1E2AE, Hi: 0x1E2AE, Stride: 1},
+			{Lo: 0x1E2EC, Hi: 0x1E2EF, Stride: 1},
+			{Lo: 0x1E4EC, Hi: 0x1E4EF, Stride: 1},
+			{Lo: 0x1E5EE, Hi: 0x1E5EF, Stride: 1},
+			{Lo: 0x1E6E3, Hi: 0x1E6E3, Stride: 1},
+			{Lo: 0x1E6E6, Hi: 0x1E6E6, Stride: 1},
+			{Lo: 0x1E6EE, Hi: 0x1E6EF, Stride: 1},
+			{Lo: 0x1E6F5, Hi: 0x1E6F5, Stride: 1},
+			{Lo: 0x1E8D0, Hi: 0x1E8D6, Stride: 1},
+			{Lo: 0x1E944, Hi: 0x1E94A, Stride: 1},
+			{Lo: 0x1F3FB, Hi: 0x1F3FF, Stride: 1},
+			{Lo: 0xE0020, Hi: 0xE007F, Stride: 1},
+			{Lo: 0xE0100, Hi: 0xE01EF, Stride: 1},
+		},
+	}
+
+	tableGraphemeL = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1100, Hi: 0x115F, Stride: 1},
+			{Lo: 0xA960, Hi: 0xA97C, Stride: 1},
+		},
+	}
+
+	tableGraphemePrepend = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+			{Lo: 0x06DD, Hi: 0x06DD, Stride: 1},
+			{Lo: 0x070F, Hi: 0x070F, Stride: 1},
+			{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+			{Lo: 0x08E2, Hi: 0x08E2, Stride: 1},
+			{Lo: 0x0D4E, Hi: 0x0D4E, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x110BD, Hi: 0x110BD, Stride: 1},
+			{Lo: 0x110CD, Hi: 0x110CD, Stride: 1},
+			{Lo: 0x111C2, Hi: 0x111C3, Stride: 1},
+			{Lo: 0x1193F, Hi: 0x1193F, Stride: 1},
+			{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+			{Lo: 0x11A3A, Hi: 0x11A3A, Stride: 1},
+			{Lo: 0x11A84, Hi: 0x11A89, Stride: 1},
+			{Lo: 0x11D46, Hi: 0x11D46, Stride: 1},
+			{Lo: 0x11F02, Hi: 0x11F02, Stride: 1},
+		},
+	}
+
+	tableGraphemeSpacingMark = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0903, Hi: 0x0903, Stride: 1},
+			{Lo: 0x093B, Hi: 0x093B, Stride: 1},
+			{Lo: 0x093E, Hi: 0x0940, Stride: 1},
+			{Lo: 0x0949, Hi: 0x094C, Stride: 1},
+			{Lo: 0x094E, Hi: 0x094F, Stride: 1},
+			{Lo: 0x0982, Hi: 0x0983, Stride: 1},
+			{Lo: 0x09BF, Hi: 0x09C0, Stride: 1},
+			{Lo: 0x09C7, Hi: 0x09C8, Stride: 1},
+			{Lo: 0x09CB, Hi: 0x09CC, Stride: 1},
+			{Lo: 0x0A03, Hi: 0x0A03, Stride: 1},
+			{Lo: 0x0A3E, Hi: 0x0A40, Stride: 1},
+			{Lo: 0x0A83, Hi: 0x0A83, Stride: 1},
+			{Lo: 0x0ABE, Hi: 0x0AC0, Stride: 1},
+			{Lo: 0x0AC9, Hi: 0x0AC9, Stride: 1},
+			{Lo: 0x0ACB, Hi: 0x0ACC, Stride: 1},
+			{Lo: 0x0B02, Hi: 0x0B03, Stride: 1},
+			{Lo: 0x0B40, Hi: 0x0B40, Stride: 1},
+			{Lo: 0x0B47, Hi: 0x0B48, Stride: 1},
+			{Lo: 0x0B4B, Hi: 0x0B4C, Stride: 1},
+			{Lo: 0x0BBF, Hi: 0x0BBF, Stride: 1},
+			{Lo: 0x0BC1, Hi: 0x0BC2, Stride: 1},
+			{Lo: 0x0BC6, Hi: 0x0BC8, Stride: 1},
+			{Lo: 0x0BCA, Hi: 0x0BCC, Stride: 1},
+			{Lo: 0x0C01, Hi: 0x0C03, Stride: 1},
+			{Lo: 0x0C41, Hi: 0x0C44, Stride: 1},
+			{Lo: 0x0C82, Hi: 0x0C83, Stride: 1},
+			{Lo: 0x0CBE, Hi: 0x0CBE, Stride: 1},
+			{Lo: 0x0CC1, Hi: 0x0CC1, Stride: 1},
+			{Lo: 0x0CC3, Hi: 0x0CC4, Stride: 1},
+			{Lo: 0x0CF3, Hi: 0x0CF3, Stride: 1},
+			{Lo: 0x0D02, Hi: 0x0D03, Stride: 1},
+			{Lo: 0x0D3F, Hi: 0x0D40, Stride: 1},
+			{Lo: 0x0D46, Hi: 0x0D48, Stride: 1},
+			{Lo: 0x0D4A, Hi: 0x0D4C, Stride: 1},
+			{Lo: 0x0D82, Hi: 0x0D83, Stride: 1},
+			{Lo: 0x0DD0, Hi: 0x0DD1, Stride: 1},
+			{Lo: 0x0DD8, Hi: 0x0DDE, Stride: 1},
+			{Lo: 0x0DF2, Hi: 0x0DF3, Stride: 1},
+			{Lo: 0x0E33, Hi: 0x0E33, Stride: 1},
+			{Lo: 0x0EB3, Hi: 0x0EB3, Stride: 1},
+			{Lo: 0x0F3E, Hi: 0x0F3F, Stride: 1},
+			{Lo: 0x0F7F, Hi: 0x0F7F, Stride: 1},
+			{Lo: 0x1031, Hi: 0x1031, Stride: 1},
+			{Lo: 0x103B, Hi: 0x103C, Stride: 1},
+			{Lo: 0x1056, Hi: 0x1057, Stride: 1},
+			{Lo: 0x1084, Hi: 0x1084, Stride: 1},
+			{Lo: 0x17B6, Hi: 0x17B6, Stride: 1},
+			{Lo: 0x17BE, Hi: 0x17C5, Stride: 1},
+			{Lo: 0x17C7, Hi: 0x17C8, Stride: 1},
+			{Lo: 0x1923, Hi: 0x1926, Stride: 1},
+			{Lo: 0x1929, Hi: 0x192B, Stride: 1},
+			{Lo: 0x1930, Hi: 0x1931, Stride: 1},
+			{Lo: 0x1933, Hi: 0x1938, Stride: 1},
+			{Lo: 0x1A19, Hi: 0x1A1A, Stride: 1},
+			{Lo: 0x1A55, Hi: 0x1A55, Stride: 1},
+			{Lo: 0x1A57, Hi: 0x1A57, Stride: 1},
+			{Lo: 0x1A6D, Hi: 0x1A72, Stride: 1},
+			{Lo: 0x1B04, Hi: 0x1B04, Stride: 1},
+			{Lo: 0x1B3E, Hi: 0x1B41, Stride: 1},
+			{Lo: 0x1B82, Hi: 0x1B82, Stride: 1},
+			{Lo: 0x1BA1, Hi: 0x1BA1, Stride: 1},
+			{Lo: 0x1BA6, Hi: 0x1BA7, Stride: 1},
+			{Lo: 0x1BE7, Hi: 0x1BE7, Stride: 1},
+			{Lo: 0x1BEA, Hi: 0x1BEC, Stride: 1},
+			{Lo: 0x1BEE, Hi: 0x1BEE, Stride: 1},
+			{Lo: 0x1C24, Hi: 0x1C2B, Stride: 1},
+			{Lo: 0x1C34, Hi: 0x1C35, Stride: 1},
+			{Lo: 0x1CE1, Hi: 0x1CE1, Stride: 1},
+			{Lo: 0x1CF7, Hi: 0x1CF7, Stride: 1},
+			{Lo: 0xA823, Hi: 0xA824, Stride: 1},
+			{Lo: 0xA827, Hi: 0xA827, Stride: 1},
+			{Lo: 0xA880, Hi: 0xA881, Stride: 1},
+			{Lo: 0xA8B4, Hi: 0xA8C3, Stride: 1},
+			{Lo: 0xA952, Hi: 0xA952, Stride: 1},
+			{Lo: 0xA983, Hi: 0xA983, Stride: 1},
+			{Lo: 0xA9B4, Hi: 0xA9B5, Stride: 1},
+			{Lo: 0xA9BA, Hi: 0xA9BB, Stride: 1},
+			{Lo: 0xA9BE, Hi: 0xA9BF, Stride: 1},
+			{Lo: 0xAA2F, Hi: 0xAA30, Stride: 1},
+			{Lo: 0xAA33, Hi: 0xAA34, Stride: 1},
+			{Lo: 0xAA4D, Hi: 0xAA4D, Stride: 1},
+			{Lo: 0xAAEB, Hi: 0xAAEB, Stride: 1},
+			{Lo: 0xAAEE, Hi: 0xAAEF, Stride: 1},
+			{Lo: 0xAAF5, Hi: 0xAAF5, Stride: 1},
+			{Lo: 0xABE3, Hi: 0xABE4, Stride: 1},
+			{Lo: 0xABE6, Hi: 0xABE7, Stride: 1},
+			{Lo: 0xABE9, Hi: 0xABEA, Stride: 1},
+			{Lo: 0xABEC, Hi: 0xABEC, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x11000, Hi: 0x11000, Stride: 1},
+			{Lo: 0x11002, Hi: 0x11002, Stride: 1},
+			{Lo: 0x11082, Hi: 0x11082, Stride: 1},
+			{Lo: 0x110B0, Hi: 0x110B2, Stride: 1},
+			{Lo: 0x110B7, Hi: 0x110B8, Stride: 1},
+			{Lo: 0x1112C, Hi: 0x1112C, Stride: 1},
+			{Lo: 0x11145, Hi: 0x11146, Stride: 1},
+			{Lo: 0x11182, Hi: 0x11182, Stride: 1},
+			{Lo: 0x111B3, Hi: 0x111B5, Stride: 1},
+			{Lo: 0x111BF, Hi: 0x111BF, Stride: 1},
+			{Lo: 0x111CE, Hi: 0x111CE, Stride: 1},
+			{Lo: 0x1122C, Hi: 0x1122E, Stride: 1},
+			{Lo: 0x11232, Hi: 0x11233, Stride: 1},
+			{Lo: 0x112E0, Hi: 0x112E2, Stride: 1},
+			{Lo: 0x11302, Hi: 0x11303, Stride: 1},
+			{Lo: 0x1133F, Hi: 0x1133F, Stride: 1},
+			{Lo: 0x11341, Hi: 0x11344, Stride: 1},
+			{Lo: 0x11347, Hi: 0x11348, Stride: 1},
+			{Lo: 0x1134B, Hi: 0x1134C, Stride: 1},
+			{Lo: 0x11362, Hi: 0x11363, Stride: 1},
+			{Lo: 0x113B9, Hi: 0x113BA, Stride: 1},
+			{Lo: 0x113CA, Hi: 0x113CA, Stride: 1},
+			{Lo: 0x113CC, Hi: 0x113CD, Stride: 1},
+			{Lo: 0x11435, Hi: 0x11437, Stride: 1},
+			{Lo: 0x11440, Hi: 0x11441, Stride: 1},
+			{Lo: 0x11445, Hi: 0x11445, Stride: 1},
+			{Lo: 0x114B1, Hi: 0x114B2, Stride: 1},
+			{Lo: 0x114B9, Hi: 0x114B9, Stride: 1},
+			{Lo: 0x114BB, Hi: 0x114BC, Stride: 1},
+			{Lo: 0x114BE, Hi: 0x114BE, Stride: 1},
+			{Lo: 0x114C1, Hi: 0x114C1, Stride: 1},
+			{Lo: 0x115B0, Hi: 0x115B1, Stride: 1},
+			{Lo: 0x115B8, Hi: 0x115BB, Stride: 1},
+			{Lo: 0x115BE, Hi: 0x115BE, Stride: 1},
+			{Lo: 0x11630, Hi: 0x11632, Stride: 1},
+			{Lo: 0x1163B, Hi: 0x1163C, Stride: 1},
+			{Lo: 0x1163E, Hi: 0x1163E, Stride: 1},
+			{Lo: 0x116AC, Hi: 0x116AC, Stride: 1},
+			{Lo: 0x116AE, Hi: 0x116AF, Stride: 1},
+			{Lo: 0x1171E, Hi: 0x1171E, Stride: 1},
+			{Lo: 0x11726, Hi: 0x11726, Stride: 1},
+			{Lo: 0x1182C, Hi: 0x1182E, Stride: 1},
+			{Lo: 0x11838, Hi: 0x11838, Stride: 1},
+			{Lo: 0x11931, Hi: 0x11935, Stride: 1},
+			{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+			{Lo: 0x11940, Hi: 0x11940, Stride: 1},
+			{Lo: 0x11942, Hi: 0x11942, Stride: 1},
+			{Lo: 0x119D1, Hi: 0x119D3, Stride: 1},
+			{Lo: 0x119DC, Hi: 0x119DF, Stride: 1},
+			{Lo: 0x119E4, Hi: 0x119E4, Stride: 1},
+			{Lo: 0x11A39, Hi: 0x11A39, Stride: 1},
+			{Lo: 0x11A57, Hi: 0x11A58, Stride: 1},
+			{Lo: 0x11A97, Hi: 0x11A97, Stride: 1},
+			{Lo: 0x11B61, Hi: 0x11B61, Stride: 1},
+			{Lo: 0x11B65, Hi: 0x11B65, Stride: 1},
+			{Lo: 0x11B67, Hi: 0x11B67, Stride: 1},
+			{Lo: 0x11C2F, Hi: 0x11C2F, Stride: 1},
+			{Lo: 0x11C3E, Hi: 0x11C3E, Stride: 1},
+			{Lo: 0x11CA9, Hi: 0x11CA9, Stride: 1},
+			{Lo: 0x11CB1, Hi: 0x11CB1, Stride: 1},
+			{Lo: 0x11CB4, Hi: 0x11CB4, Stride: 1},
+			{Lo: 0x11D8A, Hi: 0x11D8E, Stride: 1},
+			{Lo: 0x11D93, Hi: 0x11D94, Stride: 1},
+			{Lo: 0x11D96, Hi: 0x11D96, Stride: 1},
+			{Lo: 0x11EF5, Hi: 0x11EF6, Stride: 1},
+			{Lo: 0x11F03, Hi: 0x11F03, Stride: 1},
+			{Lo: 0x11F34, Hi: 0x11F35, Stride: 1},
+			{Lo: 0x11F3E, Hi: 0x11F3F, Stride: 1},
+			{Lo: 0x1612A, Hi: 0x1612C, Stride: 1},
+			{Lo: 0x16F51, Hi: 0x16F87, Stride: 1},
+		},
+	}
+
+	tableGraphemeT = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x11A8, Hi: 0x11FF, Stride: 1},
+			{Lo: 0xD7CB, Hi: 0xD7FB, Stride: 1},
+		},
+	}
+
+	tableGraphemeV = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1160, Hi: 0x11A7, Stride: 1},
+			{Lo: 0xD7B0, Hi: 0xD7C6, Stride: 1},
+		},
+	}
+
+	tableInCBConsonant = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0915, Hi: 0x0939, Stride: 1},
+			{Lo: 0x0958, Hi: 0x095F, Stride: 1},
+			{Lo: 0x0978, Hi: 0x097F, Stride: 1},
+			{Lo: 0x0995, Hi: 0x09A8, Stride: 1},
+			{Lo: 0x09AA, Hi: 0x09B0, Stride: 1},
+			{Lo: 0x09B2, Hi: 0x09B2, Stride: 1},
+			{Lo: 0x09B6, Hi: 0x09B9, Stride: 1},
+			{Lo: 0x09DC, Hi: 0x09DD, Stride: 1},
+			{Lo: 0x09DF, Hi: 0x09DF, Stride: 1},
+			{Lo: 0x09F0, Hi: 0x09F1, Stride: 1},
+			{Lo: 0x0A95, Hi: 0x0AA8, Stride: 1},
+			{Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1},
+			{Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
+			{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1},
+			{Lo: 0x0AF9, Hi: 0x0AF9, Stride: 1},
+			{Lo: 0x0B15, Hi: 0x0B28, Stride: 1},
+			{Lo: 0x0B2A, Hi: 0x0B30, Stride: 1},
+			{Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
+			{Lo: 0x0B35, Hi: 0x0B39, Stride: 1},
+			{Lo: 0x0B5C, Hi: 0x0B5D, Stride: 1},
+			{Lo: 0x0B5F, Hi: 0x0B5F, Stride: 1},
+			{Lo: 0x0B71, Hi: 0x0B71, Stride: 1},
+			{Lo: 0x0C15, Hi: 0x0C28, Stride: 1},
+			{Lo: 0x0C2A, Hi: 0x0C39, Stride: 1},
+			{Lo: 0x0C58, Hi: 0x0C5A, Stride: 1},
+			{Lo: 0x0D15, Hi: 0x0D3A, Stride: 1},
+		},
+	}
+
+	tableInCBLinker = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x094D, Hi: 0x094D, Stride: 1},
+			{Lo: 0x09CD, Hi: 0x09CD, Stride: 1},
+			{Lo: 0x0ACD, Hi: 0x0ACD, Stride: 1},
+			{Lo: 0x0B4D, Hi: 0x0B4D, Stride: 1},
+			{Lo: 0x0C4D, Hi: 0x0C4D, Stride: 1},
+			{Lo: 0x0D4D, Hi: 0x0D4D, Stride: 1},
+		},
+	}
+)
@@ -0,0 +1,246 @@
+// Command gen downloads the word break properties and emoji data of a version
+// of the Unicode Character Database and writes the range tables of wordreader
+// made from them. It is run by go generate in the wordreader directory.
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// wordBreakTables are the tables made from WordBreakProperty.txt, by the
+// property they hold. Properties that aren't in a version are written as empty
+// tables.
+var wordBreakTables = map[string]string{
+	"ALetter":            "tableALetter",
+	"Extend":             "tableExtend",
+	"ExtendNumLet":       "tableExtendNumLet",
+	"Format":             "tableFormat",
+	"Hebrew_Letter":      "tableHebrewLetter",
+	"Katakana":           "tableKatakana",
+	"MidLetter":          "tableMidLetter",
+	"MidNum":             "tableMidNum",
+	"MidNumLet":          "tableMidNumLet",
+	"Newline":            "tableNewline",
+	"Numeric":            "tableNumeric",
+	"Regional_Indicator": "tableRegionalIndicator",
+}
+
+// emojiTables are the tables made from emoji-data.txt
+var emojiTables = map[string]string{
+	"Extended_Pictographic": "tableExtendedPictographic",
+}
+
+const header = `// Code generated by go run ./internal/gen. DO NOT EDIT.
+
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode"
+`
+
+func main() {
+	log.SetFlags(0)
+
+	var (
+		version      = flag.String("version", "", "version of the unicode character database to make the word break tables from")
+		emojiVersion = flag.String("emoji-version", "", "version of the unicode character database to make the emoji tables from")
+		baseURL      = flag.String("url", "https://www.unicode.org/Public", "url of the unicode character database")
+		dir          = flag.String("o", ".", "directory to write the tables to")
+	)
+	flag.Parse()
+
+	if *version == "" && *emojiVersion == "" {
+		log.Fatal("-version or -emoji-version is required")
+	}
+
+	if *version != "" {
+		if err := generate(*baseURL+"/"+*version+"/ucd/auxiliary/WordBreakProperty.txt",
+			filepath.Join(*dir, "tables.go"), "UnicodeVersion", *version, wordBreakTables,
+			"UnicodeVersion is the version of the Unicode Character Database that the word\n"+
+				"break property tables were made from. Where words break, and so what is\n"+
+				"counted, can differ between versions."); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *emojiVersion != "" {
+		if err := generate(emojiURL(*baseURL, *emojiVersion),
+			filepath.Join(*dir, "emojitables.go"), "EmojiVersion", *emojiVersion, emojiTables,
+			"EmojiVersion is the version of the Unicode emoji data that the\n"+
+				"Extended_Pictographic table was made from"); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// emojiURL returns the url of emoji-data.txt, which is only part of the
+// character database from version 13.0.0 on and before that was published on
+// its own, with a version of just the major and minor numbers
+func emojiURL(baseURL, version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if major, err := strconv.Atoi(parts[0]); err == nil && major < 13 && len(parts) > 1 {
+		return baseURL + "/emoji/" + parts[0] + "." + parts[1] + "/emoji-data.txt"
+	}
+	return baseURL + "/" + version + "/ucd/emoji/emoji-data.txt"
+}
+
+// generate writes the tables, and the version constant, made from the
+// properties of the data file at url to fn
+func generate(url, fn, constant, version string, tables map[string]string, doc string) error {
+	resp, err := http.Get(url) // #nosec
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }() // #nosec
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	props, err := parse(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s: %v", url, err)
+	}
+
+	src, err := write(props, tables, constant, version, doc)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(fn, src, 0644) // #nosec
+}
+
+// parse returns the ranges of each property in a file of the character
+// database, with lines such as "0041..005A    ; ALetter # ..."
+func parse(r io.Reader) (map[string][]unicode.Range32, error) {
+	props := map[string][]unicode.Range32{}
+
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: invalid: %q", n, s.Text())
+		}
+
+		bounds := strings.SplitN(strings.TrimSpace(fields[0]), "..", 2)
+		if len(bounds) == 1 {
+			bounds = append(bounds, bounds[0])
+		}
+
+		lo, err := strconv.ParseUint(bounds[0], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		hi, err := strconv.ParseUint(bounds[1], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		prop := strings.TrimSpace(fields[1])
+		props[prop] = append(props[prop], unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+	}
+
+	return props, s.Err()
+}
+
+// write returns the formatted source of the tables of props
+func write(props map[string][]unicode.Range32, tables map[string]string, constant, version, doc string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(header)
+
+	fmt.Fprintf(&buf, "\n// %s\nconst %s = %q\n\nvar (\n", strings.Replace(doc, "\n", "\n// ", -1), constant, version)
+
+	names := make([]string, 0, len(tables))
+	byName := map[string]string{}
+	for prop, name := range tables {
+		names = append(names, name)
+		byName[name] = prop
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		writeTable(&buf, name, props[byName[name]])
+	}
+
+	buf.WriteString(")\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// writeTable writes the declaration of a table named name holding ranges
+func writeTable(w io.Writer, name string, ranges []unicode.Range32) {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Lo < ranges[j].Lo })
+
+	var r16, r32 []unicode.Range32
+	latinOffset := 0
+	for _, r := range ranges {
+		switch {
+		case r.Hi <= unicode.MaxLatin1:
+			latinOffset++
+			r16 = append(r16, r)
+		case r.Hi <= 0xffff:
+			r16 = append(r16, r)
+		case r.Lo > 0xffff:
+			r32 = append(r32, r)
+		default:
+			r16 = append(r16, unicode.Range32{Lo: r.Lo, Hi: 0xffff, Stride: 1})
+			r32 = append(r32, unicode.Range32{Lo: 0x10000, Hi: r.Hi, Stride: 1})
+		}
+	}
+
+	fmt.Fprintf(w, "\t%s = &unicode.RangeTable{\n", name)
+
+	if latinOffset > 0 {
+		fmt.Fprintf(w, "\t\tLatinOffset: %d,\n", latinOffset)
+	}
+
+	if len(r16) > 0 {
+		fmt.Fprintf(w, "\t\tR16: []unicode.Range16{\n")
+		for _, r := range r16 {
+			fmt.Fprintf(w, "\t\t\t{Lo: 0x%04x, Hi: 0x%04x, Stride: 1},\n", r.Lo, r.Hi)
+		}
+		fmt.Fprintf(w, "\t\t},\n")
+	}
+
+	if len(r32) > 0 {
+		fmt.Fprintf(w, "\t\tR32: []unicode.Range32{\n")
+		for _, r := range r32 {
+			fmt.Fprintf(w, "\t\t\t{Lo: 0x%x, Hi: 0x%x, Stride: 1},\n", r.Lo, r.Hi)
+		}
+		fmt.Fprintf(w, "\t\t},\n")
+	}
+
+	fmt.Fprintf(w, "\t}\n")
+}
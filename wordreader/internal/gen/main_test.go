@@ -0,0 +1,114 @@
+package main
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const wordBreakProperty = `# WordBreakProperty-9.0.0.txt
+
+0041..005A    ; ALetter # L&  [26] LATIN CAPITAL LETTER A..LATIN CAPITAL LETTER Z
+00C0..00D6    ; ALetter # L&  [23] LATIN CAPITAL LETTER A WITH GRAVE..LATIN CAPITAL LETTER O WITH DIAERESIS
+0061..007A    ; ALetter # L&  [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z
+0100..017F    ; ALetter # L& [128] LATIN CAPITAL LETTER A WITH MACRON..LATIN SMALL LETTER LONG S
+1E900..1E943  ; ALetter # L&  [68] ADLAM CAPITAL LETTER ALIF..ADLAM SMALL LETTER SHA
+0027          ; Single_Quote # Po       APOSTROPHE
+`
+
+func TestEmojiURL(t *testing.T) {
+	for version, expect := range map[string]string{
+		"11.0.0": "https://example.com/emoji/11.0/emoji-data.txt",
+		"12.1.0": "https://example.com/emoji/12.1/emoji-data.txt",
+		"13.0.0": "https://example.com/13.0.0/ucd/emoji/emoji-data.txt",
+		"17.0.0": "https://example.com/17.0.0/ucd/emoji/emoji-data.txt",
+	} {
+		if got := emojiURL("https://example.com", version); got != expect {
+			t.Errorf("%s: %s != %s", version, got, expect)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	props, err := parse(strings.NewReader(wordBreakProperty))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(props["ALetter"]); n != 5 {
+		t.Errorf("%d ALetter ranges != 5", n)
+	}
+
+	if r := props["Single_Quote"]; len(r) != 1 || r[0].Lo != '\'' || r[0].Hi != '\'' {
+		t.Errorf("unexpected Single_Quote ranges %v", r)
+	}
+
+	if _, err = parse(strings.NewReader("0041 ALetter\n")); err == nil {
+		t.Error("expected error for line without property")
+	}
+
+	if _, err = parse(strings.NewReader("XYZ ; ALetter\n")); err == nil {
+		t.Error("expected error for invalid code point")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/9.0.0/ucd/auxiliary/WordBreakProperty.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(wordBreakProperty)) // #nosec
+	}))
+	defer srv.Close()
+
+	fn := filepath.Join(t.TempDir(), "tables.go")
+	if err := generate(srv.URL+"/9.0.0/ucd/auxiliary/WordBreakProperty.txt", fn, "UnicodeVersion", "9.0.0", wordBreakTables, "UnicodeVersion is the version."); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := ioutil.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expect := range []string{
+		"// Code generated by go run ./internal/gen. DO NOT EDIT.\n",
+		"// UnicodeVersion is the version.\nconst UnicodeVersion = \"9.0.0\"\n",
+
+		// sorted, with the ranges of latin-1 counted
+		"\ttableALetter = &unicode.RangeTable{\n" +
+			"\t\tLatinOffset: 3,\n" +
+			"\t\tR16: []unicode.Range16{\n" +
+			"\t\t\t{Lo: 0x0041, Hi: 0x005a, Stride: 1},\n" +
+			"\t\t\t{Lo: 0x0061, Hi: 0x007a, Stride: 1},\n" +
+			"\t\t\t{Lo: 0x00c0, Hi: 0x00d6, Stride: 1},\n" +
+			"\t\t\t{Lo: 0x0100, Hi: 0x017f, Stride: 1},\n" +
+			"\t\t},\n" +
+			"\t\tR32: []unicode.Range32{\n" +
+			"\t\t\t{Lo: 0x1e900, Hi: 0x1e943, Stride: 1},\n" +
+			"\t\t},\n" +
+			"\t}\n",
+
+		// properties that aren't in the data are empty
+		"\ttableKatakana = &unicode.RangeTable{}\n",
+	} {
+		if !strings.Contains(string(src), expect) {
+			t.Errorf("%s doesn't contain %q", src, expect)
+		}
+	}
+
+	if strings.Contains(string(src), "Single_Quote") {
+		t.Error("unexpected table of Single_Quote")
+	}
+
+	if err = generate(srv.URL+"/missing", fn, "UnicodeVersion", "9.0.0", wordBreakTables, ""); err == nil {
+		t.Error("expected error for missing data")
+	}
+}
@@ -0,0 +1,27 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"io"
+	"iter"
+)
+
+// Words returns an iterator of the words read from r, as ReadWord would. It
+// stops at the end of r, or after yielding the first error other than io.EOF.
+func Words(r io.Reader) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		wr := New(r)
+		for {
+			word, err := wr.ReadWord()
+			if err == io.EOF {
+				return
+			}
+
+			if !yield(word, err) || err != nil {
+				return
+			}
+		}
+	}
+}
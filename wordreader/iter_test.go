@@ -0,0 +1,50 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestWords(t *testing.T) {
+	for _, test := range tests {
+		var words []string
+		for word, err := range Words(strings.NewReader(test.str)) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			words = append(words, word)
+		}
+
+		if !reflect.DeepEqual(words, test.words) {
+			t.Errorf("%q: %q != %q", test.str, words, test.words)
+		}
+	}
+
+	// stopping early
+	for word := range Words(strings.NewReader("foo bar")) {
+		if word != "foo" {
+			t.Errorf("%q != foo", word)
+		}
+		break
+	}
+
+	errRead := errors.New("read")
+
+	var errs int
+	for _, err := range Words(iotest.ErrReader(errRead)) {
+		if !errors.Is(err, errRead) {
+			t.Errorf("%v != %v", err, errRead)
+		}
+		errs++
+	}
+
+	if errs != 1 {
+		t.Errorf("%d errors != 1", errs)
+	}
+}
@@ -0,0 +1,92 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode/utf8"
+
+// SegmentBytes returns the words of b, as ReadWord would, without copying
+// them. Each is a slice of b.
+func SegmentBytes(b []byte) [][]byte {
+	var words [][]byte
+
+	start := 0
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		next := i + size
+
+		peek := func() rune {
+			r, _ := firstRune(b[next:])
+			return r
+		}
+
+		if i > start && !joins(newContext(b[start:i], r, peek)) {
+			words = append(words, b[start:i])
+			start = i
+		}
+
+		i = next
+	}
+
+	if start < len(b) {
+		words = append(words, b[start:])
+	}
+
+	return words
+}
+
+// SegmentString returns the words of s, as ReadWord would
+func SegmentString(s string) []string {
+	words := SegmentBytes([]byte(s))
+
+	ret := make([]string, len(words))
+	for i, word := range words {
+		ret[i] = string(word)
+	}
+
+	return ret
+}
+
+// ScanWords is a bufio.SplitFunc that returns the words of its input, as
+// ReadWord would, for use with a bufio.Scanner
+func ScanWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for i := 0; i < len(data); {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			break
+		}
+
+		r, size := utf8.DecodeRune(data[i:])
+		next := i + size
+
+		// more data is requested when the rune after r is needed but hasn't
+		// been read yet
+		more := false
+		peek := func() rune {
+			r, ok := firstRune(data[next:])
+			if !ok && !atEOF {
+				more = true
+			}
+			return r
+		}
+
+		if i > 0 && !joins(newContext(data[:i], r, peek)) {
+			if more {
+				break
+			}
+			return i, data[:i], nil
+		}
+
+		if more {
+			break
+		}
+
+		i = next
+	}
+
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+
+	// request more data
+	return 0, nil, nil
+}
@@ -0,0 +1,57 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSegmentString(t *testing.T) {
+	for _, test := range tests {
+		if words := SegmentString(test.str); !reflect.DeepEqual(words, test.words) {
+			t.Errorf("%q: %q != %q", test.str, words, test.words)
+		}
+
+		words := SegmentBytes([]byte(test.str))
+		if len(words) != len(test.words) {
+			t.Errorf("%q: %q != %q", test.str, words, test.words)
+			continue
+		}
+
+		for i, word := range words {
+			if string(word) != test.words[i] {
+				t.Errorf("%q: %q != %q", test.str, word, test.words[i])
+			}
+		}
+	}
+
+	if words := SegmentString(""); len(words) != 0 {
+		t.Errorf("%q != []", words)
+	}
+}
+
+func TestScanWords(t *testing.T) {
+	for _, test := range tests {
+		// a single byte at a time, so that every word is split across reads
+		s := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(test.str)))
+		s.Split(ScanWords)
+
+		var words []string
+		for s.Scan() {
+			words = append(words, s.Text())
+		}
+
+		if err := s.Err(); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(words, test.words) {
+			t.Errorf("%q: %q != %q", test.str, words, test.words)
+		}
+	}
+}
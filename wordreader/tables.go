@@ -1,3 +1,5 @@
+// Code generated by go run ./internal/gen. DO NOT EDIT.
+
 package wordreader
 
 // Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
@@ -5,620 +7,620 @@ package wordreader
 
 import "unicode"
 
+// UnicodeVersion is the version of the Unicode Character Database that the word
+// break property tables were made from. Where words break, and so what is
+// counted, can differ between versions.
+const UnicodeVersion = "17.0.0"
+
 var (
 	tableALetter = &unicode.RangeTable{
 		LatinOffset: 7,
 		R16: []unicode.Range16{
-			{Lo: 0x0041, Hi: 0x005A, Stride: 1},
-			{Lo: 0x0061, Hi: 0x007A, Stride: 1},
-			{Lo: 0x00AA, Hi: 0x00AA, Stride: 1},
-			{Lo: 0x00B5, Hi: 0x00B5, Stride: 1},
-			{Lo: 0x00BA, Hi: 0x00BA, Stride: 1},
-			{Lo: 0x00C0, Hi: 0x00D6, Stride: 1},
-			{Lo: 0x00D8, Hi: 0x00F6, Stride: 1},
-			{Lo: 0x00F8, Hi: 0x01BA, Stride: 1},
-			{Lo: 0x01BB, Hi: 0x01BB, Stride: 1},
-			{Lo: 0x01BC, Hi: 0x01BF, Stride: 1},
-			{Lo: 0x01C0, Hi: 0x01C3, Stride: 1},
-			{Lo: 0x01C4, Hi: 0x0293, Stride: 1},
-			{Lo: 0x0294, Hi: 0x0294, Stride: 1},
-			{Lo: 0x0295, Hi: 0x02AF, Stride: 1},
-			{Lo: 0x02B0, Hi: 0x02C1, Stride: 1},
-			{Lo: 0x02C6, Hi: 0x02D1, Stride: 1},
-			{Lo: 0x02E0, Hi: 0x02E4, Stride: 1},
-			{Lo: 0x02EC, Hi: 0x02EC, Stride: 1},
-			{Lo: 0x02EE, Hi: 0x02EE, Stride: 1},
-			{Lo: 0x0370, Hi: 0x0373, Stride: 1},
-			{Lo: 0x0374, Hi: 0x0374, Stride: 1},
+			{Lo: 0x0041, Hi: 0x005a, Stride: 1},
+			{Lo: 0x0061, Hi: 0x007a, Stride: 1},
+			{Lo: 0x00aa, Hi: 0x00aa, Stride: 1},
+			{Lo: 0x00b5, Hi: 0x00b5, Stride: 1},
+			{Lo: 0x00ba, Hi: 0x00ba, Stride: 1},
+			{Lo: 0x00c0, Hi: 0x00d6, Stride: 1},
+			{Lo: 0x00d8, Hi: 0x00f6, Stride: 1},
+			{Lo: 0x00f8, Hi: 0x02d7, Stride: 1},
+			{Lo: 0x02de, Hi: 0x02ff, Stride: 1},
+			{Lo: 0x0370, Hi: 0x0374, Stride: 1},
 			{Lo: 0x0376, Hi: 0x0377, Stride: 1},
-			{Lo: 0x037A, Hi: 0x037A, Stride: 1},
-			{Lo: 0x037B, Hi: 0x037D, Stride: 1},
-			{Lo: 0x037F, Hi: 0x037F, Stride: 1},
+			{Lo: 0x037a, Hi: 0x037d, Stride: 1},
+			{Lo: 0x037f, Hi: 0x037f, Stride: 1},
 			{Lo: 0x0386, Hi: 0x0386, Stride: 1},
-			{Lo: 0x0388, Hi: 0x038A, Stride: 1},
-			{Lo: 0x038C, Hi: 0x038C, Stride: 1},
-			{Lo: 0x038E, Hi: 0x03A1, Stride: 1},
-			{Lo: 0x03A3, Hi: 0x03F5, Stride: 1},
-			{Lo: 0x03F7, Hi: 0x0481, Stride: 1},
-			{Lo: 0x048A, Hi: 0x052F, Stride: 1},
+			{Lo: 0x0388, Hi: 0x038a, Stride: 1},
+			{Lo: 0x038c, Hi: 0x038c, Stride: 1},
+			{Lo: 0x038e, Hi: 0x03a1, Stride: 1},
+			{Lo: 0x03a3, Hi: 0x03f5, Stride: 1},
+			{Lo: 0x03f7, Hi: 0x0481, Stride: 1},
+			{Lo: 0x048a, Hi: 0x052f, Stride: 1},
 			{Lo: 0x0531, Hi: 0x0556, Stride: 1},
-			{Lo: 0x0559, Hi: 0x0559, Stride: 1},
-			{Lo: 0x0561, Hi: 0x0587, Stride: 1},
-			{Lo: 0x05F3, Hi: 0x05F3, Stride: 1},
-			{Lo: 0x0620, Hi: 0x063F, Stride: 1},
-			{Lo: 0x0640, Hi: 0x0640, Stride: 1},
-			{Lo: 0x0641, Hi: 0x064A, Stride: 1},
-			{Lo: 0x066E, Hi: 0x066F, Stride: 1},
-			{Lo: 0x0671, Hi: 0x06D3, Stride: 1},
-			{Lo: 0x06D5, Hi: 0x06D5, Stride: 1},
-			{Lo: 0x06E5, Hi: 0x06E6, Stride: 1},
-			{Lo: 0x06EE, Hi: 0x06EF, Stride: 1},
-			{Lo: 0x06FA, Hi: 0x06FC, Stride: 1},
-			{Lo: 0x06FF, Hi: 0x06FF, Stride: 1},
+			{Lo: 0x0559, Hi: 0x055c, Stride: 1},
+			{Lo: 0x055e, Hi: 0x055e, Stride: 1},
+			{Lo: 0x0560, Hi: 0x0588, Stride: 1},
+			{Lo: 0x058a, Hi: 0x058a, Stride: 1},
+			{Lo: 0x05f3, Hi: 0x05f3, Stride: 1},
+			{Lo: 0x0620, Hi: 0x064a, Stride: 1},
+			{Lo: 0x066e, Hi: 0x066f, Stride: 1},
+			{Lo: 0x0671, Hi: 0x06d3, Stride: 1},
+			{Lo: 0x06d5, Hi: 0x06d5, Stride: 1},
+			{Lo: 0x06e5, Hi: 0x06e6, Stride: 1},
+			{Lo: 0x06ee, Hi: 0x06ef, Stride: 1},
+			{Lo: 0x06fa, Hi: 0x06fc, Stride: 1},
+			{Lo: 0x06ff, Hi: 0x06ff, Stride: 1},
 			{Lo: 0x0710, Hi: 0x0710, Stride: 1},
-			{Lo: 0x0712, Hi: 0x072F, Stride: 1},
-			{Lo: 0x074D, Hi: 0x07A5, Stride: 1},
-			{Lo: 0x07B1, Hi: 0x07B1, Stride: 1},
-			{Lo: 0x07CA, Hi: 0x07EA, Stride: 1},
-			{Lo: 0x07F4, Hi: 0x07F5, Stride: 1},
-			{Lo: 0x07FA, Hi: 0x07FA, Stride: 1},
+			{Lo: 0x0712, Hi: 0x072f, Stride: 1},
+			{Lo: 0x074d, Hi: 0x07a5, Stride: 1},
+			{Lo: 0x07b1, Hi: 0x07b1, Stride: 1},
+			{Lo: 0x07ca, Hi: 0x07ea, Stride: 1},
+			{Lo: 0x07f4, Hi: 0x07f5, Stride: 1},
+			{Lo: 0x07fa, Hi: 0x07fa, Stride: 1},
 			{Lo: 0x0800, Hi: 0x0815, Stride: 1},
-			{Lo: 0x081A, Hi: 0x081A, Stride: 1},
+			{Lo: 0x081a, Hi: 0x081a, Stride: 1},
 			{Lo: 0x0824, Hi: 0x0824, Stride: 1},
 			{Lo: 0x0828, Hi: 0x0828, Stride: 1},
 			{Lo: 0x0840, Hi: 0x0858, Stride: 1},
-			{Lo: 0x08A0, Hi: 0x08B4, Stride: 1},
-			{Lo: 0x08B6, Hi: 0x08BD, Stride: 1},
+			{Lo: 0x0860, Hi: 0x086a, Stride: 1},
+			{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+			{Lo: 0x0889, Hi: 0x088f, Stride: 1},
+			{Lo: 0x08a0, Hi: 0x08c9, Stride: 1},
 			{Lo: 0x0904, Hi: 0x0939, Stride: 1},
-			{Lo: 0x093D, Hi: 0x093D, Stride: 1},
+			{Lo: 0x093d, Hi: 0x093d, Stride: 1},
 			{Lo: 0x0950, Hi: 0x0950, Stride: 1},
 			{Lo: 0x0958, Hi: 0x0961, Stride: 1},
-			{Lo: 0x0971, Hi: 0x0971, Stride: 1},
-			{Lo: 0x0972, Hi: 0x0980, Stride: 1},
-			{Lo: 0x0985, Hi: 0x098C, Stride: 1},
-			{Lo: 0x098F, Hi: 0x0990, Stride: 1},
-			{Lo: 0x0993, Hi: 0x09A8, Stride: 1},
-			{Lo: 0x09AA, Hi: 0x09B0, Stride: 1},
-			{Lo: 0x09B2, Hi: 0x09B2, Stride: 1},
-			{Lo: 0x09B6, Hi: 0x09B9, Stride: 1},
-			{Lo: 0x09BD, Hi: 0x09BD, Stride: 1},
-			{Lo: 0x09CE, Hi: 0x09CE, Stride: 1},
-			{Lo: 0x09DC, Hi: 0x09DD, Stride: 1},
-			{Lo: 0x09DF, Hi: 0x09E1, Stride: 1},
-			{Lo: 0x09F0, Hi: 0x09F1, Stride: 1},
-			{Lo: 0x0A05, Hi: 0x0A0A, Stride: 1},
-			{Lo: 0x0A0F, Hi: 0x0A10, Stride: 1},
-			{Lo: 0x0A13, Hi: 0x0A28, Stride: 1},
-			{Lo: 0x0A2A, Hi: 0x0A30, Stride: 1},
-			{Lo: 0x0A32, Hi: 0x0A33, Stride: 1},
-			{Lo: 0x0A35, Hi: 0x0A36, Stride: 1},
-			{Lo: 0x0A38, Hi: 0x0A39, Stride: 1},
-			{Lo: 0x0A59, Hi: 0x0A5C, Stride: 1},
-			{Lo: 0x0A5E, Hi: 0x0A5E, Stride: 1},
-			{Lo: 0x0A72, Hi: 0x0A74, Stride: 1},
-			{Lo: 0x0A85, Hi: 0x0A8D, Stride: 1},
-			{Lo: 0x0A8F, Hi: 0x0A91, Stride: 1},
-			{Lo: 0x0A93, Hi: 0x0AA8, Stride: 1},
-			{Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1},
-			{Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
-			{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1},
-			{Lo: 0x0ABD, Hi: 0x0ABD, Stride: 1},
-			{Lo: 0x0AD0, Hi: 0x0AD0, Stride: 1},
-			{Lo: 0x0AE0, Hi: 0x0AE1, Stride: 1},
-			{Lo: 0x0AF9, Hi: 0x0AF9, Stride: 1},
-			{Lo: 0x0B05, Hi: 0x0B0C, Stride: 1},
-			{Lo: 0x0B0F, Hi: 0x0B10, Stride: 1},
-			{Lo: 0x0B13, Hi: 0x0B28, Stride: 1},
-			{Lo: 0x0B2A, Hi: 0x0B30, Stride: 1},
-			{Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
-			{Lo: 0x0B35, Hi: 0x0B39, Stride: 1},
-			{Lo: 0x0B3D, Hi: 0x0B3D, Stride: 1},
-			{Lo: 0x0B5C, Hi: 0x0B5D, Stride: 1},
-			{Lo: 0x0B5F, Hi: 0x0B61, Stride: 1},
-			{Lo: 0x0B71, Hi: 0x0B71, Stride: 1},
-			{Lo: 0x0B83, Hi: 0x0B83, Stride: 1},
-			{Lo: 0x0B85, Hi: 0x0B8A, Stride: 1},
-			{Lo: 0x0B8E, Hi: 0x0B90, Stride: 1},
-			{Lo: 0x0B92, Hi: 0x0B95, Stride: 1},
-			{Lo: 0x0B99, Hi: 0x0B9A, Stride: 1},
-			{Lo: 0x0B9C, Hi: 0x0B9C, Stride: 1},
-			{Lo: 0x0B9E, Hi: 0x0B9F, Stride: 1},
-			{Lo: 0x0BA3, Hi: 0x0BA4, Stride: 1},
-			{Lo: 0x0BA8, Hi: 0x0BAA, Stride: 1},
-			{Lo: 0x0BAE, Hi: 0x0BB9, Stride: 1},
-			{Lo: 0x0BD0, Hi: 0x0BD0, Stride: 1},
-			{Lo: 0x0C05, Hi: 0x0C0C, Stride: 1},
-			{Lo: 0x0C0E, Hi: 0x0C10, Stride: 1},
-			{Lo: 0x0C12, Hi: 0x0C28, Stride: 1},
-			{Lo: 0x0C2A, Hi: 0x0C39, Stride: 1},
-			{Lo: 0x0C3D, Hi: 0x0C3D, Stride: 1},
-			{Lo: 0x0C58, Hi: 0x0C5A, Stride: 1},
-			{Lo: 0x0C60, Hi: 0x0C61, Stride: 1},
-			{Lo: 0x0C80, Hi: 0x0C80, Stride: 1},
-			{Lo: 0x0C85, Hi: 0x0C8C, Stride: 1},
-			{Lo: 0x0C8E, Hi: 0x0C90, Stride: 1},
-			{Lo: 0x0C92, Hi: 0x0CA8, Stride: 1},
-			{Lo: 0x0CAA, Hi: 0x0CB3, Stride: 1},
-			{Lo: 0x0CB5, Hi: 0x0CB9, Stride: 1},
-			{Lo: 0x0CBD, Hi: 0x0CBD, Stride: 1},
-			{Lo: 0x0CDE, Hi: 0x0CDE, Stride: 1},
-			{Lo: 0x0CE0, Hi: 0x0CE1, Stride: 1},
-			{Lo: 0x0CF1, Hi: 0x0CF2, Stride: 1},
-			{Lo: 0x0D05, Hi: 0x0D0C, Stride: 1},
-			{Lo: 0x0D0E, Hi: 0x0D10, Stride: 1},
-			{Lo: 0x0D12, Hi: 0x0D3A, Stride: 1},
-			{Lo: 0x0D3D, Hi: 0x0D3D, Stride: 1},
-			{Lo: 0x0D4E, Hi: 0x0D4E, Stride: 1},
-			{Lo: 0x0D54, Hi: 0x0D56, Stride: 1},
-			{Lo: 0x0D5F, Hi: 0x0D61, Stride: 1},
-			{Lo: 0x0D7A, Hi: 0x0D7F, Stride: 1},
-			{Lo: 0x0D85, Hi: 0x0D96, Stride: 1},
-			{Lo: 0x0D9A, Hi: 0x0DB1, Stride: 1},
-			{Lo: 0x0DB3, Hi: 0x0DBB, Stride: 1},
-			{Lo: 0x0DBD, Hi: 0x0DBD, Stride: 1},
-			{Lo: 0x0DC0, Hi: 0x0DC6, Stride: 1},
-			{Lo: 0x0F00, Hi: 0x0F00, Stride: 1},
-			{Lo: 0x0F40, Hi: 0x0F47, Stride: 1},
-			{Lo: 0x0F49, Hi: 0x0F6C, Stride: 1},
-			{Lo: 0x0F88, Hi: 0x0F8C, Stride: 1},
-			{Lo: 0x10A0, Hi: 0x10C5, Stride: 1},
-			{Lo: 0x10C7, Hi: 0x10C7, Stride: 1},
-			{Lo: 0x10CD, Hi: 0x10CD, Stride: 1},
-			{Lo: 0x10D0, Hi: 0x10FA, Stride: 1},
-			{Lo: 0x10FC, Hi: 0x10FC, Stride: 1},
-			{Lo: 0x10FD, Hi: 0x1248, Stride: 1},
-			{Lo: 0x124A, Hi: 0x124D, Stride: 1},
+			{Lo: 0x0971, Hi: 0x0980, Stride: 1},
+			{Lo: 0x0985, Hi: 0x098c, Stride: 1},
+			{Lo: 0x098f, Hi: 0x0990, Stride: 1},
+			{Lo: 0x0993, Hi: 0x09a8, Stride: 1},
+			{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+			{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+			{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+			{Lo: 0x09bd, Hi: 0x09bd, Stride: 1},
+			{Lo: 0x09ce, Hi: 0x09ce, Stride: 1},
+			{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+			{Lo: 0x09df, Hi: 0x09e1, Stride: 1},
+			{Lo: 0x09f0, Hi: 0x09f1, Stride: 1},
+			{Lo: 0x09fc, Hi: 0x09fc, Stride: 1},
+			{Lo: 0x0a05, Hi: 0x0a0a, Stride: 1},
+			{Lo: 0x0a0f, Hi: 0x0a10, Stride: 1},
+			{Lo: 0x0a13, Hi: 0x0a28, Stride: 1},
+			{Lo: 0x0a2a, Hi: 0x0a30, Stride: 1},
+			{Lo: 0x0a32, Hi: 0x0a33, Stride: 1},
+			{Lo: 0x0a35, Hi: 0x0a36, Stride: 1},
+			{Lo: 0x0a38, Hi: 0x0a39, Stride: 1},
+			{Lo: 0x0a59, Hi: 0x0a5c, Stride: 1},
+			{Lo: 0x0a5e, Hi: 0x0a5e, Stride: 1},
+			{Lo: 0x0a72, Hi: 0x0a74, Stride: 1},
+			{Lo: 0x0a85, Hi: 0x0a8d, Stride: 1},
+			{Lo: 0x0a8f, Hi: 0x0a91, Stride: 1},
+			{Lo: 0x0a93, Hi: 0x0aa8, Stride: 1},
+			{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+			{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+			{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+			{Lo: 0x0abd, Hi: 0x0abd, Stride: 1},
+			{Lo: 0x0ad0, Hi: 0x0ad0, Stride: 1},
+			{Lo: 0x0ae0, Hi: 0x0ae1, Stride: 1},
+			{Lo: 0x0af9, Hi: 0x0af9, Stride: 1},
+			{Lo: 0x0b05, Hi: 0x0b0c, Stride: 1},
+			{Lo: 0x0b0f, Hi: 0x0b10, Stride: 1},
+			{Lo: 0x0b13, Hi: 0x0b28, Stride: 1},
+			{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+			{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+			{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+			{Lo: 0x0b3d, Hi: 0x0b3d, Stride: 1},
+			{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+			{Lo: 0x0b5f, Hi: 0x0b61, Stride: 1},
+			{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+			{Lo: 0x0b83, Hi: 0x0b83, Stride: 1},
+			{Lo: 0x0b85, Hi: 0x0b8a, Stride: 1},
+			{Lo: 0x0b8e, Hi: 0x0b90, Stride: 1},
+			{Lo: 0x0b92, Hi: 0x0b95, Stride: 1},
+			{Lo: 0x0b99, Hi: 0x0b9a, Stride: 1},
+			{Lo: 0x0b9c, Hi: 0x0b9c, Stride: 1},
+			{Lo: 0x0b9e, Hi: 0x0b9f, Stride: 1},
+			{Lo: 0x0ba3, Hi: 0x0ba4, Stride: 1},
+			{Lo: 0x0ba8, Hi: 0x0baa, Stride: 1},
+			{Lo: 0x0bae, Hi: 0x0bb9, Stride: 1},
+			{Lo: 0x0bd0, Hi: 0x0bd0, Stride: 1},
+			{Lo: 0x0c05, Hi: 0x0c0c, Stride: 1},
+			{Lo: 0x0c0e, Hi: 0x0c10, Stride: 1},
+			{Lo: 0x0c12, Hi: 0x0c28, Stride: 1},
+			{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+			{Lo: 0x0c3d, Hi: 0x0c3d, Stride: 1},
+			{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+			{Lo: 0x0c5c, Hi: 0x0c5d, Stride: 1},
+			{Lo: 0x0c60, Hi: 0x0c61, Stride: 1},
+			{Lo: 0x0c80, Hi: 0x0c80, Stride: 1},
+			{Lo: 0x0c85, Hi: 0x0c8c, Stride: 1},
+			{Lo: 0x0c8e, Hi: 0x0c90, Stride: 1},
+			{Lo: 0x0c92, Hi: 0x0ca8, Stride: 1},
+			{Lo: 0x0caa, Hi: 0x0cb3, Stride: 1},
+			{Lo: 0x0cb5, Hi: 0x0cb9, Stride: 1},
+			{Lo: 0x0cbd, Hi: 0x0cbd, Stride: 1},
+			{Lo: 0x0cdc, Hi: 0x0cde, Stride: 1},
+			{Lo: 0x0ce0, Hi: 0x0ce1, Stride: 1},
+			{Lo: 0x0cf1, Hi: 0x0cf2, Stride: 1},
+			{Lo: 0x0d04, Hi: 0x0d0c, Stride: 1},
+			{Lo: 0x0d0e, Hi: 0x0d10, Stride: 1},
+			{Lo: 0x0d12, Hi: 0x0d3a, Stride: 1},
+			{Lo: 0x0d3d, Hi: 0x0d3d, Stride: 1},
+			{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+			{Lo: 0x0d54, Hi: 0x0d56, Stride: 1},
+			{Lo: 0x0d5f, Hi: 0x0d61, Stride: 1},
+			{Lo: 0x0d7a, Hi: 0x0d7f, Stride: 1},
+			{Lo: 0x0d85, Hi: 0x0d96, Stride: 1},
+			{Lo: 0x0d9a, Hi: 0x0db1, Stride: 1},
+			{Lo: 0x0db3, Hi: 0x0dbb, Stride: 1},
+			{Lo: 0x0dbd, Hi: 0x0dbd, Stride: 1},
+			{Lo: 0x0dc0, Hi: 0x0dc6, Stride: 1},
+			{Lo: 0x0f00, Hi: 0x0f00, Stride: 1},
+			{Lo: 0x0f40, Hi: 0x0f47, Stride: 1},
+			{Lo: 0x0f49, Hi: 0x0f6c, Stride: 1},
+			{Lo: 0x0f88, Hi: 0x0f8c, Stride: 1},
+			{Lo: 0x10a0, Hi: 0x10c5, Stride: 1},
+			{Lo: 0x10c7, Hi: 0x10c7, Stride: 1},
+			{Lo: 0x10cd, Hi: 0x10cd, Stride: 1},
+			{Lo: 0x10d0, Hi: 0x10fa, Stride: 1},
+			{Lo: 0x10fc, Hi: 0x1248, Stride: 1},
+			{Lo: 0x124a, Hi: 0x124d, Stride: 1},
 			{Lo: 0x1250, Hi: 0x1256, Stride: 1},
 			{Lo: 0x1258, Hi: 0x1258, Stride: 1},
-			{Lo: 0x125A, Hi: 0x125D, Stride: 1},
+			{Lo: 0x125a, Hi: 0x125d, Stride: 1},
 			{Lo: 0x1260, Hi: 0x1288, Stride: 1},
-			{Lo: 0x128A, Hi: 0x128D, Stride: 1},
-			{Lo: 0x1290, Hi: 0x12B0, Stride: 1},
-			{Lo: 0x12B2, Hi: 0x12B5, Stride: 1},
-			{Lo: 0x12B8, Hi: 0x12BE, Stride: 1},
-			{Lo: 0x12C0, Hi: 0x12C0, Stride: 1},
-			{Lo: 0x12C2, Hi: 0x12C5, Stride: 1},
-			{Lo: 0x12C8, Hi: 0x12D6, Stride: 1},
-			{Lo: 0x12D8, Hi: 0x1310, Stride: 1},
+			{Lo: 0x128a, Hi: 0x128d, Stride: 1},
+			{Lo: 0x1290, Hi: 0x12b0, Stride: 1},
+			{Lo: 0x12b2, Hi: 0x12b5, Stride: 1},
+			{Lo: 0x12b8, Hi: 0x12be, Stride: 1},
+			{Lo: 0x12c0, Hi: 0x12c0, Stride: 1},
+			{Lo: 0x12c2, Hi: 0x12c5, Stride: 1},
+			{Lo: 0x12c8, Hi: 0x12d6, Stride: 1},
+			{Lo: 0x12d8, Hi: 0x1310, Stride: 1},
 			{Lo: 0x1312, Hi: 0x1315, Stride: 1},
-			{Lo: 0x1318, Hi: 0x135A, Stride: 1},
-			{Lo: 0x1380, Hi: 0x138F, Stride: 1},
-			{Lo: 0x13A0, Hi: 0x13F5, Stride: 1},
-			{Lo: 0x13F8, Hi: 0x13FD, Stride: 1},
-			{Lo: 0x1401, Hi: 0x166C, Stride: 1},
-			{Lo: 0x166F, Hi: 0x167F, Stride: 1},
-			{Lo: 0x1681, Hi: 0x169A, Stride: 1},
-			{Lo: 0x16A0, Hi: 0x16EA, Stride: 1},
-			{Lo: 0x16EE, Hi: 0x16F0, Stride: 1},
-			{Lo: 0x16F1, Hi: 0x16F8, Stride: 1},
-			{Lo: 0x1700, Hi: 0x170C, Stride: 1},
-			{Lo: 0x170E, Hi: 0x1711, Stride: 1},
-			{Lo: 0x1720, Hi: 0x1731, Stride: 1},
+			{Lo: 0x1318, Hi: 0x135a, Stride: 1},
+			{Lo: 0x1380, Hi: 0x138f, Stride: 1},
+			{Lo: 0x13a0, Hi: 0x13f5, Stride: 1},
+			{Lo: 0x13f8, Hi: 0x13fd, Stride: 1},
+			{Lo: 0x1401, Hi: 0x166c, Stride: 1},
+			{Lo: 0x166f, Hi: 0x167f, Stride: 1},
+			{Lo: 0x1681, Hi: 0x169a, Stride: 1},
+			{Lo: 0x16a0, Hi: 0x16ea, Stride: 1},
+			{Lo: 0x16ee, Hi: 0x16f8, Stride: 1},
+			{Lo: 0x1700, Hi: 0x1711, Stride: 1},
+			{Lo: 0x171f, Hi: 0x1731, Stride: 1},
 			{Lo: 0x1740, Hi: 0x1751, Stride: 1},
-			{Lo: 0x1760, Hi: 0x176C, Stride: 1},
-			{Lo: 0x176E, Hi: 0x1770, Stride: 1},
-			{Lo: 0x1820, Hi: 0x1842, Stride: 1},
-			{Lo: 0x1843, Hi: 0x1843, Stride: 1},
-			{Lo: 0x1844, Hi: 0x1877, Stride: 1},
+			{Lo: 0x1760, Hi: 0x176c, Stride: 1},
+			{Lo: 0x176e, Hi: 0x1770, Stride: 1},
+			{Lo: 0x1820, Hi: 0x1878, Stride: 1},
 			{Lo: 0x1880, Hi: 0x1884, Stride: 1},
-			{Lo: 0x1887, Hi: 0x18A8, Stride: 1},
-			{Lo: 0x18AA, Hi: 0x18AA, Stride: 1},
-			{Lo: 0x18B0, Hi: 0x18F5, Stride: 1},
-			{Lo: 0x1900, Hi: 0x191E, Stride: 1},
-			{Lo: 0x1A00, Hi: 0x1A16, Stride: 1},
-			{Lo: 0x1B05, Hi: 0x1B33, Stride: 1},
-			{Lo: 0x1B45, Hi: 0x1B4B, Stride: 1},
-			{Lo: 0x1B83, Hi: 0x1BA0, Stride: 1},
-			{Lo: 0x1BAE, Hi: 0x1BAF, Stride: 1},
-			{Lo: 0x1BBA, Hi: 0x1BE5, Stride: 1},
-			{Lo: 0x1C00, Hi: 0x1C23, Stride: 1},
-			{Lo: 0x1C4D, Hi: 0x1C4F, Stride: 1},
-			{Lo: 0x1C5A, Hi: 0x1C77, Stride: 1},
-			{Lo: 0x1C78, Hi: 0x1C7D, Stride: 1},
-			{Lo: 0x1C80, Hi: 0x1C88, Stride: 1},
-			{Lo: 0x1CE9, Hi: 0x1CEC, Stride: 1},
-			{Lo: 0x1CEE, Hi: 0x1CF1, Stride: 1},
-			{Lo: 0x1CF5, Hi: 0x1CF6, Stride: 1},
-			{Lo: 0x1D00, Hi: 0x1D2B, Stride: 1},
-			{Lo: 0x1D2C, Hi: 0x1D6A, Stride: 1},
-			{Lo: 0x1D6B, Hi: 0x1D77, Stride: 1},
-			{Lo: 0x1D78, Hi: 0x1D78, Stride: 1},
-			{Lo: 0x1D79, Hi: 0x1D9A, Stride: 1},
-			{Lo: 0x1D9B, Hi: 0x1DBF, Stride: 1},
-			{Lo: 0x1E00, Hi: 0x1F15, Stride: 1},
-			{Lo: 0x1F18, Hi: 0x1F1D, Stride: 1},
-			{Lo: 0x1F20, Hi: 0x1F45, Stride: 1},
-			{Lo: 0x1F48, Hi: 0x1F4D, Stride: 1},
-			{Lo: 0x1F50, Hi: 0x1F57, Stride: 1},
-			{Lo: 0x1F59, Hi: 0x1F59, Stride: 1},
-			{Lo: 0x1F5B, Hi: 0x1F5B, Stride: 1},
-			{Lo: 0x1F5D, Hi: 0x1F5D, Stride: 1},
-			{Lo: 0x1F5F, Hi: 0x1F7D, Stride: 1},
-			{Lo: 0x1F80, Hi: 0x1FB4, Stride: 1},
-			{Lo: 0x1FB6, Hi: 0x1FBC, Stride: 1},
-			{Lo: 0x1FBE, Hi: 0x1FBE, Stride: 1},
-			{Lo: 0x1FC2, Hi: 0x1FC4, Stride: 1},
-			{Lo: 0x1FC6, Hi: 0x1FCC, Stride: 1},
-			{Lo: 0x1FD0, Hi: 0x1FD3, Stride: 1},
-			{Lo: 0x1FD6, Hi: 0x1FDB, Stride: 1},
-			{Lo: 0x1FE0, Hi: 0x1FEC, Stride: 1},
-			{Lo: 0x1FF2, Hi: 0x1FF4, Stride: 1},
-			{Lo: 0x1FF6, Hi: 0x1FFC, Stride: 1},
+			{Lo: 0x1887, Hi: 0x18a8, Stride: 1},
+			{Lo: 0x18aa, Hi: 0x18aa, Stride: 1},
+			{Lo: 0x18b0, Hi: 0x18f5, Stride: 1},
+			{Lo: 0x1900, Hi: 0x191e, Stride: 1},
+			{Lo: 0x1a00, Hi: 0x1a16, Stride: 1},
+			{Lo: 0x1b05, Hi: 0x1b33, Stride: 1},
+			{Lo: 0x1b45, Hi: 0x1b4c, Stride: 1},
+			{Lo: 0x1b83, Hi: 0x1ba0, Stride: 1},
+			{Lo: 0x1bae, Hi: 0x1baf, Stride: 1},
+			{Lo: 0x1bba, Hi: 0x1be5, Stride: 1},
+			{Lo: 0x1c00, Hi: 0x1c23, Stride: 1},
+			{Lo: 0x1c4d, Hi: 0x1c4f, Stride: 1},
+			{Lo: 0x1c5a, Hi: 0x1c7d, Stride: 1},
+			{Lo: 0x1c80, Hi: 0x1c8a, Stride: 1},
+			{Lo: 0x1c90, Hi: 0x1cba, Stride: 1},
+			{Lo: 0x1cbd, Hi: 0x1cbf, Stride: 1},
+			{Lo: 0x1ce9, Hi: 0x1cec, Stride: 1},
+			{Lo: 0x1cee, Hi: 0x1cf3, Stride: 1},
+			{Lo: 0x1cf5, Hi: 0x1cf6, Stride: 1},
+			{Lo: 0x1cfa, Hi: 0x1cfa, Stride: 1},
+			{Lo: 0x1d00, Hi: 0x1dbf, Stride: 1},
+			{Lo: 0x1e00, Hi: 0x1f15, Stride: 1},
+			{Lo: 0x1f18, Hi: 0x1f1d, Stride: 1},
+			{Lo: 0x1f20, Hi: 0x1f45, Stride: 1},
+			{Lo: 0x1f48, Hi: 0x1f4d, Stride: 1},
+			{Lo: 0x1f50, Hi: 0x1f57, Stride: 1},
+			{Lo: 0x1f59, Hi: 0x1f59, Stride: 1},
+			{Lo: 0x1f5b, Hi: 0x1f5b, Stride: 1},
+			{Lo: 0x1f5d, Hi: 0x1f5d, Stride: 1},
+			{Lo: 0x1f5f, Hi: 0x1f7d, Stride: 1},
+			{Lo: 0x1f80, Hi: 0x1fb4, Stride: 1},
+			{Lo: 0x1fb6, Hi: 0x1fbc, Stride: 1},
+			{Lo: 0x1fbe, Hi: 0x1fbe, Stride: 1},
+			{Lo: 0x1fc2, Hi: 0x1fc4, Stride: 1},
+			{Lo: 0x1fc6, Hi: 0x1fcc, Stride: 1},
+			{Lo: 0x1fd0, Hi: 0x1fd3, Stride: 1},
+			{Lo: 0x1fd6, Hi: 0x1fdb, Stride: 1},
+			{Lo: 0x1fe0, Hi: 0x1fec, Stride: 1},
+			{Lo: 0x1ff2, Hi: 0x1ff4, Stride: 1},
+			{Lo: 0x1ff6, Hi: 0x1ffc, Stride: 1},
 			{Lo: 0x2071, Hi: 0x2071, Stride: 1},
-			{Lo: 0x207F, Hi: 0x207F, Stride: 1},
-			{Lo: 0x2090, Hi: 0x209C, Stride: 1},
+			{Lo: 0x207f, Hi: 0x207f, Stride: 1},
+			{Lo: 0x2090, Hi: 0x209c, Stride: 1},
 			{Lo: 0x2102, Hi: 0x2102, Stride: 1},
 			{Lo: 0x2107, Hi: 0x2107, Stride: 1},
-			{Lo: 0x210A, Hi: 0x2113, Stride: 1},
+			{Lo: 0x210a, Hi: 0x2113, Stride: 1},
 			{Lo: 0x2115, Hi: 0x2115, Stride: 1},
-			{Lo: 0x2119, Hi: 0x211D, Stride: 1},
+			{Lo: 0x2119, Hi: 0x211d, Stride: 1},
 			{Lo: 0x2124, Hi: 0x2124, Stride: 1},
 			{Lo: 0x2126, Hi: 0x2126, Stride: 1},
 			{Lo: 0x2128, Hi: 0x2128, Stride: 1},
-			{Lo: 0x212A, Hi: 0x212D, Stride: 1},
-			{Lo: 0x212F, Hi: 0x2134, Stride: 1},
-			{Lo: 0x2135, Hi: 0x2138, Stride: 1},
-			{Lo: 0x2139, Hi: 0x2139, Stride: 1},
-			{Lo: 0x213C, Hi: 0x213F, Stride: 1},
+			{Lo: 0x212a, Hi: 0x212d, Stride: 1},
+			{Lo: 0x212f, Hi: 0x2139, Stride: 1},
+			{Lo: 0x213c, Hi: 0x213f, Stride: 1},
 			{Lo: 0x2145, Hi: 0x2149, Stride: 1},
-			{Lo: 0x214E, Hi: 0x214E, Stride: 1},
-			{Lo: 0x2160, Hi: 0x2182, Stride: 1},
-			{Lo: 0x2183, Hi: 0x2184, Stride: 1},
-			{Lo: 0x2185, Hi: 0x2188, Stride: 1},
-			{Lo: 0x24B6, Hi: 0x24E9, Stride: 1},
-			{Lo: 0x2C00, Hi: 0x2C2E, Stride: 1},
-			{Lo: 0x2C30, Hi: 0x2C5E, Stride: 1},
-			{Lo: 0x2C60, Hi: 0x2C7B, Stride: 1},
-			{Lo: 0x2C7C, Hi: 0x2C7D, Stride: 1},
-			{Lo: 0x2C7E, Hi: 0x2CE4, Stride: 1},
-			{Lo: 0x2CEB, Hi: 0x2CEE, Stride: 1},
-			{Lo: 0x2CF2, Hi: 0x2CF3, Stride: 1},
-			{Lo: 0x2D00, Hi: 0x2D25, Stride: 1},
-			{Lo: 0x2D27, Hi: 0x2D27, Stride: 1},
-			{Lo: 0x2D2D, Hi: 0x2D2D, Stride: 1},
-			{Lo: 0x2D30, Hi: 0x2D67, Stride: 1},
-			{Lo: 0x2D6F, Hi: 0x2D6F, Stride: 1},
-			{Lo: 0x2D80, Hi: 0x2D96, Stride: 1},
-			{Lo: 0x2DA0, Hi: 0x2DA6, Stride: 1},
-			{Lo: 0x2DA8, Hi: 0x2DAE, Stride: 1},
-			{Lo: 0x2DB0, Hi: 0x2DB6, Stride: 1},
-			{Lo: 0x2DB8, Hi: 0x2DBE, Stride: 1},
-			{Lo: 0x2DC0, Hi: 0x2DC6, Stride: 1},
-			{Lo: 0x2DC8, Hi: 0x2DCE, Stride: 1},
-			{Lo: 0x2DD0, Hi: 0x2DD6, Stride: 1},
-			{Lo: 0x2DD8, Hi: 0x2DDE, Stride: 1},
-			{Lo: 0x2E2F, Hi: 0x2E2F, Stride: 1},
+			{Lo: 0x214e, Hi: 0x214e, Stride: 1},
+			{Lo: 0x2160, Hi: 0x2188, Stride: 1},
+			{Lo: 0x24b6, Hi: 0x24e9, Stride: 1},
+			{Lo: 0x2c00, Hi: 0x2ce4, Stride: 1},
+			{Lo: 0x2ceb, Hi: 0x2cee, Stride: 1},
+			{Lo: 0x2cf2, Hi: 0x2cf3, Stride: 1},
+			{Lo: 0x2d00, Hi: 0x2d25, Stride: 1},
+			{Lo: 0x2d27, Hi: 0x2d27, Stride: 1},
+			{Lo: 0x2d2d, Hi: 0x2d2d, Stride: 1},
+			{Lo: 0x2d30, Hi: 0x2d67, Stride: 1},
+			{Lo: 0x2d6f, Hi: 0x2d6f, Stride: 1},
+			{Lo: 0x2d80, Hi: 0x2d96, Stride: 1},
+			{Lo: 0x2da0, Hi: 0x2da6, Stride: 1},
+			{Lo: 0x2da8, Hi: 0x2dae, Stride: 1},
+			{Lo: 0x2db0, Hi: 0x2db6, Stride: 1},
+			{Lo: 0x2db8, Hi: 0x2dbe, Stride: 1},
+			{Lo: 0x2dc0, Hi: 0x2dc6, Stride: 1},
+			{Lo: 0x2dc8, Hi: 0x2dce, Stride: 1},
+			{Lo: 0x2dd0, Hi: 0x2dd6, Stride: 1},
+			{Lo: 0x2dd8, Hi: 0x2dde, Stride: 1},
+			{Lo: 0x2e2f, Hi: 0x2e2f, Stride: 1},
 			{Lo: 0x3005, Hi: 0x3005, Stride: 1},
-			{Lo: 0x303B, Hi: 0x303B, Stride: 1},
-			{Lo: 0x303C, Hi: 0x303C, Stride: 1},
-			{Lo: 0x3105, Hi: 0x312D, Stride: 1},
-			{Lo: 0x3131, Hi: 0x318E, Stride: 1},
-			{Lo: 0x31A0, Hi: 0x31BA, Stride: 1},
-			{Lo: 0xA000, Hi: 0xA014, Stride: 1},
-			{Lo: 0xA015, Hi: 0xA015, Stride: 1},
-			{Lo: 0xA016, Hi: 0xA48C, Stride: 1},
-			{Lo: 0xA4D0, Hi: 0xA4F7, Stride: 1},
-			{Lo: 0xA4F8, Hi: 0xA4FD, Stride: 1},
-			{Lo: 0xA500, Hi: 0xA60B, Stride: 1},
-			{Lo: 0xA60C, Hi: 0xA60C, Stride: 1},
-			{Lo: 0xA610, Hi: 0xA61F, Stride: 1},
-			{Lo: 0xA62A, Hi: 0xA62B, Stride: 1},
-			{Lo: 0xA640, Hi: 0xA66D, Stride: 1},
-			{Lo: 0xA66E, Hi: 0xA66E, Stride: 1},
-			{Lo: 0xA67F, Hi: 0xA67F, Stride: 1},
-			{Lo: 0xA680, Hi: 0xA69B, Stride: 1},
-			{Lo: 0xA69C, Hi: 0xA69D, Stride: 1},
-			{Lo: 0xA6A0, Hi: 0xA6E5, Stride: 1},
-			{Lo: 0xA6E6, Hi: 0xA6EF, Stride: 1},
-			{Lo: 0xA717, Hi: 0xA71F, Stride: 1},
-			{Lo: 0xA722, Hi: 0xA76F, Stride: 1},
-			{Lo: 0xA770, Hi: 0xA770, Stride: 1},
-			{Lo: 0xA771, Hi: 0xA787, Stride: 1},
-			{Lo: 0xA788, Hi: 0xA788, Stride: 1},
-			{Lo: 0xA78B, Hi: 0xA78E, Stride: 1},
-			{Lo: 0xA78F, Hi: 0xA78F, Stride: 1},
-			{Lo: 0xA790, Hi: 0xA7AE, Stride: 1},
-			{Lo: 0xA7B0, Hi: 0xA7B7, Stride: 1},
-			{Lo: 0xA7F7, Hi: 0xA7F7, Stride: 1},
-			{Lo: 0xA7F8, Hi: 0xA7F9, Stride: 1},
-			{Lo: 0xA7FA, Hi: 0xA7FA, Stride: 1},
-			{Lo: 0xA7FB, Hi: 0xA801, Stride: 1},
-			{Lo: 0xA803, Hi: 0xA805, Stride: 1},
-			{Lo: 0xA807, Hi: 0xA80A, Stride: 1},
-			{Lo: 0xA80C, Hi: 0xA822, Stride: 1},
-			{Lo: 0xA840, Hi: 0xA873, Stride: 1},
-			{Lo: 0xA882, Hi: 0xA8B3, Stride: 1},
-			{Lo: 0xA8F2, Hi: 0xA8F7, Stride: 1},
-			{Lo: 0xA8FB, Hi: 0xA8FB, Stride: 1},
-			{Lo: 0xA8FD, Hi: 0xA8FD, Stride: 1},
-			{Lo: 0xA90A, Hi: 0xA925, Stride: 1},
-			{Lo: 0xA930, Hi: 0xA946, Stride: 1},
-			{Lo: 0xA960, Hi: 0xA97C, Stride: 1},
-			{Lo: 0xA984, Hi: 0xA9B2, Stride: 1},
-			{Lo: 0xA9CF, Hi: 0xA9CF, Stride: 1},
-			{Lo: 0xAA00, Hi: 0xAA28, Stride: 1},
-			{Lo: 0xAA40, Hi: 0xAA42, Stride: 1},
-			{Lo: 0xAA44, Hi: 0xAA4B, Stride: 1},
-			{Lo: 0xAAE0, Hi: 0xAAEA, Stride: 1},
-			{Lo: 0xAAF2, Hi: 0xAAF2, Stride: 1},
-			{Lo: 0xAAF3, Hi: 0xAAF4, Stride: 1},
-			{Lo: 0xAB01, Hi: 0xAB06, Stride: 1},
-			{Lo: 0xAB09, Hi: 0xAB0E, Stride: 1},
-			{Lo: 0xAB11, Hi: 0xAB16, Stride: 1},
-			{Lo: 0xAB20, Hi: 0xAB26, Stride: 1},
-			{Lo: 0xAB28, Hi: 0xAB2E, Stride: 1},
-			{Lo: 0xAB30, Hi: 0xAB5A, Stride: 1},
-			{Lo: 0xAB5C, Hi: 0xAB5F, Stride: 1},
-			{Lo: 0xAB60, Hi: 0xAB65, Stride: 1},
-			{Lo: 0xAB70, Hi: 0xABBF, Stride: 1},
-			{Lo: 0xABC0, Hi: 0xABE2, Stride: 1},
-			{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
-			{Lo: 0xD7B0, Hi: 0xD7C6, Stride: 1},
-			{Lo: 0xD7CB, Hi: 0xD7FB, Stride: 1},
-			{Lo: 0xFB00, Hi: 0xFB06, Stride: 1},
-			{Lo: 0xFB13, Hi: 0xFB17, Stride: 1},
-			{Lo: 0xFB50, Hi: 0xFBB1, Stride: 1},
-			{Lo: 0xFBD3, Hi: 0xFD3D, Stride: 1},
-			{Lo: 0xFD50, Hi: 0xFD8F, Stride: 1},
-			{Lo: 0xFD92, Hi: 0xFDC7, Stride: 1},
-			{Lo: 0xFDF0, Hi: 0xFDFB, Stride: 1},
-			{Lo: 0xFE70, Hi: 0xFE74, Stride: 1},
-			{Lo: 0xFE76, Hi: 0xFEFC, Stride: 1},
-			{Lo: 0xFF21, Hi: 0xFF3A, Stride: 1},
-			{Lo: 0xFF41, Hi: 0xFF5A, Stride: 1},
-			{Lo: 0xFFA0, Hi: 0xFFBE, Stride: 1},
-			{Lo: 0xFFC2, Hi: 0xFFC7, Stride: 1},
-			{Lo: 0xFFCA, Hi: 0xFFCF, Stride: 1},
-			{Lo: 0xFFD2, Hi: 0xFFD7, Stride: 1},
-			{Lo: 0xFFDA, Hi: 0xFFDC, Stride: 1},
+			{Lo: 0x303b, Hi: 0x303c, Stride: 1},
+			{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+			{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+			{Lo: 0x31a0, Hi: 0x31bf, Stride: 1},
+			{Lo: 0xa000, Hi: 0xa48c, Stride: 1},
+			{Lo: 0xa4d0, Hi: 0xa4fd, Stride: 1},
+			{Lo: 0xa500, Hi: 0xa60c, Stride: 1},
+			{Lo: 0xa610, Hi: 0xa61f, Stride: 1},
+			{Lo: 0xa62a, Hi: 0xa62b, Stride: 1},
+			{Lo: 0xa640, Hi: 0xa66e, Stride: 1},
+			{Lo: 0xa67f, Hi: 0xa69d, Stride: 1},
+			{Lo: 0xa6a0, Hi: 0xa6ef, Stride: 1},
+			{Lo: 0xa708, Hi: 0xa7dc, Stride: 1},
+			{Lo: 0xa7f1, Hi: 0xa801, Stride: 1},
+			{Lo: 0xa803, Hi: 0xa805, Stride: 1},
+			{Lo: 0xa807, Hi: 0xa80a, Stride: 1},
+			{Lo: 0xa80c, Hi: 0xa822, Stride: 1},
+			{Lo: 0xa840, Hi: 0xa873, Stride: 1},
+			{Lo: 0xa882, Hi: 0xa8b3, Stride: 1},
+			{Lo: 0xa8f2, Hi: 0xa8f7, Stride: 1},
+			{Lo: 0xa8fb, Hi: 0xa8fb, Stride: 1},
+			{Lo: 0xa8fd, Hi: 0xa8fe, Stride: 1},
+			{Lo: 0xa90a, Hi: 0xa925, Stride: 1},
+			{Lo: 0xa930, Hi: 0xa946, Stride: 1},
+			{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+			{Lo: 0xa984, Hi: 0xa9b2, Stride: 1},
+			{Lo: 0xa9cf, Hi: 0xa9cf, Stride: 1},
+			{Lo: 0xaa00, Hi: 0xaa28, Stride: 1},
+			{Lo: 0xaa40, Hi: 0xaa42, Stride: 1},
+			{Lo: 0xaa44, Hi: 0xaa4b, Stride: 1},
+			{Lo: 0xaae0, Hi: 0xaaea, Stride: 1},
+			{Lo: 0xaaf2, Hi: 0xaaf4, Stride: 1},
+			{Lo: 0xab01, Hi: 0xab06, Stride: 1},
+			{Lo: 0xab09, Hi: 0xab0e, Stride: 1},
+			{Lo: 0xab11, Hi: 0xab16, Stride: 1},
+			{Lo: 0xab20, Hi: 0xab26, Stride: 1},
+			{Lo: 0xab28, Hi: 0xab2e, Stride: 1},
+			{Lo: 0xab30, Hi: 0xab69, Stride: 1},
+			{Lo: 0xab70, Hi: 0xabe2, Stride: 1},
+			{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+			{Lo: 0xd7b0, Hi: 0xd7c6, Stride: 1},
+			{Lo: 0xd7cb, Hi: 0xd7fb, Stride: 1},
+			{Lo: 0xfb00, Hi: 0xfb06, Stride: 1},
+			{Lo: 0xfb13, Hi: 0xfb17, Stride: 1},
+			{Lo: 0xfb50, Hi: 0xfbb1, Stride: 1},
+			{Lo: 0xfbd3, Hi: 0xfd3d, Stride: 1},
+			{Lo: 0xfd50, Hi: 0xfd8f, Stride: 1},
+			{Lo: 0xfd92, Hi: 0xfdc7, Stride: 1},
+			{Lo: 0xfdf0, Hi: 0xfdfb, Stride: 1},
+			{Lo: 0xfe70, Hi: 0xfe74, Stride: 1},
+			{Lo: 0xfe76, Hi: 0xfefc, Stride: 1},
+			{Lo: 0xff21, Hi: 0xff3a, Stride: 1},
+			{Lo: 0xff41, Hi: 0xff5a, Stride: 1},
+			{Lo: 0xffa0, Hi: 0xffbe, Stride: 1},
+			{Lo: 0xffc2, Hi: 0xffc7, Stride: 1},
+			{Lo: 0xffca, Hi: 0xffcf, Stride: 1},
+			{Lo: 0xffd2, Hi: 0xffd7, Stride: 1},
+			{Lo: 0xffda, Hi: 0xffdc, Stride: 1},
 		},
-
 		R32: []unicode.Range32{
-			{Lo: 0x10000, Hi: 0x1000B, Stride: 1},
-			{Lo: 0x1000D, Hi: 0x10026, Stride: 1},
-			{Lo: 0x10028, Hi: 0x1003A, Stride: 1},
-			{Lo: 0x1003C, Hi: 0x1003D, Stride: 1},
-			{Lo: 0x1003F, Hi: 0x1004D, Stride: 1},
-			{Lo: 0x10050, Hi: 0x1005D, Stride: 1},
-			{Lo: 0x10080, Hi: 0x100FA, Stride: 1},
+			{Lo: 0x10000, Hi: 0x1000b, Stride: 1},
+			{Lo: 0x1000d, Hi: 0x10026, Stride: 1},
+			{Lo: 0x10028, Hi: 0x1003a, Stride: 1},
+			{Lo: 0x1003c, Hi: 0x1003d, Stride: 1},
+			{Lo: 0x1003f, Hi: 0x1004d, Stride: 1},
+			{Lo: 0x10050, Hi: 0x1005d, Stride: 1},
+			{Lo: 0x10080, Hi: 0x100fa, Stride: 1},
 			{Lo: 0x10140, Hi: 0x10174, Stride: 1},
-			{Lo: 0x10280, Hi: 0x1029C, Stride: 1},
-			{Lo: 0x102A0, Hi: 0x102D0, Stride: 1},
-			{Lo: 0x10300, Hi: 0x1031F, Stride: 1},
-			{Lo: 0x10330, Hi: 0x10340, Stride: 1},
-			{Lo: 0x10341, Hi: 0x10341, Stride: 1},
-			{Lo: 0x10342, Hi: 0x10349, Stride: 1},
-			{Lo: 0x1034A, Hi: 0x1034A, Stride: 1},
+			{Lo: 0x10280, Hi: 0x1029c, Stride: 1},
+			{Lo: 0x102a0, Hi: 0x102d0, Stride: 1},
+			{Lo: 0x10300, Hi: 0x1031f, Stride: 1},
+			{Lo: 0x1032d, Hi: 0x1034a, Stride: 1},
 			{Lo: 0x10350, Hi: 0x10375, Stride: 1},
-			{Lo: 0x10380, Hi: 0x1039D, Stride: 1},
-			{Lo: 0x103A0, Hi: 0x103C3, Stride: 1},
-			{Lo: 0x103C8, Hi: 0x103CF, Stride: 1},
-			{Lo: 0x103D1, Hi: 0x103D5, Stride: 1},
-			{Lo: 0x10400, Hi: 0x1044F, Stride: 1},
-			{Lo: 0x10450, Hi: 0x1049D, Stride: 1},
-			{Lo: 0x104B0, Hi: 0x104D3, Stride: 1},
-			{Lo: 0x104D8, Hi: 0x104FB, Stride: 1},
+			{Lo: 0x10380, Hi: 0x1039d, Stride: 1},
+			{Lo: 0x103a0, Hi: 0x103c3, Stride: 1},
+			{Lo: 0x103c8, Hi: 0x103cf, Stride: 1},
+			{Lo: 0x103d1, Hi: 0x103d5, Stride: 1},
+			{Lo: 0x10400, Hi: 0x1049d, Stride: 1},
+			{Lo: 0x104b0, Hi: 0x104d3, Stride: 1},
+			{Lo: 0x104d8, Hi: 0x104fb, Stride: 1},
 			{Lo: 0x10500, Hi: 0x10527, Stride: 1},
 			{Lo: 0x10530, Hi: 0x10563, Stride: 1},
+			{Lo: 0x10570, Hi: 0x1057a, Stride: 1},
+			{Lo: 0x1057c, Hi: 0x1058a, Stride: 1},
+			{Lo: 0x1058c, Hi: 0x10592, Stride: 1},
+			{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+			{Lo: 0x10597, Hi: 0x105a1, Stride: 1},
+			{Lo: 0x105a3, Hi: 0x105b1, Stride: 1},
+			{Lo: 0x105b3, Hi: 0x105b9, Stride: 1},
+			{Lo: 0x105bb, Hi: 0x105bc, Stride: 1},
+			{Lo: 0x105c0, Hi: 0x105f3, Stride: 1},
 			{Lo: 0x10600, Hi: 0x10736, Stride: 1},
 			{Lo: 0x10740, Hi: 0x10755, Stride: 1},
 			{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+			{Lo: 0x10780, Hi: 0x10785, Stride: 1},
+			{Lo: 0x10787, Hi: 0x107b0, Stride: 1},
+			{Lo: 0x107b2, Hi: 0x107ba, Stride: 1},
 			{Lo: 0x10800, Hi: 0x10805, Stride: 1},
 			{Lo: 0x10808, Hi: 0x10808, Stride: 1},
-			{Lo: 0x1080A, Hi: 0x10835, Stride: 1},
+			{Lo: 0x1080a, Hi: 0x10835, Stride: 1},
 			{Lo: 0x10837, Hi: 0x10838, Stride: 1},
-			{Lo: 0x1083C, Hi: 0x1083C, Stride: 1},
-			{Lo: 0x1083F, Hi: 0x10855, Stride: 1},
+			{Lo: 0x1083c, Hi: 0x1083c, Stride: 1},
+			{Lo: 0x1083f, Hi: 0x10855, Stride: 1},
 			{Lo: 0x10860, Hi: 0x10876, Stride: 1},
-			{Lo: 0x10880, Hi: 0x1089E, Stride: 1},
-			{Lo: 0x108E0, Hi: 0x108F2, Stride: 1},
-			{Lo: 0x108F4, Hi: 0x108F5, Stride: 1},
+			{Lo: 0x10880, Hi: 0x1089e, Stride: 1},
+			{Lo: 0x108e0, Hi: 0x108f2, Stride: 1},
+			{Lo: 0x108f4, Hi: 0x108f5, Stride: 1},
 			{Lo: 0x10900, Hi: 0x10915, Stride: 1},
 			{Lo: 0x10920, Hi: 0x10939, Stride: 1},
-			{Lo: 0x10980, Hi: 0x109B7, Stride: 1},
-			{Lo: 0x109BE, Hi: 0x109BF, Stride: 1},
-			{Lo: 0x10A00, Hi: 0x10A00, Stride: 1},
-			{Lo: 0x10A10, Hi: 0x10A13, Stride: 1},
-			{Lo: 0x10A15, Hi: 0x10A17, Stride: 1},
-			{Lo: 0x10A19, Hi: 0x10A33, Stride: 1},
-			{Lo: 0x10A60, Hi: 0x10A7C, Stride: 1},
-			{Lo: 0x10A80, Hi: 0x10A9C, Stride: 1},
-			{Lo: 0x10AC0, Hi: 0x10AC7, Stride: 1},
-			{Lo: 0x10AC9, Hi: 0x10AE4, Stride: 1},
-			{Lo: 0x10B00, Hi: 0x10B35, Stride: 1},
-			{Lo: 0x10B40, Hi: 0x10B55, Stride: 1},
-			{Lo: 0x10B60, Hi: 0x10B72, Stride: 1},
-			{Lo: 0x10B80, Hi: 0x10B91, Stride: 1},
-			{Lo: 0x10C00, Hi: 0x10C48, Stride: 1},
-			{Lo: 0x10C80, Hi: 0x10CB2, Stride: 1},
-			{Lo: 0x10CC0, Hi: 0x10CF2, Stride: 1},
+			{Lo: 0x10940, Hi: 0x10959, Stride: 1},
+			{Lo: 0x10980, Hi: 0x109b7, Stride: 1},
+			{Lo: 0x109be, Hi: 0x109bf, Stride: 1},
+			{Lo: 0x10a00, Hi: 0x10a00, Stride: 1},
+			{Lo: 0x10a10, Hi: 0x10a13, Stride: 1},
+			{Lo: 0x10a15, Hi: 0x10a17, Stride: 1},
+			{Lo: 0x10a19, Hi: 0x10a35, Stride: 1},
+			{Lo: 0x10a60, Hi: 0x10a7c, Stride: 1},
+			{Lo: 0x10a80, Hi: 0x10a9c, Stride: 1},
+			{Lo: 0x10ac0, Hi: 0x10ac7, Stride: 1},
+			{Lo: 0x10ac9, Hi: 0x10ae4, Stride: 1},
+			{Lo: 0x10b00, Hi: 0x10b35, Stride: 1},
+			{Lo: 0x10b40, Hi: 0x10b55, Stride: 1},
+			{Lo: 0x10b60, Hi: 0x10b72, Stride: 1},
+			{Lo: 0x10b80, Hi: 0x10b91, Stride: 1},
+			{Lo: 0x10c00, Hi: 0x10c48, Stride: 1},
+			{Lo: 0x10c80, Hi: 0x10cb2, Stride: 1},
+			{Lo: 0x10cc0, Hi: 0x10cf2, Stride: 1},
+			{Lo: 0x10d00, Hi: 0x10d23, Stride: 1},
+			{Lo: 0x10d4a, Hi: 0x10d65, Stride: 1},
+			{Lo: 0x10d6f, Hi: 0x10d85, Stride: 1},
+			{Lo: 0x10e80, Hi: 0x10ea9, Stride: 1},
+			{Lo: 0x10eb0, Hi: 0x10eb1, Stride: 1},
+			{Lo: 0x10ec2, Hi: 0x10ec7, Stride: 1},
+			{Lo: 0x10f00, Hi: 0x10f1c, Stride: 1},
+			{Lo: 0x10f27, Hi: 0x10f27, Stride: 1},
+			{Lo: 0x10f30, Hi: 0x10f45, Stride: 1},
+			{Lo: 0x10f70, Hi: 0x10f81, Stride: 1},
+			{Lo: 0x10fb0, Hi: 0x10fc4, Stride: 1},
+			{Lo: 0x10fe0, Hi: 0x10ff6, Stride: 1},
 			{Lo: 0x11003, Hi: 0x11037, Stride: 1},
-			{Lo: 0x11083, Hi: 0x110AF, Stride: 1},
-			{Lo: 0x110D0, Hi: 0x110E8, Stride: 1},
+			{Lo: 0x11071, Hi: 0x11072, Stride: 1},
+			{Lo: 0x11075, Hi: 0x11075, Stride: 1},
+			{Lo: 0x11083, Hi: 0x110af, Stride: 1},
+			{Lo: 0x110d0, Hi: 0x110e8, Stride: 1},
 			{Lo: 0x11103, Hi: 0x11126, Stride: 1},
+			{Lo: 0x11144, Hi: 0x11144, Stride: 1},
+			{Lo: 0x11147, Hi: 0x11147, Stride: 1},
 			{Lo: 0x11150, Hi: 0x11172, Stride: 1},
 			{Lo: 0x11176, Hi: 0x11176, Stride: 1},
-			{Lo: 0x11183, Hi: 0x111B2, Stride: 1},
-			{Lo: 0x111C1, Hi: 0x111C4, Stride: 1},
-			{Lo: 0x111DA, Hi: 0x111DA, Stride: 1},
-			{Lo: 0x111DC, Hi: 0x111DC, Stride: 1},
+			{Lo: 0x11183, Hi: 0x111b2, Stride: 1},
+			{Lo: 0x111c1, Hi: 0x111c4, Stride: 1},
+			{Lo: 0x111da, Hi: 0x111da, Stride: 1},
+			{Lo: 0x111dc, Hi: 0x111dc, Stride: 1},
 			{Lo: 0x11200, Hi: 0x11211, Stride: 1},
-			{Lo: 0x11213, Hi: 0x1122B, Stride: 1},
+			{Lo: 0x11213, Hi: 0x1122b, Stride: 1},
+			{Lo: 0x1123f, Hi: 0x11240, Stride: 1},
 			{Lo: 0x11280, Hi: 0x11286, Stride: 1},
 			{Lo: 0x11288, Hi: 0x11288, Stride: 1},
-			{Lo: 0x1128A, Hi: 0x1128D, Stride: 1},
-			{Lo: 0x1128F, Hi: 0x1129D, Stride: 1},
-			{Lo: 0x1129F, Hi: 0x112A8, Stride: 1},
-			{Lo: 0x112B0, Hi: 0x112DE, Stride: 1},
-			{Lo: 0x11305, Hi: 0x1130C, Stride: 1},
-			{Lo: 0x1130F, Hi: 0x11310, Stride: 1},
+			{Lo: 0x1128a, Hi: 0x1128d, Stride: 1},
+			{Lo: 0x1128f, Hi: 0x1129d, Stride: 1},
+			{Lo: 0x1129f, Hi: 0x112a8, Stride: 1},
+			{Lo: 0x112b0, Hi: 0x112de, Stride: 1},
+			{Lo: 0x11305, Hi: 0x1130c, Stride: 1},
+			{Lo: 0x1130f, Hi: 0x11310, Stride: 1},
 			{Lo: 0x11313, Hi: 0x11328, Stride: 1},
-			{Lo: 0x1132A, Hi: 0x11330, Stride: 1},
+			{Lo: 0x1132a, Hi: 0x11330, Stride: 1},
 			{Lo: 0x11332, Hi: 0x11333, Stride: 1},
 			{Lo: 0x11335, Hi: 0x11339, Stride: 1},
-			{Lo: 0x1133D, Hi: 0x1133D, Stride: 1},
+			{Lo: 0x1133d, Hi: 0x1133d, Stride: 1},
 			{Lo: 0x11350, Hi: 0x11350, Stride: 1},
-			{Lo: 0x1135D, Hi: 0x11361, Stride: 1},
+			{Lo: 0x1135d, Hi: 0x11361, Stride: 1},
+			{Lo: 0x11380, Hi: 0x11389, Stride: 1},
+			{Lo: 0x1138b, Hi: 0x1138b, Stride: 1},
+			{Lo: 0x1138e, Hi: 0x1138e, Stride: 1},
+			{Lo: 0x11390, Hi: 0x113b5, Stride: 1},
+			{Lo: 0x113b7, Hi: 0x113b7, Stride: 1},
+			{Lo: 0x113d1, Hi: 0x113d1, Stride: 1},
+			{Lo: 0x113d3, Hi: 0x113d3, Stride: 1},
 			{Lo: 0x11400, Hi: 0x11434, Stride: 1},
-			{Lo: 0x11447, Hi: 0x1144A, Stride: 1},
-			{Lo: 0x11480, Hi: 0x114AF, Stride: 1},
-			{Lo: 0x114C4, Hi: 0x114C5, Stride: 1},
-			{Lo: 0x114C7, Hi: 0x114C7, Stride: 1},
-			{Lo: 0x11580, Hi: 0x115AE, Stride: 1},
-			{Lo: 0x115D8, Hi: 0x115DB, Stride: 1},
-			{Lo: 0x11600, Hi: 0x1162F, Stride: 1},
+			{Lo: 0x11447, Hi: 0x1144a, Stride: 1},
+			{Lo: 0x1145f, Hi: 0x11461, Stride: 1},
+			{Lo: 0x11480, Hi: 0x114af, Stride: 1},
+			{Lo: 0x114c4, Hi: 0x114c5, Stride: 1},
+			{Lo: 0x114c7, Hi: 0x114c7, Stride: 1},
+			{Lo: 0x11580, Hi: 0x115ae, Stride: 1},
+			{Lo: 0x115d8, Hi: 0x115db, Stride: 1},
+			{Lo: 0x11600, Hi: 0x1162f, Stride: 1},
 			{Lo: 0x11644, Hi: 0x11644, Stride: 1},
-			{Lo: 0x11680, Hi: 0x116AA, Stride: 1},
-			{Lo: 0x118A0, Hi: 0x118DF, Stride: 1},
-			{Lo: 0x118FF, Hi: 0x118FF, Stride: 1},
-			{Lo: 0x11AC0, Hi: 0x11AF8, Stride: 1},
-			{Lo: 0x11C00, Hi: 0x11C08, Stride: 1},
-			{Lo: 0x11C0A, Hi: 0x11C2E, Stride: 1},
-			{Lo: 0x11C40, Hi: 0x11C40, Stride: 1},
-			{Lo: 0x11C72, Hi: 0x11C8F, Stride: 1},
+			{Lo: 0x11680, Hi: 0x116aa, Stride: 1},
+			{Lo: 0x116b8, Hi: 0x116b8, Stride: 1},
+			{Lo: 0x11800, Hi: 0x1182b, Stride: 1},
+			{Lo: 0x118a0, Hi: 0x118df, Stride: 1},
+			{Lo: 0x118ff, Hi: 0x11906, Stride: 1},
+			{Lo: 0x11909, Hi: 0x11909, Stride: 1},
+			{Lo: 0x1190c, Hi: 0x11913, Stride: 1},
+			{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+			{Lo: 0x11918, Hi: 0x1192f, Stride: 1},
+			{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+			{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+			{Lo: 0x119a0, Hi: 0x119a7, Stride: 1},
+			{Lo: 0x119aa, Hi: 0x119d0, Stride: 1},
+			{Lo: 0x119e1, Hi: 0x119e1, Stride: 1},
+			{Lo: 0x119e3, Hi: 0x119e3, Stride: 1},
+			{Lo: 0x11a00, Hi: 0x11a00, Stride: 1},
+			{Lo: 0x11a0b, Hi: 0x11a32, Stride: 1},
+			{Lo: 0x11a3a, Hi: 0x11a3a, Stride: 1},
+			{Lo: 0x11a50, Hi: 0x11a50, Stride: 1},
+			{Lo: 0x11a5c, Hi: 0x11a89, Stride: 1},
+			{Lo: 0x11a9d, Hi: 0x11a9d, Stride: 1},
+			{Lo: 0x11ab0, Hi: 0x11af8, Stride: 1},
+			{Lo: 0x11bc0, Hi: 0x11be0, Stride: 1},
+			{Lo: 0x11c00, Hi: 0x11c08, Stride: 1},
+			{Lo: 0x11c0a, Hi: 0x11c2e, Stride: 1},
+			{Lo: 0x11c40, Hi: 0x11c40, Stride: 1},
+			{Lo: 0x11c72, Hi: 0x11c8f, Stride: 1},
+			{Lo: 0x11d00, Hi: 0x11d06, Stride: 1},
+			{Lo: 0x11d08, Hi: 0x11d09, Stride: 1},
+			{Lo: 0x11d0b, Hi: 0x11d30, Stride: 1},
+			{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+			{Lo: 0x11d60, Hi: 0x11d65, Stride: 1},
+			{Lo: 0x11d67, Hi: 0x11d68, Stride: 1},
+			{Lo: 0x11d6a, Hi: 0x11d89, Stride: 1},
+			{Lo: 0x11d98, Hi: 0x11d98, Stride: 1},
+			{Lo: 0x11db0, Hi: 0x11ddb, Stride: 1},
+			{Lo: 0x11ee0, Hi: 0x11ef2, Stride: 1},
+			{Lo: 0x11f02, Hi: 0x11f02, Stride: 1},
+			{Lo: 0x11f04, Hi: 0x11f10, Stride: 1},
+			{Lo: 0x11f12, Hi: 0x11f33, Stride: 1},
+			{Lo: 0x11fb0, Hi: 0x11fb0, Stride: 1},
 			{Lo: 0x12000, Hi: 0x12399, Stride: 1},
-			{Lo: 0x12400, Hi: 0x1246E, Stride: 1},
+			{Lo: 0x12400, Hi: 0x1246e, Stride: 1},
 			{Lo: 0x12480, Hi: 0x12543, Stride: 1},
-			{Lo: 0x13000, Hi: 0x1342E, Stride: 1},
+			{Lo: 0x12f90, Hi: 0x12ff0, Stride: 1},
+			{Lo: 0x13000, Hi: 0x1342f, Stride: 1},
+			{Lo: 0x13441, Hi: 0x13446, Stride: 1},
+			{Lo: 0x13460, Hi: 0x143fa, Stride: 1},
 			{Lo: 0x14400, Hi: 0x14646, Stride: 1},
-			{Lo: 0x16800, Hi: 0x16A38, Stride: 1},
-			{Lo: 0x16A40, Hi: 0x16A5E, Stride: 1},
-			{Lo: 0x16AD0, Hi: 0x16AED, Stride: 1},
-			{Lo: 0x16B00, Hi: 0x16B2F, Stride: 1},
-			{Lo: 0x16B40, Hi: 0x16B43, Stride: 1},
-			{Lo: 0x16B63, Hi: 0x16B77, Stride: 1},
-			{Lo: 0x16B7D, Hi: 0x16B8F, Stride: 1},
-			{Lo: 0x16F00, Hi: 0x16F44, Stride: 1},
-			{Lo: 0x16F50, Hi: 0x16F50, Stride: 1},
-			{Lo: 0x16F93, Hi: 0x16F9F, Stride: 1},
-			{Lo: 0x16FE0, Hi: 0x16FE0, Stride: 1},
-			{Lo: 0x1BC00, Hi: 0x1BC6A, Stride: 1},
-			{Lo: 0x1BC70, Hi: 0x1BC7C, Stride: 1},
-			{Lo: 0x1BC80, Hi: 0x1BC88, Stride: 1},
-			{Lo: 0x1BC90, Hi: 0x1BC99, Stride: 1},
-			{Lo: 0x1D400, Hi: 0x1D454, Stride: 1},
-			{Lo: 0x1D456, Hi: 0x1D49C, Stride: 1},
-			{Lo: 0x1D49E, Hi: 0x1D49F, Stride: 1},
-			{Lo: 0x1D4A2, Hi: 0x1D4A2, Stride: 1},
-			{Lo: 0x1D4A5, Hi: 0x1D4A6, Stride: 1},
-			{Lo: 0x1D4A9, Hi: 0x1D4AC, Stride: 1},
-			{Lo: 0x1D4AE, Hi: 0x1D4B9, Stride: 1},
-			{Lo: 0x1D4BB, Hi: 0x1D4BB, Stride: 1},
-			{Lo: 0x1D4BD, Hi: 0x1D4C3, Stride: 1},
-			{Lo: 0x1D4C5, Hi: 0x1D505, Stride: 1},
-			{Lo: 0x1D507, Hi: 0x1D50A, Stride: 1},
-			{Lo: 0x1D50D, Hi: 0x1D514, Stride: 1},
-			{Lo: 0x1D516, Hi: 0x1D51C, Stride: 1},
-			{Lo: 0x1D51E, Hi: 0x1D539, Stride: 1},
-			{Lo: 0x1D53B, Hi: 0x1D53E, Stride: 1},
-			{Lo: 0x1D540, Hi: 0x1D544, Stride: 1},
-			{Lo: 0x1D546, Hi: 0x1D546, Stride: 1},
-			{Lo: 0x1D54A, Hi: 0x1D550, Stride: 1},
-			{Lo: 0x1D552, Hi: 0x1D6A5, Stride: 1},
-			{Lo: 0x1D6A8, Hi: 0x1D6C0, Stride: 1},
-			{Lo: 0x1D6C2, Hi: 0x1D6DA, Stride: 1},
-			{Lo: 0x1D6DC, Hi: 0x1D6FA, Stride: 1},
-			{Lo: 0x1D6FC, Hi: 0x1D714, Stride: 1},
-			{Lo: 0x1D716, Hi: 0x1D734, Stride: 1},
-			{Lo: 0x1D736, Hi: 0x1D74E, Stride: 1},
-			{Lo: 0x1D750, Hi: 0x1D76E, Stride: 1},
-			{Lo: 0x1D770, Hi: 0x1D788, Stride: 1},
-			{Lo: 0x1D78A, Hi: 0x1D7A8, Stride: 1},
-			{Lo: 0x1D7AA, Hi: 0x1D7C2, Stride: 1},
-			{Lo: 0x1D7C4, Hi: 0x1D7CB, Stride: 1},
-			{Lo: 0x1E800, Hi: 0x1E8C4, Stride: 1},
-			{Lo: 0x1E900, Hi: 0x1E943, Stride: 1},
-			{Lo: 0x1EE00, Hi: 0x1EE03, Stride: 1},
-			{Lo: 0x1EE05, Hi: 0x1EE1F, Stride: 1},
-			{Lo: 0x1EE21, Hi: 0x1EE22, Stride: 1},
-			{Lo: 0x1EE24, Hi: 0x1EE24, Stride: 1},
-			{Lo: 0x1EE27, Hi: 0x1EE27, Stride: 1},
-			{Lo: 0x1EE29, Hi: 0x1EE32, Stride: 1},
-			{Lo: 0x1EE34, Hi: 0x1EE37, Stride: 1},
-			{Lo: 0x1EE39, Hi: 0x1EE39, Stride: 1},
-			{Lo: 0x1EE3B, Hi: 0x1EE3B, Stride: 1},
-			{Lo: 0x1EE42, Hi: 0x1EE42, Stride: 1},
-			{Lo: 0x1EE47, Hi: 0x1EE47, Stride: 1},
-			{Lo: 0x1EE49, Hi: 0x1EE49, Stride: 1},
-			{Lo: 0x1EE4B, Hi: 0x1EE4B, Stride: 1},
-			{Lo: 0x1EE4D, Hi: 0x1EE4F, Stride: 1},
-			{Lo: 0x1EE51, Hi: 0x1EE52, Stride: 1},
-			{Lo: 0x1EE54, Hi: 0x1EE54, Stride: 1},
-			{Lo: 0x1EE57, Hi: 0x1EE57, Stride: 1},
-			{Lo: 0x1EE59, Hi: 0x1EE59, Stride: 1},
-			{Lo: 0x1EE5B, Hi: 0x1EE5B, Stride: 1},
-			{Lo: 0x1EE5D, Hi: 0x1EE5D, Stride: 1},
-			{Lo: 0x1EE5F, Hi: 0x1EE5F, Stride: 1},
-			{Lo: 0x1EE61, Hi: 0x1EE62, Stride: 1},
-			{Lo: 0x1EE64, Hi: 0x1EE64, Stride: 1},
-			{Lo: 0x1EE67, Hi: 0x1EE6A, Stride: 1},
-			{Lo: 0x1EE6C, Hi: 0x1EE72, Stride: 1},
-			{Lo: 0x1EE74, Hi: 0x1EE77, Stride: 1},
-			{Lo: 0x1EE79, Hi: 0x1EE7C, Stride: 1},
-			{Lo: 0x1EE7E, Hi: 0x1EE7E, Stride: 1},
-			{Lo: 0x1EE80, Hi: 0x1EE89, Stride: 1},
-			{Lo: 0x1EE8B, Hi: 0x1EE9B, Stride: 1},
-			{Lo: 0x1EEA1, Hi: 0x1EEA3, Stride: 1},
-			{Lo: 0x1EEA5, Hi: 0x1EEA9, Stride: 1},
-			{Lo: 0x1EEAB, Hi: 0x1EEBB, Stride: 1},
-			{Lo: 0x1F130, Hi: 0x1F149, Stride: 1},
-			{Lo: 0x1F150, Hi: 0x1F169, Stride: 1},
-			{Lo: 0x1F170, Hi: 0x1F189, Stride: 1}},
-	}
-
-	tableEBase = &unicode.RangeTable{
-		R16: []unicode.Range16{
-			{Lo: 0x261D, Hi: 0x261D, Stride: 1},
-			{Lo: 0x26F9, Hi: 0x26F9, Stride: 1},
-			{Lo: 0x270A, Hi: 0x270D, Stride: 1},
-		},
-
-		R32: []unicode.Range32{
-			{Lo: 0x1F385, Hi: 0x1F385, Stride: 1},
-			{Lo: 0x1F3C3, Hi: 0x1F3C4, Stride: 1},
-			{Lo: 0x1F3CA, Hi: 0x1F3CB, Stride: 1},
-			{Lo: 0x1F442, Hi: 0x1F443, Stride: 1},
-			{Lo: 0x1F446, Hi: 0x1F450, Stride: 1},
-			{Lo: 0x1F46E, Hi: 0x1F46E, Stride: 1},
-			{Lo: 0x1F470, Hi: 0x1F478, Stride: 1},
-			{Lo: 0x1F47C, Hi: 0x1F47C, Stride: 1},
-			{Lo: 0x1F481, Hi: 0x1F483, Stride: 1},
-			{Lo: 0x1F485, Hi: 0x1F487, Stride: 1},
-			{Lo: 0x1F4AA, Hi: 0x1F4AA, Stride: 1},
-			{Lo: 0x1F575, Hi: 0x1F575, Stride: 1},
-			{Lo: 0x1F57A, Hi: 0x1F57A, Stride: 1},
-			{Lo: 0x1F590, Hi: 0x1F590, Stride: 1},
-			{Lo: 0x1F595, Hi: 0x1F596, Stride: 1},
-			{Lo: 0x1F645, Hi: 0x1F647, Stride: 1},
-			{Lo: 0x1F64B, Hi: 0x1F64F, Stride: 1},
-			{Lo: 0x1F6A3, Hi: 0x1F6A3, Stride: 1},
-			{Lo: 0x1F6B4, Hi: 0x1F6B6, Stride: 1},
-			{Lo: 0x1F6C0, Hi: 0x1F6C0, Stride: 1},
-			{Lo: 0x1F918, Hi: 0x1F91E, Stride: 1},
-			{Lo: 0x1F926, Hi: 0x1F926, Stride: 1},
-			{Lo: 0x1F930, Hi: 0x1F930, Stride: 1},
-			{Lo: 0x1F933, Hi: 0x1F939, Stride: 1},
-			{Lo: 0x1F93C, Hi: 0x1F93E, Stride: 1},
-		},
-	}
-
-	tableEBaseGAZ = &unicode.RangeTable{
-		R32: []unicode.Range32{
-			{Lo: 0x1F466, Hi: 0x1F469, Stride: 1},
-		},
-	}
-
-	tableEModifier = &unicode.RangeTable{
-		R32: []unicode.Range32{
-			{Lo: 0x1F3FB, Hi: 0x1F3FF, Stride: 1},
+			{Lo: 0x16100, Hi: 0x1611d, Stride: 1},
+			{Lo: 0x16800, Hi: 0x16a38, Stride: 1},
+			{Lo: 0x16a40, Hi: 0x16a5e, Stride: 1},
+			{Lo: 0x16a70, Hi: 0x16abe, Stride: 1},
+			{Lo: 0x16ad0, Hi: 0x16aed, Stride: 1},
+			{Lo: 0x16b00, Hi: 0x16b2f, Stride: 1},
+			{Lo: 0x16b40, Hi: 0x16b43, Stride: 1},
+			{Lo: 0x16b63, Hi: 0x16b77, Stride: 1},
+			{Lo: 0x16b7d, Hi: 0x16b8f, Stride: 1},
+			{Lo: 0x16d40, Hi: 0x16d6c, Stride: 1},
+			{Lo: 0x16e40, Hi: 0x16e7f, Stride: 1},
+			{Lo: 0x16ea0, Hi: 0x16eb8, Stride: 1},
+			{Lo: 0x16ebb, Hi: 0x16ed3, Stride: 1},
+			{Lo: 0x16f00, Hi: 0x16f4a, Stride: 1},
+			{Lo: 0x16f50, Hi: 0x16f50, Stride: 1},
+			{Lo: 0x16f93, Hi: 0x16f9f, Stride: 1},
+			{Lo: 0x16fe0, Hi: 0x16fe1, Stride: 1},
+			{Lo: 0x16fe3, Hi: 0x16fe3, Stride: 1},
+			{Lo: 0x1bc00, Hi: 0x1bc6a, Stride: 1},
+			{Lo: 0x1bc70, Hi: 0x1bc7c, Stride: 1},
+			{Lo: 0x1bc80, Hi: 0x1bc88, Stride: 1},
+			{Lo: 0x1bc90, Hi: 0x1bc99, Stride: 1},
+			{Lo: 0x1d400, Hi: 0x1d454, Stride: 1},
+			{Lo: 0x1d456, Hi: 0x1d49c, Stride: 1},
+			{Lo: 0x1d49e, Hi: 0x1d49f, Stride: 1},
+			{Lo: 0x1d4a2, Hi: 0x1d4a2, Stride: 1},
+			{Lo: 0x1d4a5, Hi: 0x1d4a6, Stride: 1},
+			{Lo: 0x1d4a9, Hi: 0x1d4ac, Stride: 1},
+			{Lo: 0x1d4ae, Hi: 0x1d4b9, Stride: 1},
+			{Lo: 0x1d4bb, Hi: 0x1d4bb, Stride: 1},
+			{Lo: 0x1d4bd, Hi: 0x1d4c3, Stride: 1},
+			{Lo: 0x1d4c5, Hi: 0x1d505, Stride: 1},
+			{Lo: 0x1d507, Hi: 0x1d50a, Stride: 1},
+			{Lo: 0x1d50d, Hi: 0x1d514, Stride: 1},
+			{Lo: 0x1d516, Hi: 0x1d51c, Stride: 1},
+			{Lo: 0x1d51e, Hi: 0x1d539, Stride: 1},
+			{Lo: 0x1d53b, Hi: 0x1d53e, Stride: 1},
+			{Lo: 0x1d540, Hi: 0x1d544, Stride: 1},
+			{Lo: 0x1d546, Hi: 0x1d546, Stride: 1},
+			{Lo: 0x1d54a, Hi: 0x1d550, Stride: 1},
+			{Lo: 0x1d552, Hi: 0x1d6a5, Stride: 1},
+			{Lo: 0x1d6a8, Hi: 0x1d6c0, Stride: 1},
+			{Lo: 0x1d6c2, Hi: 0x1d6da, Stride: 1},
+			{Lo: 0x1d6dc, Hi: 0x1d6fa, Stride: 1},
+			{Lo: 0x1d6fc, Hi: 0x1d714, Stride: 1},
+			{Lo: 0x1d716, Hi: 0x1d734, Stride: 1},
+			{Lo: 0x1d736, Hi: 0x1d74e, Stride: 1},
+			{Lo: 0x1d750, Hi: 0x1d76e, Stride: 1},
+			{Lo: 0x1d770, Hi: 0x1d788, Stride: 1},
+			{Lo: 0x1d78a, Hi: 0x1d7a8, Stride: 1},
+			{Lo: 0x1d7aa, Hi: 0x1d7c2, Stride: 1},
+			{Lo: 0x1d7c4, Hi: 0x1d7cb, Stride: 1},
+			{Lo: 0x1df00, Hi: 0x1df1e, Stride: 1},
+			{Lo: 0x1df25, Hi: 0x1df2a, Stride: 1},
+			{Lo: 0x1e030, Hi: 0x1e06d, Stride: 1},
+			{Lo: 0x1e100, Hi: 0x1e12c, Stride: 1},
+			{Lo: 0x1e137, Hi: 0x1e13d, Stride: 1},
+			{Lo: 0x1e14e, Hi: 0x1e14e, Stride: 1},
+			{Lo: 0x1e290, Hi: 0x1e2ad, Stride: 1},
+			{Lo: 0x1e2c0, Hi: 0x1e2eb, Stride: 1},
+			{Lo: 0x1e4d0, Hi: 0x1e4eb, Stride: 1},
+			{Lo: 0x1e5d0, Hi: 0x1e5ed, Stride: 1},
+			{Lo: 0x1e5f0, Hi: 0x1e5f0, Stride: 1},
+			{Lo: 0x1e7e0, Hi: 0x1e7e6, Stride: 1},
+			{Lo: 0x1e7e8, Hi: 0x1e7eb, Stride: 1},
+			{Lo: 0x1e7ed, Hi: 0x1e7ee, Stride: 1},
+			{Lo: 0x1e7f0, Hi: 0x1e7fe, Stride: 1},
+			{Lo: 0x1e800, Hi: 0x1e8c4, Stride: 1},
+			{Lo: 0x1e900, Hi: 0x1e943, Stride: 1},
+			{Lo: 0x1e94b, Hi: 0x1e94b, Stride: 1},
+			{Lo: 0x1ee00, Hi: 0x1ee03, Stride: 1},
+			{Lo: 0x1ee05, Hi: 0x1ee1f, Stride: 1},
+			{Lo: 0x1ee21, Hi: 0x1ee22, Stride: 1},
+			{Lo: 0x1ee24, Hi: 0x1ee24, Stride: 1},
+			{Lo: 0x1ee27, Hi: 0x1ee27, Stride: 1},
+			{Lo: 0x1ee29, Hi: 0x1ee32, Stride: 1},
+			{Lo: 0x1ee34, Hi: 0x1ee37, Stride: 1},
+			{Lo: 0x1ee39, Hi: 0x1ee39, Stride: 1},
+			{Lo: 0x1ee3b, Hi: 0x1ee3b, Stride: 1},
+			{Lo: 0x1ee42, Hi: 0x1ee42, Stride: 1},
+			{Lo: 0x1ee47, Hi: 0x1ee47, Stride: 1},
+			{Lo: 0x1ee49, Hi: 0x1ee49, Stride: 1},
+			{Lo: 0x1ee4b, Hi: 0x1ee4b, Stride: 1},
+			{Lo: 0x1ee4d, Hi: 0x1ee4f, Stride: 1},
+			{Lo: 0x1ee51, Hi: 0x1ee52, Stride: 1},
+			{Lo: 0x1ee54, Hi: 0x1ee54, Stride: 1},
+			{Lo: 0x1ee57, Hi: 0x1ee57, Stride: 1},
+			{Lo: 0x1ee59, Hi: 0x1ee59, Stride: 1},
+			{Lo: 0x1ee5b, Hi: 0x1ee5b, Stride: 1},
+			{Lo: 0x1ee5d, Hi: 0x1ee5d, Stride: 1},
+			{Lo: 0x1ee5f, Hi: 0x1ee5f, Stride: 1},
+			{Lo: 0x1ee61, Hi: 0x1ee62, Stride: 1},
+			{Lo: 0x1ee64, Hi: 0x1ee64, Stride: 1},
+			{Lo: 0x1ee67, Hi: 0x1ee6a, Stride: 1},
+			{Lo: 0x1ee6c, Hi: 0x1ee72, Stride: 1},
+			{Lo: 0x1ee74, Hi: 0x1ee77, Stride: 1},
+			{Lo: 0x1ee79, Hi: 0x1ee7c, Stride: 1},
+			{Lo: 0x1ee7e, Hi: 0x1ee7e, Stride: 1},
+			{Lo: 0x1ee80, Hi: 0x1ee89, Stride: 1},
+			{Lo: 0x1ee8b, Hi: 0x1ee9b, Stride: 1},
+			{Lo: 0x1eea1, Hi: 0x1eea3, Stride: 1},
+			{Lo: 0x1eea5, Hi: 0x1eea9, Stride: 1},
+			{Lo: 0x1eeab, Hi: 0x1eebb, Stride: 1},
+			{Lo: 0x1f130, Hi: 0x1f149, Stride: 1},
+			{Lo: 0x1f150, Hi: 0x1f169, Stride: 1},
+			{Lo: 0x1f170, Hi: 0x1f189, Stride: 1},
 		},
 	}
 
 	tableExtend = &unicode.RangeTable{
 		R16: []unicode.Range16{
 			{Lo: 0x0300, Hi: 0x036f, Stride: 1},
-			{Lo: 0x0483, Hi: 0x0487, Stride: 1},
-			{Lo: 0x0488, Hi: 0x0489, Stride: 1},
+			{Lo: 0x0483, Hi: 0x0489, Stride: 1},
 			{Lo: 0x0591, Hi: 0x05bd, Stride: 1},
 			{Lo: 0x05bf, Hi: 0x05bf, Stride: 1},
 			{Lo: 0x05c1, Hi: 0x05c2, Stride: 1},
@@ -635,108 +637,79 @@ var (
 			{Lo: 0x0730, Hi: 0x074a, Stride: 1},
 			{Lo: 0x07a6, Hi: 0x07b0, Stride: 1},
 			{Lo: 0x07eb, Hi: 0x07f3, Stride: 1},
+			{Lo: 0x07fd, Hi: 0x07fd, Stride: 1},
 			{Lo: 0x0816, Hi: 0x0819, Stride: 1},
 			{Lo: 0x081b, Hi: 0x0823, Stride: 1},
 			{Lo: 0x0825, Hi: 0x0827, Stride: 1},
 			{Lo: 0x0829, Hi: 0x082d, Stride: 1},
 			{Lo: 0x0859, Hi: 0x085b, Stride: 1},
-			{Lo: 0x08d4, Hi: 0x08e1, Stride: 1},
-			{Lo: 0x08e3, Hi: 0x0902, Stride: 1},
-			{Lo: 0x0903, Hi: 0x0903, Stride: 1},
-			{Lo: 0x093a, Hi: 0x093a, Stride: 1},
-			{Lo: 0x093b, Hi: 0x093b, Stride: 1},
-			{Lo: 0x093c, Hi: 0x093c, Stride: 1},
-			{Lo: 0x093e, Hi: 0x0940, Stride: 1},
-			{Lo: 0x0941, Hi: 0x0948, Stride: 1},
-			{Lo: 0x0949, Hi: 0x094c, Stride: 1},
-			{Lo: 0x094d, Hi: 0x094d, Stride: 1},
-			{Lo: 0x094e, Hi: 0x094f, Stride: 1},
+			{Lo: 0x0897, Hi: 0x089f, Stride: 1},
+			{Lo: 0x08ca, Hi: 0x08e1, Stride: 1},
+			{Lo: 0x08e3, Hi: 0x0903, Stride: 1},
+			{Lo: 0x093a, Hi: 0x093c, Stride: 1},
+			{Lo: 0x093e, Hi: 0x094f, Stride: 1},
 			{Lo: 0x0951, Hi: 0x0957, Stride: 1},
 			{Lo: 0x0962, Hi: 0x0963, Stride: 1},
-			{Lo: 0x0981, Hi: 0x0981, Stride: 1},
-			{Lo: 0x0982, Hi: 0x0983, Stride: 1},
+			{Lo: 0x0981, Hi: 0x0983, Stride: 1},
 			{Lo: 0x09bc, Hi: 0x09bc, Stride: 1},
-			{Lo: 0x09be, Hi: 0x09c0, Stride: 1},
-			{Lo: 0x09c1, Hi: 0x09c4, Stride: 1},
+			{Lo: 0x09be, Hi: 0x09c4, Stride: 1},
 			{Lo: 0x09c7, Hi: 0x09c8, Stride: 1},
-			{Lo: 0x09cb, Hi: 0x09cc, Stride: 1},
-			{Lo: 0x09cd, Hi: 0x09cd, Stride: 1},
+			{Lo: 0x09cb, Hi: 0x09cd, Stride: 1},
 			{Lo: 0x09d7, Hi: 0x09d7, Stride: 1},
 			{Lo: 0x09e2, Hi: 0x09e3, Stride: 1},
-			{Lo: 0x0a01, Hi: 0x0a02, Stride: 1},
-			{Lo: 0x0a03, Hi: 0x0a03, Stride: 1},
+			{Lo: 0x09fe, Hi: 0x09fe, Stride: 1},
+			{Lo: 0x0a01, Hi: 0x0a03, Stride: 1},
 			{Lo: 0x0a3c, Hi: 0x0a3c, Stride: 1},
-			{Lo: 0x0a3e, Hi: 0x0a40, Stride: 1},
-			{Lo: 0x0a41, Hi: 0x0a42, Stride: 1},
+			{Lo: 0x0a3e, Hi: 0x0a42, Stride: 1},
 			{Lo: 0x0a47, Hi: 0x0a48, Stride: 1},
 			{Lo: 0x0a4b, Hi: 0x0a4d, Stride: 1},
 			{Lo: 0x0a51, Hi: 0x0a51, Stride: 1},
 			{Lo: 0x0a70, Hi: 0x0a71, Stride: 1},
 			{Lo: 0x0a75, Hi: 0x0a75, Stride: 1},
-			{Lo: 0x0a81, Hi: 0x0a82, Stride: 1},
-			{Lo: 0x0a83, Hi: 0x0a83, Stride: 1},
+			{Lo: 0x0a81, Hi: 0x0a83, Stride: 1},
 			{Lo: 0x0abc, Hi: 0x0abc, Stride: 1},
-			{Lo: 0x0abe, Hi: 0x0ac0, Stride: 1},
-			{Lo: 0x0ac1, Hi: 0x0ac5, Stride: 1},
-			{Lo: 0x0ac7, Hi: 0x0ac8, Stride: 1},
-			{Lo: 0x0ac9, Hi: 0x0ac9, Stride: 1},
-			{Lo: 0x0acb, Hi: 0x0acc, Stride: 1},
-			{Lo: 0x0acd, Hi: 0x0acd, Stride: 1},
+			{Lo: 0x0abe, Hi: 0x0ac5, Stride: 1},
+			{Lo: 0x0ac7, Hi: 0x0ac9, Stride: 1},
+			{Lo: 0x0acb, Hi: 0x0acd, Stride: 1},
 			{Lo: 0x0ae2, Hi: 0x0ae3, Stride: 1},
-			{Lo: 0x0b01, Hi: 0x0b01, Stride: 1},
-			{Lo: 0x0b02, Hi: 0x0b03, Stride: 1},
+			{Lo: 0x0afa, Hi: 0x0aff, Stride: 1},
+			{Lo: 0x0b01, Hi: 0x0b03, Stride: 1},
 			{Lo: 0x0b3c, Hi: 0x0b3c, Stride: 1},
-			{Lo: 0x0b3e, Hi: 0x0b3e, Stride: 1},
-			{Lo: 0x0b3f, Hi: 0x0b3f, Stride: 1},
-			{Lo: 0x0b40, Hi: 0x0b40, Stride: 1},
-			{Lo: 0x0b41, Hi: 0x0b44, Stride: 1},
+			{Lo: 0x0b3e, Hi: 0x0b44, Stride: 1},
 			{Lo: 0x0b47, Hi: 0x0b48, Stride: 1},
-			{Lo: 0x0b4b, Hi: 0x0b4c, Stride: 1},
-			{Lo: 0x0b4d, Hi: 0x0b4d, Stride: 1},
-			{Lo: 0x0b56, Hi: 0x0b56, Stride: 1},
-			{Lo: 0x0b57, Hi: 0x0b57, Stride: 1},
+			{Lo: 0x0b4b, Hi: 0x0b4d, Stride: 1},
+			{Lo: 0x0b55, Hi: 0x0b57, Stride: 1},
 			{Lo: 0x0b62, Hi: 0x0b63, Stride: 1},
 			{Lo: 0x0b82, Hi: 0x0b82, Stride: 1},
-			{Lo: 0x0bbe, Hi: 0x0bbf, Stride: 1},
-			{Lo: 0x0bc0, Hi: 0x0bc0, Stride: 1},
-			{Lo: 0x0bc1, Hi: 0x0bc2, Stride: 1},
+			{Lo: 0x0bbe, Hi: 0x0bc2, Stride: 1},
 			{Lo: 0x0bc6, Hi: 0x0bc8, Stride: 1},
-			{Lo: 0x0bca, Hi: 0x0bcc, Stride: 1},
-			{Lo: 0x0bcd, Hi: 0x0bcd, Stride: 1},
+			{Lo: 0x0bca, Hi: 0x0bcd, Stride: 1},
 			{Lo: 0x0bd7, Hi: 0x0bd7, Stride: 1},
-			{Lo: 0x0c00, Hi: 0x0c00, Stride: 1},
-			{Lo: 0x0c01, Hi: 0x0c03, Stride: 1},
-			{Lo: 0x0c3e, Hi: 0x0c40, Stride: 1},
-			{Lo: 0x0c41, Hi: 0x0c44, Stride: 1},
+			{Lo: 0x0c00, Hi: 0x0c04, Stride: 1},
+			{Lo: 0x0c3c, Hi: 0x0c3c, Stride: 1},
+			{Lo: 0x0c3e, Hi: 0x0c44, Stride: 1},
 			{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
 			{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
 			{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
 			{Lo: 0x0c62, Hi: 0x0c63, Stride: 1},
-			{Lo: 0x0c81, Hi: 0x0c81, Stride: 1},
-			{Lo: 0x0c82, Hi: 0x0c83, Stride: 1},
+			{Lo: 0x0c81, Hi: 0x0c83, Stride: 1},
 			{Lo: 0x0cbc, Hi: 0x0cbc, Stride: 1},
-			{Lo: 0x0cbe, Hi: 0x0cbe, Stride: 1},
-			{Lo: 0x0cbf, Hi: 0x0cbf, Stride: 1},
-			{Lo: 0x0cc0, Hi: 0x0cc4, Stride: 1},
-			{Lo: 0x0cc6, Hi: 0x0cc6, Stride: 1},
-			{Lo: 0x0cc7, Hi: 0x0cc8, Stride: 1},
-			{Lo: 0x0cca, Hi: 0x0ccb, Stride: 1},
-			{Lo: 0x0ccc, Hi: 0x0ccd, Stride: 1},
+			{Lo: 0x0cbe, Hi: 0x0cc4, Stride: 1},
+			{Lo: 0x0cc6, Hi: 0x0cc8, Stride: 1},
+			{Lo: 0x0cca, Hi: 0x0ccd, Stride: 1},
 			{Lo: 0x0cd5, Hi: 0x0cd6, Stride: 1},
 			{Lo: 0x0ce2, Hi: 0x0ce3, Stride: 1},
-			{Lo: 0x0d01, Hi: 0x0d01, Stride: 1},
-			{Lo: 0x0d02, Hi: 0x0d03, Stride: 1},
-			{Lo: 0x0d3e, Hi: 0x0d40, Stride: 1},
-			{Lo: 0x0d41, Hi: 0x0d44, Stride: 1},
+			{Lo: 0x0cf3, Hi: 0x0cf3, Stride: 1},
+			{Lo: 0x0d00, Hi: 0x0d03, Stride: 1},
+			{Lo: 0x0d3b, Hi: 0x0d3c, Stride: 1},
+			{Lo: 0x0d3e, Hi: 0x0d44, Stride: 1},
 			{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
-			{Lo: 0x0d4a, Hi: 0x0d4c, Stride: 1},
-			{Lo: 0x0d4d, Hi: 0x0d4d, Stride: 1},
+			{Lo: 0x0d4a, Hi: 0x0d4d, Stride: 1},
 			{Lo: 0x0d57, Hi: 0x0d57, Stride: 1},
 			{Lo: 0x0d62, Hi: 0x0d63, Stride: 1},
-			{Lo: 0x0d82, Hi: 0x0d83, Stride: 1},
+			{Lo: 0x0d81, Hi: 0x0d83, Stride: 1},
 			{Lo: 0x0dca, Hi: 0x0dca, Stride: 1},
-			{Lo: 0x0dcf, Hi: 0x0dd1, Stride: 1},
-			{Lo: 0x0dd2, Hi: 0x0dd4, Stride: 1},
+			{Lo: 0x0dcf, Hi: 0x0dd4, Stride: 1},
 			{Lo: 0x0dd6, Hi: 0x0dd6, Stride: 1},
 			{Lo: 0x0dd8, Hi: 0x0ddf, Stride: 1},
 			{Lo: 0x0df2, Hi: 0x0df3, Stride: 1},
@@ -744,309 +717,210 @@ var (
 			{Lo: 0x0e34, Hi: 0x0e3a, Stride: 1},
 			{Lo: 0x0e47, Hi: 0x0e4e, Stride: 1},
 			{Lo: 0x0eb1, Hi: 0x0eb1, Stride: 1},
-			{Lo: 0x0eb4, Hi: 0x0eb9, Stride: 1},
-			{Lo: 0x0ebb, Hi: 0x0ebc, Stride: 1},
-			{Lo: 0x0ec8, Hi: 0x0ecd, Stride: 1},
+			{Lo: 0x0eb4, Hi: 0x0ebc, Stride: 1},
+			{Lo: 0x0ec8, Hi: 0x0ece, Stride: 1},
 			{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
 			{Lo: 0x0f35, Hi: 0x0f35, Stride: 1},
 			{Lo: 0x0f37, Hi: 0x0f37, Stride: 1},
 			{Lo: 0x0f39, Hi: 0x0f39, Stride: 1},
 			{Lo: 0x0f3e, Hi: 0x0f3f, Stride: 1},
-			{Lo: 0x0f71, Hi: 0x0f7e, Stride: 1},
-			{Lo: 0x0f7f, Hi: 0x0f7f, Stride: 1},
-			{Lo: 0x0f80, Hi: 0x0f84, Stride: 1},
+			{Lo: 0x0f71, Hi: 0x0f84, Stride: 1},
 			{Lo: 0x0f86, Hi: 0x0f87, Stride: 1},
 			{Lo: 0x0f8d, Hi: 0x0f97, Stride: 1},
 			{Lo: 0x0f99, Hi: 0x0fbc, Stride: 1},
 			{Lo: 0x0fc6, Hi: 0x0fc6, Stride: 1},
-			{Lo: 0x102b, Hi: 0x102c, Stride: 1},
-			{Lo: 0x102d, Hi: 0x1030, Stride: 1},
-			{Lo: 0x1031, Hi: 0x1031, Stride: 1},
-			{Lo: 0x1032, Hi: 0x1037, Stride: 1},
-			{Lo: 0x1038, Hi: 0x1038, Stride: 1},
-			{Lo: 0x1039, Hi: 0x103a, Stride: 1},
-			{Lo: 0x103b, Hi: 0x103c, Stride: 1},
-			{Lo: 0x103d, Hi: 0x103e, Stride: 1},
-			{Lo: 0x1056, Hi: 0x1057, Stride: 1},
-			{Lo: 0x1058, Hi: 0x1059, Stride: 1},
+			{Lo: 0x102b, Hi: 0x103e, Stride: 1},
+			{Lo: 0x1056, Hi: 0x1059, Stride: 1},
 			{Lo: 0x105e, Hi: 0x1060, Stride: 1},
 			{Lo: 0x1062, Hi: 0x1064, Stride: 1},
 			{Lo: 0x1067, Hi: 0x106d, Stride: 1},
 			{Lo: 0x1071, Hi: 0x1074, Stride: 1},
-			{Lo: 0x1082, Hi: 0x1082, Stride: 1},
-			{Lo: 0x1083, Hi: 0x1084, Stride: 1},
-			{Lo: 0x1085, Hi: 0x1086, Stride: 1},
-			{Lo: 0x1087, Hi: 0x108c, Stride: 1},
-			{Lo: 0x108d, Hi: 0x108d, Stride: 1},
+			{Lo: 0x1082, Hi: 0x108d, Stride: 1},
 			{Lo: 0x108f, Hi: 0x108f, Stride: 1},
-			{Lo: 0x109a, Hi: 0x109c, Stride: 1},
-			{Lo: 0x109d, Hi: 0x109d, Stride: 1},
+			{Lo: 0x109a, Hi: 0x109d, Stride: 1},
 			{Lo: 0x135d, Hi: 0x135f, Stride: 1},
-			{Lo: 0x1712, Hi: 0x1714, Stride: 1},
+			{Lo: 0x1712, Hi: 0x1715, Stride: 1},
 			{Lo: 0x1732, Hi: 0x1734, Stride: 1},
 			{Lo: 0x1752, Hi: 0x1753, Stride: 1},
 			{Lo: 0x1772, Hi: 0x1773, Stride: 1},
-			{Lo: 0x17b4, Hi: 0x17b5, Stride: 1},
-			{Lo: 0x17b6, Hi: 0x17b6, Stride: 1},
-			{Lo: 0x17b7, Hi: 0x17bd, Stride: 1},
-			{Lo: 0x17be, Hi: 0x17c5, Stride: 1},
-			{Lo: 0x17c6, Hi: 0x17c6, Stride: 1},
-			{Lo: 0x17c7, Hi: 0x17c8, Stride: 1},
-			{Lo: 0x17c9, Hi: 0x17d3, Stride: 1},
+			{Lo: 0x17b4, Hi: 0x17d3, Stride: 1},
 			{Lo: 0x17dd, Hi: 0x17dd, Stride: 1},
 			{Lo: 0x180b, Hi: 0x180d, Stride: 1},
+			{Lo: 0x180f, Hi: 0x180f, Stride: 1},
 			{Lo: 0x1885, Hi: 0x1886, Stride: 1},
 			{Lo: 0x18a9, Hi: 0x18a9, Stride: 1},
-			{Lo: 0x1920, Hi: 0x1922, Stride: 1},
-			{Lo: 0x1923, Hi: 0x1926, Stride: 1},
-			{Lo: 0x1927, Hi: 0x1928, Stride: 1},
-			{Lo: 0x1929, Hi: 0x192b, Stride: 1},
-			{Lo: 0x1930, Hi: 0x1931, Stride: 1},
-			{Lo: 0x1932, Hi: 0x1932, Stride: 1},
-			{Lo: 0x1933, Hi: 0x1938, Stride: 1},
-			{Lo: 0x1939, Hi: 0x193b, Stride: 1},
-			{Lo: 0x1a17, Hi: 0x1a18, Stride: 1},
-			{Lo: 0x1a19, Hi: 0x1a1a, Stride: 1},
-			{Lo: 0x1a1b, Hi: 0x1a1b, Stride: 1},
-			{Lo: 0x1a55, Hi: 0x1a55, Stride: 1},
-			{Lo: 0x1a56, Hi: 0x1a56, Stride: 1},
-			{Lo: 0x1a57, Hi: 0x1a57, Stride: 1},
-			{Lo: 0x1a58, Hi: 0x1a5e, Stride: 1},
-			{Lo: 0x1a60, Hi: 0x1a60, Stride: 1},
-			{Lo: 0x1a61, Hi: 0x1a61, Stride: 1},
-			{Lo: 0x1a62, Hi: 0x1a62, Stride: 1},
-			{Lo: 0x1a63, Hi: 0x1a64, Stride: 1},
-			{Lo: 0x1a65, Hi: 0x1a6c, Stride: 1},
-			{Lo: 0x1a6d, Hi: 0x1a72, Stride: 1},
-			{Lo: 0x1a73, Hi: 0x1a7c, Stride: 1},
+			{Lo: 0x1920, Hi: 0x192b, Stride: 1},
+			{Lo: 0x1930, Hi: 0x193b, Stride: 1},
+			{Lo: 0x1a17, Hi: 0x1a1b, Stride: 1},
+			{Lo: 0x1a55, Hi: 0x1a5e, Stride: 1},
+			{Lo: 0x1a60, Hi: 0x1a7c, Stride: 1},
 			{Lo: 0x1a7f, Hi: 0x1a7f, Stride: 1},
-			{Lo: 0x1ab0, Hi: 0x1abd, Stride: 1},
-			{Lo: 0x1abe, Hi: 0x1abe, Stride: 1},
-			{Lo: 0x1b00, Hi: 0x1b03, Stride: 1},
-			{Lo: 0x1b04, Hi: 0x1b04, Stride: 1},
-			{Lo: 0x1b34, Hi: 0x1b34, Stride: 1},
-			{Lo: 0x1b35, Hi: 0x1b35, Stride: 1},
-			{Lo: 0x1b36, Hi: 0x1b3a, Stride: 1},
-			{Lo: 0x1b3b, Hi: 0x1b3b, Stride: 1},
-			{Lo: 0x1b3c, Hi: 0x1b3c, Stride: 1},
-			{Lo: 0x1b3d, Hi: 0x1b41, Stride: 1},
-			{Lo: 0x1b42, Hi: 0x1b42, Stride: 1},
-			{Lo: 0x1b43, Hi: 0x1b44, Stride: 1},
+			{Lo: 0x1ab0, Hi: 0x1add, Stride: 1},
+			{Lo: 0x1ae0, Hi: 0x1aeb, Stride: 1},
+			{Lo: 0x1b00, Hi: 0x1b04, Stride: 1},
+			{Lo: 0x1b34, Hi: 0x1b44, Stride: 1},
 			{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
-			{Lo: 0x1b80, Hi: 0x1b81, Stride: 1},
-			{Lo: 0x1b82, Hi: 0x1b82, Stride: 1},
-			{Lo: 0x1ba1, Hi: 0x1ba1, Stride: 1},
-			{Lo: 0x1ba2, Hi: 0x1ba5, Stride: 1},
-			{Lo: 0x1ba6, Hi: 0x1ba7, Stride: 1},
-			{Lo: 0x1ba8, Hi: 0x1ba9, Stride: 1},
-			{Lo: 0x1baa, Hi: 0x1baa, Stride: 1},
-			{Lo: 0x1bab, Hi: 0x1bad, Stride: 1},
-			{Lo: 0x1be6, Hi: 0x1be6, Stride: 1},
-			{Lo: 0x1be7, Hi: 0x1be7, Stride: 1},
-			{Lo: 0x1be8, Hi: 0x1be9, Stride: 1},
-			{Lo: 0x1bea, Hi: 0x1bec, Stride: 1},
-			{Lo: 0x1bed, Hi: 0x1bed, Stride: 1},
-			{Lo: 0x1bee, Hi: 0x1bee, Stride: 1},
-			{Lo: 0x1bef, Hi: 0x1bf1, Stride: 1},
-			{Lo: 0x1bf2, Hi: 0x1bf3, Stride: 1},
-			{Lo: 0x1c24, Hi: 0x1c2b, Stride: 1},
-			{Lo: 0x1c2c, Hi: 0x1c33, Stride: 1},
-			{Lo: 0x1c34, Hi: 0x1c35, Stride: 1},
-			{Lo: 0x1c36, Hi: 0x1c37, Stride: 1},
+			{Lo: 0x1b80, Hi: 0x1b82, Stride: 1},
+			{Lo: 0x1ba1, Hi: 0x1bad, Stride: 1},
+			{Lo: 0x1be6, Hi: 0x1bf3, Stride: 1},
+			{Lo: 0x1c24, Hi: 0x1c37, Stride: 1},
 			{Lo: 0x1cd0, Hi: 0x1cd2, Stride: 1},
-			{Lo: 0x1cd4, Hi: 0x1ce0, Stride: 1},
-			{Lo: 0x1ce1, Hi: 0x1ce1, Stride: 1},
-			{Lo: 0x1ce2, Hi: 0x1ce8, Stride: 1},
+			{Lo: 0x1cd4, Hi: 0x1ce8, Stride: 1},
 			{Lo: 0x1ced, Hi: 0x1ced, Stride: 1},
-			{Lo: 0x1cf2, Hi: 0x1cf3, Stride: 1},
 			{Lo: 0x1cf4, Hi: 0x1cf4, Stride: 1},
-			{Lo: 0x1cf8, Hi: 0x1cf9, Stride: 1},
-			{Lo: 0x1dc0, Hi: 0x1df5, Stride: 1},
-			{Lo: 0x1dfb, Hi: 0x1dff, Stride: 1},
+			{Lo: 0x1cf7, Hi: 0x1cf9, Stride: 1},
+			{Lo: 0x1dc0, Hi: 0x1dff, Stride: 1},
 			{Lo: 0x200c, Hi: 0x200c, Stride: 1},
-			{Lo: 0x20d0, Hi: 0x20dc, Stride: 1},
-			{Lo: 0x20dd, Hi: 0x20e0, Stride: 1},
-			{Lo: 0x20e1, Hi: 0x20e1, Stride: 1},
-			{Lo: 0x20e2, Hi: 0x20e4, Stride: 1},
-			{Lo: 0x20e5, Hi: 0x20f0, Stride: 1},
+			{Lo: 0x20d0, Hi: 0x20f0, Stride: 1},
 			{Lo: 0x2cef, Hi: 0x2cf1, Stride: 1},
 			{Lo: 0x2d7f, Hi: 0x2d7f, Stride: 1},
 			{Lo: 0x2de0, Hi: 0x2dff, Stride: 1},
-			{Lo: 0x302a, Hi: 0x302d, Stride: 1},
-			{Lo: 0x302e, Hi: 0x302f, Stride: 1},
+			{Lo: 0x302a, Hi: 0x302f, Stride: 1},
 			{Lo: 0x3099, Hi: 0x309a, Stride: 1},
-			{Lo: 0xa66f, Hi: 0xa66f, Stride: 1},
-			{Lo: 0xa670, Hi: 0xa672, Stride: 1},
+			{Lo: 0xa66f, Hi: 0xa672, Stride: 1},
 			{Lo: 0xa674, Hi: 0xa67d, Stride: 1},
 			{Lo: 0xa69e, Hi: 0xa69f, Stride: 1},
 			{Lo: 0xa6f0, Hi: 0xa6f1, Stride: 1},
 			{Lo: 0xa802, Hi: 0xa802, Stride: 1},
 			{Lo: 0xa806, Hi: 0xa806, Stride: 1},
 			{Lo: 0xa80b, Hi: 0xa80b, Stride: 1},
-			{Lo: 0xa823, Hi: 0xa824, Stride: 1},
-			{Lo: 0xa825, Hi: 0xa826, Stride: 1},
-			{Lo: 0xa827, Hi: 0xa827, Stride: 1},
+			{Lo: 0xa823, Hi: 0xa827, Stride: 1},
+			{Lo: 0xa82c, Hi: 0xa82c, Stride: 1},
 			{Lo: 0xa880, Hi: 0xa881, Stride: 1},
-			{Lo: 0xa8b4, Hi: 0xa8c3, Stride: 1},
-			{Lo: 0xa8c4, Hi: 0xa8c5, Stride: 1},
+			{Lo: 0xa8b4, Hi: 0xa8c5, Stride: 1},
 			{Lo: 0xa8e0, Hi: 0xa8f1, Stride: 1},
+			{Lo: 0xa8ff, Hi: 0xa8ff, Stride: 1},
 			{Lo: 0xa926, Hi: 0xa92d, Stride: 1},
-			{Lo: 0xa947, Hi: 0xa951, Stride: 1},
-			{Lo: 0xa952, Hi: 0xa953, Stride: 1},
-			{Lo: 0xa980, Hi: 0xa982, Stride: 1},
-			{Lo: 0xa983, Hi: 0xa983, Stride: 1},
-			{Lo: 0xa9b3, Hi: 0xa9b3, Stride: 1},
-			{Lo: 0xa9b4, Hi: 0xa9b5, Stride: 1},
-			{Lo: 0xa9b6, Hi: 0xa9b9, Stride: 1},
-			{Lo: 0xa9ba, Hi: 0xa9bb, Stride: 1},
-			{Lo: 0xa9bc, Hi: 0xa9bc, Stride: 1},
-			{Lo: 0xa9bd, Hi: 0xa9c0, Stride: 1},
+			{Lo: 0xa947, Hi: 0xa953, Stride: 1},
+			{Lo: 0xa980, Hi: 0xa983, Stride: 1},
+			{Lo: 0xa9b3, Hi: 0xa9c0, Stride: 1},
 			{Lo: 0xa9e5, Hi: 0xa9e5, Stride: 1},
-			{Lo: 0xaa29, Hi: 0xaa2e, Stride: 1},
-			{Lo: 0xaa2f, Hi: 0xaa30, Stride: 1},
-			{Lo: 0xaa31, Hi: 0xaa32, Stride: 1},
-			{Lo: 0xaa33, Hi: 0xaa34, Stride: 1},
-			{Lo: 0xaa35, Hi: 0xaa36, Stride: 1},
+			{Lo: 0xaa29, Hi: 0xaa36, Stride: 1},
 			{Lo: 0xaa43, Hi: 0xaa43, Stride: 1},
-			{Lo: 0xaa4c, Hi: 0xaa4c, Stride: 1},
-			{Lo: 0xaa4d, Hi: 0xaa4d, Stride: 1},
-			{Lo: 0xaa7b, Hi: 0xaa7b, Stride: 1},
-			{Lo: 0xaa7c, Hi: 0xaa7c, Stride: 1},
-			{Lo: 0xaa7d, Hi: 0xaa7d, Stride: 1},
+			{Lo: 0xaa4c, Hi: 0xaa4d, Stride: 1},
+			{Lo: 0xaa7b, Hi: 0xaa7d, Stride: 1},
 			{Lo: 0xaab0, Hi: 0xaab0, Stride: 1},
 			{Lo: 0xaab2, Hi: 0xaab4, Stride: 1},
 			{Lo: 0xaab7, Hi: 0xaab8, Stride: 1},
 			{Lo: 0xaabe, Hi: 0xaabf, Stride: 1},
 			{Lo: 0xaac1, Hi: 0xaac1, Stride: 1},
-			{Lo: 0xaaeb, Hi: 0xaaeb, Stride: 1},
-			{Lo: 0xaaec, Hi: 0xaaed, Stride: 1},
-			{Lo: 0xaaee, Hi: 0xaaef, Stride: 1},
-			{Lo: 0xaaf5, Hi: 0xaaf5, Stride: 1},
-			{Lo: 0xaaf6, Hi: 0xaaf6, Stride: 1},
-			{Lo: 0xabe3, Hi: 0xabe4, Stride: 1},
-			{Lo: 0xabe5, Hi: 0xabe5, Stride: 1},
-			{Lo: 0xabe6, Hi: 0xabe7, Stride: 1},
-			{Lo: 0xabe8, Hi: 0xabe8, Stride: 1},
-			{Lo: 0xabe9, Hi: 0xabea, Stride: 1},
-			{Lo: 0xabec, Hi: 0xabec, Stride: 1},
-			{Lo: 0xabed, Hi: 0xabed, Stride: 1},
+			{Lo: 0xaaeb, Hi: 0xaaef, Stride: 1},
+			{Lo: 0xaaf5, Hi: 0xaaf6, Stride: 1},
+			{Lo: 0xabe3, Hi: 0xabea, Stride: 1},
+			{Lo: 0xabec, Hi: 0xabed, Stride: 1},
 			{Lo: 0xfb1e, Hi: 0xfb1e, Stride: 1},
 			{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
 			{Lo: 0xfe20, Hi: 0xfe2f, Stride: 1},
 			{Lo: 0xff9e, Hi: 0xff9f, Stride: 1},
 		},
-
 		R32: []unicode.Range32{
-			{Lo: 0x10376, Hi: 0x1037a, Stride: 1},
 			{Lo: 0x101fd, Hi: 0x101fd, Stride: 1},
 			{Lo: 0x102e0, Hi: 0x102e0, Stride: 1},
+			{Lo: 0x10376, Hi: 0x1037a, Stride: 1},
 			{Lo: 0x10a01, Hi: 0x10a03, Stride: 1},
 			{Lo: 0x10a05, Hi: 0x10a06, Stride: 1},
 			{Lo: 0x10a0c, Hi: 0x10a0f, Stride: 1},
 			{Lo: 0x10a38, Hi: 0x10a3a, Stride: 1},
 			{Lo: 0x10a3f, Hi: 0x10a3f, Stride: 1},
 			{Lo: 0x10ae5, Hi: 0x10ae6, Stride: 1},
-			{Lo: 0x11000, Hi: 0x11000, Stride: 1},
-			{Lo: 0x11001, Hi: 0x11001, Stride: 1},
-			{Lo: 0x11002, Hi: 0x11002, Stride: 1},
+			{Lo: 0x10d24, Hi: 0x10d27, Stride: 1},
+			{Lo: 0x10d69, Hi: 0x10d6d, Stride: 1},
+			{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+			{Lo: 0x10efa, Hi: 0x10eff, Stride: 1},
+			{Lo: 0x10f46, Hi: 0x10f50, Stride: 1},
+			{Lo: 0x10f82, Hi: 0x10f85, Stride: 1},
+			{Lo: 0x11000, Hi: 0x11002, Stride: 1},
 			{Lo: 0x11038, Hi: 0x11046, Stride: 1},
-			{Lo: 0x1107f, Hi: 0x11081, Stride: 1},
-			{Lo: 0x11082, Hi: 0x11082, Stride: 1},
-			{Lo: 0x110b0, Hi: 0x110b2, Stride: 1},
-			{Lo: 0x110b3, Hi: 0x110b6, Stride: 1},
-			{Lo: 0x110b7, Hi: 0x110b8, Stride: 1},
-			{Lo: 0x110b9, Hi: 0x110ba, Stride: 1},
+			{Lo: 0x11070, Hi: 0x11070, Stride: 1},
+			{Lo: 0x11073, Hi: 0x11074, Stride: 1},
+			{Lo: 0x1107f, Hi: 0x11082, Stride: 1},
+			{Lo: 0x110b0, Hi: 0x110ba, Stride: 1},
+			{Lo: 0x110c2, Hi: 0x110c2, Stride: 1},
 			{Lo: 0x11100, Hi: 0x11102, Stride: 1},
-			{Lo: 0x11127, Hi: 0x1112b, Stride: 1},
-			{Lo: 0x1112c, Hi: 0x1112c, Stride: 1},
-			{Lo: 0x1112d, Hi: 0x11134, Stride: 1},
+			{Lo: 0x11127, Hi: 0x11134, Stride: 1},
+			{Lo: 0x11145, Hi: 0x11146, Stride: 1},
 			{Lo: 0x11173, Hi: 0x11173, Stride: 1},
-			{Lo: 0x11180, Hi: 0x11181, Stride: 1},
-			{Lo: 0x11182, Hi: 0x11182, Stride: 1},
-			{Lo: 0x111b3, Hi: 0x111b5, Stride: 1},
-			{Lo: 0x111b6, Hi: 0x111be, Stride: 1},
-			{Lo: 0x111bf, Hi: 0x111c0, Stride: 1},
-			{Lo: 0x111ca, Hi: 0x111cc, Stride: 1},
-			{Lo: 0x1122c, Hi: 0x1122e, Stride: 1},
-			{Lo: 0x1122f, Hi: 0x11231, Stride: 1},
-			{Lo: 0x11232, Hi: 0x11233, Stride: 1},
-			{Lo: 0x11234, Hi: 0x11234, Stride: 1},
-			{Lo: 0x11235, Hi: 0x11235, Stride: 1},
-			{Lo: 0x11236, Hi: 0x11237, Stride: 1},
+			{Lo: 0x11180, Hi: 0x11182, Stride: 1},
+			{Lo: 0x111b3, Hi: 0x111c0, Stride: 1},
+			{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
+			{Lo: 0x111ce, Hi: 0x111cf, Stride: 1},
+			{Lo: 0x1122c, Hi: 0x11237, Stride: 1},
 			{Lo: 0x1123e, Hi: 0x1123e, Stride: 1},
-			{Lo: 0x112df, Hi: 0x112df, Stride: 1},
-			{Lo: 0x112e0, Hi: 0x112e2, Stride: 1},
-			{Lo: 0x112e3, Hi: 0x112ea, Stride: 1},
-			{Lo: 0x11300, Hi: 0x11301, Stride: 1},
-			{Lo: 0x11302, Hi: 0x11303, Stride: 1},
-			{Lo: 0x1133c, Hi: 0x1133c, Stride: 1},
-			{Lo: 0x1133e, Hi: 0x1133f, Stride: 1},
-			{Lo: 0x11340, Hi: 0x11340, Stride: 1},
-			{Lo: 0x11341, Hi: 0x11344, Stride: 1},
+			{Lo: 0x11241, Hi: 0x11241, Stride: 1},
+			{Lo: 0x112df, Hi: 0x112ea, Stride: 1},
+			{Lo: 0x11300, Hi: 0x11303, Stride: 1},
+			{Lo: 0x1133b, Hi: 0x1133c, Stride: 1},
+			{Lo: 0x1133e, Hi: 0x11344, Stride: 1},
 			{Lo: 0x11347, Hi: 0x11348, Stride: 1},
 			{Lo: 0x1134b, Hi: 0x1134d, Stride: 1},
 			{Lo: 0x11357, Hi: 0x11357, Stride: 1},
 			{Lo: 0x11362, Hi: 0x11363, Stride: 1},
 			{Lo: 0x11366, Hi: 0x1136c, Stride: 1},
 			{Lo: 0x11370, Hi: 0x11374, Stride: 1},
-			{Lo: 0x11435, Hi: 0x11437, Stride: 1},
-			{Lo: 0x11438, Hi: 0x1143f, Stride: 1},
-			{Lo: 0x11440, Hi: 0x11441, Stride: 1},
-			{Lo: 0x11442, Hi: 0x11444, Stride: 1},
-			{Lo: 0x11445, Hi: 0x11445, Stride: 1},
-			{Lo: 0x11446, Hi: 0x11446, Stride: 1},
-			{Lo: 0x114b0, Hi: 0x114b2, Stride: 1},
-			{Lo: 0x114b3, Hi: 0x114b8, Stride: 1},
-			{Lo: 0x114b9, Hi: 0x114b9, Stride: 1},
-			{Lo: 0x114ba, Hi: 0x114ba, Stride: 1},
-			{Lo: 0x114bb, Hi: 0x114be, Stride: 1},
-			{Lo: 0x114bf, Hi: 0x114c0, Stride: 1},
-			{Lo: 0x114c1, Hi: 0x114c1, Stride: 1},
-			{Lo: 0x114c2, Hi: 0x114c3, Stride: 1},
-			{Lo: 0x115af, Hi: 0x115b1, Stride: 1},
-			{Lo: 0x115b2, Hi: 0x115b5, Stride: 1},
-			{Lo: 0x115b8, Hi: 0x115bb, Stride: 1},
-			{Lo: 0x115bc, Hi: 0x115bd, Stride: 1},
-			{Lo: 0x115be, Hi: 0x115be, Stride: 1},
-			{Lo: 0x115bf, Hi: 0x115c0, Stride: 1},
+			{Lo: 0x113b8, Hi: 0x113c0, Stride: 1},
+			{Lo: 0x113c2, Hi: 0x113c2, Stride: 1},
+			{Lo: 0x113c5, Hi: 0x113c5, Stride: 1},
+			{Lo: 0x113c7, Hi: 0x113ca, Stride: 1},
+			{Lo: 0x113cc, Hi: 0x113d0, Stride: 1},
+			{Lo: 0x113d2, Hi: 0x113d2, Stride: 1},
+			{Lo: 0x113e1, Hi: 0x113e2, Stride: 1},
+			{Lo: 0x11435, Hi: 0x11446, Stride: 1},
+			{Lo: 0x1145e, Hi: 0x1145e, Stride: 1},
+			{Lo: 0x114b0, Hi: 0x114c3, Stride: 1},
+			{Lo: 0x115af, Hi: 0x115b5, Stride: 1},
+			{Lo: 0x115b8, Hi: 0x115c0, Stride: 1},
 			{Lo: 0x115dc, Hi: 0x115dd, Stride: 1},
-			{Lo: 0x11630, Hi: 0x11632, Stride: 1},
-			{Lo: 0x11633, Hi: 0x1163a, Stride: 1},
-			{Lo: 0x1163b, Hi: 0x1163c, Stride: 1},
-			{Lo: 0x1163d, Hi: 0x1163d, Stride: 1},
-			{Lo: 0x1163e, Hi: 0x1163e, Stride: 1},
-			{Lo: 0x1163f, Hi: 0x11640, Stride: 1},
-			{Lo: 0x116ab, Hi: 0x116ab, Stride: 1},
-			{Lo: 0x116ac, Hi: 0x116ac, Stride: 1},
-			{Lo: 0x116ad, Hi: 0x116ad, Stride: 1},
-			{Lo: 0x116ae, Hi: 0x116af, Stride: 1},
-			{Lo: 0x116b0, Hi: 0x116b5, Stride: 1},
-			{Lo: 0x116b6, Hi: 0x116b6, Stride: 1},
-			{Lo: 0x116b7, Hi: 0x116b7, Stride: 1},
-			{Lo: 0x1171d, Hi: 0x1171f, Stride: 1},
-			{Lo: 0x11720, Hi: 0x11721, Stride: 1},
-			{Lo: 0x11722, Hi: 0x11725, Stride: 1},
-			{Lo: 0x11726, Hi: 0x11726, Stride: 1},
-			{Lo: 0x11727, Hi: 0x1172b, Stride: 1},
-			{Lo: 0x11c2f, Hi: 0x11c2f, Stride: 1},
-			{Lo: 0x11c30, Hi: 0x11c36, Stride: 1},
-			{Lo: 0x11c38, Hi: 0x11c3d, Stride: 1},
-			{Lo: 0x11c3e, Hi: 0x11c3e, Stride: 1},
-			{Lo: 0x11c3f, Hi: 0x11c3f, Stride: 1},
+			{Lo: 0x11630, Hi: 0x11640, Stride: 1},
+			{Lo: 0x116ab, Hi: 0x116b7, Stride: 1},
+			{Lo: 0x1171d, Hi: 0x1172b, Stride: 1},
+			{Lo: 0x1182c, Hi: 0x1183a, Stride: 1},
+			{Lo: 0x11930, Hi: 0x11935, Stride: 1},
+			{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+			{Lo: 0x1193b, Hi: 0x1193e, Stride: 1},
+			{Lo: 0x11940, Hi: 0x11940, Stride: 1},
+			{Lo: 0x11942, Hi: 0x11943, Stride: 1},
+			{Lo: 0x119d1, Hi: 0x119d7, Stride: 1},
+			{Lo: 0x119da, Hi: 0x119e0, Stride: 1},
+			{Lo: 0x119e4, Hi: 0x119e4, Stride: 1},
+			{Lo: 0x11a01, Hi: 0x11a0a, Stride: 1},
+			{Lo: 0x11a33, Hi: 0x11a39, Stride: 1},
+			{Lo: 0x11a3b, Hi: 0x11a3e, Stride: 1},
+			{Lo: 0x11a47, Hi: 0x11a47, Stride: 1},
+			{Lo: 0x11a51, Hi: 0x11a5b, Stride: 1},
+			{Lo: 0x11a8a, Hi: 0x11a99, Stride: 1},
+			{Lo: 0x11b60, Hi: 0x11b67, Stride: 1},
+			{Lo: 0x11c2f, Hi: 0x11c36, Stride: 1},
+			{Lo: 0x11c38, Hi: 0x11c3f, Stride: 1},
 			{Lo: 0x11c92, Hi: 0x11ca7, Stride: 1},
-			{Lo: 0x11ca9, Hi: 0x11ca9, Stride: 1},
-			{Lo: 0x11caa, Hi: 0x11cb0, Stride: 1},
-			{Lo: 0x11cb1, Hi: 0x11cb1, Stride: 1},
-			{Lo: 0x11cb2, Hi: 0x11cb3, Stride: 1},
-			{Lo: 0x11cb4, Hi: 0x11cb4, Stride: 1},
-			{Lo: 0x11cb5, Hi: 0x11cb6, Stride: 1},
+			{Lo: 0x11ca9, Hi: 0x11cb6, Stride: 1},
+			{Lo: 0x11d31, Hi: 0x11d36, Stride: 1},
+			{Lo: 0x11d3a, Hi: 0x11d3a, Stride: 1},
+			{Lo: 0x11d3c, Hi: 0x11d3d, Stride: 1},
+			{Lo: 0x11d3f, Hi: 0x11d45, Stride: 1},
+			{Lo: 0x11d47, Hi: 0x11d47, Stride: 1},
+			{Lo: 0x11d8a, Hi: 0x11d8e, Stride: 1},
+			{Lo: 0x11d90, Hi: 0x11d91, Stride: 1},
+			{Lo: 0x11d93, Hi: 0x11d97, Stride: 1},
+			{Lo: 0x11ef3, Hi: 0x11ef6, Stride: 1},
+			{Lo: 0x11f00, Hi: 0x11f01, Stride: 1},
+			{Lo: 0x11f03, Hi: 0x11f03, Stride: 1},
+			{Lo: 0x11f34, Hi: 0x11f3a, Stride: 1},
+			{Lo: 0x11f3e, Hi: 0x11f42, Stride: 1},
+			{Lo: 0x11f5a, Hi: 0x11f5a, Stride: 1},
+			{Lo: 0x13440, Hi: 0x13440, Stride: 1},
+			{Lo: 0x13447, Hi: 0x13455, Stride: 1},
+			{Lo: 0x1611e, Hi: 0x1612f, Stride: 1},
 			{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
 			{Lo: 0x16b30, Hi: 0x16b36, Stride: 1},
-			{Lo: 0x16f51, Hi: 0x16f7e, Stride: 1},
+			{Lo: 0x16f4f, Hi: 0x16f4f, Stride: 1},
+			{Lo: 0x16f51, Hi: 0x16f87, Stride: 1},
 			{Lo: 0x16f8f, Hi: 0x16f92, Stride: 1},
+			{Lo: 0x16fe4, Hi: 0x16fe4, Stride: 1},
+			{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
 			{Lo: 0x1bc9d, Hi: 0x1bc9e, Stride: 1},
-			{Lo: 0x1d165, Hi: 0x1d166, Stride: 1},
-			{Lo: 0x1d167, Hi: 0x1d169, Stride: 1},
+			{Lo: 0x1cf00, Hi: 0x1cf2d, Stride: 1},
+			{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
+			{Lo: 0x1d165, Hi: 0x1d169, Stride: 1},
 			{Lo: 0x1d16d, Hi: 0x1d172, Stride: 1},
 			{Lo: 0x1d17b, Hi: 0x1d182, Stride: 1},
 			{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
@@ -1063,8 +937,19 @@ var (
 			{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
 			{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
 			{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
+			{Lo: 0x1e08f, Hi: 0x1e08f, Stride: 1},
+			{Lo: 0x1e130, Hi: 0x1e136, Stride: 1},
+			{Lo: 0x1e2ae, Hi: 0x1e2ae, Stride: 1},
+			{Lo: 0x1e2ec, Hi: 0x1e2ef, Stride: 1},
+			{Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 1},
+			{Lo: 0x1e5ee, Hi: 0x1e5ef, Stride: 1},
+			{Lo: 0x1e6e3, Hi: 0x1e6e3, Stride: 1},
+			{Lo: 0x1e6e6, Hi: 0x1e6e6, Stride: 1},
+			{Lo: 0x1e6ee, Hi: 0x1e6ef, Stride: 1},
+			{Lo: 0x1e6f5, Hi: 0x1e6f5, Stride: 1},
 			{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
 			{Lo: 0x1e944, Hi: 0x1e94a, Stride: 1},
+			{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
 			{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
 			{Lo: 0xe0100, Hi: 0xe01ef, Stride: 1},
 		},
@@ -1091,6 +976,7 @@ var (
 			{Lo: 0x061c, Hi: 0x061c, Stride: 1},
 			{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
 			{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+			{Lo: 0x0890, Hi: 0x0891, Stride: 1},
 			{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
 			{Lo: 0x180e, Hi: 0x180e, Stride: 1},
 			{Lo: 0x200e, Hi: 0x200f, Stride: 1},
@@ -1100,29 +986,20 @@ var (
 			{Lo: 0xfeff, Hi: 0xfeff, Stride: 1},
 			{Lo: 0xfff9, Hi: 0xfffb, Stride: 1},
 		},
-
 		R32: []unicode.Range32{
 			{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+			{Lo: 0x110cd, Hi: 0x110cd, Stride: 1},
+			{Lo: 0x13430, Hi: 0x1343f, Stride: 1},
 			{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
 			{Lo: 0x1d173, Hi: 0x1d17a, Stride: 1},
 			{Lo: 0xe0001, Hi: 0xe0001, Stride: 1},
 		},
 	}
 
-	tableGlueAfterZWJ = &unicode.RangeTable{
-		R16: []unicode.Range16{
-			{Lo: 2764, Hi: 2764, Stride: 1},
-		},
-		R32: []unicode.Range32{
-			{Lo: 0x1f48b, Hi: 0x1f48b, Stride: 1},
-			{Lo: 0x1f5e8, Hi: 0x1f5e8, Stride: 1},
-		},
-	}
-
 	tableHebrewLetter = &unicode.RangeTable{
 		R16: []unicode.Range16{
 			{Lo: 0x05d0, Hi: 0x05ea, Stride: 1},
-			{Lo: 0x05f0, Hi: 0x05f2, Stride: 1},
+			{Lo: 0x05ef, Hi: 0x05f2, Stride: 1},
 			{Lo: 0xfb1d, Hi: 0xfb1d, Stride: 1},
 			{Lo: 0xfb1f, Hi: 0xfb28, Stride: 1},
 			{Lo: 0xfb2a, Hi: 0xfb36, Stride: 1},
@@ -1138,20 +1015,21 @@ var (
 		R16: []unicode.Range16{
 			{Lo: 0x3031, Hi: 0x3035, Stride: 1},
 			{Lo: 0x309b, Hi: 0x309c, Stride: 1},
-			{Lo: 0x30a0, Hi: 0x30a0, Stride: 1},
-			{Lo: 0x30a1, Hi: 0x30fa, Stride: 1},
-			{Lo: 0x30fc, Hi: 0x30fe, Stride: 1},
-			{Lo: 0x30ff, Hi: 0x30ff, Stride: 1},
+			{Lo: 0x30a0, Hi: 0x30fa, Stride: 1},
+			{Lo: 0x30fc, Hi: 0x30ff, Stride: 1},
 			{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
 			{Lo: 0x32d0, Hi: 0x32fe, Stride: 1},
 			{Lo: 0x3300, Hi: 0x3357, Stride: 1},
-			{Lo: 0xff66, Hi: 0xff6f, Stride: 1},
-			{Lo: 0xff70, Hi: 0xff70, Stride: 1},
-			{Lo: 0xff71, Hi: 0xff9d, Stride: 1},
+			{Lo: 0xff66, Hi: 0xff9d, Stride: 1},
 		},
-
 		R32: []unicode.Range32{
+			{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+			{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+			{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
 			{Lo: 0x1b000, Hi: 0x1b000, Stride: 1},
+			{Lo: 0x1b120, Hi: 0x1b122, Stride: 1},
+			{Lo: 0x1b155, Hi: 0x1b155, Stride: 1},
+			{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
 		},
 	}
 
@@ -1160,8 +1038,8 @@ var (
 		R16: []unicode.Range16{
 			{Lo: 0x003a, Hi: 0x003a, Stride: 1},
 			{Lo: 0x00b7, Hi: 0x00b7, Stride: 1},
-			{Lo: 0x02d7, Hi: 0x02d7, Stride: 1},
 			{Lo: 0x0387, Hi: 0x0387, Stride: 1},
+			{Lo: 0x055f, Hi: 0x055f, Stride: 1},
 			{Lo: 0x05f4, Hi: 0x05f4, Stride: 1},
 			{Lo: 0x2027, Hi: 0x2027, Stride: 1},
 			{Lo: 0xfe13, Hi: 0xfe13, Stride: 1},
@@ -1194,8 +1072,7 @@ var (
 		LatinOffset: 1,
 		R16: []unicode.Range16{
 			{Lo: 0x002e, Hi: 0x002e, Stride: 1},
-			{Lo: 0x2018, Hi: 0x2018, Stride: 1},
-			{Lo: 0x2019, Hi: 0x2019, Stride: 1},
+			{Lo: 0x2018, Hi: 0x2019, Stride: 1},
 			{Lo: 0x2024, Hi: 0x2024, Stride: 1},
 			{Lo: 0xfe52, Hi: 0xfe52, Stride: 1},
 			{Lo: 0xff07, Hi: 0xff07, Stride: 1},
@@ -1208,8 +1085,7 @@ var (
 		R16: []unicode.Range16{
 			{Lo: 0x000b, Hi: 0x000c, Stride: 1},
 			{Lo: 0x0085, Hi: 0x0085, Stride: 1},
-			{Lo: 0x2028, Hi: 0x2028, Stride: 1},
-			{Lo: 0x2029, Hi: 0x2029, Stride: 1},
+			{Lo: 0x2028, Hi: 0x2029, Stride: 1},
 		},
 	}
 
@@ -1253,10 +1129,12 @@ var (
 			{Lo: 0xa9f0, Hi: 0xa9f9, Stride: 1},
 			{Lo: 0xaa50, Hi: 0xaa59, Stride: 1},
 			{Lo: 0xabf0, Hi: 0xabf9, Stride: 1},
+			{Lo: 0xff10, Hi: 0xff19, Stride: 1},
 		},
-
 		R32: []unicode.Range32{
 			{Lo: 0x104a0, Hi: 0x104a9, Stride: 1},
+			{Lo: 0x10d30, Hi: 0x10d39, Stride: 1},
+			{Lo: 0x10d40, Hi: 0x10d49, Stride: 1},
 			{Lo: 0x11066, Hi: 0x1106f, Stride: 1},
 			{Lo: 0x110f0, Hi: 0x110f9, Stride: 1},
 			{Lo: 0x11136, Hi: 0x1113f, Stride: 1},
@@ -1266,13 +1144,29 @@ var (
 			{Lo: 0x114d0, Hi: 0x114d9, Stride: 1},
 			{Lo: 0x11650, Hi: 0x11659, Stride: 1},
 			{Lo: 0x116c0, Hi: 0x116c9, Stride: 1},
+			{Lo: 0x116d0, Hi: 0x116e3, Stride: 1},
 			{Lo: 0x11730, Hi: 0x11739, Stride: 1},
 			{Lo: 0x118e0, Hi: 0x118e9, Stride: 1},
+			{Lo: 0x11950, Hi: 0x11959, Stride: 1},
+			{Lo: 0x11bf0, Hi: 0x11bf9, Stride: 1},
 			{Lo: 0x11c50, Hi: 0x11c59, Stride: 1},
+			{Lo: 0x11d50, Hi: 0x11d59, Stride: 1},
+			{Lo: 0x11da0, Hi: 0x11da9, Stride: 1},
+			{Lo: 0x11de0, Hi: 0x11de9, Stride: 1},
+			{Lo: 0x11f50, Hi: 0x11f59, Stride: 1},
+			{Lo: 0x16130, Hi: 0x16139, Stride: 1},
 			{Lo: 0x16a60, Hi: 0x16a69, Stride: 1},
+			{Lo: 0x16ac0, Hi: 0x16ac9, Stride: 1},
 			{Lo: 0x16b50, Hi: 0x16b59, Stride: 1},
+			{Lo: 0x16d70, Hi: 0x16d79, Stride: 1},
+			{Lo: 0x1ccf0, Hi: 0x1ccf9, Stride: 1},
 			{Lo: 0x1d7ce, Hi: 0x1d7ff, Stride: 1},
+			{Lo: 0x1e140, Hi: 0x1e149, Stride: 1},
+			{Lo: 0x1e2f0, Hi: 0x1e2f9, Stride: 1},
+			{Lo: 0x1e4f0, Hi: 0x1e4f9, Stride: 1},
+			{Lo: 0x1e5f1, Hi: 0x1e5fa, Stride: 1},
 			{Lo: 0x1e950, Hi: 0x1e959, Stride: 1},
+			{Lo: 0x1fbf0, Hi: 0x1fbf9, Stride: 1},
 		},
 	}
 
@@ -0,0 +1,53 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import "unicode/utf8"
+
+// Context is what the decision of whether to break before a rune is made from.
+// Prev and Prev2 are the last two runes of the word before Rune, and Next the
+// rune after it, skipping Extend, Format and ZWJ as WB4 requires. Last is the
+// last rune of the word as it is. Runes that don't exist, such as Prev2 when the
+// word is a single rune, are utf8.RuneError.
+type Context struct {
+	Prev2, Prev, Last rune
+	Rune              rune
+
+	next func() rune
+}
+
+// newContext returns the context of r following word, with peek returning the
+// rune after r
+func newContext(word []byte, r rune, peek func() rune) Context {
+	prev, last, prev2 := lastRunes(word)
+	return Context{
+		Prev2: prev2,
+		Prev:  prev,
+		Last:  last,
+		Rune:  r,
+		next:  peek,
+	}
+}
+
+// Next returns the rune after Rune, skipping Extend, Format and ZWJ. It is only
+// read from the source when Next is called.
+func (c Context) Next() rune {
+	if c.next == nil {
+		return utf8.RuneError
+	}
+	return c.next()
+}
+
+// A Tailoring overrides the break decisions of a TokenReader. It is passed the
+// context of each rune and whether the rules of TR29 join it to the word before
+// it, and returns whether it does.
+type Tailoring func(c Context, joins bool) bool
+
+// WithTailoring causes a TokenReader to tailor where words break with t, such as
+// to not break words at hyphens.
+func WithTailoring(t Tailoring) Option {
+	return func(wr *wordReader) {
+		wr.tailoring = t
+	}
+}
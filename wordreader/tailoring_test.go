@@ -0,0 +1,60 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestWithTailoring(t *testing.T) {
+	// treating '-' as MidLetter
+	hyphens := func(c Context, joins bool) bool {
+		switch {
+		case c.Rune == '-' && unicode.IsLetter(c.Prev) && unicode.IsLetter(c.Next()):
+			return true
+		case c.Prev == '-' && unicode.IsLetter(c.Prev2) && unicode.IsLetter(c.Rune):
+			return true
+		}
+		return joins
+	}
+
+	// breaking at apostrophes
+	apostrophes := func(c Context, joins bool) bool {
+		return joins && c.Rune != '\'' && c.Prev != '\''
+	}
+
+	for _, test := range []struct {
+		tailoring Tailoring
+		str       string
+		words     []string
+	}{
+		{hyphens, "well-known -x y- a-́b", []string{"well-known", " ", "-", "x", " ", "y", "-", " ", "a-́b"}},
+		{apostrophes, "don't go", []string{"don", "'", "t", " ", "go"}},
+		{nil, "well-known don't", []string{"well", "-", "known", " ", "don't"}},
+	} {
+		tr := NewTokenReader(strings.NewReader(test.str), WithTailoring(test.tailoring))
+
+		var words []string
+		for {
+			word, err := tr.ReadWord()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			words = append(words, word)
+		}
+
+		if !reflect.DeepEqual(words, test.words) {
+			t.Errorf("%q: %q != %q", test.str, words, test.words)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"fmt"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind is the kind of a token, the text between two word boundaries
+type Kind int
+
+// The kinds of tokens
+const (
+	Other       Kind = iota
+	Word             // letters, possibly with digits and joining punctuation, e.g. "don't" or "3a"
+	Number           // digits, possibly with separators, e.g. "3,456.789"
+	Punctuation      // punctuation and symbols other than emoji
+	Space            // spaces and tabs
+	Newline          // a line break, including "\r\n"
+	Emoji            // emoji, including modifier, zwj and flag sequences
+)
+
+var kindNames = [...]string{"other", "word", "number", "punctuation", "space", "newline", "emoji"}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return kindNames[Other]
+	}
+	return kindNames[k]
+}
+
+// Position is where a token starts in the source it was read from
+type Position struct {
+	Offset     int64 // in bytes, from 0
+	RuneOffset int64 // in runes, from 0
+
+	// Line and Column, in runes, both from 1, are only set when reading
+	// WithLines
+	Line, Column int
+}
+
+func (p Position) String() string {
+	if p.Line == 0 {
+		return fmt.Sprintf("offset %d", p.Offset)
+	}
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// An Option configures optional behavior of a TokenReader
+type Option func(*wordReader)
+
+// WithLines causes a TokenReader to track the line and column of each token. A
+// line ends with a Newline token, such as "\n" or "\r\n".
+func WithLines() Option {
+	return func(wr *wordReader) {
+		wr.lines = true
+		wr.pos = Position{Line: 1, Column: 1}
+		wr.start = wr.pos
+	}
+}
+
+// TokenReader is a WordReader that can also classify what it reads and tell
+// where it was read from.
+//
+// ReadToken reads a single token, returning it along with its kind, or any
+// error encountered. At the end of the input it will return an empty token and
+// io.EOF.
+//
+// Position returns the position of the token, or word, last read. Offsets
+// count the bytes of the source, which may differ from those of the token when
+// the source isn't valid UTF-8.
+//
+// Reset discards anything buffered and starts reading from r, so that a
+// TokenReader, and its buffers, can be reused rather than allocating another.
+//
+// ReadWordBytes reads a single word as ReadWord does, but without allocating.
+// The word is only valid until the next read.
+type TokenReader interface {
+	WordReader
+	ReadToken() (string, Kind, error)
+	ReadWordBytes() ([]byte, error)
+	Position() Position
+	Reset(r io.Reader)
+}
+
+// NewTokenReader returns a new TokenReader
+func NewTokenReader(r io.Reader, opts ...Option) TokenReader {
+	wr := New(r).(*wordReader)
+	for _, opt := range opts {
+		opt(wr)
+	}
+	return wr
+}
+
+// ReadToken returns a single token, and its kind, from a wordReader's source.
+func (wr *wordReader) ReadToken() (string, Kind, error) {
+	token, err := wr.ReadWord()
+	if err != nil {
+		return token, Other, err
+	}
+	return token, Classify(token), nil
+}
+
+func (wr *wordReader) Position() Position {
+	return wr.token
+}
+
+// emoji reports whether r is a pictographic symbol or part of an emoji
+// sequence
+func emoji(r rune) bool {
+	if ri(r) {
+		return true
+	}
+
+	// the pictographs, dingbats and the supplementary planes of symbols, as
+	// others, such as ©, are usually shown as text
+	return (extendedPictographic(r) || unicode.Is(unicode.So, r)) && (r >= 0x1f000 || (r >= 0x2600 && r <= 0x27bf))
+}
+
+// ignorable reports whether r doesn't affect the kind of the token it is in
+func ignorable(r rune) bool {
+	return extend(r) || format(r) || r == zwj
+}
+
+// Classify returns the kind of token, which should be a single token as read by
+// a WordReader
+func Classify(token string) Kind {
+	var t tally
+	for _, r := range token {
+		t.add(r)
+	}
+	return t.kind()
+}
+
+// ClassifyBytes returns the kind of token, as Classify does, without converting
+// it to a string, such as for the words returned by ReadWordBytes
+func ClassifyBytes(token []byte) Kind {
+	var t tally
+	for i := 0; i < len(token); {
+		r, size := utf8.DecodeRune(token[i:])
+		t.add(r)
+		i += size
+	}
+	return t.kind()
+}
+
+// tally counts the runes of a token by their kind
+type tally struct {
+	runes                      int
+	newlines, spaces, puncts   int
+	letters, numbers, pictures bool
+}
+
+func (t *tally) add(r rune) {
+	if ignorable(r) {
+		return
+	}
+	t.runes++
+
+	switch {
+	case newline(r) || r == carriageReturn || r == lineFeed:
+		t.newlines++
+	case unicode.IsSpace(r):
+		t.spaces++
+	case ahLetter(r) || katakana(r) || unicode.IsLetter(r):
+		t.letters = true
+	case numeric(r) || unicode.IsNumber(r):
+		t.numbers = true
+	case emoji(r):
+		t.pictures = true
+	case unicode.IsPunct(r) || unicode.IsSymbol(r):
+		t.puncts++
+	}
+}
+
+// kind returns the kind of a token with the runes counted by t
+func (t *tally) kind() Kind {
+	switch {
+	case t.runes == 0:
+		return Other
+	case t.newlines == t.runes:
+		return Newline
+	case t.newlines+t.spaces == t.runes:
+		return Space
+	case t.letters:
+		return Word
+	case t.numbers:
+		return Number
+	case t.pictures:
+		return Emoji
+	case t.puncts == t.runes:
+		return Punctuation
+	}
+
+	return Other
+}
@@ -0,0 +1,145 @@
+package wordreader
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// All rights reserved
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	for _, v := range []struct {
+		token  string
+		expect Kind
+	}{
+		{"foo", Word},
+		{"don't", Word},
+		{"3a", Word},
+		{"日", Word},
+		{"ナイン", Word},
+		{"42", Number},
+		{"3,456.789", Number},
+		{"٣", Number},
+		{".", Punctuation},
+		{"$", Punctuation},
+		{"©", Punctuation},
+		{" ", Space},
+		{"\t", Space},
+		{" ", Space},
+		{"\n", Newline},
+		{"\r\n", Newline},
+		{"\v", Newline},
+		{" ", Newline},
+		{"😀", Emoji},
+		{"👍🏽", Emoji},
+		{"👨‍👩‍👧", Emoji},
+		{"🇺🇸", Emoji},
+		{"\x00", Other},
+		{"", Other},
+	} {
+		if got := Classify(v.token); got != v.expect {
+			t.Errorf("%q: %s != %s", v.token, got, v.expect)
+		}
+
+		if got := ClassifyBytes([]byte(v.token)); got != v.expect {
+			t.Errorf("%q: bytes: %s != %s", v.token, got, v.expect)
+		}
+	}
+}
+
+func TestReadToken(t *testing.T) {
+	tr := NewTokenReader(strings.NewReader("It's 3.5 👍🏽!\n"))
+
+	var got []string
+	for {
+		token, kind, err := tr.ReadToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, kind.String()+":"+token)
+	}
+
+	expect := "word:It's|space: |number:3.5|space: |emoji:👍🏽|punctuation:!|newline:\n"
+	if strings.Join(got, "|") != expect {
+		t.Errorf("%q != %q", strings.Join(got, "|"), expect)
+	}
+
+	if s := Kind(100).String(); s != "other" {
+		t.Errorf("%q != other", s)
+	}
+}
+
+func TestPosition(t *testing.T) {
+	for _, v := range []struct {
+		text   string
+		expect string
+	}{
+		{"héllo wörld\r\n日本", "héllo:0/0 ' ':6/5 wörld:7/6 '\r\n':13/11 日:15/13 本:18/14"},
+		{"a\xffb c", "a:0/0 �:1/1 b:2/2 ' ':3/3 c:4/4"},
+	} {
+		tr := NewTokenReader(strings.NewReader(v.text))
+
+		var got []string
+		for {
+			token, kind, err := tr.ReadToken()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if kind == Space || kind == Newline || kind == Other {
+				token = "'" + token + "'"
+			}
+
+			pos := tr.Position()
+			got = append(got, fmt.Sprintf("%s:%d/%d", token, pos.Offset, pos.RuneOffset))
+		}
+
+		if strings.Join(got, " ") != v.expect {
+			t.Errorf("%q != %q", strings.Join(got, " "), v.expect)
+		}
+	}
+}
+
+func TestPositionLines(t *testing.T) {
+	tr := NewTokenReader(strings.NewReader("one two\r\nthree\n\nfoür\rfive"), WithLines())
+
+	var got []string
+	for {
+		token, kind, err := tr.ReadToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if kind == Word {
+			got = append(got, token+"@"+tr.Position().String())
+		}
+	}
+
+	expect := "one@1:1 two@1:5 three@2:1 foür@4:1 five@5:1"
+	if strings.Join(got, " ") != expect {
+		t.Errorf("%q != %q", strings.Join(got, " "), expect)
+	}
+
+	tr = NewTokenReader(strings.NewReader("a b"))
+	for i := 0; i < 3; i++ {
+		if _, _, err := tr.ReadToken(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if s := tr.Position().String(); s != "offset 2" {
+		t.Errorf("%q != offset 2", s)
+	}
+}
@@ -3,6 +3,8 @@ package wordreader
 // Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
 // All rights reserved
 
+//go:generate go run ./internal/gen -version 17.0.0 -emoji-version 17.0.0
+
 import (
 	"bufio"
 	"bytes"
@@ -29,9 +31,11 @@ type WordReader interface {
 
 // New returns a new WordReader
 func New(r io.Reader) WordReader {
-	return &wordReader{
+	wr := &wordReader{
 		Reader: bufio.NewReader(r),
 	}
+	wr.peek = wr.peekRune
+	return wr
 }
 
 // wordReader takes an input io.Reader and parses it into words using the
@@ -42,25 +46,63 @@ func New(r io.Reader) WordReader {
 type wordReader struct {
 	*bufio.Reader
 	Buf bytes.Buffer
+
+	// pos is the position in the source after the last rune read, start that
+	// of the first rune in Buf and token that of the word last returned
+	pos, start, token Position
+
+	// lines is set when the line and column of positions are tracked, and
+	// prev is then the last rune read
+	lines bool
+	prev  rune
+
+	// word is the word last returned by ReadWordBytes, whose memory is reused
+	// by the next
+	word []byte
+
+	// peek is peekRune, made once rather than for every rune read
+	peek func() rune
+
+	tailoring Tailoring
+}
+
+// Reset discards anything buffered, and the position, and reads from r
+// instead. Options are kept.
+func (wr *wordReader) Reset(r io.Reader) {
+	wr.Reader.Reset(r)
+	wr.Buf.Reset()
+	wr.word = wr.word[:0]
+
+	wr.pos, wr.token, wr.prev = Position{}, Position{}, 0
+	if wr.lines {
+		wr.pos = Position{Line: 1, Column: 1}
+	}
+	wr.start = wr.pos
 }
 
-func (wr *wordReader) emitWord() (string, error) {
-	word := wr.Buf.String()
+func (wr *wordReader) emitWord() ([]byte, error) {
+	wr.word = append(wr.word[:0], wr.Buf.Bytes()...)
 	wr.Buf.Reset()
-	return word, nil
+	wr.token = wr.start
+	return wr.word, nil
 }
 
-func (wr *wordReader) emitWordPushRune(r rune) (string, error) {
-	word := wr.Buf.String()
+// emitWordPushRune returns the word in Buf and starts the next with r, which
+// is at the position at
+func (wr *wordReader) emitWordPushRune(r rune, at Position) ([]byte, error) {
+	wr.word = append(wr.word[:0], wr.Buf.Bytes()...)
 	wr.Buf.Reset()
 	_, _ = wr.Buf.WriteRune(r) // #nosec
 
+	wr.token = wr.start
+	wr.start = at
+
 	// if the word is zero-length, try again
-	if len(word) == 0 {
-		return wr.ReadWord()
+	if len(wr.word) == 0 {
+		return wr.ReadWordBytes()
 	}
 
-	return word, nil
+	return wr.word, nil
 }
 
 func getLastRune(data []byte) (r rune, size int) {
@@ -82,11 +124,13 @@ func getLastRune(data []byte) (r rune, size int) {
 	return
 }
 
-func (wr *wordReader) lastRune() (rune, rune, rune) {
+// lastRunes returns the last rune of word, ignoring Extend, Format and ZWJ as
+// WB4 requires, the last rune including them, and the second to last rune
+// ignoring them
+func lastRunes(word []byte) (rune, rune, rune) {
 	lastRune := utf8.RuneError
 	secondToLastRune := utf8.RuneError
 
-	word := wr.Buf.Bytes()
 	lastRuneLiteral, _ := getLastRune(word)
 
 	for i := len(word); i >= 0; i-- {
@@ -96,7 +140,7 @@ func (wr *wordReader) lastRune() (rune, rune, rune) {
 		}
 		i -= size - 1
 
-		if extend(r) || format(r) {
+		if ignorable(r) {
 			continue
 		}
 
@@ -150,18 +194,6 @@ func extendNumLet(r rune) bool {
 	return unicode.In(r, tableExtendNumLet)
 }
 
-func eModifier(r rune) bool {
-	return unicode.In(r, tableEModifier)
-}
-
-func eBase(r rune) bool {
-	return unicode.In(r, tableEBase)
-}
-
-func ebg(r rune) bool {
-	return unicode.In(r, tableEBaseGAZ)
-}
-
 func extend(r rune) bool {
 	return unicode.In(r, tableExtend)
 }
@@ -170,8 +202,8 @@ func format(r rune) bool {
 	return unicode.In(r, tableFormat)
 }
 
-func glueAfterZWJ(r rune) bool {
-	return unicode.In(r, tableGlueAfterZWJ)
+func extendedPictographic(r rune) bool {
+	return unicode.In(r, tableExtendedPictographic)
 }
 
 func newline(r rune) bool {
@@ -184,135 +216,202 @@ func ri(r rune) bool {
 
 // ReadWord returns a single word from a wordReader's source.
 func (wr *wordReader) ReadWord() (string, error) {
+	word, err := wr.ReadWordBytes()
+	return string(word), err
+}
+
+// ReadWordBytes returns a single word from a wordReader's source, without
+// allocating. The word is only valid until the next read.
+func (wr *wordReader) ReadWordBytes() ([]byte, error) {
 	for {
-		r, _, err := wr.ReadRune()
+		r, size, err := wr.ReadRune()
 		if err == io.EOF && wr.Buf.Len() > 0 {
 			return wr.emitWord()
 		}
 
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
-		lastRune, lastRuneLiteral, secondToLastRune := wr.lastRune()
-		nextRune := wr.peekRune()
+		at := wr.pos
+		wr.advance(r, size)
+
+		// the next rune is only read when needed so that words are returned
+		// without waiting on more content from the source
+		c := newContext(wr.Buf.Bytes(), r, wr.peek)
 
-		switch {
-		// Do not break within CRLF.
-		case lastRuneLiteral == carriageReturn && r == lineFeed:
-			// WB3	CR	×	LF
+		join := joins(c)
+		if wr.tailoring != nil {
+			join = wr.tailoring(c, join)
+		}
+
+		if join {
 			_, _ = wr.Buf.WriteRune(r) // #nosec
+			continue
+		}
 
-		// Otherwise break before and after Newlines (including CR and LF)
+		return wr.emitWordPushRune(r, at)
+	}
+}
 
-		case newline(lastRune) || lastRune == carriageReturn || lastRune == lineFeed:
-			// WB3a	(Newline | CR | LF)	÷
-			return wr.emitWordPushRune(r)
-		case newline(r) || r == carriageReturn || r == lineFeed:
-			// WB3b	÷	(Newline | CR | LF)
-			return wr.emitWordPushRune(r)
+// joins reports whether the rune of c continues the word before it rather than
+// starting another
+func joins(c Context) bool {
+	switch {
+	// Do not break within CRLF.
+	case c.Last == carriageReturn && c.Rune == lineFeed:
+		// WB3	CR	×	LF
+		return true
 
-		// Do not break within emoji zwj sequences.
+	// Otherwise break before and after Newlines (including CR and LF)
 
-		case lastRune == zwj && (glueAfterZWJ(r) || ebg(r)):
-			// WB3c	ZWJ	×	(Glue_After_Zwj | EBG)
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case newline(c.Prev) || c.Prev == carriageReturn || c.Prev == lineFeed:
+		// WB3a	(Newline | CR | LF)	÷
+		return false
+	case newline(c.Rune) || c.Rune == carriageReturn || c.Rune == lineFeed:
+		// WB3b	÷	(Newline | CR | LF)
+		return false
 
-		// Ignore Format and Extend characters, except after sot, CR, LF, and
-		// Newline. (See Section 6.2, Replacing Ignore Rules.) This also has the
-		// effect of: Any × (Format | Extend | ZWJ
+	// Do not break within emoji zwj sequences.
 
-		case extend(r) || format(r) || r == zwj:
-			// WB4	X (Extend | Format | ZWJ)*	→	X
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case c.Last == zwj && extendedPictographic(c.Rune):
+		// WB3c	ZWJ	×	\p{Extended_Pictographic}
+		return true
 
-		// Do not break between most letters.
+	// Ignore Format and Extend characters, except after sot, CR, LF, and
+	// Newline. (See Section 6.2, Replacing Ignore Rules.) This also has the
+	// effect of: Any × (Format | Extend | ZWJ
 
-		case ahLetter(lastRune) && ahLetter(r):
-			// WB5	AHLetter	×	AHLetter
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case extend(c.Rune) || format(c.Rune) || c.Rune == zwj:
+		// WB4	X (Extend | Format | ZWJ)*	→	X
+		return true
 
-		// Do not break letters across certain punctuation.
+	// Do not break between most letters.
 
-		case ahLetter(lastRune) && (midLetter(r) || midNumLetQ(r)) && ahLetter(nextRune):
-			// WB6	AHLetter	×	(MidLetter | MidNumLetQ) AHLetter
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case ahLetter(secondToLastRune) && (midLetter(lastRune) || midNumLetQ(lastRune)) && ahLetter(r):
-			// WB7	AHLetter (MidLetter | MidNumLetQ)	×	AHLetter
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case hebrew(lastRune) && r == singleQuote:
-			// WB7a		Hebrew_Letter	×	Single_Quote
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case hebrew(lastRune) && r == doubleQuote && hebrew(nextRune):
-			// WB7b		Hebrew_Letter	×	Double_Quote Hebrew_Letter
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case hebrew(secondToLastRune) && lastRune == doubleQuote && hebrew(r):
-			// WB7c		Hebrew_Letter Double_Quote	×	Hebrew_Letter
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case ahLetter(c.Prev) && ahLetter(c.Rune):
+		// WB5	AHLetter	×	AHLetter
+		return true
 
-		// Do not break within sequences of digits, or digits adjacent to
-		// letters (“3a”, or “A3”).
+	// Do not break letters across certain punctuation.
 
-		case numeric(lastRune) && numeric(r):
-			// WB8	Numeric	×	Numeric
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case ahLetter(lastRune) && numeric(r):
-			// WB9	AHLetter	×	Numeric
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case numeric(lastRune) && ahLetter(r):
-			// WB10	Numeric	×	AHLetter
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case ahLetter(c.Prev) && (midLetter(c.Rune) || midNumLetQ(c.Rune)) && ahLetter(c.Next()):
+		// WB6	AHLetter	×	(MidLetter | MidNumLetQ) AHLetter
+		return true
+	case ahLetter(c.Prev2) && (midLetter(c.Prev) || midNumLetQ(c.Prev)) && ahLetter(c.Rune):
+		// WB7	AHLetter (MidLetter | MidNumLetQ)	×	AHLetter
+		return true
+	case hebrew(c.Prev) && c.Rune == singleQuote:
+		// WB7a		Hebrew_Letter	×	Single_Quote
+		return true
+	case hebrew(c.Prev) && c.Rune == doubleQuote && hebrew(c.Next()):
+		// WB7b		Hebrew_Letter	×	Double_Quote Hebrew_Letter
+		return true
+	case hebrew(c.Prev2) && c.Prev == doubleQuote && hebrew(c.Rune):
+		// WB7c		Hebrew_Letter Double_Quote	×	Hebrew_Letter
+		return true
 
-		// Do not break within sequences, such as “3.2” or “3,456.789”.
+	// Do not break within sequences of digits, or digits adjacent to
+	// letters (“3a”, or “A3”).
 
-		case numeric(secondToLastRune) && (midnum(lastRune) || midNumLetQ(lastRune)) && numeric(r):
-			// WB11	Numeric (MidNum | MidNumLetQ)	×	Numeric
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case numeric(lastRune) && (midnum(r) || midNumLetQ(r)) && numeric(nextRune):
-			// WB12	Numeric	×	(MidNum | MidNumLetQ) Numeric
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case numeric(c.Prev) && numeric(c.Rune):
+		// WB8	Numeric	×	Numeric
+		return true
+	case ahLetter(c.Prev) && numeric(c.Rune):
+		// WB9	AHLetter	×	Numeric
+		return true
+	case numeric(c.Prev) && ahLetter(c.Rune):
+		// WB10	Numeric	×	AHLetter
+		return true
 
-		// Do not break between Katakana.
+	// Do not break within sequences, such as “3.2” or “3,456.789”.
 
-		case katakana(lastRune) && katakana(r):
-			// WB13	Katakana	×	Katakana
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case numeric(c.Prev2) && (midnum(c.Prev) || midNumLetQ(c.Prev)) && numeric(c.Rune):
+		// WB11	Numeric (MidNum | MidNumLetQ)	×	Numeric
+		return true
+	case numeric(c.Prev) && (midnum(c.Rune) || midNumLetQ(c.Rune)) && numeric(c.Next()):
+		// WB12	Numeric	×	(MidNum | MidNumLetQ) Numeric
+		return true
 
-		// Do not break from extenders.
+	// Do not break between Katakana.
 
-		case (ahLetter(lastRune) || numeric(lastRune) || katakana(lastRune) || extendNumLet(lastRune)) && extendNumLet(r):
-			// WB13a	(AHLetter | Numeric | Katakana | ExtendNumLet)	×	ExtendNumLet
-			_, _ = wr.Buf.WriteRune(r) // #nosec
-		case extendNumLet(lastRune) && (ahLetter(r) || numeric(r) || katakana(r)):
-			// WB13b	ExtendNumLet	×	(AHLetter | Numeric | Katakana)
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case katakana(c.Prev) && katakana(c.Rune):
+		// WB13	Katakana	×	Katakana
+		return true
 
-		// Do not break within emoji modifier sequences.
+	// Do not break from extenders.
 
-		case (eBase(lastRune) || ebg(lastRune)) && eModifier(r):
-			// WB14	(E_Base | EBG)	×	E_Modifier
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case (ahLetter(c.Prev) || numeric(c.Prev) || katakana(c.Prev) || extendNumLet(c.Prev)) && extendNumLet(c.Rune):
+		// WB13a	(AHLetter | Numeric | Katakana | ExtendNumLet)	×	ExtendNumLet
+		return true
+	case extendNumLet(c.Prev) && (ahLetter(c.Rune) || numeric(c.Rune) || katakana(c.Rune)):
+		// WB13b	ExtendNumLet	×	(AHLetter | Numeric | Katakana)
+		return true
 
-		// Do not break within emoji flag sequences. That is, do not break
-		// between regional indicator (RI) symbols if there is an odd number of
-		// RI characters before the break point.
+	// Do not break within emoji flag sequences. That is, do not break
+	// between regional indicator (RI) symbols if there is an odd number of
+	// RI characters before the break point.
 
-		case !ri(secondToLastRune) && ri(lastRune) && ri(r):
-			// WB15	^ (RI RI)* RI	×	RI
-			// WB16	[^RI] (RI RI)* RI	×	RI
-			_, _ = wr.Buf.WriteRune(r) // #nosec
+	case !ri(c.Prev2) && ri(c.Prev) && ri(c.Rune):
+		// WB15	^ (RI RI)* RI	×	RI
+		// WB16	[^RI] (RI RI)* RI	×	RI
+		return true
 
-		default:
-			return wr.emitWordPushRune(r)
-		}
+	default:
+		return false
+	}
+}
+
+// advance moves the position past r, of the given size
+func (wr *wordReader) advance(r rune, size int) {
+	wr.pos.Offset += int64(size)
+	wr.pos.RuneOffset++
+
+	if !wr.lines {
+		return
 	}
+
+	switch {
+	case wr.prev == carriageReturn && r == lineFeed:
+		// CRLF is a single line break
+	case newline(r) || r == carriageReturn || r == lineFeed:
+		wr.pos.Line++
+		wr.pos.Column = 1
+	default:
+		wr.pos.Column++
+	}
+
+	wr.prev = r
 }
 
+// peekRune returns the next rune that isn't ignored by WB4, without consuming
+// it, or utf8.RuneError if there isn't one. Only as much is read as is needed,
+// up to the size of the buffer.
 func (wr *wordReader) peekRune() rune {
-	r, _, err := wr.ReadRune()
-	if err != nil {
-		return utf8.RuneError
+	for n := wr.Buffered(); ; n++ {
+		next, err := wr.Peek(n)
+		if r, ok := firstRune(next); ok {
+			return r
+		}
+
+		if err != nil {
+			return utf8.RuneError
+		}
+
+		if buffered := wr.Buffered(); buffered > n {
+			n = buffered - 1
+		}
+	}
+}
+
+// firstRune returns the first rune of b that isn't ignored by WB4, and whether
+// there is one, which there isn't when b ends before one is complete
+func firstRune(b []byte) (rune, bool) {
+	for len(b) > 0 && utf8.FullRune(b) {
+		r, size := utf8.DecodeRune(b)
+		if !ignorable(r) {
+			return r, true
+		}
+		b = b[size:]
 	}
-	_ = wr.UnreadRune() // #nosec
-	return r
+	return utf8.RuneError, false
 }
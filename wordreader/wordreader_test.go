@@ -5,6 +5,7 @@ package wordreader
 
 import (
 	"io"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -38,8 +39,14 @@ var tests = []splitTest{
 	// http://unicode.org/reports/tr29/#WB3c
 	{"👨‍👩‍👧", []string{"👨‍👩‍👧"}},
 	{"‍👨‍👩‍👧‍", []string{"‍👨‍👩‍👧‍"}}, // there's a ZWJ fore & aft here
+	{"🧑‍🤝‍🧑 👩🏽‍🚀", []string{"🧑‍🤝‍🧑", " ", "👩🏽‍🚀"}},
+	{"🏳️‍🌈🛑", []string{"🏳️‍🌈", "🛑"}},
 
-	// TODO: http://unicode.org/reports/tr29/#WB4
+	// http://unicode.org/reports/tr29/#WB4
+	{"foo\u0301\u200dbar baz", []string{"foo\u0301\u200dbar", " ", "baz"}},
+	{"can'\u0308t go", []string{"can'\u0308t", " ", "go"}},
+	{"3.\u00ad2", []string{"3.\u00ad2"}},
+	{"foo\n\u0301bar", []string{"foo", "\n", "\u0301", "bar"}},
 
 	// http://unicode.org/reports/tr29/#WB5
 	{"fooכbar baz", []string{"fooכbar", " ", "baz"}},
@@ -69,7 +76,10 @@ var tests = []splitTest{
 	// http://unicode.org/reports/tr29/#WB13
 	{"ツアひらがな", []string{"ツア", "ひ", "ら", "が", "な"}},
 
-	// TODO: add tests for WB13a, WB13b & WB14
+	// TODO: add tests for WB13a & WB13b
+
+	// emoji modifiers are Extend, replacing WB14
+	{"👍🏽👍🏿 a🏽", []string{"👍🏽", "👍🏿", " ", "a🏽"}},
 
 	// http://unicode.org/reports/tr29/#WB15
 	// http://unicode.org/reports/tr29/#WB16
@@ -95,7 +105,7 @@ var tests = []splitTest{
 	// letter
 	{"\u05d0\u05d0\"\u05d0", []string{"\u05d0\u05d0\"\u05d0"}},
 	{"foo'-dot", []string{"foo", "'", "-", "dot"}},
-	{"Āll A\u0301ll test\u00adi\u00adfy\u00ading test·\u00adi2\u00adfyア\u00ading", []string{"Āll", " ", "A\u0301ll", " ", "test\u00adi\u00adfy\u00ading", " ", "test", "·\u00ad", "i2\u00adfy", "ア\u00ad", "ing"}},
+	{"Āll A\u0301ll test\u00adi\u00adfy\u00ading test·\u00adi2\u00adfyア\u00ading", []string{"Āll", " ", "A\u0301ll", " ", "test\u00adi\u00adfy\u00ading", " ", "test·\u00adi2\u00adfy", "ア\u00ad", "ing"}},
 	{"ア'", []string{"ア", "'"}},
 	{"foo\u202fbar格\u202f尔", []string{"foo\u202fbar", "格", "\u202f", "尔"}},
 	{"كنت أردت أن أقر", []string{"كنت", " ", "أردت", " ", "أن", " ", "أقر"}},
@@ -165,3 +175,139 @@ func TestWordSplitter(t *testing.T) {
 		}
 	})
 }
+
+// pipeReader returns the content of r and then blocks, as with a pipe that
+// hasn't been closed
+type pipeReader struct {
+	io.Reader
+	block chan struct{}
+}
+
+func (r pipeReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	if err == io.EOF {
+		<-r.block
+	}
+	return n, err
+}
+
+func TestReadWordNoLookahead(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	wr := New(pipeReader{Reader: strings.NewReader("foo bar\n"), block: block})
+
+	for _, word := range []string{"foo", " ", "bar"} {
+		readWord, err := wr.ReadWord()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if readWord != word {
+			t.Errorf("%s != %s", readWord, word)
+		}
+	}
+}
+
+func TestUnicodeVersion(t *testing.T) {
+	major := func(version string) int {
+		n, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	// letters new in each version, which are only in the tables from then on
+	for _, v := range []struct {
+		version string
+		letter  rune
+	}{
+		{"9.0.0", '\U0001E900'},  // ADLAM CAPITAL LETTER ALIF
+		{"10.0.0", '\u0860'},     // SYRIAC LETTER MALAYALAM NGA
+		{"11.0.0", '\u1C90'},     // GEORGIAN MTAVRULI CAPITAL LETTER AN
+		{"12.0.0", '\U0001E2C0'}, // WANCHO LETTER AA
+		{"16.0.0", '\U00010D50'}, // GARAY CAPITAL LETTER A
+		{"17.0.0", '\U00010940'}, // SIDETIC LETTER N01
+	} {
+		expect := major(v.version) <= major(UnicodeVersion)
+
+		if got := ahLetter(v.letter); got != expect {
+			t.Errorf("%U of unicode %s: %v != %v with unicode %s tables", v.letter, v.version, got, expect, UnicodeVersion)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	tr := NewTokenReader(strings.NewReader("foo bar\nbaz"), WithLines())
+
+	// stopping partway, with content still buffered
+	if _, err := tr.ReadWord(); err != nil {
+		t.Fatal(err)
+	}
+
+	tr.Reset(strings.NewReader("one\ntwo three"))
+
+	for _, v := range []struct {
+		word string
+		pos  Position
+	}{
+		{"one", Position{Offset: 0, RuneOffset: 0, Line: 1, Column: 1}},
+		{"\n", Position{Offset: 3, RuneOffset: 3, Line: 1, Column: 4}},
+		{"two", Position{Offset: 4, RuneOffset: 4, Line: 2, Column: 1}},
+		{" ", Position{Offset: 7, RuneOffset: 7, Line: 2, Column: 4}},
+		{"three", Position{Offset: 8, RuneOffset: 8, Line: 2, Column: 5}},
+	} {
+		word, err := tr.ReadWord()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if word != v.word || tr.Position() != v.pos {
+			t.Errorf("%q %s != %q %s", word, tr.Position(), v.word, v.pos)
+		}
+	}
+
+	if _, err := tr.ReadWord(); err != io.EOF {
+		t.Errorf("%v != io.EOF", err)
+	}
+}
+
+func TestReadWordBytes(t *testing.T) {
+	for _, test := range tests {
+		tr := NewTokenReader(strings.NewReader(test.str))
+
+		for _, word := range test.words {
+			got, err := tr.ReadWordBytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != word {
+				t.Errorf("%s != %s", got, word)
+			}
+		}
+
+		if got, err := tr.ReadWordBytes(); err != io.EOF || len(got) != 0 {
+			t.Errorf("%q, %v != io.EOF", got, err)
+		}
+	}
+
+	str := strings.Repeat("foo bar, ", 100)
+	sr := strings.NewReader(str)
+	tr := NewTokenReader(sr)
+
+	allocs := testing.AllocsPerRun(1, func() {
+		sr.Reset(str)
+		tr.Reset(sr)
+		for {
+			if _, err := tr.ReadWordBytes(); err != nil {
+				break
+			}
+		}
+	})
+
+	if allocs > 0 {
+		t.Errorf("%v allocations reading words", allocs)
+	}
+}
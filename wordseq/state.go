@@ -0,0 +1,263 @@
+package wordseq
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// stateMagic begins every Counter written by WriteTo
+var stateMagic = []byte("nrcounts")
+
+// stateVersion is the version written by WriteTo. Version 1 didn't include
+// the MaxError of approximate counts, and version 2 only the first context of
+// each sequence.
+const stateVersion = 3
+
+// maxStateString is the longest word or context that will be read, and
+// maxStateSeqSize the largest sequence size
+const (
+	maxStateString  = 1 << 24
+	maxStateSeqSize = 1 << 16
+)
+
+// IsState reports whether r begins with a Counter written by WriteTo, without
+// consuming any of it
+func IsState(r *bufio.Reader) bool {
+	b, _ := r.Peek(len(stateMagic)) // #nosec
+	return bytes.Equal(b, stateMagic)
+}
+
+// countingWriter counts the bytes written to it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.w.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+// WriteTo writes every sequence counted so far, along with the totals, to w in
+// a compact binary form that can be read by ReadCounter. Each distinct word is
+// only written once.
+func (c *Counter) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	uvarint := func(v uint64) {
+		// write errors are retained by bw and returned by Flush
+		_, _ = bw.Write(buf[:binary.PutUvarint(buf, v)]) // #nosec
+	}
+	str := func(s string) {
+		uvarint(uint64(len(s)))
+		_, _ = bw.WriteString(s) // #nosec
+	}
+
+	// the words are assigned ids in the order they are first seen
+	ids := map[string]int{}
+	var words []string
+	for _, seq := range c.h {
+		for _, word := range seq.Words {
+			if _, ok := ids[word]; !ok {
+				ids[word] = len(words)
+				words = append(words, word)
+			}
+		}
+	}
+
+	_, _ = bw.Write(stateMagic) // #nosec
+	uvarint(stateVersion)
+	uvarint(uint64(c.seqSize))
+	uvarint(uint64(c.total))
+	uvarint(uint64(c.words))
+	uvarint(uint64(c.bytes))
+	uvarint(uint64(c.maxError))
+
+	uvarint(uint64(len(words)))
+	for _, word := range words {
+		str(word)
+	}
+
+	uvarint(uint64(len(c.h)))
+	for _, seq := range c.h {
+		uvarint(uint64(seq.Count))
+		for _, word := range seq.Words {
+			uvarint(uint64(ids[word]))
+		}
+		str(seq.Context)
+
+		uvarint(uint64(len(seq.Contexts)))
+		for _, s := range seq.Contexts {
+			str(s)
+		}
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// stateReader reads the values written by WriteTo, retaining the first error
+type stateReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+// error returns the error that stopped reading, if any
+func (r *stateReader) error() error {
+	if r.err == nil {
+		return nil
+	}
+
+	if r.err == io.EOF {
+		return fmt.Errorf("invalid state: %v", io.ErrUnexpectedEOF)
+	}
+
+	return fmt.Errorf("invalid state: %v", r.err)
+}
+
+func (r *stateReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	var v uint64
+	v, r.err = binary.ReadUvarint(r.r)
+	return v
+}
+
+func (r *stateReader) int() int {
+	v := r.uvarint()
+	if v > uint64(^uint(0)>>1) && r.err == nil {
+		r.err = fmt.Errorf("value out of range")
+	}
+	return int(v)
+}
+
+func (r *stateReader) str() string {
+	n := r.uvarint()
+	if r.err != nil {
+		return ""
+	}
+
+	// no word or context is anywhere near this long
+	if n > maxStateString {
+		r.err = fmt.Errorf("string too long")
+		return ""
+	}
+
+	b := make([]byte, n)
+	_, r.err = io.ReadFull(r.r, b)
+	return string(b)
+}
+
+// ReadCounter reads a Counter written by WriteTo from r, using opts as with
+// NewCounter. Options that affect how words are counted have no effect on the
+// sequences that were read. It returns io.EOF if r is empty. Several counters
+// written one after another can be read from the same *bufio.Reader.
+func ReadCounter(r *bufio.Reader, opts ...Option) (*Counter, error) {
+	magic := make([]byte, len(stateMagic))
+	if n, err := io.ReadFull(r, magic); err != nil {
+		if n == 0 && err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("invalid state: %v", err)
+	}
+
+	if !bytes.Equal(magic, stateMagic) {
+		return nil, fmt.Errorf("invalid state: not a saved counter")
+	}
+
+	sr := &stateReader{r: r}
+
+	version := sr.uvarint()
+	if sr.err == nil && (version < 1 || version > stateVersion) {
+		return nil, fmt.Errorf("invalid state: unsupported version %d", version)
+	}
+
+	seqSize := sr.int()
+	total := sr.int()
+	words := sr.int()
+	size := sr.int()
+
+	var maxError int
+	if version > 1 {
+		maxError = sr.int()
+	}
+	if sr.err == nil && seqSize > maxStateSeqSize {
+		sr.err = fmt.Errorf("sequence size %d out of range", seqSize)
+	}
+	if sr.err != nil {
+		return nil, sr.error()
+	}
+
+	c, err := NewCounter(seqSize, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.total = total
+	c.words = words
+	c.bytes = int64(size)
+	c.maxError = maxError
+
+	vocab := make([]string, 0, 1024)
+	for i, n := 0, sr.int(); i < n && sr.err == nil; i++ {
+		vocab = append(vocab, sr.str())
+	}
+
+	for i, n := 0, sr.int(); i < n && sr.err == nil; i++ {
+		seq := &Sequence{
+			Count: sr.int(),
+			Words: make([]string, seqSize),
+		}
+
+		for j := range seq.Words {
+			id := sr.int()
+			if sr.err == nil && id >= len(vocab) {
+				sr.err = fmt.Errorf("unknown word id %d", id)
+			}
+			if sr.err != nil {
+				break
+			}
+			seq.Words[j] = vocab[id]
+		}
+
+		seq.Context = sr.str()
+
+		if version > 2 {
+			for j, n := 0, sr.int(); j < n && sr.err == nil; j++ {
+				seq.Contexts = append(seq.Contexts, sr.str())
+			}
+		}
+
+		if sr.err != nil {
+			break
+		}
+
+		k := key(seq.Words)
+		if _, ok := c.cache[k]; ok {
+			return nil, fmt.Errorf("invalid state: duplicate sequence %v", seq.Words)
+		}
+
+		c.cache[k] = seq
+		heap.Push(c.h, seq)
+	}
+
+	if sr.err != nil {
+		return nil, sr.error()
+	}
+
+	c.prune()
+
+	return c, nil
+}
@@ -0,0 +1,119 @@
+package wordseq
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func topString(t *testing.T, c *Counter) string {
+	t.Helper()
+
+	var ret []string
+	err := c.Top(c.Len(), func(seq *Sequence) error {
+		s := fmt.Sprintf("%d %v %q", seq.Count, seq.Words, seq.Context)
+		if len(seq.Contexts) > 0 {
+			s += fmt.Sprintf(" %q", seq.Contexts)
+		}
+		ret = append(ret, s)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Join(ret, "\n")
+}
+
+func TestState(t *testing.T) {
+	const text = "the cat sat on the mat and the cat sat on the hat"
+
+	var buf bytes.Buffer
+	var counters []*Counter
+
+	for _, size := range []int{1, 3} {
+		c, err := NewCounter(size, WithContext(1), WithContexts(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = c.Add(strings.NewReader(text)); err != nil {
+			t.Fatal(err)
+		}
+
+		n, err := c.WriteTo(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n == 0 {
+			t.Error("nothing was written")
+		}
+
+		counters = append(counters, c)
+	}
+
+	data := buf.Bytes()
+	r := bufio.NewReader(bytes.NewReader(data))
+
+	if !IsState(r) {
+		t.Error("state wasn't recognized")
+	}
+
+	if IsState(bufio.NewReader(strings.NewReader(text))) {
+		t.Error("text was recognized as state")
+	}
+
+	for _, c := range counters {
+		read, err := ReadCounter(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if read.SeqSize() != c.SeqSize() || read.Total() != c.Total() ||
+			read.Words() != c.Words() || read.Bytes() != c.Bytes() || read.Len() != c.Len() {
+			t.Errorf("size %d: totals weren't restored", c.SeqSize())
+		}
+
+		if got, expect := topString(t, read), topString(t, c); got != expect {
+			t.Errorf("size %d:\n%s\n!=\n%s", c.SeqSize(), got, expect)
+		}
+
+		// the read counter continues counting where the saved one stopped
+		if err = read.Add(strings.NewReader(text)); err != nil {
+			t.Fatal(err)
+		}
+
+		if read.Total() != 2*c.Total() {
+			t.Errorf("size %d: total(%d) != %d", c.SeqSize(), read.Total(), 2*c.Total())
+		}
+	}
+
+	if _, err := ReadCounter(r); err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+
+	// options are applied to the read sequences
+	c, err := ReadCounter(bufio.NewReader(bytes.NewReader(data)), WithMinCount(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := topString(t, c); strings.Count(got, "\n") != 3 {
+		t.Errorf("min count wasn't applied:\n%s", got)
+	}
+
+	for _, bad := range [][]byte{
+		data[:len(data)/4],
+		[]byte("not counts"),
+		append([]byte("nrcounts"), 99),
+	} {
+		if _, err = ReadCounter(bufio.NewReader(bytes.NewReader(bad))); err == nil || err == io.EOF {
+			t.Errorf("%q: expected error, got %v", bad, err)
+		}
+	}
+}
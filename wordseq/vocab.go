@@ -0,0 +1,114 @@
+package wordseq
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A Vocabulary assigns sequential integer IDs, starting at 0, to words in the
+// order they are first seen
+type Vocabulary struct {
+	ids   map[string]int
+	words []string
+}
+
+// NewVocabulary returns an empty Vocabulary
+func NewVocabulary() *Vocabulary {
+	return &Vocabulary{
+		ids: map[string]int{},
+	}
+}
+
+// ReadVocabulary reads a vocabulary in the format written by WriteTo
+func ReadVocabulary(r io.Reader) (*Vocabulary, error) {
+	v := NewVocabulary()
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		v.ID(s.Text())
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// ID returns the ID of word, assigning a new one if it hasn't been seen before
+func (v *Vocabulary) ID(word string) int {
+	if id, ok := v.ids[word]; ok {
+		return id
+	}
+
+	id := len(v.words)
+	v.ids[word] = id
+	v.words = append(v.words, word)
+	return id
+}
+
+// Word returns the word with the given ID
+func (v *Vocabulary) Word(id int) (string, bool) {
+	if id < 0 || id >= len(v.words) {
+		return "", false
+	}
+
+	return v.words[id], true
+}
+
+// Len returns the number of words in the vocabulary
+func (v *Vocabulary) Len() int {
+	return len(v.words)
+}
+
+// WriteTo writes the vocabulary to w, one word per line, where the line number
+// (starting at 0) is the ID of the word
+func (v *Vocabulary) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, word := range v.words {
+		m, err := fmt.Fprintln(w, word)
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// WriteIDs writes the IDs of words to w as a single line of space separated
+// integers, assigning IDs to any words not yet in the vocabulary
+func (v *Vocabulary) WriteIDs(w io.Writer, words []string) error {
+	ids := make([]string, len(words))
+	for i, word := range words {
+		ids[i] = strconv.Itoa(v.ID(word))
+	}
+
+	_, err := fmt.Fprintln(w, strings.Join(ids, " "))
+	return err
+}
+
+// DecodeIDs converts a line written by WriteIDs back into words
+func (v *Vocabulary) DecodeIDs(line string) ([]string, error) {
+	fields := strings.Fields(line)
+	words := make([]string, len(fields))
+
+	for i, field := range fields {
+		id, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+
+		var ok bool
+		if words[i], ok = v.Word(id); !ok {
+			return nil, fmt.Errorf("unknown word id: %d", id)
+		}
+	}
+
+	return words, nil
+}
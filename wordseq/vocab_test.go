@@ -0,0 +1,94 @@
+package wordseq
+
+// Copyright 2018 Joshua Rubin <joshua@rubixconsulting.com>
+// Released under the MIT license
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestVocabulary(t *testing.T) {
+	v := NewVocabulary()
+
+	if v.ID("a") != 0 || v.ID("b") != 1 || v.ID("a") != 0 {
+		t.Error("ids were not assigned sequentially")
+	}
+
+	if v.Len() != 2 {
+		t.Errorf("len(%d) != 2", v.Len())
+	}
+
+	if word, ok := v.Word(1); !ok || word != "b" {
+		t.Errorf("word(%s) != b", word)
+	}
+
+	if _, ok := v.Word(2); ok {
+		t.Error("expected unknown id to not be found")
+	}
+
+	if _, err := v.DecodeIDs("0 2"); err == nil {
+		t.Error("expected error decoding unknown id")
+	}
+
+	if _, err := v.DecodeIDs("0 x"); err == nil {
+		t.Error("expected error decoding invalid id")
+	}
+}
+
+func TestIDStream(t *testing.T) {
+	text := "The cat sat on the mat, and the cat sat down."
+
+	v := NewVocabulary()
+	var ids bytes.Buffer
+	var windows [][]string
+	var err error
+
+	_, err = Process(strings.NewReader(text), 3, 100, WithWindows(func(words []string) {
+		windows = append(windows, append([]string(nil), words...))
+		if werr := v.WriteIDs(&ids, words); werr != nil {
+			err = werr
+		}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(windows) != 9 {
+		t.Fatalf("got %d windows, expected 9", len(windows))
+	}
+
+	var vocab bytes.Buffer
+	if _, err = v.WriteTo(&vocab); err != nil {
+		t.Fatal(err)
+	}
+
+	// decode using only the emitted artifacts
+	decoder, err := ReadVocabulary(&vocab)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decoder.Len() != 7 {
+		t.Errorf("vocabulary len(%d) != 7", decoder.Len())
+	}
+
+	s := bufio.NewScanner(&ids)
+	var i int
+	for ; s.Scan(); i++ {
+		words, err := decoder.DecodeIDs(s.Text())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(words, " ") != strings.Join(windows[i], " ") {
+			t.Errorf("%v != %v", words, windows[i])
+		}
+	}
+
+	if i != len(windows) {
+		t.Errorf("decoded %d windows, expected %d", i, len(windows))
+	}
+}
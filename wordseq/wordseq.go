@@ -8,10 +8,17 @@ import (
 	"crypto/sha1"
 	"fmt"
 	"io"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
+	"golang.org/x/text/cases"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
 	"jrubin.io/nr/wordreader"
 )
 
@@ -20,7 +27,218 @@ type Sequence struct {
 	Words []string
 	Count int
 
+	// Context is a snippet of the original text surrounding the first
+	// occurrence of the sequence. It is only set when the WithContext option
+	// is used.
+	Context string
+
+	// Contexts are snippets of the original text surrounding the first
+	// occurrences of the sequence, the first of which is Context. They are
+	// only set when the WithContexts option is used.
+	Contexts []string
+
 	index int
+	total int
+}
+
+// PerMillion returns how often the sequence occurs per million sequences in the
+// content, making counts comparable across differently sized content
+func (s *Sequence) PerMillion() float64 {
+	if s.total == 0 {
+		return 0
+	}
+
+	return float64(s.Count) * 1e6 / float64(s.total)
+}
+
+// Percent returns the share of all sequences in the content that are this
+// sequence, from 0 to 100
+func (s *Sequence) Percent() float64 {
+	return s.PerMillion() / 1e4
+}
+
+type options struct {
+	context       int
+	contexts      int
+	windows       func([]string)
+	words         func(string)
+	stopwords     []string
+	caseSensitive bool
+	keepPunct     bool
+	minCount      int
+	filters       []func([]string) bool
+	containing    []string
+	lang          *language.Tag
+	maxSeqs       int
+	timing        *Timing
+
+	// lower is the case mapping for lang, it is created for each Counter since
+	// it isn't safe for concurrent use
+	lower *cases.Caser
+
+	// collator orders the words of sequences by the collation of lang, and
+	// likewise isn't safe for concurrent use
+	collator *collate.Collator
+}
+
+// An Option configures optional behavior of Process
+type Option func(*options)
+
+// WithContext causes Process to capture up to n words before and after the
+// first occurrence of each sequence into Sequence.Context
+func WithContext(n int) Option {
+	return func(o *options) {
+		o.context = n
+	}
+}
+
+// WithContexts causes Process to capture the context of up to k occurrences of
+// each sequence into Sequence.Contexts, rather than only the first. The number
+// of words around each is set by WithContext.
+func WithContexts(k int) Option {
+	return func(o *options) {
+		o.contexts = k
+	}
+}
+
+// WithWindows causes Process to call fn with the normalized words of every
+// sequence as it is read, including repeated ones. The slice is only valid for
+// the duration of the call.
+func WithWindows(fn func(words []string)) Option {
+	return func(o *options) {
+		o.windows = fn
+	}
+}
+
+// WithWords causes Process to call fn with the normalized form of every word,
+// including stopwords, as it is read. It may be used more than once, in which
+// case every fn is called.
+func WithWords(fn func(word string)) Option {
+	return func(o *options) {
+		if prev := o.words; prev != nil {
+			o.words = func(word string) {
+				prev(word)
+				fn(word)
+			}
+			return
+		}
+		o.words = fn
+	}
+}
+
+// WithStopwords causes Process to drop words from the content before sequences
+// are formed. The words are compared after normalization, so case and
+// punctuation are ignored.
+func WithStopwords(words []string) Option {
+	return func(o *options) {
+		o.stopwords = append(o.stopwords, words...)
+	}
+}
+
+// WithCaseSensitive causes Process to count words that differ only in case,
+// such as "Apple" and "apple", separately. Stopwords are always matched
+// regardless of case.
+func WithCaseSensitive(caseSensitive bool) Option {
+	return func(o *options) {
+		o.caseSensitive = caseSensitive
+	}
+}
+
+// WithLanguage causes Process to use the case mappings of the language tag,
+// such as those for the Turkish dotted and dotless i, when converting words to
+// lower case, and its collation to order sequences that occur equally often
+func WithLanguage(tag language.Tag) Option {
+	return func(o *options) {
+		o.lang = &tag
+	}
+}
+
+// WithKeepPunct causes Process to count words exactly as they are segmented,
+// without stripping punctuation from them (so "don't" and "dont" differ) or
+// discarding words made up only of punctuation (such as ".")
+func WithKeepPunct(keepPunct bool) Option {
+	return func(o *options) {
+		o.keepPunct = keepPunct
+	}
+}
+
+// WithMinCount causes Process to omit sequences that occur fewer than n times
+// from its results
+func WithMinCount(n int) Option {
+	return func(o *options) {
+		o.minCount = n
+	}
+}
+
+// WithMaxSequences limits a Counter to about n distinct sequences, bounding the
+// memory it uses. Whenever there would be more, all but the n/2 most frequent
+// are discarded, so the counts become approximate: any count may be lower than
+// the true count by up to MaxError, and sequences that occur fewer times than
+// that may be missing.
+func WithMaxSequences(n int) Option {
+	return func(o *options) {
+		o.maxSeqs = n
+	}
+}
+
+// WithFilter causes Process to omit sequences from its results unless fn returns
+// true for their words. It may be used more than once, in which case every fn
+// must return true.
+func WithFilter(fn func(words []string) bool) Option {
+	return func(o *options) {
+		o.filters = append(o.filters, fn)
+	}
+}
+
+// WithContaining causes Process to omit sequences from its results unless at
+// least one of their words is one of words. The words are compared after
+// normalization.
+func WithContaining(words []string) Option {
+	return func(o *options) {
+		o.containing = append(o.containing, words...)
+	}
+}
+
+// Timing is the time spent counting content, by stage
+type Timing struct {
+	// Segment is the time spent reading words, which includes reading the
+	// content they are segmented from
+	Segment time.Duration
+
+	// Count is the time spent counting the sequences of the words
+	Count time.Duration
+}
+
+// WithTiming causes the time spent counting content to be added to t. When
+// several counters count the same content, only the first one's t is used, and
+// it includes the time spent by all of them.
+func WithTiming(t *Timing) Option {
+	return func(o *options) {
+		o.timing = t
+	}
+}
+
+// pendingContext is a context snippet that is still waiting on the words that
+// follow the sequence
+type pendingContext struct {
+	seq       *Sequence
+	words     []string
+	remaining int
+
+	// all is set when the contexts of every occurrence are kept
+	all bool
+}
+
+func (p *pendingContext) finish() {
+	s := strings.Join(p.words, " ")
+
+	if p.seq.Context == "" {
+		p.seq.Context = s
+	}
+
+	if p.all {
+		p.seq.Contexts = append(p.seq.Contexts, s)
+	}
 }
 
 type seqHeap map[int]*Sequence
@@ -38,13 +256,44 @@ func (h seqHeap) Less(i, j int) bool {
 	}
 
 	// next sort on words lexicographically
-	for k := range h[i].Words {
-		if h[i].Words[k] != h[j].Words[k] {
-			return h[i].Words[k] < h[j].Words[k]
+	return Less(h[i].Words, h[j].Words)
+}
+
+// Less reports whether the words a sort lexicographically before b
+func Less(a, b []string) bool {
+	for k := range a {
+		if k >= len(b) {
+			return false
+		}
+
+		if a[k] != b[k] {
+			return a[k] < b[k]
 		}
 	}
 
-	return false
+	return len(a) < len(b)
+}
+
+// CollateLess reports whether the words a sort before b by the collation of
+// col. Words that collate equally, but differ, are ordered lexicographically so
+// that the order is always the same.
+func CollateLess(col *collate.Collator, a, b []string) bool {
+	for k := range a {
+		if k >= len(b) {
+			return false
+		}
+
+		if a[k] == b[k] {
+			continue
+		}
+
+		if cmp := col.CompareString(a[k], b[k]); cmp != 0 {
+			return cmp < 0
+		}
+		return a[k] < b[k]
+	}
+
+	return len(a) < len(b)
 }
 
 func (h seqHeap) Swap(i, j int) {
@@ -66,99 +315,701 @@ func (h seqHeap) Pop() interface{} {
 	return item
 }
 
-// basically the same as unicode.IsSpace but works on strings and includes CRLF
-func isSpace(s string) bool {
-	switch s {
-	case " ", "\t", "\n", "\v", "\f", "\r", "\u0085", "\u00a0", "\r\n", "\n\r":
-		return true
+// isSpace reports whether a token of the given kind separates words
+func isSpace(kind wordreader.Kind) bool {
+	return kind == wordreader.Space || kind == wordreader.Newline
+}
+
+// isSpaceToken reports whether token is made up of space or line breaks. Most
+// tokens start with an ascii character, which is enough to tell unless it is a
+// space that isn't alone, so they don't need to be classified.
+func isSpaceToken(token []byte) bool {
+	if len(token) > 0 && token[0] < utf8.RuneSelf {
+		switch token[0] {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+			if len(token) == 1 {
+				return true
+			}
+		default:
+			return false
+		}
 	}
-	return false
+
+	return isSpace(wordreader.ClassifyBytes(token))
 }
 
 // Process the content and build a list of the most frequent word sequences
-func Process(n io.Reader, seqSize, topN int) ([]*Sequence, error) {
-	if seqSize < 1 || topN < 1 {
-		return nil, fmt.Errorf("invalid argument")
+func Process(n io.Reader, seqSize, topN int, opts ...Option) ([]*Sequence, error) {
+	var ret []*Sequence
+
+	err := ProcessFunc(n, seqSize, topN, func(seq *Sequence) error {
+		ret = append(ret, seq)
+		return nil
+	}, opts...)
+
+	if err != nil {
+		return nil, err
 	}
 
-	wr := wordreader.New(n)
+	return ret, nil
+}
+
+// ProcessFunc processes the content like Process, but rather than building a
+// list, calls fn with each of the most frequent word sequences, in order, as
+// soon as it is known. If fn returns an error, processing stops and the error
+// is returned.
+func ProcessFunc(n io.Reader, seqSize, topN int, fn func(*Sequence) error, opts ...Option) error {
+	if topN < 1 {
+		return fmt.Errorf("invalid argument")
+	}
+
+	c, err := NewCounter(seqSize, opts...)
+	if err != nil {
+		return err
+	}
+
+	if err = c.Add(n); err != nil {
+		return err
+	}
+
+	return c.Top(topN, fn)
+}
 
-	window := make([]string, 0, seqSize+1)
+// A Counter counts the word sequences in content. Counts from multiple pieces
+// of content, or multiple Counters, can be combined.
+type Counter struct {
+	seqSize int
+	o       options
 
 	// cache needed to index by sequence words
-	cache := map[[sha1.Size]byte]*Sequence{}
+	cache map[[sha1.Size]byte]*Sequence
 
 	// heap needed to keep sorted sequence counts
+	h seqHeap
+
+	// total number of sequences counted, including repeats
+	total int
+
+	// words and bytes of content read
+	words int
+	bytes int64
+
+	// maxError is the most that any count may be too low by after the least
+	// frequent sequences were discarded to stay within WithMaxSequences
+	maxError int
+
+	// normalized, lower case, stopwords
+	stopwords map[string]bool
+}
+
+// NewCounter returns a Counter of sequences of seqSize words
+func NewCounter(seqSize int, opts ...Option) (*Counter, error) {
+	if seqSize < 1 {
+		return nil, fmt.Errorf("invalid argument")
+	}
+
+	c := Counter{
+		seqSize: seqSize,
+		cache:   map[[sha1.Size]byte]*Sequence{},
+		h:       seqHeap{},
+	}
+
+	for _, opt := range opts {
+		opt(&c.o)
+	}
+
+	if c.o.context < 0 || c.o.contexts < 0 || c.o.minCount < 0 || c.o.maxSeqs < 0 {
+		return nil, fmt.Errorf("invalid argument")
+	}
+
+	if c.o.lang != nil {
+		lower := cases.Lower(*c.o.lang)
+		c.o.lower = &lower
+		c.o.collator = collate.New(*c.o.lang)
+	}
+
+	if len(c.o.stopwords) > 0 {
+		c.stopwords = map[string]bool{}
+		for _, word := range c.o.stopwords {
+			if w := strings.ToLower(c.o.normalize(word)); w != "" {
+				c.stopwords[w] = true
+			}
+		}
+	}
+
+	if len(c.o.containing) > 0 {
+		containing := map[string]bool{}
+		for _, word := range c.o.containing {
+			containing[c.o.normalize(word)] = true
+		}
+
+		c.o.filters = append(c.o.filters, func(words []string) bool {
+			for _, w := range words {
+				if containing[w] {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	heap.Init(c.h)
+
+	return &c, nil
+}
+
+// SeqSize returns the number of words per sequence
+func (c *Counter) SeqSize() int {
+	return c.seqSize
+}
+
+// Total returns the number of sequences counted, including repeats
+func (c *Counter) Total() int {
+	return c.total
+}
+
+// Less reports whether the words a sort before b, by the collation of the
+// WithLanguage tag if there is one, or otherwise lexicographically
+func (c *Counter) Less(a, b []string) bool {
+	if c.o.collator == nil {
+		return Less(a, b)
+	}
+	return CollateLess(c.o.collator, a, b)
+}
+
+// Normalize returns the form of word that sequences are made of, or "" if it
+// isn't part of any sequence, as with space, punctuation and stopwords
+func (c *Counter) Normalize(word string) string {
+	if isSpaceToken([]byte(word)) {
+		return ""
+	}
+
+	w := c.o.normalize(word)
+	if c.stopwords != nil && c.stopwords[strings.ToLower(w)] {
+		return ""
+	}
+	return w
+}
+
+// Words returns the number of words read, including stopwords
+func (c *Counter) Words() int {
+	return c.words
+}
+
+// Bytes returns the number of bytes of content read
+func (c *Counter) Bytes() int64 {
+	return c.bytes
+}
+
+// Len returns the number of distinct sequences counted
+func (c *Counter) Len() int {
+	return len(c.cache)
+}
+
+// Approximate reports whether sequences were discarded to stay within
+// WithMaxSequences, making the counts approximate
+func (c *Counter) Approximate() bool {
+	return c.maxError > 0
+}
+
+// MaxError returns the most that any count may be lower than the true count by
+// when the counts are approximate
+func (c *Counter) MaxError() int {
+	return c.maxError
+}
+
+// prune discards all but the maxSeqs/2 most frequent sequences once there are
+// more than maxSeqs
+func (c *Counter) prune() {
+	if c.o.maxSeqs == 0 || len(c.cache) <= c.o.maxSeqs {
+		return
+	}
+
+	counts := make([]int, 0, len(c.cache))
+	for _, seq := range c.cache {
+		counts = append(counts, seq.Count)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+
+	// sequences as frequent as the first that isn't kept are also discarded,
+	// since there is nothing to choose between them
+	threshold := counts[c.o.maxSeqs/2]
+
 	h := seqHeap{}
+	for k, seq := range c.cache {
+		if seq.Count <= threshold {
+			delete(c.cache, k)
+			continue
+		}
+
+		seq.index = len(h)
+		h[len(h)] = seq
+	}
+
 	heap.Init(h)
+	c.h = h
+
+	// a discarded sequence that is seen again starts counting from 0
+	c.maxError += threshold
+}
+
+// keep reports whether the sequence should be included in results
+func (o *options) keep(seq *Sequence) bool {
+	if seq.Count < o.minCount {
+		return false
+	}
+
+	for _, fn := range o.filters {
+		if !fn(seq.Words) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalize returns the form of word that is counted, without punctuation,
+// unless it is kept, and in lower case, unless case sensitive
+func (o *options) normalize(word string) string {
+	w := o.appendNormalized(nil, []byte(word))
+	if o.lower != nil && !o.caseSensitive {
+		return o.lower.String(string(w))
+	}
+	return string(w)
+}
+
+// appendNormalized appends word to dst without punctuation, unless it is kept,
+// and in lower case, unless case sensitive or the case mapping of a language
+// is still to be applied
+func (o *options) appendNormalized(dst, word []byte) []byte {
+	for i := 0; i < len(word); {
+		r, size := utf8.DecodeRune(word[i:])
+		i += size
 
+		if !o.keepPunct && unicode.IsPunct(r) {
+			// ignore punctuation
+			continue
+		}
+
+		if !o.caseSensitive && o.lower == nil {
+			// convert to lower case
+			// TODO(jrubin) should runes such as 'Ü' be equivalent to 'u'
+			r = unicode.ToLower(r)
+		}
+
+		dst = utf8.AppendRune(dst, r)
+	}
+
+	return dst
+}
+
+// appendLower appends b to dst in lower case, as strings.ToLower would
+func appendLower(dst, b []byte) []byte {
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		dst = utf8.AppendRune(dst, unicode.ToLower(r))
+		i += size
+	}
+	return dst
+}
+
+// appendCased appends src to dst with the case mapping of lower applied
+func appendCased(lower *cases.Caser, dst, src []byte) []byte {
 	for {
-		// read in a word at a time
-		word, err := wr.ReadWord()
+		lower.Reset()
+
+		n, _, err := lower.Transform(dst[len(dst):cap(dst)], src, true)
+		if err != transform.ErrShortDst {
+			return dst[:len(dst)+n]
+		}
+
+		dst = slices.Grow(dst, 2*len(src)+utf8.UTFMax)
+	}
+}
+
+// key returns the cache key for the words of a sequence
+func key(words []string) [sha1.Size]byte {
+	// sha1 to ensure key size is fixed while remaining fast enough
+	return sha1.Sum(appendJoined(nil, words))
+}
+
+// appendJoined appends the words of a sequence to dst as they are hashed for
+// its key
+func appendJoined(dst []byte, words []string) []byte {
+	// NULL can't exist in the word, so use it as a joiner
+	for i, w := range words {
+		if i > 0 {
+			dst = append(dst, 0)
+		}
+		dst = append(dst, w...)
+	}
+	return dst
+}
+
+// Add counts the sequences in the content read from n. Sequences do not span
+// separate calls to Add.
+func (c *Counter) Add(n io.Reader) error {
+	return AddAll(n, c)
+}
+
+// AddAll counts the sequences in the content read from n with each of
+// counters, such as ones for different sequence sizes, reading it only once
+func AddAll(n io.Reader, counters ...*Counter) error {
+	wr := wordreader.NewTokenReader(n)
+
+	adders := make([]*adder, len(counters))
+	for i, c := range counters {
+		adders[i] = &adder{
+			Counter: c,
+			window:  make([]string, 0, c.seqSize),
+		}
+	}
+
+	// finish any snippets that ran out of content
+	defer func() {
+		for _, a := range adders {
+			a.finish()
+		}
+	}()
+
+	var timing *Timing
+	if len(counters) > 0 {
+		timing = counters[0].o.timing
+	}
+
+	for {
+		var start time.Time
+		if timing != nil {
+			start = time.Now()
+		}
+
+		// read in a word at a time, which is only made a string once it is
+		// normalized and counted
+		b, err := wr.ReadWordBytes()
+
+		if timing != nil {
+			now := time.Now()
+			timing.Segment += now.Sub(start)
+			start = now
+		}
 
 		if err == io.EOF {
-			break // finished reading words
+			return nil // finished reading words
 		}
 
 		if err != nil {
-			return nil, err
+			return err
+		}
+
+		for _, c := range counters {
+			c.bytes += int64(len(b))
 		}
 
-		if isSpace(word) {
+		if isSpaceToken(b) {
 			continue
 		}
 
-		w := make([]rune, 0, utf8.RuneCountInString(word))
-		for _, r := range word {
-			if unicode.IsPunct(r) {
-				// ignore punctuation
-				continue
-			}
+		for _, a := range adders {
+			a.add(b)
+		}
 
-			// convert to lower case
-			// TODO(jrubin) should runes such as 'Ü' be equivalent to 'u'
-			w = append(w, unicode.ToLower(r))
+		if timing != nil {
+			timing.Count += time.Since(start)
 		}
+	}
+}
 
-		if len(w) == 0 {
-			continue
+// adder holds the state of a Counter while it is reading content
+type adder struct {
+	*Counter
+
+	window []string
+
+	// history holds the original form of the most recent words, enough to
+	// cover the sequence and the context that precedes it
+	history []string
+	pending []*pendingContext
+
+	// norm, cased and lower hold the normalized form of the word being added,
+	// and joined the key of its sequence, their memory is reused by the next
+	norm, cased, lower, joined []byte
+}
+
+func (a *adder) finish() {
+	for _, p := range a.pending {
+		p.finish()
+	}
+}
+
+// normalize returns the form of token that is counted, as options.normalize
+// does, in memory that is reused by the next call
+func (a *adder) normalize(token []byte) []byte {
+	a.norm = a.o.appendNormalized(a.norm[:0], token)
+	if a.o.lower == nil || a.o.caseSensitive {
+		return a.norm
+	}
+
+	a.cased = appendCased(a.o.lower, a.cased[:0], a.norm)
+	return a.cased
+}
+
+// add counts the sequence that ends with token, if there is one
+func (a *adder) add(token []byte) {
+	w := a.normalize(token)
+	if len(w) == 0 {
+		return
+	}
+
+	a.words++
+
+	if a.o.words != nil {
+		a.o.words(string(w))
+	}
+
+	if a.o.context > 0 {
+		word := string(token)
+
+		a.history = append(a.history, word)
+		if len(a.history) > a.o.context+a.seqSize {
+			a.history = a.history[1:]
 		}
 
-		window = append(window, string(w))
+		// feed the word to any snippets still waiting on trailing context
+		for len(a.pending) > 0 && a.pending[0].remaining == 0 {
+			a.pending[0].finish()
+			a.pending = a.pending[1:]
+		}
+		for _, p := range a.pending {
+			p.words = append(p.words, word)
+			p.remaining--
+		}
+	}
 
-		if len(window) < seqSize {
-			// the window isn't yet full, continue adding words until it is
-			continue
+	if a.stopwords != nil {
+		a.lower = appendLower(a.lower[:0], w)
+		if a.stopwords[string(a.lower)] {
+			// stopwords remain in the context, but not in sequences
+			return
 		}
+	}
 
-		seq := window       // seq holds the current N word sequence
-		window = window[1:] // slide the window to the right
+	if len(a.window) == a.seqSize {
+		// slide the window to the right, reusing its memory
+		copy(a.window, a.window[1:])
+		a.window = a.window[:a.seqSize-1]
+	}
 
-		// sha1 to ensure key size is fixed while remaining fast enough
-		// NULL can't exist in the word, so use it as a joiner
-		key := sha1.Sum([]byte(strings.Join(seq, "\x00")))
+	a.window = append(a.window, string(w))
 
-		if item, ok := cache[key]; ok {
-			item.Count++
-			heap.Fix(h, item.index)
-			continue
+	if len(a.window) < a.seqSize {
+		// the window isn't yet full, continue adding words until it is
+		return
+	}
+
+	seq := a.window // seq holds the current N word sequence
+
+	a.total++
+
+	if a.o.windows != nil {
+		a.o.windows(seq)
+	}
+
+	a.joined = appendJoined(a.joined[:0], seq)
+	k := sha1.Sum(a.joined)
+
+	if item, ok := a.cache[k]; ok {
+		item.Count++
+		heap.Fix(a.h, item.index)
+
+		if item.Count <= a.o.contexts {
+			a.capture(item)
+		}
+		return
+	}
+
+	// the window is reused, so only new sequences copy its words
+	item := &Sequence{
+		Words: append([]string(nil), seq...),
+		Count: 1,
+	}
+	a.cache[k] = item
+	heap.Push(a.h, item)
+	a.prune()
+
+	a.capture(item)
+}
+
+// capture begins the context of the occurrence of seq that was just read
+func (a *adder) capture(seq *Sequence) {
+	if a.o.context == 0 {
+		return
+	}
+
+	a.pending = append(a.pending, &pendingContext{
+		seq:       seq,
+		words:     append([]string(nil), a.history...),
+		remaining: a.o.context,
+		all:       a.o.contexts > 0,
+	})
+}
+
+// Merge adds the counts from o into c. Where both have context for a sequence,
+// the one from c is kept, followed by those of o up to the WithContexts limit.
+func (c *Counter) Merge(o *Counter) {
+	c.total += o.total
+	c.words += o.words
+	c.bytes += o.bytes
+	c.maxError += o.maxError
+
+	defer c.prune()
+
+	for k, seq := range o.cache {
+		c.insert(k, seq)
+	}
+}
+
+// AddSequence adds count occurrences of words, a sequence that was counted
+// elsewhere, such as in saved results, to c as though it had counted them.
+// Where c already has context for the sequence, it is kept.
+func (c *Counter) AddSequence(words []string, count int, context string) error {
+	if len(words) != c.seqSize {
+		return fmt.Errorf("sequence of %d words added to a counter of size %d", len(words), c.seqSize)
+	}
+
+	if count < 1 {
+		return fmt.Errorf("invalid count: %d", count)
+	}
+
+	c.total += count
+	c.insert(key(words), &Sequence{
+		Words:   append([]string(nil), words...),
+		Count:   count,
+		Context: context,
+	})
+	c.prune()
+
+	return nil
+}
+
+// insert adds the count and contexts of seq to the sequence with key k, adding
+// it to the cache and heap if it hasn't been seen before
+func (c *Counter) insert(k [sha1.Size]byte, seq *Sequence) {
+	if item, ok := c.cache[k]; ok {
+		item.Count += seq.Count
+		if item.Context == "" {
+			item.Context = seq.Context
+		}
+		for _, s := range seq.Contexts {
+			if len(item.Contexts) >= c.o.contexts {
+				break
+			}
+			item.Contexts = append(item.Contexts, s)
+		}
+		heap.Fix(c.h, item.index)
+		return
+	}
+
+	item := &Sequence{
+		Words:    seq.Words,
+		Count:    seq.Count,
+		Context:  seq.Context,
+		Contexts: seq.Contexts,
+	}
+	c.cache[k] = item
+	heap.Push(c.h, item)
+}
+
+// Top calls fn with each of the topN most frequent sequences counted so far, in
+// order, stopping early at any that occur fewer than the WithMinCount times and
+// skipping any excluded by WithFilter. The sequences passed to fn are copies
+// whose counts and contexts are unaffected by further counting, but they share
+// their Words and Contexts with the counter, so fn must not modify them. If fn
+// returns an error, it is returned immediately.
+func (c *Counter) Top(topN int, fn func(*Sequence) error) error {
+	var popped []*Sequence
+
+	// restore the heap when done
+	defer func() {
+		for _, item := range popped {
+			heap.Push(c.h, item)
+		}
+	}()
+
+	// the heap is ordered by count so the remaining sequences can be skipped
+	// once the minimum is reached
+	for n := 0; n < topN && c.h.Len() > 0 && c.h[0].Count >= c.o.minCount; {
+		ties := []*Sequence{heap.Pop(c.h).(*Sequence)}
+
+		// the heap orders the words of equal counts lexicographically, so
+		// they are collated once they have all been popped
+		if c.o.collator != nil {
+			for c.h.Len() > 0 && c.h[0].Count == ties[0].Count {
+				ties = append(ties, heap.Pop(c.h).(*Sequence))
+			}
+
+			sort.Slice(ties, func(i, j int) bool {
+				return c.Less(ties[i].Words, ties[j].Words)
+			})
 		}
+		popped = append(popped, ties...)
+
+		for _, item := range ties {
+			if n == topN {
+				break
+			}
+
+			if !c.o.keep(item) {
+				continue
+			}
+			n++
+
+			seq := *item
+			seq.total = c.total
 
-		item := &Sequence{
-			Words: seq,
-			Count: 1,
+			if err := fn(&seq); err != nil {
+				return err
+			}
 		}
-		cache[key] = item
-		heap.Push(h, item)
 	}
 
-	// build the return slice limited to the topN most frequent sequences
+	return nil
+}
 
-	ret := make([]*Sequence, 0, topN)
+// Bottom calls fn with each of the bottomN least frequent sequences counted so
+// far, in order, skipping any that occur fewer than the WithMinCount times or
+// are excluded by WithFilter. The sequences passed to fn are copies whose
+// counts and contexts are unaffected by further counting, but they share their
+// Words and Contexts with the counter, so fn must not modify them. If fn
+// returns an error, it is returned immediately.
+func (c *Counter) Bottom(bottomN int, fn func(*Sequence) error) error {
+	seqs := make([]*Sequence, 0, len(c.h))
+	for _, item := range c.h {
+		if c.o.keep(item) {
+			seqs = append(seqs, item)
+		}
+	}
 
-	for len(ret) < topN && h.Len() > 0 {
-		ret = append(ret, heap.Pop(h).(*Sequence))
+	// sort from the least to the most frequent, but still with the words of
+	// equal counts in order
+	sort.Slice(seqs, func(i, j int) bool {
+		if seqs[i].Count != seqs[j].Count {
+			return seqs[i].Count < seqs[j].Count
+		}
+		return c.Less(seqs[i].Words, seqs[j].Words)
+	})
+
+	if bottomN > len(seqs) {
+		bottomN = len(seqs)
 	}
 
-	return ret, nil
+	for _, item := range seqs[:bottomN] {
+		seq := *item
+		seq.total = c.total
+
+		if err := fn(&seq); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
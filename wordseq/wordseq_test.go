@@ -4,11 +4,17 @@ package wordseq
 // Released under the MIT license
 
 import (
+	"bufio"
 	"bytes"
 	"container/heap"
+	"fmt"
 	"io"
 	"strings"
 	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"jrubin.io/nr/wordreader"
 )
 
 func TestHeap(t *testing.T) {
@@ -151,3 +157,768 @@ func TestProcess(t *testing.T) {
 		}
 	}
 }
+
+func TestProcessContext(t *testing.T) {
+	for _, v := range []struct {
+		text    string
+		context int
+		expect  map[string]string
+	}{{
+		text:    "one two three four five six seven",
+		context: 2,
+		expect: map[string]string{
+			"one two three":   "one two three four five",
+			"three four five": "one two three four five six seven",
+			"five six seven":  "three four five six seven",
+			"four five six":   "two three four five six seven",
+			"two three four":  "one two three four five six",
+		},
+	}, {
+		text:    "Foo, bar baz. x foo bar baz y",
+		context: 1,
+		expect: map[string]string{
+			"foo bar baz": "Foo bar baz x",
+			"bar baz x":   "Foo bar baz x foo",
+			"baz x foo":   "bar baz x foo bar",
+			"x foo bar":   "baz x foo bar baz",
+			"bar baz y":   "foo bar baz y",
+		},
+	}} {
+		seqs, err := Process(strings.NewReader(v.text), 3, 100, WithContext(v.context))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(seqs) != len(v.expect) {
+			t.Fatalf("got %d sequences, expected %d", len(seqs), len(v.expect))
+		}
+
+		for _, seq := range seqs {
+			key := strings.Join(seq.Words, " ")
+			if seq.Context != v.expect[key] {
+				t.Errorf("context for %q: %q != %q", key, seq.Context, v.expect[key])
+			}
+		}
+	}
+
+	if _, err := Process(strings.NewReader("a b c"), 3, 100, WithContext(-1)); err == nil {
+		t.Error("expected error for negative context")
+	}
+}
+
+func TestProcessContexts(t *testing.T) {
+	seqs, err := Process(strings.NewReader("a x b a y b a z b"), 1, 1, WithContext(1), WithContexts(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seqs) != 1 || strings.Join(seqs[0].Words, " ") != "a" {
+		t.Fatalf("unexpected sequences: %v", seqs)
+	}
+
+	expect := []string{"a x", "b a y"}
+	if got := seqs[0].Contexts; fmt.Sprint(got) != fmt.Sprint(expect) || seqs[0].Context != expect[0] {
+		t.Errorf("%q, %q != %q", seqs[0].Context, got, expect)
+	}
+
+	a, err := NewCounter(1, WithContext(1), WithContexts(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewCounter(1, WithContext(1), WithContexts(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Add(strings.NewReader("a b")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Add(strings.NewReader("c a d a e")); err != nil {
+		t.Fatal(err)
+	}
+
+	a.Merge(b)
+
+	err = a.Top(1, func(seq *Sequence) error {
+		if expect := []string{"a b", "c a d"}; fmt.Sprint(seq.Contexts) != fmt.Sprint(expect) {
+			t.Errorf("merged %q != %q", seq.Contexts, expect)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = NewCounter(1, WithContexts(-1)); err == nil {
+		t.Error("expected error for negative contexts")
+	}
+}
+
+func TestCounterNormalize(t *testing.T) {
+	c, err := NewCounter(2, WithStopwords([]string{"the"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for word, expect := range map[string]string{
+		"Cat": "cat",
+		"The": "",
+		" ":   "",
+		",":   "",
+	} {
+		if got := c.Normalize(word); got != expect {
+			t.Errorf("%q: %q != %q", word, got, expect)
+		}
+	}
+}
+
+func TestProcessStopwords(t *testing.T) {
+	text := "The cat sat on the mat. The cat sat on THE hat."
+
+	seqs, err := Process(strings.NewReader(text), 2, 100, WithStopwords([]string{"the", "On"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := map[string]int{
+		"cat sat": 2,
+		"sat mat": 1,
+		"mat cat": 1,
+		"sat hat": 1,
+	}
+
+	if len(seqs) != len(expect) {
+		t.Fatalf("got %d sequences, expected %d", len(seqs), len(expect))
+	}
+
+	for _, seq := range seqs {
+		key := strings.Join(seq.Words, " ")
+		if seq.Count != expect[key] {
+			t.Errorf("count for %q: %d != %d", key, seq.Count, expect[key])
+		}
+	}
+
+	seqs, err = Process(strings.NewReader("a the b"), 2, 100, WithStopwords([]string{"the"}), WithContext(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seqs) != 1 || seqs[0].Context != "a the b" {
+		t.Errorf("stopwords should remain in the context: %+v", seqs)
+	}
+}
+
+func TestProcessCaseSensitive(t *testing.T) {
+	text := "Apple pie. apple pie. THE Apple pie."
+
+	for _, v := range []struct {
+		caseSensitive bool
+		expect        map[string]int
+	}{{
+		caseSensitive: false,
+		expect:        map[string]int{"apple pie": 3, "pie apple": 2},
+	}, {
+		caseSensitive: true,
+		expect:        map[string]int{"Apple pie": 2, "apple pie": 1, "pie apple": 1, "pie Apple": 1},
+	}} {
+		seqs, err := Process(strings.NewReader(text), 2, 100,
+			WithCaseSensitive(v.caseSensitive),
+			WithStopwords([]string{"the"}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, seq := range seqs {
+			key := strings.Join(seq.Words, " ")
+			if seq.Count != v.expect[key] {
+				t.Errorf("case sensitive %v: count for %q: %d != %d", v.caseSensitive, key, seq.Count, v.expect[key])
+			}
+		}
+	}
+}
+
+func TestProcessKeepPunct(t *testing.T) {
+	text := "I don't know. I dont know!"
+
+	for _, v := range []struct {
+		keepPunct bool
+		expect    map[string]int
+	}{{
+		keepPunct: false,
+		expect:    map[string]int{"i dont": 2, "dont know": 2, "know i": 1},
+	}, {
+		keepPunct: true,
+		expect: map[string]int{
+			"i don't":    1,
+			"don't know": 1,
+			"know .":     1,
+			". i":        1,
+			"i dont":     1,
+			"dont know":  1,
+			"know !":     1,
+		},
+	}} {
+		seqs, err := Process(strings.NewReader(text), 2, 100, WithKeepPunct(v.keepPunct))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(seqs) != len(v.expect) {
+			t.Errorf("keep punct %v: got %d sequences, expected %d", v.keepPunct, len(seqs), len(v.expect))
+		}
+
+		for _, seq := range seqs {
+			key := strings.Join(seq.Words, " ")
+			if seq.Count != v.expect[key] {
+				t.Errorf("keep punct %v: count for %q: %d != %d", v.keepPunct, key, seq.Count, v.expect[key])
+			}
+		}
+	}
+}
+
+func TestProcessMinCount(t *testing.T) {
+	seqs, err := Process(strings.NewReader("a b a b a b c d c d e"), 2, 100, WithMinCount(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{"a b:3", "b a:2", "c d:2"}
+	got := make([]string, len(seqs))
+	for i, seq := range seqs {
+		got[i] = fmt.Sprintf("%s:%d", strings.Join(seq.Words, " "), seq.Count)
+	}
+
+	if strings.Join(got, ",") != strings.Join(expect, ",") {
+		t.Errorf("%v != %v", got, expect)
+	}
+
+	if _, err = Process(strings.NewReader("a b"), 2, 100, WithMinCount(-1)); err == nil {
+		t.Error("expected error for negative min count")
+	}
+}
+
+func TestPerMillion(t *testing.T) {
+	// 4 sequences in total: [a b c] twice, [b c a] and [c a b] once each
+	seqs, err := Process(strings.NewReader("a b c a b c"), 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []float64{500000, 250000, 250000}
+
+	if len(seqs) != len(expect) {
+		t.Fatalf("got %d sequences, expected %d", len(seqs), len(expect))
+	}
+
+	for i, seq := range seqs {
+		if seq.total != 4 {
+			t.Errorf("total(%d) != 4", seq.total)
+		}
+
+		if pm := seq.PerMillion(); pm != expect[i] {
+			t.Errorf("%v: %f != %f", seq.Words, pm, expect[i])
+		}
+
+		if pct := seq.Percent(); pct != expect[i]/1e4 {
+			t.Errorf("%v: %f%% != %f%%", seq.Words, pct, expect[i]/1e4)
+		}
+	}
+
+	var seq Sequence
+	if seq.PerMillion() != 0 {
+		t.Error("per million of an empty sequence wasn't 0")
+	}
+}
+
+func TestProcessFunc(t *testing.T) {
+	stop := fmt.Errorf("stop")
+
+	var seen int
+	err := ProcessFunc(strings.NewReader("a b c d e f"), 3, 100, func(seq *Sequence) error {
+		seen++
+		if seen == 2 {
+			return stop
+		}
+		return nil
+	})
+
+	if err != stop {
+		t.Errorf("err(%v) != stop", err)
+	}
+
+	if seen != 2 {
+		t.Errorf("seen(%d) != 2", seen)
+	}
+}
+
+func top(t *testing.T, c *Counter, n int) []*Sequence {
+	t.Helper()
+
+	var ret []*Sequence
+	err := c.Top(n, func(seq *Sequence) error {
+		ret = append(ret, seq)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ret
+}
+
+func TestCounter(t *testing.T) {
+	if _, err := NewCounter(0); err == nil {
+		t.Error("expected error for invalid sequence size")
+	}
+
+	c, err := NewCounter(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Add(strings.NewReader("a b a b")); err != nil {
+		t.Fatal(err)
+	}
+
+	first := top(t, c, 100)
+
+	// top doesn't consume the counts
+	if !seqsEqual(first, top(t, c, 100)) {
+		t.Error("second call to top returned different results")
+	}
+
+	// sequences don't span calls to add
+	if err = c.Add(strings.NewReader("b a")); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []*Sequence{{
+		Words: []string{"a", "b"},
+		Count: 2,
+	}, {
+		Words: []string{"b", "a"},
+		Count: 2,
+	}}
+
+	if got := top(t, c, 100); !seqsEqual(expect, got) {
+		t.Errorf("unexpected sequences: %v", got)
+	}
+
+	// earlier results are unaffected by later counting
+	if first[1].Count != 1 {
+		t.Error("earlier result was modified")
+	}
+
+	if c.Total() != 4 || c.Len() != 2 {
+		t.Errorf("total(%d) != 4 || len(%d) != 2", c.Total(), c.Len())
+	}
+}
+
+func TestCounterMerge(t *testing.T) {
+	a, err := NewCounter(2, WithContext(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewCounter(2, WithContext(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Add(strings.NewReader("x y z")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Add(strings.NewReader("y z y z w")); err != nil {
+		t.Fatal(err)
+	}
+
+	a.Merge(b)
+
+	expect := []*Sequence{{
+		Words: []string{"y", "z"},
+		Count: 3,
+	}, {
+		Words: []string{"x", "y"},
+		Count: 1,
+	}, {
+		Words: []string{"z", "w"},
+		Count: 1,
+	}, {
+		Words: []string{"z", "y"},
+		Count: 1,
+	}}
+
+	got := top(t, a, 100)
+	if !seqsEqual(expect, got) {
+		t.Fatalf("unexpected sequences: %v", got)
+	}
+
+	if got[0].Context != "x y z" || got[2].Context != "y z w" {
+		t.Errorf("unexpected contexts: %q, %q", got[0].Context, got[2].Context)
+	}
+
+	if a.Total() != 6 {
+		t.Errorf("total(%d) != 6", a.Total())
+	}
+
+	if got[0].PerMillion() != 500000 {
+		t.Errorf("per million(%f) != 500000", got[0].PerMillion())
+	}
+}
+
+func TestCounterAddSequence(t *testing.T) {
+	c, err := NewCounter(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Add(strings.NewReader("x y z")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.AddSequence([]string{"y", "z"}, 2, "y z"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.AddSequence([]string{"w", "x"}, 1, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []*Sequence{{
+		Words: []string{"y", "z"},
+		Count: 3,
+	}, {
+		Words: []string{"w", "x"},
+		Count: 1,
+	}, {
+		Words: []string{"x", "y"},
+		Count: 1,
+	}}
+
+	got := top(t, c, 100)
+	if !seqsEqual(expect, got) {
+		t.Fatalf("unexpected sequences: %v", got)
+	}
+
+	if c.Total() != 5 {
+		t.Errorf("total(%d) != 5", c.Total())
+	}
+
+	if err = c.AddSequence([]string{"x"}, 1, ""); err == nil {
+		t.Error("expected error for a sequence of the wrong size")
+	}
+
+	if err = c.AddSequence([]string{"x", "y"}, 0, ""); err == nil {
+		t.Error("expected error for a count of 0")
+	}
+}
+
+func TestIsSpaceToken(t *testing.T) {
+	tokens := []string{"\r\n", "  ", " a", "\u00a0", "\u2028", "word", "\r\na", "é", ""}
+	for r := rune(0); r < utf8.RuneSelf; r++ {
+		tokens = append(tokens, string(r))
+	}
+
+	for _, token := range tokens {
+		if got, expect := isSpaceToken([]byte(token)), isSpace(wordreader.Classify(token)); got != expect {
+			t.Errorf("%q: %v != %v", token, got, expect)
+		}
+	}
+}
+
+func TestAddAll(t *testing.T) {
+	var counters []*Counter
+	for size := 1; size <= 3; size++ {
+		c, err := NewCounter(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counters = append(counters, c)
+	}
+
+	if err := AddAll(strings.NewReader("a b a b c"), counters...); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expect := range []string{"a:2", "a b:2", "a b a:1"} {
+		c := counters[i]
+
+		if c.SeqSize() != i+1 {
+			t.Errorf("seq size(%d) != %d", c.SeqSize(), i+1)
+		}
+
+		if c.Total() != 5-i {
+			t.Errorf("size %d: total(%d) != %d", c.SeqSize(), c.Total(), 5-i)
+		}
+
+		if c.Words() != 5 || c.Bytes() != 9 {
+			t.Errorf("size %d: words(%d) != 5 or bytes(%d) != 9", c.SeqSize(), c.Words(), c.Bytes())
+		}
+
+		seq := top(t, c, 1)[0]
+		if got := fmt.Sprintf("%s:%d", strings.Join(seq.Words, " "), seq.Count); got != expect {
+			t.Errorf("size %d: %s != %s", c.SeqSize(), got, expect)
+		}
+	}
+}
+
+func TestWithTiming(t *testing.T) {
+	var timing Timing
+
+	c, err := NewCounter(2, WithTiming(&timing))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Add(strings.NewReader(strings.Repeat("the quick brown fox ", 1000))); err != nil {
+		t.Fatal(err)
+	}
+
+	if timing.Segment <= 0 || timing.Count <= 0 {
+		t.Errorf("segment(%v) and count(%v) should be positive", timing.Segment, timing.Count)
+	}
+}
+
+func TestCounterBottom(t *testing.T) {
+	c, err := NewCounter(1, WithMinCount(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Add(strings.NewReader("a a a b b c c d")); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	err = c.Bottom(10, func(seq *Sequence) error {
+		got = append(got, fmt.Sprintf("%s:%d", strings.Join(seq.Words, " "), seq.Count))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(got, ",") != "b:2,c:2,a:3" {
+		t.Errorf("%v != [b:2 c:2 a:3]", got)
+	}
+
+	if Less([]string{"a", "b"}, []string{"a"}) || !Less([]string{"a"}, []string{"a", "b"}) {
+		t.Error("shorter sequences should sort first")
+	}
+}
+
+func TestProcessFilter(t *testing.T) {
+	seqs, err := Process(strings.NewReader("a b a b a b c d c d"), 2, 2,
+		WithFilter(func(words []string) bool { return words[0] != "a" }),
+		WithFilter(func(words []string) bool { return words[1] != "c" }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, seq := range seqs {
+		got = append(got, fmt.Sprintf("%s:%d", strings.Join(seq.Words, " "), seq.Count))
+	}
+
+	if strings.Join(got, ",") != "b a:2,c d:2" {
+		t.Errorf("%v != [b a:2 c d:2]", got)
+	}
+}
+
+func TestProcessContaining(t *testing.T) {
+	seqs, err := Process(strings.NewReader("the red fox and the blue fox jumped"), 2, 100,
+		WithContaining([]string{"FOX", "jumped!"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, seq := range seqs {
+		got = append(got, strings.Join(seq.Words, " "))
+	}
+
+	expect := "blue fox,fox and,fox jumped,red fox"
+	if strings.Join(got, ",") != expect {
+		t.Errorf("%v != %s", got, expect)
+	}
+}
+
+func TestProcessLanguage(t *testing.T) {
+	for _, v := range []struct {
+		opts   []Option
+		expect string
+	}{
+		{nil, "isparta"},
+		{[]Option{WithLanguage(language.Turkish)}, "ısparta"},
+		{[]Option{WithLanguage(language.Turkish), WithCaseSensitive(true)}, "ISPARTA"},
+	} {
+		seqs, err := Process(strings.NewReader("ISPARTA"), 1, 1, v.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(seqs) != 1 {
+			t.Errorf("%d sequences != 1", len(seqs))
+			continue
+		}
+
+		if seqs[0].Words[0] != v.expect {
+			t.Errorf("%q != %q", seqs[0].Words[0], v.expect)
+		}
+	}
+}
+
+func TestProcessCollation(t *testing.T) {
+	for _, v := range []struct {
+		opts   []Option
+		expect string
+	}{
+		{nil, "the apple zebra"},
+		{[]Option{WithLanguage(language.German)}, "the apple öl"},
+		{[]Option{WithLanguage(language.Swedish)}, "the apple zebra"},
+	} {
+		seqs, err := Process(strings.NewReader("zebra öl the apple the"), 1, 3, v.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, seq := range seqs {
+			got = append(got, seq.Words[0])
+		}
+
+		if strings.Join(got, " ") != v.expect {
+			t.Errorf("%v != %s", got, v.expect)
+		}
+	}
+
+	c, err := NewCounter(1, WithLanguage(language.German))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Add(strings.NewReader("zebra öl apple")); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	err = c.Bottom(3, func(seq *Sequence) error {
+		got = append(got, seq.Words[0])
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(got, " ") != "apple öl zebra" {
+		t.Errorf("bottom: %v != [apple öl zebra]", got)
+	}
+}
+
+func TestCounterMaxSequences(t *testing.T) {
+	c, err := NewCounter(1, WithMaxSequences(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Add(strings.NewReader("a a a a b b b c c d e f g")); err != nil {
+		t.Fatal(err)
+	}
+
+	// e is the fifth sequence, discarding all but the 2 most frequent
+	if got, expect := topString(t, c), "4 [a] \"\"\n3 [b] \"\"\n1 [f] \"\"\n1 [g] \"\""; got != expect {
+		t.Errorf("%q != %q", got, expect)
+	}
+
+	if !c.Approximate() || c.MaxError() != 2 {
+		t.Errorf("approximate(%v) != true || max error(%d) != 2", c.Approximate(), c.MaxError())
+	}
+
+	if c.Total() != 13 {
+		t.Errorf("total(%d) != 13", c.Total())
+	}
+
+	// merging keeps to the limit of the counter merged into
+	exact, err := NewCounter(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = exact.Add(strings.NewReader("h i j")); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Merge(exact)
+
+	if got, expect := topString(t, c), "4 [a] \"\"\n3 [b] \"\""; got != expect {
+		t.Errorf("%q != %q", got, expect)
+	}
+
+	if c.MaxError() != 3 {
+		t.Errorf("max error(%d) != 3", c.MaxError())
+	}
+
+	// saved counts remain approximate
+	var buf bytes.Buffer
+	if _, err = c.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := ReadCounter(bufio.NewReader(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if read.MaxError() != 3 {
+		t.Errorf("read max error(%d) != 3", read.MaxError())
+	}
+
+	// as do sequences that are added
+	for _, word := range []string{"k", "l", "m"} {
+		if err = c.AddSequence([]string{word}, 1, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, expect := topString(t, c), "4 [a] \"\"\n3 [b] \"\""; got != expect {
+		t.Errorf("%q != %q", got, expect)
+	}
+
+	if _, err = NewCounter(1, WithMaxSequences(-1)); err == nil {
+		t.Error("expected error for negative max sequences")
+	}
+}
+
+func TestAddAllAllocs(t *testing.T) {
+	allocs := func(text string) float64 {
+		return testing.AllocsPerRun(10, func() {
+			c, err := NewCounter(1, WithStopwords([]string{"the"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = AddAll(strings.NewReader(text), c); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	base := allocs("a b")
+
+	// space, punctuation and stopwords aren't counted, so they aren't copied
+	if got := allocs("a" + strings.Repeat(" , ... the ; The ", 1000) + "b"); got != base {
+		t.Errorf("%v allocations with uncounted tokens, expected %v", got, base)
+	}
+
+	// a word that is counted again is only made a string
+	few := allocs(strings.Repeat(" Word,", 10))
+	if got := allocs(strings.Repeat(" Word,", 1010)); got > few+1000 {
+		t.Errorf("%v allocations counting 1000 more words, expected at most %v", got, few+1000)
+	}
+}